@@ -0,0 +1,3285 @@
+// Code generated by protoc-gen-go-pulsar. DO NOT EDIT.
+package evmgovv1
+
+import (
+	fmt "fmt"
+	runtime "github.com/cosmos/cosmos-proto/runtime"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoiface "google.golang.org/protobuf/runtime/protoiface"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	anypb "google.golang.org/protobuf/types/known/anypb"
+	io "io"
+	reflect "reflect"
+	sync "sync"
+)
+
+var (
+	md_ExecutionOptions                   protoreflect.MessageDescriptor
+	fd_ExecutionOptions_mode              protoreflect.FieldDescriptor
+	fd_ExecutionOptions_execute_at_height protoreflect.FieldDescriptor
+	fd_ExecutionOptions_execute_at_time   protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_mitosis_evmgov_v1_execution_proto_init()
+	md_ExecutionOptions = File_mitosis_evmgov_v1_execution_proto.Messages().ByName("ExecutionOptions")
+	fd_ExecutionOptions_mode = md_ExecutionOptions.Fields().ByName("mode")
+	fd_ExecutionOptions_execute_at_height = md_ExecutionOptions.Fields().ByName("execute_at_height")
+	fd_ExecutionOptions_execute_at_time = md_ExecutionOptions.Fields().ByName("execute_at_time")
+}
+
+var _ protoreflect.Message = (*fastReflection_ExecutionOptions)(nil)
+
+type fastReflection_ExecutionOptions ExecutionOptions
+
+func (x *ExecutionOptions) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_ExecutionOptions)(x)
+}
+
+func (x *ExecutionOptions) slowProtoReflect() protoreflect.Message {
+	mi := &file_mitosis_evmgov_v1_execution_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_ExecutionOptions_messageType fastReflection_ExecutionOptions_messageType
+var _ protoreflect.MessageType = fastReflection_ExecutionOptions_messageType{}
+
+type fastReflection_ExecutionOptions_messageType struct{}
+
+func (x fastReflection_ExecutionOptions_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_ExecutionOptions)(nil)
+}
+func (x fastReflection_ExecutionOptions_messageType) New() protoreflect.Message {
+	return new(fastReflection_ExecutionOptions)
+}
+func (x fastReflection_ExecutionOptions_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_ExecutionOptions
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_ExecutionOptions) Descriptor() protoreflect.MessageDescriptor {
+	return md_ExecutionOptions
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_ExecutionOptions) Type() protoreflect.MessageType {
+	return _fastReflection_ExecutionOptions_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_ExecutionOptions) New() protoreflect.Message {
+	return new(fastReflection_ExecutionOptions)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_ExecutionOptions) Interface() protoreflect.ProtoMessage {
+	return (*ExecutionOptions)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_ExecutionOptions) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Mode != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.Mode))
+		if !f(fd_ExecutionOptions_mode, value) {
+			return
+		}
+	}
+	if x.ExecuteAtHeight != int64(0) {
+		value := protoreflect.ValueOfInt64(x.ExecuteAtHeight)
+		if !f(fd_ExecutionOptions_execute_at_height, value) {
+			return
+		}
+	}
+	if x.ExecuteAtTime != int64(0) {
+		value := protoreflect.ValueOfInt64(x.ExecuteAtTime)
+		if !f(fd_ExecutionOptions_execute_at_time, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_ExecutionOptions) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "mitosis.evmgov.v1.ExecutionOptions.mode":
+		return x.Mode != 0
+	case "mitosis.evmgov.v1.ExecutionOptions.execute_at_height":
+		return x.ExecuteAtHeight != int64(0)
+	case "mitosis.evmgov.v1.ExecutionOptions.execute_at_time":
+		return x.ExecuteAtTime != int64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mitosis.evmgov.v1.ExecutionOptions"))
+		}
+		panic(fmt.Errorf("message mitosis.evmgov.v1.ExecutionOptions does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ExecutionOptions) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "mitosis.evmgov.v1.ExecutionOptions.mode":
+		x.Mode = 0
+	case "mitosis.evmgov.v1.ExecutionOptions.execute_at_height":
+		x.ExecuteAtHeight = int64(0)
+	case "mitosis.evmgov.v1.ExecutionOptions.execute_at_time":
+		x.ExecuteAtTime = int64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mitosis.evmgov.v1.ExecutionOptions"))
+		}
+		panic(fmt.Errorf("message mitosis.evmgov.v1.ExecutionOptions does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_ExecutionOptions) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "mitosis.evmgov.v1.ExecutionOptions.mode":
+		value := x.Mode
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "mitosis.evmgov.v1.ExecutionOptions.execute_at_height":
+		value := x.ExecuteAtHeight
+		return protoreflect.ValueOfInt64(value)
+	case "mitosis.evmgov.v1.ExecutionOptions.execute_at_time":
+		value := x.ExecuteAtTime
+		return protoreflect.ValueOfInt64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mitosis.evmgov.v1.ExecutionOptions"))
+		}
+		panic(fmt.Errorf("message mitosis.evmgov.v1.ExecutionOptions does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ExecutionOptions) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "mitosis.evmgov.v1.ExecutionOptions.mode":
+		x.Mode = (ExecutionMode)(value.Enum())
+	case "mitosis.evmgov.v1.ExecutionOptions.execute_at_height":
+		x.ExecuteAtHeight = value.Int()
+	case "mitosis.evmgov.v1.ExecutionOptions.execute_at_time":
+		x.ExecuteAtTime = value.Int()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mitosis.evmgov.v1.ExecutionOptions"))
+		}
+		panic(fmt.Errorf("message mitosis.evmgov.v1.ExecutionOptions does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ExecutionOptions) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "mitosis.evmgov.v1.ExecutionOptions.mode":
+		panic(fmt.Errorf("field mode of message mitosis.evmgov.v1.ExecutionOptions is not mutable"))
+	case "mitosis.evmgov.v1.ExecutionOptions.execute_at_height":
+		panic(fmt.Errorf("field execute_at_height of message mitosis.evmgov.v1.ExecutionOptions is not mutable"))
+	case "mitosis.evmgov.v1.ExecutionOptions.execute_at_time":
+		panic(fmt.Errorf("field execute_at_time of message mitosis.evmgov.v1.ExecutionOptions is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mitosis.evmgov.v1.ExecutionOptions"))
+		}
+		panic(fmt.Errorf("message mitosis.evmgov.v1.ExecutionOptions does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_ExecutionOptions) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "mitosis.evmgov.v1.ExecutionOptions.mode":
+		return protoreflect.ValueOfEnum(0)
+	case "mitosis.evmgov.v1.ExecutionOptions.execute_at_height":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "mitosis.evmgov.v1.ExecutionOptions.execute_at_time":
+		return protoreflect.ValueOfInt64(int64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mitosis.evmgov.v1.ExecutionOptions"))
+		}
+		panic(fmt.Errorf("message mitosis.evmgov.v1.ExecutionOptions does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_ExecutionOptions) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in mitosis.evmgov.v1.ExecutionOptions", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_ExecutionOptions) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ExecutionOptions) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_ExecutionOptions) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_ExecutionOptions) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*ExecutionOptions)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Mode != 0 {
+			n += 1 + runtime.Sov(uint64(x.Mode))
+		}
+		if x.ExecuteAtHeight != 0 {
+			n += 1 + runtime.Sov(uint64(x.ExecuteAtHeight))
+		}
+		if x.ExecuteAtTime != 0 {
+			n += 1 + runtime.Sov(uint64(x.ExecuteAtTime))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*ExecutionOptions)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.ExecuteAtTime != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ExecuteAtTime))
+			i--
+			dAtA[i] = 0x18
+		}
+		if x.ExecuteAtHeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ExecuteAtHeight))
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.Mode != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Mode))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*ExecutionOptions)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ExecutionOptions: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ExecutionOptions: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Mode", wireType)
+				}
+				x.Mode = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Mode |= ExecutionMode(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ExecuteAtHeight", wireType)
+				}
+				x.ExecuteAtHeight = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ExecuteAtHeight |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ExecuteAtTime", wireType)
+				}
+				x.ExecuteAtTime = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ExecuteAtTime |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MessageResult          protoreflect.MessageDescriptor
+	fd_MessageResult_raw_msg  protoreflect.FieldDescriptor
+	fd_MessageResult_status   protoreflect.FieldDescriptor
+	fd_MessageResult_error    protoreflect.FieldDescriptor
+	fd_MessageResult_response protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_mitosis_evmgov_v1_execution_proto_init()
+	md_MessageResult = File_mitosis_evmgov_v1_execution_proto.Messages().ByName("MessageResult")
+	fd_MessageResult_raw_msg = md_MessageResult.Fields().ByName("raw_msg")
+	fd_MessageResult_status = md_MessageResult.Fields().ByName("status")
+	fd_MessageResult_error = md_MessageResult.Fields().ByName("error")
+	fd_MessageResult_response = md_MessageResult.Fields().ByName("response")
+}
+
+var _ protoreflect.Message = (*fastReflection_MessageResult)(nil)
+
+type fastReflection_MessageResult MessageResult
+
+func (x *MessageResult) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MessageResult)(x)
+}
+
+func (x *MessageResult) slowProtoReflect() protoreflect.Message {
+	mi := &file_mitosis_evmgov_v1_execution_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MessageResult_messageType fastReflection_MessageResult_messageType
+var _ protoreflect.MessageType = fastReflection_MessageResult_messageType{}
+
+type fastReflection_MessageResult_messageType struct{}
+
+func (x fastReflection_MessageResult_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MessageResult)(nil)
+}
+func (x fastReflection_MessageResult_messageType) New() protoreflect.Message {
+	return new(fastReflection_MessageResult)
+}
+func (x fastReflection_MessageResult_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MessageResult
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MessageResult) Descriptor() protoreflect.MessageDescriptor {
+	return md_MessageResult
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MessageResult) Type() protoreflect.MessageType {
+	return _fastReflection_MessageResult_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MessageResult) New() protoreflect.Message {
+	return new(fastReflection_MessageResult)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MessageResult) Interface() protoreflect.ProtoMessage {
+	return (*MessageResult)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MessageResult) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.RawMsg != "" {
+		value := protoreflect.ValueOfString(x.RawMsg)
+		if !f(fd_MessageResult_raw_msg, value) {
+			return
+		}
+	}
+	if x.Status != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.Status))
+		if !f(fd_MessageResult_status, value) {
+			return
+		}
+	}
+	if x.Error != "" {
+		value := protoreflect.ValueOfString(x.Error)
+		if !f(fd_MessageResult_error, value) {
+			return
+		}
+	}
+	if x.Response != nil {
+		value := protoreflect.ValueOfMessage(x.Response.ProtoReflect())
+		if !f(fd_MessageResult_response, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MessageResult) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "mitosis.evmgov.v1.MessageResult.raw_msg":
+		return x.RawMsg != ""
+	case "mitosis.evmgov.v1.MessageResult.status":
+		return x.Status != 0
+	case "mitosis.evmgov.v1.MessageResult.error":
+		return x.Error != ""
+	case "mitosis.evmgov.v1.MessageResult.response":
+		return x.Response != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mitosis.evmgov.v1.MessageResult"))
+		}
+		panic(fmt.Errorf("message mitosis.evmgov.v1.MessageResult does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MessageResult) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "mitosis.evmgov.v1.MessageResult.raw_msg":
+		x.RawMsg = ""
+	case "mitosis.evmgov.v1.MessageResult.status":
+		x.Status = 0
+	case "mitosis.evmgov.v1.MessageResult.error":
+		x.Error = ""
+	case "mitosis.evmgov.v1.MessageResult.response":
+		x.Response = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mitosis.evmgov.v1.MessageResult"))
+		}
+		panic(fmt.Errorf("message mitosis.evmgov.v1.MessageResult does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MessageResult) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "mitosis.evmgov.v1.MessageResult.raw_msg":
+		value := x.RawMsg
+		return protoreflect.ValueOfString(value)
+	case "mitosis.evmgov.v1.MessageResult.status":
+		value := x.Status
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "mitosis.evmgov.v1.MessageResult.error":
+		value := x.Error
+		return protoreflect.ValueOfString(value)
+	case "mitosis.evmgov.v1.MessageResult.response":
+		value := x.Response
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mitosis.evmgov.v1.MessageResult"))
+		}
+		panic(fmt.Errorf("message mitosis.evmgov.v1.MessageResult does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MessageResult) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "mitosis.evmgov.v1.MessageResult.raw_msg":
+		x.RawMsg = value.Interface().(string)
+	case "mitosis.evmgov.v1.MessageResult.status":
+		x.Status = (MessageStatus)(value.Enum())
+	case "mitosis.evmgov.v1.MessageResult.error":
+		x.Error = value.Interface().(string)
+	case "mitosis.evmgov.v1.MessageResult.response":
+		x.Response = value.Message().Interface().(*anypb.Any)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mitosis.evmgov.v1.MessageResult"))
+		}
+		panic(fmt.Errorf("message mitosis.evmgov.v1.MessageResult does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MessageResult) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "mitosis.evmgov.v1.MessageResult.response":
+		if x.Response == nil {
+			x.Response = new(anypb.Any)
+		}
+		return protoreflect.ValueOfMessage(x.Response.ProtoReflect())
+	case "mitosis.evmgov.v1.MessageResult.raw_msg":
+		panic(fmt.Errorf("field raw_msg of message mitosis.evmgov.v1.MessageResult is not mutable"))
+	case "mitosis.evmgov.v1.MessageResult.status":
+		panic(fmt.Errorf("field status of message mitosis.evmgov.v1.MessageResult is not mutable"))
+	case "mitosis.evmgov.v1.MessageResult.error":
+		panic(fmt.Errorf("field error of message mitosis.evmgov.v1.MessageResult is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mitosis.evmgov.v1.MessageResult"))
+		}
+		panic(fmt.Errorf("message mitosis.evmgov.v1.MessageResult does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MessageResult) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "mitosis.evmgov.v1.MessageResult.raw_msg":
+		return protoreflect.ValueOfString("")
+	case "mitosis.evmgov.v1.MessageResult.status":
+		return protoreflect.ValueOfEnum(0)
+	case "mitosis.evmgov.v1.MessageResult.error":
+		return protoreflect.ValueOfString("")
+	case "mitosis.evmgov.v1.MessageResult.response":
+		m := new(anypb.Any)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mitosis.evmgov.v1.MessageResult"))
+		}
+		panic(fmt.Errorf("message mitosis.evmgov.v1.MessageResult does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MessageResult) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in mitosis.evmgov.v1.MessageResult", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MessageResult) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MessageResult) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MessageResult) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MessageResult) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MessageResult)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.RawMsg)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Status != 0 {
+			n += 1 + runtime.Sov(uint64(x.Status))
+		}
+		l = len(x.Error)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Response != nil {
+			l = options.Size(x.Response)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MessageResult)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Response != nil {
+			encoded, err := options.Marshal(x.Response)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.Error) > 0 {
+			i -= len(x.Error)
+			copy(dAtA[i:], x.Error)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Error)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if x.Status != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Status))
+			i--
+			dAtA[i] = 0x10
+		}
+		if len(x.RawMsg) > 0 {
+			i -= len(x.RawMsg)
+			copy(dAtA[i:], x.RawMsg)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.RawMsg)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MessageResult)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MessageResult: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MessageResult: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RawMsg", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.RawMsg = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				}
+				x.Status = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Status |= MessageStatus(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Error = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Response", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Response == nil {
+					x.Response = &anypb.Any{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Response); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_ExecutionRecord_5_list)(nil)
+
+type _ExecutionRecord_5_list struct {
+	list *[]*MessageResult
+}
+
+func (x *_ExecutionRecord_5_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_ExecutionRecord_5_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_ExecutionRecord_5_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*MessageResult)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_ExecutionRecord_5_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*MessageResult)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_ExecutionRecord_5_list) AppendMutable() protoreflect.Value {
+	v := new(MessageResult)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_ExecutionRecord_5_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_ExecutionRecord_5_list) NewElement() protoreflect.Value {
+	v := new(MessageResult)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_ExecutionRecord_5_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_ExecutionRecord                        protoreflect.MessageDescriptor
+	fd_ExecutionRecord_id                     protoreflect.FieldDescriptor
+	fd_ExecutionRecord_evm_block_hash         protoreflect.FieldDescriptor
+	fd_ExecutionRecord_height                 protoreflect.FieldDescriptor
+	fd_ExecutionRecord_applied                protoreflect.FieldDescriptor
+	fd_ExecutionRecord_results                protoreflect.FieldDescriptor
+	fd_ExecutionRecord_mode                   protoreflect.FieldDescriptor
+	fd_ExecutionRecord_scheduled_execution_id protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_mitosis_evmgov_v1_execution_proto_init()
+	md_ExecutionRecord = File_mitosis_evmgov_v1_execution_proto.Messages().ByName("ExecutionRecord")
+	fd_ExecutionRecord_id = md_ExecutionRecord.Fields().ByName("id")
+	fd_ExecutionRecord_evm_block_hash = md_ExecutionRecord.Fields().ByName("evm_block_hash")
+	fd_ExecutionRecord_height = md_ExecutionRecord.Fields().ByName("height")
+	fd_ExecutionRecord_applied = md_ExecutionRecord.Fields().ByName("applied")
+	fd_ExecutionRecord_results = md_ExecutionRecord.Fields().ByName("results")
+	fd_ExecutionRecord_mode = md_ExecutionRecord.Fields().ByName("mode")
+	fd_ExecutionRecord_scheduled_execution_id = md_ExecutionRecord.Fields().ByName("scheduled_execution_id")
+}
+
+var _ protoreflect.Message = (*fastReflection_ExecutionRecord)(nil)
+
+type fastReflection_ExecutionRecord ExecutionRecord
+
+func (x *ExecutionRecord) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_ExecutionRecord)(x)
+}
+
+func (x *ExecutionRecord) slowProtoReflect() protoreflect.Message {
+	mi := &file_mitosis_evmgov_v1_execution_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_ExecutionRecord_messageType fastReflection_ExecutionRecord_messageType
+var _ protoreflect.MessageType = fastReflection_ExecutionRecord_messageType{}
+
+type fastReflection_ExecutionRecord_messageType struct{}
+
+func (x fastReflection_ExecutionRecord_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_ExecutionRecord)(nil)
+}
+func (x fastReflection_ExecutionRecord_messageType) New() protoreflect.Message {
+	return new(fastReflection_ExecutionRecord)
+}
+func (x fastReflection_ExecutionRecord_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_ExecutionRecord
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_ExecutionRecord) Descriptor() protoreflect.MessageDescriptor {
+	return md_ExecutionRecord
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_ExecutionRecord) Type() protoreflect.MessageType {
+	return _fastReflection_ExecutionRecord_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_ExecutionRecord) New() protoreflect.Message {
+	return new(fastReflection_ExecutionRecord)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_ExecutionRecord) Interface() protoreflect.ProtoMessage {
+	return (*ExecutionRecord)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_ExecutionRecord) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Id != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Id)
+		if !f(fd_ExecutionRecord_id, value) {
+			return
+		}
+	}
+	if x.EvmBlockHash != "" {
+		value := protoreflect.ValueOfString(x.EvmBlockHash)
+		if !f(fd_ExecutionRecord_evm_block_hash, value) {
+			return
+		}
+	}
+	if x.Height != int64(0) {
+		value := protoreflect.ValueOfInt64(x.Height)
+		if !f(fd_ExecutionRecord_height, value) {
+			return
+		}
+	}
+	if x.Applied != false {
+		value := protoreflect.ValueOfBool(x.Applied)
+		if !f(fd_ExecutionRecord_applied, value) {
+			return
+		}
+	}
+	if len(x.Results) != 0 {
+		value := protoreflect.ValueOfList(&_ExecutionRecord_5_list{list: &x.Results})
+		if !f(fd_ExecutionRecord_results, value) {
+			return
+		}
+	}
+	if x.Mode != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.Mode))
+		if !f(fd_ExecutionRecord_mode, value) {
+			return
+		}
+	}
+	if x.ScheduledExecutionId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.ScheduledExecutionId)
+		if !f(fd_ExecutionRecord_scheduled_execution_id, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_ExecutionRecord) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "mitosis.evmgov.v1.ExecutionRecord.id":
+		return x.Id != uint64(0)
+	case "mitosis.evmgov.v1.ExecutionRecord.evm_block_hash":
+		return x.EvmBlockHash != ""
+	case "mitosis.evmgov.v1.ExecutionRecord.height":
+		return x.Height != int64(0)
+	case "mitosis.evmgov.v1.ExecutionRecord.applied":
+		return x.Applied != false
+	case "mitosis.evmgov.v1.ExecutionRecord.results":
+		return len(x.Results) != 0
+	case "mitosis.evmgov.v1.ExecutionRecord.mode":
+		return x.Mode != 0
+	case "mitosis.evmgov.v1.ExecutionRecord.scheduled_execution_id":
+		return x.ScheduledExecutionId != uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mitosis.evmgov.v1.ExecutionRecord"))
+		}
+		panic(fmt.Errorf("message mitosis.evmgov.v1.ExecutionRecord does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ExecutionRecord) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "mitosis.evmgov.v1.ExecutionRecord.id":
+		x.Id = uint64(0)
+	case "mitosis.evmgov.v1.ExecutionRecord.evm_block_hash":
+		x.EvmBlockHash = ""
+	case "mitosis.evmgov.v1.ExecutionRecord.height":
+		x.Height = int64(0)
+	case "mitosis.evmgov.v1.ExecutionRecord.applied":
+		x.Applied = false
+	case "mitosis.evmgov.v1.ExecutionRecord.results":
+		x.Results = nil
+	case "mitosis.evmgov.v1.ExecutionRecord.mode":
+		x.Mode = 0
+	case "mitosis.evmgov.v1.ExecutionRecord.scheduled_execution_id":
+		x.ScheduledExecutionId = uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mitosis.evmgov.v1.ExecutionRecord"))
+		}
+		panic(fmt.Errorf("message mitosis.evmgov.v1.ExecutionRecord does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_ExecutionRecord) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "mitosis.evmgov.v1.ExecutionRecord.id":
+		value := x.Id
+		return protoreflect.ValueOfUint64(value)
+	case "mitosis.evmgov.v1.ExecutionRecord.evm_block_hash":
+		value := x.EvmBlockHash
+		return protoreflect.ValueOfString(value)
+	case "mitosis.evmgov.v1.ExecutionRecord.height":
+		value := x.Height
+		return protoreflect.ValueOfInt64(value)
+	case "mitosis.evmgov.v1.ExecutionRecord.applied":
+		value := x.Applied
+		return protoreflect.ValueOfBool(value)
+	case "mitosis.evmgov.v1.ExecutionRecord.results":
+		if len(x.Results) == 0 {
+			return protoreflect.ValueOfList(&_ExecutionRecord_5_list{})
+		}
+		listValue := &_ExecutionRecord_5_list{list: &x.Results}
+		return protoreflect.ValueOfList(listValue)
+	case "mitosis.evmgov.v1.ExecutionRecord.mode":
+		value := x.Mode
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "mitosis.evmgov.v1.ExecutionRecord.scheduled_execution_id":
+		value := x.ScheduledExecutionId
+		return protoreflect.ValueOfUint64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mitosis.evmgov.v1.ExecutionRecord"))
+		}
+		panic(fmt.Errorf("message mitosis.evmgov.v1.ExecutionRecord does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ExecutionRecord) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "mitosis.evmgov.v1.ExecutionRecord.id":
+		x.Id = value.Uint()
+	case "mitosis.evmgov.v1.ExecutionRecord.evm_block_hash":
+		x.EvmBlockHash = value.Interface().(string)
+	case "mitosis.evmgov.v1.ExecutionRecord.height":
+		x.Height = value.Int()
+	case "mitosis.evmgov.v1.ExecutionRecord.applied":
+		x.Applied = value.Bool()
+	case "mitosis.evmgov.v1.ExecutionRecord.results":
+		lv := value.List()
+		clv := lv.(*_ExecutionRecord_5_list)
+		x.Results = *clv.list
+	case "mitosis.evmgov.v1.ExecutionRecord.mode":
+		x.Mode = (ExecutionMode)(value.Enum())
+	case "mitosis.evmgov.v1.ExecutionRecord.scheduled_execution_id":
+		x.ScheduledExecutionId = value.Uint()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mitosis.evmgov.v1.ExecutionRecord"))
+		}
+		panic(fmt.Errorf("message mitosis.evmgov.v1.ExecutionRecord does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ExecutionRecord) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "mitosis.evmgov.v1.ExecutionRecord.results":
+		if x.Results == nil {
+			x.Results = []*MessageResult{}
+		}
+		value := &_ExecutionRecord_5_list{list: &x.Results}
+		return protoreflect.ValueOfList(value)
+	case "mitosis.evmgov.v1.ExecutionRecord.id":
+		panic(fmt.Errorf("field id of message mitosis.evmgov.v1.ExecutionRecord is not mutable"))
+	case "mitosis.evmgov.v1.ExecutionRecord.evm_block_hash":
+		panic(fmt.Errorf("field evm_block_hash of message mitosis.evmgov.v1.ExecutionRecord is not mutable"))
+	case "mitosis.evmgov.v1.ExecutionRecord.height":
+		panic(fmt.Errorf("field height of message mitosis.evmgov.v1.ExecutionRecord is not mutable"))
+	case "mitosis.evmgov.v1.ExecutionRecord.applied":
+		panic(fmt.Errorf("field applied of message mitosis.evmgov.v1.ExecutionRecord is not mutable"))
+	case "mitosis.evmgov.v1.ExecutionRecord.mode":
+		panic(fmt.Errorf("field mode of message mitosis.evmgov.v1.ExecutionRecord is not mutable"))
+	case "mitosis.evmgov.v1.ExecutionRecord.scheduled_execution_id":
+		panic(fmt.Errorf("field scheduled_execution_id of message mitosis.evmgov.v1.ExecutionRecord is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mitosis.evmgov.v1.ExecutionRecord"))
+		}
+		panic(fmt.Errorf("message mitosis.evmgov.v1.ExecutionRecord does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_ExecutionRecord) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "mitosis.evmgov.v1.ExecutionRecord.id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "mitosis.evmgov.v1.ExecutionRecord.evm_block_hash":
+		return protoreflect.ValueOfString("")
+	case "mitosis.evmgov.v1.ExecutionRecord.height":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "mitosis.evmgov.v1.ExecutionRecord.applied":
+		return protoreflect.ValueOfBool(false)
+	case "mitosis.evmgov.v1.ExecutionRecord.results":
+		list := []*MessageResult{}
+		return protoreflect.ValueOfList(&_ExecutionRecord_5_list{list: &list})
+	case "mitosis.evmgov.v1.ExecutionRecord.mode":
+		return protoreflect.ValueOfEnum(0)
+	case "mitosis.evmgov.v1.ExecutionRecord.scheduled_execution_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mitosis.evmgov.v1.ExecutionRecord"))
+		}
+		panic(fmt.Errorf("message mitosis.evmgov.v1.ExecutionRecord does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_ExecutionRecord) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in mitosis.evmgov.v1.ExecutionRecord", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_ExecutionRecord) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ExecutionRecord) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_ExecutionRecord) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_ExecutionRecord) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*ExecutionRecord)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Id != 0 {
+			n += 1 + runtime.Sov(uint64(x.Id))
+		}
+		l = len(x.EvmBlockHash)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Height != 0 {
+			n += 1 + runtime.Sov(uint64(x.Height))
+		}
+		if x.Applied {
+			n += 2
+		}
+		if len(x.Results) > 0 {
+			for _, e := range x.Results {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.Mode != 0 {
+			n += 1 + runtime.Sov(uint64(x.Mode))
+		}
+		if x.ScheduledExecutionId != 0 {
+			n += 1 + runtime.Sov(uint64(x.ScheduledExecutionId))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*ExecutionRecord)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.ScheduledExecutionId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ScheduledExecutionId))
+			i--
+			dAtA[i] = 0x38
+		}
+		if x.Mode != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Mode))
+			i--
+			dAtA[i] = 0x30
+		}
+		if len(x.Results) > 0 {
+			for iNdEx := len(x.Results) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Results[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x2a
+			}
+		}
+		if x.Applied {
+			i--
+			if x.Applied {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x20
+		}
+		if x.Height != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Height))
+			i--
+			dAtA[i] = 0x18
+		}
+		if len(x.EvmBlockHash) > 0 {
+			i -= len(x.EvmBlockHash)
+			copy(dAtA[i:], x.EvmBlockHash)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.EvmBlockHash)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.Id != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Id))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*ExecutionRecord)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ExecutionRecord: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ExecutionRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				}
+				x.Id = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Id |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field EvmBlockHash", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.EvmBlockHash = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+				}
+				x.Height = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Height |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Applied", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.Applied = bool(v != 0)
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Results", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Results = append(x.Results, &MessageResult{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Results[len(x.Results)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 6:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Mode", wireType)
+				}
+				x.Mode = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Mode |= ExecutionMode(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 7:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ScheduledExecutionId", wireType)
+				}
+				x.ScheduledExecutionId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ScheduledExecutionId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_ScheduledExecution_5_list)(nil)
+
+type _ScheduledExecution_5_list struct {
+	list *[]string
+}
+
+func (x *_ScheduledExecution_5_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_ScheduledExecution_5_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_ScheduledExecution_5_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_ScheduledExecution_5_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_ScheduledExecution_5_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message ScheduledExecution at list field RawMsgs as it is not of Message kind"))
+}
+
+func (x *_ScheduledExecution_5_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_ScheduledExecution_5_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_ScheduledExecution_5_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_ScheduledExecution                protoreflect.MessageDescriptor
+	fd_ScheduledExecution_id             protoreflect.FieldDescriptor
+	fd_ScheduledExecution_evm_block_hash protoreflect.FieldDescriptor
+	fd_ScheduledExecution_height         protoreflect.FieldDescriptor
+	fd_ScheduledExecution_options        protoreflect.FieldDescriptor
+	fd_ScheduledExecution_raw_msgs       protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_mitosis_evmgov_v1_execution_proto_init()
+	md_ScheduledExecution = File_mitosis_evmgov_v1_execution_proto.Messages().ByName("ScheduledExecution")
+	fd_ScheduledExecution_id = md_ScheduledExecution.Fields().ByName("id")
+	fd_ScheduledExecution_evm_block_hash = md_ScheduledExecution.Fields().ByName("evm_block_hash")
+	fd_ScheduledExecution_height = md_ScheduledExecution.Fields().ByName("height")
+	fd_ScheduledExecution_options = md_ScheduledExecution.Fields().ByName("options")
+	fd_ScheduledExecution_raw_msgs = md_ScheduledExecution.Fields().ByName("raw_msgs")
+}
+
+var _ protoreflect.Message = (*fastReflection_ScheduledExecution)(nil)
+
+type fastReflection_ScheduledExecution ScheduledExecution
+
+func (x *ScheduledExecution) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_ScheduledExecution)(x)
+}
+
+func (x *ScheduledExecution) slowProtoReflect() protoreflect.Message {
+	mi := &file_mitosis_evmgov_v1_execution_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_ScheduledExecution_messageType fastReflection_ScheduledExecution_messageType
+var _ protoreflect.MessageType = fastReflection_ScheduledExecution_messageType{}
+
+type fastReflection_ScheduledExecution_messageType struct{}
+
+func (x fastReflection_ScheduledExecution_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_ScheduledExecution)(nil)
+}
+func (x fastReflection_ScheduledExecution_messageType) New() protoreflect.Message {
+	return new(fastReflection_ScheduledExecution)
+}
+func (x fastReflection_ScheduledExecution_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_ScheduledExecution
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_ScheduledExecution) Descriptor() protoreflect.MessageDescriptor {
+	return md_ScheduledExecution
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_ScheduledExecution) Type() protoreflect.MessageType {
+	return _fastReflection_ScheduledExecution_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_ScheduledExecution) New() protoreflect.Message {
+	return new(fastReflection_ScheduledExecution)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_ScheduledExecution) Interface() protoreflect.ProtoMessage {
+	return (*ScheduledExecution)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_ScheduledExecution) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Id != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Id)
+		if !f(fd_ScheduledExecution_id, value) {
+			return
+		}
+	}
+	if x.EvmBlockHash != "" {
+		value := protoreflect.ValueOfString(x.EvmBlockHash)
+		if !f(fd_ScheduledExecution_evm_block_hash, value) {
+			return
+		}
+	}
+	if x.Height != int64(0) {
+		value := protoreflect.ValueOfInt64(x.Height)
+		if !f(fd_ScheduledExecution_height, value) {
+			return
+		}
+	}
+	if x.Options != nil {
+		value := protoreflect.ValueOfMessage(x.Options.ProtoReflect())
+		if !f(fd_ScheduledExecution_options, value) {
+			return
+		}
+	}
+	if len(x.RawMsgs) != 0 {
+		value := protoreflect.ValueOfList(&_ScheduledExecution_5_list{list: &x.RawMsgs})
+		if !f(fd_ScheduledExecution_raw_msgs, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_ScheduledExecution) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "mitosis.evmgov.v1.ScheduledExecution.id":
+		return x.Id != uint64(0)
+	case "mitosis.evmgov.v1.ScheduledExecution.evm_block_hash":
+		return x.EvmBlockHash != ""
+	case "mitosis.evmgov.v1.ScheduledExecution.height":
+		return x.Height != int64(0)
+	case "mitosis.evmgov.v1.ScheduledExecution.options":
+		return x.Options != nil
+	case "mitosis.evmgov.v1.ScheduledExecution.raw_msgs":
+		return len(x.RawMsgs) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mitosis.evmgov.v1.ScheduledExecution"))
+		}
+		panic(fmt.Errorf("message mitosis.evmgov.v1.ScheduledExecution does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ScheduledExecution) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "mitosis.evmgov.v1.ScheduledExecution.id":
+		x.Id = uint64(0)
+	case "mitosis.evmgov.v1.ScheduledExecution.evm_block_hash":
+		x.EvmBlockHash = ""
+	case "mitosis.evmgov.v1.ScheduledExecution.height":
+		x.Height = int64(0)
+	case "mitosis.evmgov.v1.ScheduledExecution.options":
+		x.Options = nil
+	case "mitosis.evmgov.v1.ScheduledExecution.raw_msgs":
+		x.RawMsgs = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mitosis.evmgov.v1.ScheduledExecution"))
+		}
+		panic(fmt.Errorf("message mitosis.evmgov.v1.ScheduledExecution does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_ScheduledExecution) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "mitosis.evmgov.v1.ScheduledExecution.id":
+		value := x.Id
+		return protoreflect.ValueOfUint64(value)
+	case "mitosis.evmgov.v1.ScheduledExecution.evm_block_hash":
+		value := x.EvmBlockHash
+		return protoreflect.ValueOfString(value)
+	case "mitosis.evmgov.v1.ScheduledExecution.height":
+		value := x.Height
+		return protoreflect.ValueOfInt64(value)
+	case "mitosis.evmgov.v1.ScheduledExecution.options":
+		value := x.Options
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "mitosis.evmgov.v1.ScheduledExecution.raw_msgs":
+		if len(x.RawMsgs) == 0 {
+			return protoreflect.ValueOfList(&_ScheduledExecution_5_list{})
+		}
+		listValue := &_ScheduledExecution_5_list{list: &x.RawMsgs}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mitosis.evmgov.v1.ScheduledExecution"))
+		}
+		panic(fmt.Errorf("message mitosis.evmgov.v1.ScheduledExecution does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ScheduledExecution) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "mitosis.evmgov.v1.ScheduledExecution.id":
+		x.Id = value.Uint()
+	case "mitosis.evmgov.v1.ScheduledExecution.evm_block_hash":
+		x.EvmBlockHash = value.Interface().(string)
+	case "mitosis.evmgov.v1.ScheduledExecution.height":
+		x.Height = value.Int()
+	case "mitosis.evmgov.v1.ScheduledExecution.options":
+		x.Options = value.Message().Interface().(*ExecutionOptions)
+	case "mitosis.evmgov.v1.ScheduledExecution.raw_msgs":
+		lv := value.List()
+		clv := lv.(*_ScheduledExecution_5_list)
+		x.RawMsgs = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mitosis.evmgov.v1.ScheduledExecution"))
+		}
+		panic(fmt.Errorf("message mitosis.evmgov.v1.ScheduledExecution does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ScheduledExecution) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "mitosis.evmgov.v1.ScheduledExecution.options":
+		if x.Options == nil {
+			x.Options = new(ExecutionOptions)
+		}
+		return protoreflect.ValueOfMessage(x.Options.ProtoReflect())
+	case "mitosis.evmgov.v1.ScheduledExecution.raw_msgs":
+		if x.RawMsgs == nil {
+			x.RawMsgs = []string{}
+		}
+		value := &_ScheduledExecution_5_list{list: &x.RawMsgs}
+		return protoreflect.ValueOfList(value)
+	case "mitosis.evmgov.v1.ScheduledExecution.id":
+		panic(fmt.Errorf("field id of message mitosis.evmgov.v1.ScheduledExecution is not mutable"))
+	case "mitosis.evmgov.v1.ScheduledExecution.evm_block_hash":
+		panic(fmt.Errorf("field evm_block_hash of message mitosis.evmgov.v1.ScheduledExecution is not mutable"))
+	case "mitosis.evmgov.v1.ScheduledExecution.height":
+		panic(fmt.Errorf("field height of message mitosis.evmgov.v1.ScheduledExecution is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mitosis.evmgov.v1.ScheduledExecution"))
+		}
+		panic(fmt.Errorf("message mitosis.evmgov.v1.ScheduledExecution does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_ScheduledExecution) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "mitosis.evmgov.v1.ScheduledExecution.id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "mitosis.evmgov.v1.ScheduledExecution.evm_block_hash":
+		return protoreflect.ValueOfString("")
+	case "mitosis.evmgov.v1.ScheduledExecution.height":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "mitosis.evmgov.v1.ScheduledExecution.options":
+		m := new(ExecutionOptions)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "mitosis.evmgov.v1.ScheduledExecution.raw_msgs":
+		list := []string{}
+		return protoreflect.ValueOfList(&_ScheduledExecution_5_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mitosis.evmgov.v1.ScheduledExecution"))
+		}
+		panic(fmt.Errorf("message mitosis.evmgov.v1.ScheduledExecution does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_ScheduledExecution) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in mitosis.evmgov.v1.ScheduledExecution", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_ScheduledExecution) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ScheduledExecution) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_ScheduledExecution) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_ScheduledExecution) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*ScheduledExecution)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Id != 0 {
+			n += 1 + runtime.Sov(uint64(x.Id))
+		}
+		l = len(x.EvmBlockHash)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Height != 0 {
+			n += 1 + runtime.Sov(uint64(x.Height))
+		}
+		if x.Options != nil {
+			l = options.Size(x.Options)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.RawMsgs) > 0 {
+			for _, s := range x.RawMsgs {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*ScheduledExecution)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.RawMsgs) > 0 {
+			for iNdEx := len(x.RawMsgs) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.RawMsgs[iNdEx])
+				copy(dAtA[i:], x.RawMsgs[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.RawMsgs[iNdEx])))
+				i--
+				dAtA[i] = 0x2a
+			}
+		}
+		if x.Options != nil {
+			encoded, err := options.Marshal(x.Options)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if x.Height != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Height))
+			i--
+			dAtA[i] = 0x18
+		}
+		if len(x.EvmBlockHash) > 0 {
+			i -= len(x.EvmBlockHash)
+			copy(dAtA[i:], x.EvmBlockHash)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.EvmBlockHash)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.Id != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Id))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*ScheduledExecution)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ScheduledExecution: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ScheduledExecution: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				}
+				x.Id = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Id |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field EvmBlockHash", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.EvmBlockHash = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+				}
+				x.Height = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Height |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Options", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Options == nil {
+					x.Options = &ExecutionOptions{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Options); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RawMsgs", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.RawMsgs = append(x.RawMsgs, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.0
+// 	protoc        (unknown)
+// source: mitosis/evmgov/v1/execution.proto
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// ExecutionMode defines how the messages of a MsgExecute event are executed
+type ExecutionMode int32
+
+const (
+	// EXECUTION_MODE_ATOMIC means no message is applied if any of them fails
+	ExecutionMode_EXECUTION_MODE_ATOMIC ExecutionMode = 0
+	// EXECUTION_MODE_BEST_EFFORT means each message is applied independently
+	// of the others
+	ExecutionMode_EXECUTION_MODE_BEST_EFFORT ExecutionMode = 1
+)
+
+// Enum value maps for ExecutionMode.
+var (
+	ExecutionMode_name = map[int32]string{
+		0: "EXECUTION_MODE_ATOMIC",
+		1: "EXECUTION_MODE_BEST_EFFORT",
+	}
+	ExecutionMode_value = map[string]int32{
+		"EXECUTION_MODE_ATOMIC":      0,
+		"EXECUTION_MODE_BEST_EFFORT": 1,
+	}
+)
+
+func (x ExecutionMode) Enum() *ExecutionMode {
+	p := new(ExecutionMode)
+	*p = x
+	return p
+}
+
+func (x ExecutionMode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ExecutionMode) Descriptor() protoreflect.EnumDescriptor {
+	return file_mitosis_evmgov_v1_execution_proto_enumTypes[0].Descriptor()
+}
+
+func (ExecutionMode) Type() protoreflect.EnumType {
+	return &file_mitosis_evmgov_v1_execution_proto_enumTypes[0]
+}
+
+func (x ExecutionMode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ExecutionMode.Descriptor instead.
+func (ExecutionMode) EnumDescriptor() ([]byte, []int) {
+	return file_mitosis_evmgov_v1_execution_proto_rawDescGZIP(), []int{0}
+}
+
+// MessageStatus defines the execution status of a governance message
+type MessageStatus int32
+
+const (
+	// MESSAGE_STATUS_UNSPECIFIED defines an unspecified status
+	MessageStatus_MESSAGE_STATUS_UNSPECIFIED MessageStatus = 0
+	// MESSAGE_STATUS_EXECUTED means the message was executed and applied
+	MessageStatus_MESSAGE_STATUS_EXECUTED MessageStatus = 1
+	// MESSAGE_STATUS_FAILED means the message failed to be parsed or executed
+	MessageStatus_MESSAGE_STATUS_FAILED MessageStatus = 2
+	// MESSAGE_STATUS_REVERTED means the message was executed but its state
+	// changes were reverted because another message in the atomic batch failed
+	MessageStatus_MESSAGE_STATUS_REVERTED MessageStatus = 3
+	// MESSAGE_STATUS_NOT_EXECUTED means the message was not executed because
+	// another message in the atomic batch failed
+	MessageStatus_MESSAGE_STATUS_NOT_EXECUTED MessageStatus = 4
+)
+
+// Enum value maps for MessageStatus.
+var (
+	MessageStatus_name = map[int32]string{
+		0: "MESSAGE_STATUS_UNSPECIFIED",
+		1: "MESSAGE_STATUS_EXECUTED",
+		2: "MESSAGE_STATUS_FAILED",
+		3: "MESSAGE_STATUS_REVERTED",
+		4: "MESSAGE_STATUS_NOT_EXECUTED",
+	}
+	MessageStatus_value = map[string]int32{
+		"MESSAGE_STATUS_UNSPECIFIED":  0,
+		"MESSAGE_STATUS_EXECUTED":     1,
+		"MESSAGE_STATUS_FAILED":       2,
+		"MESSAGE_STATUS_REVERTED":     3,
+		"MESSAGE_STATUS_NOT_EXECUTED": 4,
+	}
+)
+
+func (x MessageStatus) Enum() *MessageStatus {
+	p := new(MessageStatus)
+	*p = x
+	return p
+}
+
+func (x MessageStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (MessageStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_mitosis_evmgov_v1_execution_proto_enumTypes[1].Descriptor()
+}
+
+func (MessageStatus) Type() protoreflect.EnumType {
+	return &file_mitosis_evmgov_v1_execution_proto_enumTypes[1]
+}
+
+func (x MessageStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use MessageStatus.Descriptor instead.
+func (MessageStatus) EnumDescriptor() ([]byte, []int) {
+	return file_mitosis_evmgov_v1_execution_proto_rawDescGZIP(), []int{1}
+}
+
+// ExecutionOptions defines the options of a MsgExecute event. It can be put
+// as the first message of the event with its type URL, e.g.
+// {"@type": "/mitosis.evmgov.v1.ExecutionOptions", "mode":
+// "EXECUTION_MODE_BEST_EFFORT"}. If omitted, the default options are used.
+type ExecutionOptions struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// mode is the execution mode of the messages
+	Mode ExecutionMode `protobuf:"varint,1,opt,name=mode,proto3,enum=mitosis.evmgov.v1.ExecutionMode" json:"mode,omitempty"`
+	// execute_at_height is the block height at which the messages are executed.
+	// If zero or not in the future, the messages are not scheduled by height.
+	ExecuteAtHeight int64 `protobuf:"varint,2,opt,name=execute_at_height,json=executeAtHeight,proto3" json:"execute_at_height,omitempty"`
+	// execute_at_time is the unix timestamp (seconds) of the block time after
+	// which the messages are executed. If zero or not in the future, the messages
+	// are not scheduled by time. It cannot be used with execute_at_height.
+	ExecuteAtTime int64 `protobuf:"varint,3,opt,name=execute_at_time,json=executeAtTime,proto3" json:"execute_at_time,omitempty"`
+}
+
+func (x *ExecutionOptions) Reset() {
+	*x = ExecutionOptions{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mitosis_evmgov_v1_execution_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExecutionOptions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExecutionOptions) ProtoMessage() {}
+
+// Deprecated: Use ExecutionOptions.ProtoReflect.Descriptor instead.
+func (*ExecutionOptions) Descriptor() ([]byte, []int) {
+	return file_mitosis_evmgov_v1_execution_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ExecutionOptions) GetMode() ExecutionMode {
+	if x != nil {
+		return x.Mode
+	}
+	return ExecutionMode_EXECUTION_MODE_ATOMIC
+}
+
+func (x *ExecutionOptions) GetExecuteAtHeight() int64 {
+	if x != nil {
+		return x.ExecuteAtHeight
+	}
+	return 0
+}
+
+func (x *ExecutionOptions) GetExecuteAtTime() int64 {
+	if x != nil {
+		return x.ExecuteAtTime
+	}
+	return 0
+}
+
+// MessageResult defines the execution result of a governance message
+type MessageResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// raw_msg is the raw JSON message emitted by the governance entrypoint
+	RawMsg string `protobuf:"bytes,1,opt,name=raw_msg,json=rawMsg,proto3" json:"raw_msg,omitempty"`
+	// status is the execution status of the message
+	Status MessageStatus `protobuf:"varint,2,opt,name=status,proto3,enum=mitosis.evmgov.v1.MessageStatus" json:"status,omitempty"`
+	// error is the error message if the message failed
+	Error string `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+	// response is the response of the message handler if the message was
+	// executed
+	Response *anypb.Any `protobuf:"bytes,4,opt,name=response,proto3" json:"response,omitempty"`
+}
+
+func (x *MessageResult) Reset() {
+	*x = MessageResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mitosis_evmgov_v1_execution_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MessageResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MessageResult) ProtoMessage() {}
+
+// Deprecated: Use MessageResult.ProtoReflect.Descriptor instead.
+func (*MessageResult) Descriptor() ([]byte, []int) {
+	return file_mitosis_evmgov_v1_execution_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *MessageResult) GetRawMsg() string {
+	if x != nil {
+		return x.RawMsg
+	}
+	return ""
+}
+
+func (x *MessageResult) GetStatus() MessageStatus {
+	if x != nil {
+		return x.Status
+	}
+	return MessageStatus_MESSAGE_STATUS_UNSPECIFIED
+}
+
+func (x *MessageResult) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *MessageResult) GetResponse() *anypb.Any {
+	if x != nil {
+		return x.Response
+	}
+	return nil
+}
+
+// ExecutionRecord defines the record of a MsgExecute event of the governance
+// entrypoint
+type ExecutionRecord struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// evm_block_hash is the hash of the EVM block containing the event
+	EvmBlockHash string `protobuf:"bytes,2,opt,name=evm_block_hash,json=evmBlockHash,proto3" json:"evm_block_hash,omitempty"`
+	// height is the height at which the event was processed
+	Height int64 `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
+	// applied indicates whether the state changes of the execution were applied.
+	// In best-effort mode, it is true if at least one message was executed.
+	Applied bool `protobuf:"varint,4,opt,name=applied,proto3" json:"applied,omitempty"`
+	// results is the list of the execution results of each message
+	Results []*MessageResult `protobuf:"bytes,5,rep,name=results,proto3" json:"results,omitempty"`
+	// mode is the execution mode of the messages
+	Mode ExecutionMode `protobuf:"varint,6,opt,name=mode,proto3,enum=mitosis.evmgov.v1.ExecutionMode" json:"mode,omitempty"`
+	// scheduled_execution_id is the ID of the scheduled execution if the messages
+	// were scheduled, otherwise zero
+	ScheduledExecutionId uint64 `protobuf:"varint,7,opt,name=scheduled_execution_id,json=scheduledExecutionId,proto3" json:"scheduled_execution_id,omitempty"`
+}
+
+func (x *ExecutionRecord) Reset() {
+	*x = ExecutionRecord{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mitosis_evmgov_v1_execution_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExecutionRecord) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExecutionRecord) ProtoMessage() {}
+
+// Deprecated: Use ExecutionRecord.ProtoReflect.Descriptor instead.
+func (*ExecutionRecord) Descriptor() ([]byte, []int) {
+	return file_mitosis_evmgov_v1_execution_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ExecutionRecord) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ExecutionRecord) GetEvmBlockHash() string {
+	if x != nil {
+		return x.EvmBlockHash
+	}
+	return ""
+}
+
+func (x *ExecutionRecord) GetHeight() int64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *ExecutionRecord) GetApplied() bool {
+	if x != nil {
+		return x.Applied
+	}
+	return false
+}
+
+func (x *ExecutionRecord) GetResults() []*MessageResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+func (x *ExecutionRecord) GetMode() ExecutionMode {
+	if x != nil {
+		return x.Mode
+	}
+	return ExecutionMode_EXECUTION_MODE_ATOMIC
+}
+
+func (x *ExecutionRecord) GetScheduledExecutionId() uint64 {
+	if x != nil {
+		return x.ScheduledExecutionId
+	}
+	return 0
+}
+
+// ScheduledExecution defines the messages of a MsgExecute event which are
+// scheduled to be executed later
+type ScheduledExecution struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// evm_block_hash is the hash of the EVM block containing the event
+	EvmBlockHash string `protobuf:"bytes,2,opt,name=evm_block_hash,json=evmBlockHash,proto3" json:"evm_block_hash,omitempty"`
+	// height is the height at which the messages were scheduled
+	Height int64 `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
+	// options is the execution options of the messages
+	Options *ExecutionOptions `protobuf:"bytes,4,opt,name=options,proto3" json:"options,omitempty"`
+	// raw_msgs is the list of the raw JSON messages to be executed
+	RawMsgs []string `protobuf:"bytes,5,rep,name=raw_msgs,json=rawMsgs,proto3" json:"raw_msgs,omitempty"`
+}
+
+func (x *ScheduledExecution) Reset() {
+	*x = ScheduledExecution{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mitosis_evmgov_v1_execution_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ScheduledExecution) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScheduledExecution) ProtoMessage() {}
+
+// Deprecated: Use ScheduledExecution.ProtoReflect.Descriptor instead.
+func (*ScheduledExecution) Descriptor() ([]byte, []int) {
+	return file_mitosis_evmgov_v1_execution_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ScheduledExecution) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ScheduledExecution) GetEvmBlockHash() string {
+	if x != nil {
+		return x.EvmBlockHash
+	}
+	return ""
+}
+
+func (x *ScheduledExecution) GetHeight() int64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *ScheduledExecution) GetOptions() *ExecutionOptions {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+func (x *ScheduledExecution) GetRawMsgs() []string {
+	if x != nil {
+		return x.RawMsgs
+	}
+	return nil
+}
+
+var File_mitosis_evmgov_v1_execution_proto protoreflect.FileDescriptor
+
+var file_mitosis_evmgov_v1_execution_proto_rawDesc = []byte{
+	0x0a, 0x21, 0x6d, 0x69, 0x74, 0x6f, 0x73, 0x69, 0x73, 0x2f, 0x65, 0x76, 0x6d, 0x67, 0x6f, 0x76,
+	0x2f, 0x76, 0x31, 0x2f, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x12, 0x11, 0x6d, 0x69, 0x74, 0x6f, 0x73, 0x69, 0x73, 0x2e, 0x65, 0x76, 0x6d,
+	0x67, 0x6f, 0x76, 0x2e, 0x76, 0x31, 0x1a, 0x14, 0x67, 0x6f, 0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2f, 0x67, 0x6f, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x19, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x61, 0x6e,
+	0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x9c, 0x01, 0x0a, 0x10, 0x45, 0x78, 0x65, 0x63,
+	0x75, 0x74, 0x69, 0x6f, 0x6e, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x34, 0x0a, 0x04,
+	0x6d, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x20, 0x2e, 0x6d, 0x69, 0x74,
+	0x6f, 0x73, 0x69, 0x73, 0x2e, 0x65, 0x76, 0x6d, 0x67, 0x6f, 0x76, 0x2e, 0x76, 0x31, 0x2e, 0x45,
+	0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x6d, 0x6f,
+	0x64, 0x65, 0x12, 0x2a, 0x0a, 0x11, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x5f, 0x61, 0x74,
+	0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x65,
+	0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x41, 0x74, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x26,
+	0x0a, 0x0f, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x5f, 0x61, 0x74, 0x5f, 0x74, 0x69, 0x6d,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65,
+	0x41, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x22, 0xaa, 0x01, 0x0a, 0x0d, 0x4d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x72, 0x61, 0x77, 0x5f,
+	0x6d, 0x73, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x61, 0x77, 0x4d, 0x73,
+	0x67, 0x12, 0x38, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x20, 0x2e, 0x6d, 0x69, 0x74, 0x6f, 0x73, 0x69, 0x73, 0x2e, 0x65, 0x76, 0x6d, 0x67,
+	0x6f, 0x76, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x65,
+	0x72, 0x72, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f,
+	0x72, 0x12, 0x30, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x41, 0x6e, 0x79, 0x52, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0xaf, 0x02, 0x0a, 0x0f, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x12, 0x16, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x04, 0x42, 0x06, 0xe2, 0xde, 0x1f, 0x02, 0x49, 0x44, 0x52, 0x02, 0x69, 0x64, 0x12,
+	0x24, 0x0a, 0x0e, 0x65, 0x76, 0x6d, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x61, 0x73,
+	0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x65, 0x76, 0x6d, 0x42, 0x6c, 0x6f, 0x63,
+	0x6b, 0x48, 0x61, 0x73, 0x68, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x18, 0x0a,
+	0x07, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07,
+	0x61, 0x70, 0x70, 0x6c, 0x69, 0x65, 0x64, 0x12, 0x40, 0x0a, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x6d, 0x69, 0x74, 0x6f, 0x73,
+	0x69, 0x73, 0x2e, 0x65, 0x76, 0x6d, 0x67, 0x6f, 0x76, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00,
+	0x52, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x12, 0x34, 0x0a, 0x04, 0x6d, 0x6f, 0x64,
+	0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x20, 0x2e, 0x6d, 0x69, 0x74, 0x6f, 0x73, 0x69,
+	0x73, 0x2e, 0x65, 0x76, 0x6d, 0x67, 0x6f, 0x76, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78, 0x65, 0x63,
+	0x75, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x12,
+	0x34, 0x0a, 0x16, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x5f, 0x65, 0x78, 0x65,
+	0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x14, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74,
+	0x69, 0x6f, 0x6e, 0x49, 0x64, 0x22, 0xca, 0x01, 0x0a, 0x12, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75,
+	0x6c, 0x65, 0x64, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x06, 0xe2, 0xde, 0x1f, 0x02, 0x49, 0x44,
+	0x52, 0x02, 0x69, 0x64, 0x12, 0x24, 0x0a, 0x0e, 0x65, 0x76, 0x6d, 0x5f, 0x62, 0x6c, 0x6f, 0x63,
+	0x6b, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x65, 0x76,
+	0x6d, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x61, 0x73, 0x68, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65,
+	0x69, 0x67, 0x68, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67,
+	0x68, 0x74, 0x12, 0x43, 0x0a, 0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x6d, 0x69, 0x74, 0x6f, 0x73, 0x69, 0x73, 0x2e, 0x65, 0x76,
+	0x6d, 0x67, 0x6f, 0x76, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f,
+	0x6e, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x07,
+	0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x19, 0x0a, 0x08, 0x72, 0x61, 0x77, 0x5f, 0x6d,
+	0x73, 0x67, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x72, 0x61, 0x77, 0x4d, 0x73,
+	0x67, 0x73, 0x2a, 0x86, 0x01, 0x0a, 0x0d, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e,
+	0x4d, 0x6f, 0x64, 0x65, 0x12, 0x32, 0x0a, 0x15, 0x45, 0x58, 0x45, 0x43, 0x55, 0x54, 0x49, 0x4f,
+	0x4e, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x41, 0x54, 0x4f, 0x4d, 0x49, 0x43, 0x10, 0x00, 0x1a,
+	0x17, 0x8a, 0x9d, 0x20, 0x13, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x6f,
+	0x64, 0x65, 0x41, 0x74, 0x6f, 0x6d, 0x69, 0x63, 0x12, 0x3b, 0x0a, 0x1a, 0x45, 0x58, 0x45, 0x43,
+	0x55, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x42, 0x45, 0x53, 0x54, 0x5f,
+	0x45, 0x46, 0x46, 0x4f, 0x52, 0x54, 0x10, 0x01, 0x1a, 0x1b, 0x8a, 0x9d, 0x20, 0x17, 0x45, 0x78,
+	0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x6f, 0x64, 0x65, 0x42, 0x65, 0x73, 0x74, 0x45,
+	0x66, 0x66, 0x6f, 0x72, 0x74, 0x1a, 0x04, 0x88, 0xa3, 0x1e, 0x00, 0x2a, 0xb6, 0x02, 0x0a, 0x0d,
+	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x3c, 0x0a,
+	0x1a, 0x4d, 0x45, 0x53, 0x53, 0x41, 0x47, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f,
+	0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x1a, 0x1c, 0x8a,
+	0x9d, 0x20, 0x18, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x55, 0x6e, 0x73, 0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x65, 0x64, 0x12, 0x36, 0x0a, 0x17, 0x4d,
+	0x45, 0x53, 0x53, 0x41, 0x47, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x45, 0x58,
+	0x45, 0x43, 0x55, 0x54, 0x45, 0x44, 0x10, 0x01, 0x1a, 0x19, 0x8a, 0x9d, 0x20, 0x15, 0x4d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x45, 0x78, 0x65, 0x63, 0x75,
+	0x74, 0x65, 0x64, 0x12, 0x32, 0x0a, 0x15, 0x4d, 0x45, 0x53, 0x53, 0x41, 0x47, 0x45, 0x5f, 0x53,
+	0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x02, 0x1a, 0x17,
+	0x8a, 0x9d, 0x20, 0x13, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x12, 0x36, 0x0a, 0x17, 0x4d, 0x45, 0x53, 0x53, 0x41,
+	0x47, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x52, 0x45, 0x56, 0x45, 0x52, 0x54,
+	0x45, 0x44, 0x10, 0x03, 0x1a, 0x19, 0x8a, 0x9d, 0x20, 0x15, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x76, 0x65, 0x72, 0x74, 0x65, 0x64, 0x12,
+	0x3d, 0x0a, 0x1b, 0x4d, 0x45, 0x53, 0x53, 0x41, 0x47, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55,
+	0x53, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x45, 0x58, 0x45, 0x43, 0x55, 0x54, 0x45, 0x44, 0x10, 0x04,
+	0x1a, 0x1c, 0x8a, 0x9d, 0x20, 0x18, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x4e, 0x6f, 0x74, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x64, 0x1a, 0x04,
+	0x88, 0xa3, 0x1e, 0x00, 0x42, 0xba, 0x01, 0x0a, 0x15, 0x63, 0x6f, 0x6d, 0x2e, 0x6d, 0x69, 0x74,
+	0x6f, 0x73, 0x69, 0x73, 0x2e, 0x65, 0x76, 0x6d, 0x67, 0x6f, 0x76, 0x2e, 0x76, 0x31, 0x42, 0x0e,
+	0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01,
+	0x5a, 0x2b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61,
+	0x70, 0x69, 0x2f, 0x6d, 0x69, 0x74, 0x6f, 0x73, 0x69, 0x73, 0x2f, 0x65, 0x76, 0x6d, 0x67, 0x6f,
+	0x76, 0x2f, 0x76, 0x31, 0x3b, 0x65, 0x76, 0x6d, 0x67, 0x6f, 0x76, 0x76, 0x31, 0xa2, 0x02, 0x03,
+	0x4d, 0x45, 0x58, 0xaa, 0x02, 0x11, 0x4d, 0x69, 0x74, 0x6f, 0x73, 0x69, 0x73, 0x2e, 0x45, 0x76,
+	0x6d, 0x67, 0x6f, 0x76, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x11, 0x4d, 0x69, 0x74, 0x6f, 0x73, 0x69,
+	0x73, 0x5c, 0x45, 0x76, 0x6d, 0x67, 0x6f, 0x76, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x1d, 0x4d, 0x69,
+	0x74, 0x6f, 0x73, 0x69, 0x73, 0x5c, 0x45, 0x76, 0x6d, 0x67, 0x6f, 0x76, 0x5c, 0x56, 0x31, 0x5c,
+	0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x13, 0x4d, 0x69,
+	0x74, 0x6f, 0x73, 0x69, 0x73, 0x3a, 0x3a, 0x45, 0x76, 0x6d, 0x67, 0x6f, 0x76, 0x3a, 0x3a, 0x56,
+	0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_mitosis_evmgov_v1_execution_proto_rawDescOnce sync.Once
+	file_mitosis_evmgov_v1_execution_proto_rawDescData = file_mitosis_evmgov_v1_execution_proto_rawDesc
+)
+
+func file_mitosis_evmgov_v1_execution_proto_rawDescGZIP() []byte {
+	file_mitosis_evmgov_v1_execution_proto_rawDescOnce.Do(func() {
+		file_mitosis_evmgov_v1_execution_proto_rawDescData = protoimpl.X.CompressGZIP(file_mitosis_evmgov_v1_execution_proto_rawDescData)
+	})
+	return file_mitosis_evmgov_v1_execution_proto_rawDescData
+}
+
+var file_mitosis_evmgov_v1_execution_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_mitosis_evmgov_v1_execution_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_mitosis_evmgov_v1_execution_proto_goTypes = []interface{}{
+	(ExecutionMode)(0),         // 0: mitosis.evmgov.v1.ExecutionMode
+	(MessageStatus)(0),         // 1: mitosis.evmgov.v1.MessageStatus
+	(*ExecutionOptions)(nil),   // 2: mitosis.evmgov.v1.ExecutionOptions
+	(*MessageResult)(nil),      // 3: mitosis.evmgov.v1.MessageResult
+	(*ExecutionRecord)(nil),    // 4: mitosis.evmgov.v1.ExecutionRecord
+	(*ScheduledExecution)(nil), // 5: mitosis.evmgov.v1.ScheduledExecution
+	(*anypb.Any)(nil),          // 6: google.protobuf.Any
+}
+var file_mitosis_evmgov_v1_execution_proto_depIdxs = []int32{
+	0, // 0: mitosis.evmgov.v1.ExecutionOptions.mode:type_name -> mitosis.evmgov.v1.ExecutionMode
+	1, // 1: mitosis.evmgov.v1.MessageResult.status:type_name -> mitosis.evmgov.v1.MessageStatus
+	6, // 2: mitosis.evmgov.v1.MessageResult.response:type_name -> google.protobuf.Any
+	3, // 3: mitosis.evmgov.v1.ExecutionRecord.results:type_name -> mitosis.evmgov.v1.MessageResult
+	0, // 4: mitosis.evmgov.v1.ExecutionRecord.mode:type_name -> mitosis.evmgov.v1.ExecutionMode
+	2, // 5: mitosis.evmgov.v1.ScheduledExecution.options:type_name -> mitosis.evmgov.v1.ExecutionOptions
+	6, // [6:6] is the sub-list for method output_type
+	6, // [6:6] is the sub-list for method input_type
+	6, // [6:6] is the sub-list for extension type_name
+	6, // [6:6] is the sub-list for extension extendee
+	0, // [0:6] is the sub-list for field type_name
+}
+
+func init() { file_mitosis_evmgov_v1_execution_proto_init() }
+func file_mitosis_evmgov_v1_execution_proto_init() {
+	if File_mitosis_evmgov_v1_execution_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_mitosis_evmgov_v1_execution_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExecutionOptions); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mitosis_evmgov_v1_execution_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MessageResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mitosis_evmgov_v1_execution_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExecutionRecord); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mitosis_evmgov_v1_execution_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ScheduledExecution); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_mitosis_evmgov_v1_execution_proto_rawDesc,
+			NumEnums:      2,
+			NumMessages:   4,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_mitosis_evmgov_v1_execution_proto_goTypes,
+		DependencyIndexes: file_mitosis_evmgov_v1_execution_proto_depIdxs,
+		EnumInfos:         file_mitosis_evmgov_v1_execution_proto_enumTypes,
+		MessageInfos:      file_mitosis_evmgov_v1_execution_proto_msgTypes,
+	}.Build()
+	File_mitosis_evmgov_v1_execution_proto = out.File
+	file_mitosis_evmgov_v1_execution_proto_rawDesc = nil
+	file_mitosis_evmgov_v1_execution_proto_goTypes = nil
+	file_mitosis_evmgov_v1_execution_proto_depIdxs = nil
+}
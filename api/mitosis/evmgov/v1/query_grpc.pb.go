@@ -0,0 +1,349 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             (unknown)
+// source: mitosis/evmgov/v1/query.proto
+
+package evmgovv1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	Query_Params_FullMethodName                    = "/mitosis.evmgov.v1.Query/Params"
+	Query_GovEntrypointContractAddr_FullMethodName = "/mitosis.evmgov.v1.Query/GovEntrypointContractAddr"
+	Query_ExecutionRecord_FullMethodName           = "/mitosis.evmgov.v1.Query/ExecutionRecord"
+	Query_ExecutionRecords_FullMethodName          = "/mitosis.evmgov.v1.Query/ExecutionRecords"
+	Query_ScheduledExecution_FullMethodName        = "/mitosis.evmgov.v1.Query/ScheduledExecution"
+	Query_ScheduledExecutions_FullMethodName       = "/mitosis.evmgov.v1.Query/ScheduledExecutions"
+	Query_SimulateExecute_FullMethodName           = "/mitosis.evmgov.v1.Query/SimulateExecute"
+)
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type QueryClient interface {
+	// Params returns the parameters of the x/evmgov module
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// GovEntrypointContractAddr returns the address of the entrypoint contract
+	// for governance
+	GovEntrypointContractAddr(ctx context.Context, in *QueryGovEntrypointContractAddrRequest, opts ...grpc.CallOption) (*QueryGovEntrypointContractAddrResponse, error)
+	// ExecutionRecord returns the execution record by ID
+	ExecutionRecord(ctx context.Context, in *QueryExecutionRecordRequest, opts ...grpc.CallOption) (*QueryExecutionRecordResponse, error)
+	// ExecutionRecords returns all execution records
+	ExecutionRecords(ctx context.Context, in *QueryExecutionRecordsRequest, opts ...grpc.CallOption) (*QueryExecutionRecordsResponse, error)
+	// ScheduledExecution returns the pending scheduled execution by ID
+	ScheduledExecution(ctx context.Context, in *QueryScheduledExecutionRequest, opts ...grpc.CallOption) (*QueryScheduledExecutionResponse, error)
+	// ScheduledExecutions returns all pending scheduled executions
+	ScheduledExecutions(ctx context.Context, in *QueryScheduledExecutionsRequest, opts ...grpc.CallOption) (*QueryScheduledExecutionsResponse, error)
+	// SimulateExecute simulates the execution of the raw messages on the current
+	// state without committing any state changes
+	SimulateExecute(ctx context.Context, in *QuerySimulateExecuteRequest, opts ...grpc.CallOption) (*QuerySimulateExecuteResponse, error)
+}
+
+type queryClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewQueryClient(cc grpc.ClientConnInterface) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, Query_Params_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GovEntrypointContractAddr(ctx context.Context, in *QueryGovEntrypointContractAddrRequest, opts ...grpc.CallOption) (*QueryGovEntrypointContractAddrResponse, error) {
+	out := new(QueryGovEntrypointContractAddrResponse)
+	err := c.cc.Invoke(ctx, Query_GovEntrypointContractAddr_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ExecutionRecord(ctx context.Context, in *QueryExecutionRecordRequest, opts ...grpc.CallOption) (*QueryExecutionRecordResponse, error) {
+	out := new(QueryExecutionRecordResponse)
+	err := c.cc.Invoke(ctx, Query_ExecutionRecord_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ExecutionRecords(ctx context.Context, in *QueryExecutionRecordsRequest, opts ...grpc.CallOption) (*QueryExecutionRecordsResponse, error) {
+	out := new(QueryExecutionRecordsResponse)
+	err := c.cc.Invoke(ctx, Query_ExecutionRecords_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ScheduledExecution(ctx context.Context, in *QueryScheduledExecutionRequest, opts ...grpc.CallOption) (*QueryScheduledExecutionResponse, error) {
+	out := new(QueryScheduledExecutionResponse)
+	err := c.cc.Invoke(ctx, Query_ScheduledExecution_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ScheduledExecutions(ctx context.Context, in *QueryScheduledExecutionsRequest, opts ...grpc.CallOption) (*QueryScheduledExecutionsResponse, error) {
+	out := new(QueryScheduledExecutionsResponse)
+	err := c.cc.Invoke(ctx, Query_ScheduledExecutions_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SimulateExecute(ctx context.Context, in *QuerySimulateExecuteRequest, opts ...grpc.CallOption) (*QuerySimulateExecuteResponse, error) {
+	out := new(QuerySimulateExecuteResponse)
+	err := c.cc.Invoke(ctx, Query_SimulateExecute_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+// All implementations must embed UnimplementedQueryServer
+// for forward compatibility
+type QueryServer interface {
+	// Params returns the parameters of the x/evmgov module
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// GovEntrypointContractAddr returns the address of the entrypoint contract
+	// for governance
+	GovEntrypointContractAddr(context.Context, *QueryGovEntrypointContractAddrRequest) (*QueryGovEntrypointContractAddrResponse, error)
+	// ExecutionRecord returns the execution record by ID
+	ExecutionRecord(context.Context, *QueryExecutionRecordRequest) (*QueryExecutionRecordResponse, error)
+	// ExecutionRecords returns all execution records
+	ExecutionRecords(context.Context, *QueryExecutionRecordsRequest) (*QueryExecutionRecordsResponse, error)
+	// ScheduledExecution returns the pending scheduled execution by ID
+	ScheduledExecution(context.Context, *QueryScheduledExecutionRequest) (*QueryScheduledExecutionResponse, error)
+	// ScheduledExecutions returns all pending scheduled executions
+	ScheduledExecutions(context.Context, *QueryScheduledExecutionsRequest) (*QueryScheduledExecutionsResponse, error)
+	// SimulateExecute simulates the execution of the raw messages on the current
+	// state without committing any state changes
+	SimulateExecute(context.Context, *QuerySimulateExecuteRequest) (*QuerySimulateExecuteResponse, error)
+	mustEmbedUnimplementedQueryServer()
+}
+
+// UnimplementedQueryServer must be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (UnimplementedQueryServer) Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (UnimplementedQueryServer) GovEntrypointContractAddr(context.Context, *QueryGovEntrypointContractAddrRequest) (*QueryGovEntrypointContractAddrResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GovEntrypointContractAddr not implemented")
+}
+func (UnimplementedQueryServer) ExecutionRecord(context.Context, *QueryExecutionRecordRequest) (*QueryExecutionRecordResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExecutionRecord not implemented")
+}
+func (UnimplementedQueryServer) ExecutionRecords(context.Context, *QueryExecutionRecordsRequest) (*QueryExecutionRecordsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExecutionRecords not implemented")
+}
+func (UnimplementedQueryServer) ScheduledExecution(context.Context, *QueryScheduledExecutionRequest) (*QueryScheduledExecutionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScheduledExecution not implemented")
+}
+func (UnimplementedQueryServer) ScheduledExecutions(context.Context, *QueryScheduledExecutionsRequest) (*QueryScheduledExecutionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScheduledExecutions not implemented")
+}
+func (UnimplementedQueryServer) SimulateExecute(context.Context, *QuerySimulateExecuteRequest) (*QuerySimulateExecuteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SimulateExecute not implemented")
+}
+func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
+
+// UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to QueryServer will
+// result in compilation errors.
+type UnsafeQueryServer interface {
+	mustEmbedUnimplementedQueryServer()
+}
+
+func RegisterQueryServer(s grpc.ServiceRegistrar, srv QueryServer) {
+	s.RegisterService(&Query_ServiceDesc, srv)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_Params_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GovEntrypointContractAddr_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGovEntrypointContractAddrRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GovEntrypointContractAddr(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_GovEntrypointContractAddr_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GovEntrypointContractAddr(ctx, req.(*QueryGovEntrypointContractAddrRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ExecutionRecord_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryExecutionRecordRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ExecutionRecord(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ExecutionRecord_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ExecutionRecord(ctx, req.(*QueryExecutionRecordRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ExecutionRecords_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryExecutionRecordsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ExecutionRecords(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ExecutionRecords_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ExecutionRecords(ctx, req.(*QueryExecutionRecordsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ScheduledExecution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryScheduledExecutionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ScheduledExecution(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ScheduledExecution_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ScheduledExecution(ctx, req.(*QueryScheduledExecutionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ScheduledExecutions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryScheduledExecutionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ScheduledExecutions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ScheduledExecutions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ScheduledExecutions(ctx, req.(*QueryScheduledExecutionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SimulateExecute_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySimulateExecuteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SimulateExecute(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_SimulateExecute_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SimulateExecute(ctx, req.(*QuerySimulateExecuteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Query_ServiceDesc is the grpc.ServiceDesc for Query service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Query_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "mitosis.evmgov.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "GovEntrypointContractAddr",
+			Handler:    _Query_GovEntrypointContractAddr_Handler,
+		},
+		{
+			MethodName: "ExecutionRecord",
+			Handler:    _Query_ExecutionRecord_Handler,
+		},
+		{
+			MethodName: "ExecutionRecords",
+			Handler:    _Query_ExecutionRecords_Handler,
+		},
+		{
+			MethodName: "ScheduledExecution",
+			Handler:    _Query_ScheduledExecution_Handler,
+		},
+		{
+			MethodName: "ScheduledExecutions",
+			Handler:    _Query_ScheduledExecutions_Handler,
+		},
+		{
+			MethodName: "SimulateExecute",
+			Handler:    _Query_SimulateExecute_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "mitosis/evmgov/v1/query.proto",
+}
@@ -0,0 +1,1336 @@
+// Code generated by protoc-gen-go-pulsar. DO NOT EDIT.
+package evmvalidatorv1
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	runtime "github.com/cosmos/cosmos-proto/runtime"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoiface "google.golang.org/protobuf/runtime/protoiface"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	io "io"
+	reflect "reflect"
+	sync "sync"
+)
+
+var (
+	md_CircuitBreaker                                   protoreflect.MessageDescriptor
+	fd_CircuitBreaker_registration_paused               protoreflect.FieldDescriptor
+	fd_CircuitBreaker_deposits_paused                   protoreflect.FieldDescriptor
+	fd_CircuitBreaker_withdrawals_paused                protoreflect.FieldDescriptor
+	fd_CircuitBreaker_extra_voting_power_updates_paused protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_mitosis_evmvalidator_v1_circuit_breaker_proto_init()
+	md_CircuitBreaker = File_mitosis_evmvalidator_v1_circuit_breaker_proto.Messages().ByName("CircuitBreaker")
+	fd_CircuitBreaker_registration_paused = md_CircuitBreaker.Fields().ByName("registration_paused")
+	fd_CircuitBreaker_deposits_paused = md_CircuitBreaker.Fields().ByName("deposits_paused")
+	fd_CircuitBreaker_withdrawals_paused = md_CircuitBreaker.Fields().ByName("withdrawals_paused")
+	fd_CircuitBreaker_extra_voting_power_updates_paused = md_CircuitBreaker.Fields().ByName("extra_voting_power_updates_paused")
+}
+
+var _ protoreflect.Message = (*fastReflection_CircuitBreaker)(nil)
+
+type fastReflection_CircuitBreaker CircuitBreaker
+
+func (x *CircuitBreaker) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_CircuitBreaker)(x)
+}
+
+func (x *CircuitBreaker) slowProtoReflect() protoreflect.Message {
+	mi := &file_mitosis_evmvalidator_v1_circuit_breaker_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_CircuitBreaker_messageType fastReflection_CircuitBreaker_messageType
+var _ protoreflect.MessageType = fastReflection_CircuitBreaker_messageType{}
+
+type fastReflection_CircuitBreaker_messageType struct{}
+
+func (x fastReflection_CircuitBreaker_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_CircuitBreaker)(nil)
+}
+func (x fastReflection_CircuitBreaker_messageType) New() protoreflect.Message {
+	return new(fastReflection_CircuitBreaker)
+}
+func (x fastReflection_CircuitBreaker_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_CircuitBreaker
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_CircuitBreaker) Descriptor() protoreflect.MessageDescriptor {
+	return md_CircuitBreaker
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_CircuitBreaker) Type() protoreflect.MessageType {
+	return _fastReflection_CircuitBreaker_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_CircuitBreaker) New() protoreflect.Message {
+	return new(fastReflection_CircuitBreaker)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_CircuitBreaker) Interface() protoreflect.ProtoMessage {
+	return (*CircuitBreaker)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_CircuitBreaker) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.RegistrationPaused != false {
+		value := protoreflect.ValueOfBool(x.RegistrationPaused)
+		if !f(fd_CircuitBreaker_registration_paused, value) {
+			return
+		}
+	}
+	if x.DepositsPaused != false {
+		value := protoreflect.ValueOfBool(x.DepositsPaused)
+		if !f(fd_CircuitBreaker_deposits_paused, value) {
+			return
+		}
+	}
+	if x.WithdrawalsPaused != false {
+		value := protoreflect.ValueOfBool(x.WithdrawalsPaused)
+		if !f(fd_CircuitBreaker_withdrawals_paused, value) {
+			return
+		}
+	}
+	if x.ExtraVotingPowerUpdatesPaused != false {
+		value := protoreflect.ValueOfBool(x.ExtraVotingPowerUpdatesPaused)
+		if !f(fd_CircuitBreaker_extra_voting_power_updates_paused, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_CircuitBreaker) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "mitosis.evmvalidator.v1.CircuitBreaker.registration_paused":
+		return x.RegistrationPaused != false
+	case "mitosis.evmvalidator.v1.CircuitBreaker.deposits_paused":
+		return x.DepositsPaused != false
+	case "mitosis.evmvalidator.v1.CircuitBreaker.withdrawals_paused":
+		return x.WithdrawalsPaused != false
+	case "mitosis.evmvalidator.v1.CircuitBreaker.extra_voting_power_updates_paused":
+		return x.ExtraVotingPowerUpdatesPaused != false
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mitosis.evmvalidator.v1.CircuitBreaker"))
+		}
+		panic(fmt.Errorf("message mitosis.evmvalidator.v1.CircuitBreaker does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_CircuitBreaker) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "mitosis.evmvalidator.v1.CircuitBreaker.registration_paused":
+		x.RegistrationPaused = false
+	case "mitosis.evmvalidator.v1.CircuitBreaker.deposits_paused":
+		x.DepositsPaused = false
+	case "mitosis.evmvalidator.v1.CircuitBreaker.withdrawals_paused":
+		x.WithdrawalsPaused = false
+	case "mitosis.evmvalidator.v1.CircuitBreaker.extra_voting_power_updates_paused":
+		x.ExtraVotingPowerUpdatesPaused = false
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mitosis.evmvalidator.v1.CircuitBreaker"))
+		}
+		panic(fmt.Errorf("message mitosis.evmvalidator.v1.CircuitBreaker does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_CircuitBreaker) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "mitosis.evmvalidator.v1.CircuitBreaker.registration_paused":
+		value := x.RegistrationPaused
+		return protoreflect.ValueOfBool(value)
+	case "mitosis.evmvalidator.v1.CircuitBreaker.deposits_paused":
+		value := x.DepositsPaused
+		return protoreflect.ValueOfBool(value)
+	case "mitosis.evmvalidator.v1.CircuitBreaker.withdrawals_paused":
+		value := x.WithdrawalsPaused
+		return protoreflect.ValueOfBool(value)
+	case "mitosis.evmvalidator.v1.CircuitBreaker.extra_voting_power_updates_paused":
+		value := x.ExtraVotingPowerUpdatesPaused
+		return protoreflect.ValueOfBool(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mitosis.evmvalidator.v1.CircuitBreaker"))
+		}
+		panic(fmt.Errorf("message mitosis.evmvalidator.v1.CircuitBreaker does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_CircuitBreaker) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "mitosis.evmvalidator.v1.CircuitBreaker.registration_paused":
+		x.RegistrationPaused = value.Bool()
+	case "mitosis.evmvalidator.v1.CircuitBreaker.deposits_paused":
+		x.DepositsPaused = value.Bool()
+	case "mitosis.evmvalidator.v1.CircuitBreaker.withdrawals_paused":
+		x.WithdrawalsPaused = value.Bool()
+	case "mitosis.evmvalidator.v1.CircuitBreaker.extra_voting_power_updates_paused":
+		x.ExtraVotingPowerUpdatesPaused = value.Bool()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mitosis.evmvalidator.v1.CircuitBreaker"))
+		}
+		panic(fmt.Errorf("message mitosis.evmvalidator.v1.CircuitBreaker does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_CircuitBreaker) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "mitosis.evmvalidator.v1.CircuitBreaker.registration_paused":
+		panic(fmt.Errorf("field registration_paused of message mitosis.evmvalidator.v1.CircuitBreaker is not mutable"))
+	case "mitosis.evmvalidator.v1.CircuitBreaker.deposits_paused":
+		panic(fmt.Errorf("field deposits_paused of message mitosis.evmvalidator.v1.CircuitBreaker is not mutable"))
+	case "mitosis.evmvalidator.v1.CircuitBreaker.withdrawals_paused":
+		panic(fmt.Errorf("field withdrawals_paused of message mitosis.evmvalidator.v1.CircuitBreaker is not mutable"))
+	case "mitosis.evmvalidator.v1.CircuitBreaker.extra_voting_power_updates_paused":
+		panic(fmt.Errorf("field extra_voting_power_updates_paused of message mitosis.evmvalidator.v1.CircuitBreaker is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mitosis.evmvalidator.v1.CircuitBreaker"))
+		}
+		panic(fmt.Errorf("message mitosis.evmvalidator.v1.CircuitBreaker does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_CircuitBreaker) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "mitosis.evmvalidator.v1.CircuitBreaker.registration_paused":
+		return protoreflect.ValueOfBool(false)
+	case "mitosis.evmvalidator.v1.CircuitBreaker.deposits_paused":
+		return protoreflect.ValueOfBool(false)
+	case "mitosis.evmvalidator.v1.CircuitBreaker.withdrawals_paused":
+		return protoreflect.ValueOfBool(false)
+	case "mitosis.evmvalidator.v1.CircuitBreaker.extra_voting_power_updates_paused":
+		return protoreflect.ValueOfBool(false)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mitosis.evmvalidator.v1.CircuitBreaker"))
+		}
+		panic(fmt.Errorf("message mitosis.evmvalidator.v1.CircuitBreaker does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_CircuitBreaker) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in mitosis.evmvalidator.v1.CircuitBreaker", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_CircuitBreaker) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_CircuitBreaker) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_CircuitBreaker) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_CircuitBreaker) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*CircuitBreaker)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.RegistrationPaused {
+			n += 2
+		}
+		if x.DepositsPaused {
+			n += 2
+		}
+		if x.WithdrawalsPaused {
+			n += 2
+		}
+		if x.ExtraVotingPowerUpdatesPaused {
+			n += 2
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*CircuitBreaker)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.ExtraVotingPowerUpdatesPaused {
+			i--
+			if x.ExtraVotingPowerUpdatesPaused {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x20
+		}
+		if x.WithdrawalsPaused {
+			i--
+			if x.WithdrawalsPaused {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x18
+		}
+		if x.DepositsPaused {
+			i--
+			if x.DepositsPaused {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.RegistrationPaused {
+			i--
+			if x.RegistrationPaused {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*CircuitBreaker)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: CircuitBreaker: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: CircuitBreaker: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RegistrationPaused", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.RegistrationPaused = bool(v != 0)
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DepositsPaused", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.DepositsPaused = bool(v != 0)
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field WithdrawalsPaused", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.WithdrawalsPaused = bool(v != 0)
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ExtraVotingPowerUpdatesPaused", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.ExtraVotingPowerUpdatesPaused = bool(v != 0)
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_PendingExtraVotingPowerUpdate                    protoreflect.MessageDescriptor
+	fd_PendingExtraVotingPowerUpdate_val_addr           protoreflect.FieldDescriptor
+	fd_PendingExtraVotingPowerUpdate_extra_voting_power protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_mitosis_evmvalidator_v1_circuit_breaker_proto_init()
+	md_PendingExtraVotingPowerUpdate = File_mitosis_evmvalidator_v1_circuit_breaker_proto.Messages().ByName("PendingExtraVotingPowerUpdate")
+	fd_PendingExtraVotingPowerUpdate_val_addr = md_PendingExtraVotingPowerUpdate.Fields().ByName("val_addr")
+	fd_PendingExtraVotingPowerUpdate_extra_voting_power = md_PendingExtraVotingPowerUpdate.Fields().ByName("extra_voting_power")
+}
+
+var _ protoreflect.Message = (*fastReflection_PendingExtraVotingPowerUpdate)(nil)
+
+type fastReflection_PendingExtraVotingPowerUpdate PendingExtraVotingPowerUpdate
+
+func (x *PendingExtraVotingPowerUpdate) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_PendingExtraVotingPowerUpdate)(x)
+}
+
+func (x *PendingExtraVotingPowerUpdate) slowProtoReflect() protoreflect.Message {
+	mi := &file_mitosis_evmvalidator_v1_circuit_breaker_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_PendingExtraVotingPowerUpdate_messageType fastReflection_PendingExtraVotingPowerUpdate_messageType
+var _ protoreflect.MessageType = fastReflection_PendingExtraVotingPowerUpdate_messageType{}
+
+type fastReflection_PendingExtraVotingPowerUpdate_messageType struct{}
+
+func (x fastReflection_PendingExtraVotingPowerUpdate_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_PendingExtraVotingPowerUpdate)(nil)
+}
+func (x fastReflection_PendingExtraVotingPowerUpdate_messageType) New() protoreflect.Message {
+	return new(fastReflection_PendingExtraVotingPowerUpdate)
+}
+func (x fastReflection_PendingExtraVotingPowerUpdate_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_PendingExtraVotingPowerUpdate
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_PendingExtraVotingPowerUpdate) Descriptor() protoreflect.MessageDescriptor {
+	return md_PendingExtraVotingPowerUpdate
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_PendingExtraVotingPowerUpdate) Type() protoreflect.MessageType {
+	return _fastReflection_PendingExtraVotingPowerUpdate_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_PendingExtraVotingPowerUpdate) New() protoreflect.Message {
+	return new(fastReflection_PendingExtraVotingPowerUpdate)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_PendingExtraVotingPowerUpdate) Interface() protoreflect.ProtoMessage {
+	return (*PendingExtraVotingPowerUpdate)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_PendingExtraVotingPowerUpdate) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.ValAddr) != 0 {
+		value := protoreflect.ValueOfBytes(x.ValAddr)
+		if !f(fd_PendingExtraVotingPowerUpdate_val_addr, value) {
+			return
+		}
+	}
+	if x.ExtraVotingPower != "" {
+		value := protoreflect.ValueOfString(x.ExtraVotingPower)
+		if !f(fd_PendingExtraVotingPowerUpdate_extra_voting_power, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_PendingExtraVotingPowerUpdate) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "mitosis.evmvalidator.v1.PendingExtraVotingPowerUpdate.val_addr":
+		return len(x.ValAddr) != 0
+	case "mitosis.evmvalidator.v1.PendingExtraVotingPowerUpdate.extra_voting_power":
+		return x.ExtraVotingPower != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mitosis.evmvalidator.v1.PendingExtraVotingPowerUpdate"))
+		}
+		panic(fmt.Errorf("message mitosis.evmvalidator.v1.PendingExtraVotingPowerUpdate does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PendingExtraVotingPowerUpdate) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "mitosis.evmvalidator.v1.PendingExtraVotingPowerUpdate.val_addr":
+		x.ValAddr = nil
+	case "mitosis.evmvalidator.v1.PendingExtraVotingPowerUpdate.extra_voting_power":
+		x.ExtraVotingPower = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mitosis.evmvalidator.v1.PendingExtraVotingPowerUpdate"))
+		}
+		panic(fmt.Errorf("message mitosis.evmvalidator.v1.PendingExtraVotingPowerUpdate does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_PendingExtraVotingPowerUpdate) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "mitosis.evmvalidator.v1.PendingExtraVotingPowerUpdate.val_addr":
+		value := x.ValAddr
+		return protoreflect.ValueOfBytes(value)
+	case "mitosis.evmvalidator.v1.PendingExtraVotingPowerUpdate.extra_voting_power":
+		value := x.ExtraVotingPower
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mitosis.evmvalidator.v1.PendingExtraVotingPowerUpdate"))
+		}
+		panic(fmt.Errorf("message mitosis.evmvalidator.v1.PendingExtraVotingPowerUpdate does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PendingExtraVotingPowerUpdate) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "mitosis.evmvalidator.v1.PendingExtraVotingPowerUpdate.val_addr":
+		x.ValAddr = value.Bytes()
+	case "mitosis.evmvalidator.v1.PendingExtraVotingPowerUpdate.extra_voting_power":
+		x.ExtraVotingPower = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mitosis.evmvalidator.v1.PendingExtraVotingPowerUpdate"))
+		}
+		panic(fmt.Errorf("message mitosis.evmvalidator.v1.PendingExtraVotingPowerUpdate does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PendingExtraVotingPowerUpdate) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "mitosis.evmvalidator.v1.PendingExtraVotingPowerUpdate.val_addr":
+		panic(fmt.Errorf("field val_addr of message mitosis.evmvalidator.v1.PendingExtraVotingPowerUpdate is not mutable"))
+	case "mitosis.evmvalidator.v1.PendingExtraVotingPowerUpdate.extra_voting_power":
+		panic(fmt.Errorf("field extra_voting_power of message mitosis.evmvalidator.v1.PendingExtraVotingPowerUpdate is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mitosis.evmvalidator.v1.PendingExtraVotingPowerUpdate"))
+		}
+		panic(fmt.Errorf("message mitosis.evmvalidator.v1.PendingExtraVotingPowerUpdate does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_PendingExtraVotingPowerUpdate) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "mitosis.evmvalidator.v1.PendingExtraVotingPowerUpdate.val_addr":
+		return protoreflect.ValueOfBytes(nil)
+	case "mitosis.evmvalidator.v1.PendingExtraVotingPowerUpdate.extra_voting_power":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mitosis.evmvalidator.v1.PendingExtraVotingPowerUpdate"))
+		}
+		panic(fmt.Errorf("message mitosis.evmvalidator.v1.PendingExtraVotingPowerUpdate does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_PendingExtraVotingPowerUpdate) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in mitosis.evmvalidator.v1.PendingExtraVotingPowerUpdate", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_PendingExtraVotingPowerUpdate) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PendingExtraVotingPowerUpdate) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_PendingExtraVotingPowerUpdate) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_PendingExtraVotingPowerUpdate) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*PendingExtraVotingPowerUpdate)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.ValAddr)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.ExtraVotingPower)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*PendingExtraVotingPowerUpdate)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.ExtraVotingPower) > 0 {
+			i -= len(x.ExtraVotingPower)
+			copy(dAtA[i:], x.ExtraVotingPower)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ExtraVotingPower)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.ValAddr) > 0 {
+			i -= len(x.ValAddr)
+			copy(dAtA[i:], x.ValAddr)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ValAddr)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*PendingExtraVotingPowerUpdate)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: PendingExtraVotingPowerUpdate: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: PendingExtraVotingPowerUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ValAddr", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ValAddr = append(x.ValAddr[:0], dAtA[iNdEx:postIndex]...)
+				if x.ValAddr == nil {
+					x.ValAddr = []byte{}
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ExtraVotingPower", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ExtraVotingPower = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.0
+// 	protoc        (unknown)
+// source: mitosis/evmvalidator/v1/circuit_breaker.proto
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// CircuitBreaker defines the emergency flags to pause each feature of the
+// module during an incident
+type CircuitBreaker struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// registration_paused pauses validator registrations.
+	// The initial collateral of a paused registration is refunded.
+	RegistrationPaused bool `protobuf:"varint,1,opt,name=registration_paused,json=registrationPaused,proto3" json:"registration_paused,omitempty"`
+	// deposits_paused pauses collateral deposits.
+	// The collateral of a paused deposit is refunded.
+	DepositsPaused bool `protobuf:"varint,2,opt,name=deposits_paused,json=depositsPaused,proto3" json:"deposits_paused,omitempty"`
+	// withdrawals_paused pauses the processing of matured withdrawals.
+	// Withdrawals are kept in the queue and processed after unpausing.
+	WithdrawalsPaused bool `protobuf:"varint,3,opt,name=withdrawals_paused,json=withdrawalsPaused,proto3" json:"withdrawals_paused,omitempty"`
+	// extra_voting_power_updates_paused pauses extra voting power updates.
+	// The updates are queued while paused and applied after unpausing. Only the
+	// latest update of each validator is kept.
+	ExtraVotingPowerUpdatesPaused bool `protobuf:"varint,4,opt,name=extra_voting_power_updates_paused,json=extraVotingPowerUpdatesPaused,proto3" json:"extra_voting_power_updates_paused,omitempty"`
+}
+
+func (x *CircuitBreaker) Reset() {
+	*x = CircuitBreaker{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mitosis_evmvalidator_v1_circuit_breaker_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CircuitBreaker) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CircuitBreaker) ProtoMessage() {}
+
+// Deprecated: Use CircuitBreaker.ProtoReflect.Descriptor instead.
+func (*CircuitBreaker) Descriptor() ([]byte, []int) {
+	return file_mitosis_evmvalidator_v1_circuit_breaker_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *CircuitBreaker) GetRegistrationPaused() bool {
+	if x != nil {
+		return x.RegistrationPaused
+	}
+	return false
+}
+
+func (x *CircuitBreaker) GetDepositsPaused() bool {
+	if x != nil {
+		return x.DepositsPaused
+	}
+	return false
+}
+
+func (x *CircuitBreaker) GetWithdrawalsPaused() bool {
+	if x != nil {
+		return x.WithdrawalsPaused
+	}
+	return false
+}
+
+func (x *CircuitBreaker) GetExtraVotingPowerUpdatesPaused() bool {
+	if x != nil {
+		return x.ExtraVotingPowerUpdatesPaused
+	}
+	return false
+}
+
+// PendingExtraVotingPowerUpdate defines an extra voting power update queued
+// while extra voting power updates are paused
+type PendingExtraVotingPowerUpdate struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// val_addr is the address of the validator
+	ValAddr []byte `protobuf:"bytes,1,opt,name=val_addr,json=valAddr,proto3" json:"val_addr,omitempty"`
+	// extra_voting_power is the new extra voting power of the validator (in gwei
+	// unit)
+	ExtraVotingPower string `protobuf:"bytes,2,opt,name=extra_voting_power,json=extraVotingPower,proto3" json:"extra_voting_power,omitempty"`
+}
+
+func (x *PendingExtraVotingPowerUpdate) Reset() {
+	*x = PendingExtraVotingPowerUpdate{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mitosis_evmvalidator_v1_circuit_breaker_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PendingExtraVotingPowerUpdate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PendingExtraVotingPowerUpdate) ProtoMessage() {}
+
+// Deprecated: Use PendingExtraVotingPowerUpdate.ProtoReflect.Descriptor instead.
+func (*PendingExtraVotingPowerUpdate) Descriptor() ([]byte, []int) {
+	return file_mitosis_evmvalidator_v1_circuit_breaker_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *PendingExtraVotingPowerUpdate) GetValAddr() []byte {
+	if x != nil {
+		return x.ValAddr
+	}
+	return nil
+}
+
+func (x *PendingExtraVotingPowerUpdate) GetExtraVotingPower() string {
+	if x != nil {
+		return x.ExtraVotingPower
+	}
+	return ""
+}
+
+var File_mitosis_evmvalidator_v1_circuit_breaker_proto protoreflect.FileDescriptor
+
+var file_mitosis_evmvalidator_v1_circuit_breaker_proto_rawDesc = []byte{
+	0x0a, 0x2d, 0x6d, 0x69, 0x74, 0x6f, 0x73, 0x69, 0x73, 0x2f, 0x65, 0x76, 0x6d, 0x76, 0x61, 0x6c,
+	0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x69, 0x72, 0x63, 0x75, 0x69,
+	0x74, 0x5f, 0x62, 0x72, 0x65, 0x61, 0x6b, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12,
+	0x17, 0x6d, 0x69, 0x74, 0x6f, 0x73, 0x69, 0x73, 0x2e, 0x65, 0x76, 0x6d, 0x76, 0x61, 0x6c, 0x69,
+	0x64, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x1a, 0x14, 0x67, 0x6f, 0x67, 0x6f, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x6f, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x19,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xe3, 0x01, 0x0a, 0x0e, 0x43, 0x69,
+	0x72, 0x63, 0x75, 0x69, 0x74, 0x42, 0x72, 0x65, 0x61, 0x6b, 0x65, 0x72, 0x12, 0x2f, 0x0a, 0x13,
+	0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x70, 0x61, 0x75,
+	0x73, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x12, 0x72, 0x65, 0x67, 0x69, 0x73,
+	0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x61, 0x75, 0x73, 0x65, 0x64, 0x12, 0x27, 0x0a,
+	0x0f, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x73, 0x5f, 0x70, 0x61, 0x75, 0x73, 0x65, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x73,
+	0x50, 0x61, 0x75, 0x73, 0x65, 0x64, 0x12, 0x2d, 0x0a, 0x12, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72,
+	0x61, 0x77, 0x61, 0x6c, 0x73, 0x5f, 0x70, 0x61, 0x75, 0x73, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x11, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x61, 0x6c, 0x73, 0x50,
+	0x61, 0x75, 0x73, 0x65, 0x64, 0x12, 0x48, 0x0a, 0x21, 0x65, 0x78, 0x74, 0x72, 0x61, 0x5f, 0x76,
+	0x6f, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x5f, 0x75, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x73, 0x5f, 0x70, 0x61, 0x75, 0x73, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x1d, 0x65, 0x78, 0x74, 0x72, 0x61, 0x56, 0x6f, 0x74, 0x69, 0x6e, 0x67, 0x50, 0x6f, 0x77,
+	0x65, 0x72, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x73, 0x50, 0x61, 0x75, 0x73, 0x65, 0x64, 0x22,
+	0xce, 0x01, 0x0a, 0x1d, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x45, 0x78, 0x74, 0x72, 0x61,
+	0x56, 0x6f, 0x74, 0x69, 0x6e, 0x67, 0x50, 0x6f, 0x77, 0x65, 0x72, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x12, 0x50, 0x0a, 0x08, 0x76, 0x61, 0x6c, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0c, 0x42, 0x35, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x2d, 0x67, 0x69, 0x74,
+	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6d, 0x69, 0x74, 0x6f, 0x73, 0x69, 0x73, 0x2d,
+	0x6f, 0x72, 0x67, 0x2f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e,
+	0x45, 0x74, 0x68, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x07, 0x76, 0x61, 0x6c, 0x41,
+	0x64, 0x64, 0x72, 0x12, 0x5b, 0x0a, 0x12, 0x65, 0x78, 0x74, 0x72, 0x61, 0x5f, 0x76, 0x6f, 0x74,
+	0x69, 0x6e, 0x67, 0x5f, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x2d, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x16, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73,
+	0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x55, 0x69, 0x6e, 0x74, 0xd2,
+	0xb4, 0x2d, 0x0b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x55, 0x69, 0x6e, 0x74, 0x52, 0x10,
+	0x65, 0x78, 0x74, 0x72, 0x61, 0x56, 0x6f, 0x74, 0x69, 0x6e, 0x67, 0x50, 0x6f, 0x77, 0x65, 0x72,
+	0x42, 0xe9, 0x01, 0x0a, 0x1b, 0x63, 0x6f, 0x6d, 0x2e, 0x6d, 0x69, 0x74, 0x6f, 0x73, 0x69, 0x73,
+	0x2e, 0x65, 0x76, 0x6d, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31,
+	0x42, 0x13, 0x43, 0x69, 0x72, 0x63, 0x75, 0x69, 0x74, 0x42, 0x72, 0x65, 0x61, 0x6b, 0x65, 0x72,
+	0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x37, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73,
+	0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6d, 0x69, 0x74, 0x6f, 0x73, 0x69,
+	0x73, 0x2f, 0x65, 0x76, 0x6d, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x2f, 0x76,
+	0x31, 0x3b, 0x65, 0x76, 0x6d, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x76, 0x31,
+	0xa2, 0x02, 0x03, 0x4d, 0x45, 0x58, 0xaa, 0x02, 0x17, 0x4d, 0x69, 0x74, 0x6f, 0x73, 0x69, 0x73,
+	0x2e, 0x45, 0x76, 0x6d, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x56, 0x31,
+	0xca, 0x02, 0x17, 0x4d, 0x69, 0x74, 0x6f, 0x73, 0x69, 0x73, 0x5c, 0x45, 0x76, 0x6d, 0x76, 0x61,
+	0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x23, 0x4d, 0x69, 0x74,
+	0x6f, 0x73, 0x69, 0x73, 0x5c, 0x45, 0x76, 0x6d, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f,
+	0x72, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
+	0xea, 0x02, 0x19, 0x4d, 0x69, 0x74, 0x6f, 0x73, 0x69, 0x73, 0x3a, 0x3a, 0x45, 0x76, 0x6d, 0x76,
+	0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_mitosis_evmvalidator_v1_circuit_breaker_proto_rawDescOnce sync.Once
+	file_mitosis_evmvalidator_v1_circuit_breaker_proto_rawDescData = file_mitosis_evmvalidator_v1_circuit_breaker_proto_rawDesc
+)
+
+func file_mitosis_evmvalidator_v1_circuit_breaker_proto_rawDescGZIP() []byte {
+	file_mitosis_evmvalidator_v1_circuit_breaker_proto_rawDescOnce.Do(func() {
+		file_mitosis_evmvalidator_v1_circuit_breaker_proto_rawDescData = protoimpl.X.CompressGZIP(file_mitosis_evmvalidator_v1_circuit_breaker_proto_rawDescData)
+	})
+	return file_mitosis_evmvalidator_v1_circuit_breaker_proto_rawDescData
+}
+
+var file_mitosis_evmvalidator_v1_circuit_breaker_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_mitosis_evmvalidator_v1_circuit_breaker_proto_goTypes = []interface{}{
+	(*CircuitBreaker)(nil),                // 0: mitosis.evmvalidator.v1.CircuitBreaker
+	(*PendingExtraVotingPowerUpdate)(nil), // 1: mitosis.evmvalidator.v1.PendingExtraVotingPowerUpdate
+}
+var file_mitosis_evmvalidator_v1_circuit_breaker_proto_depIdxs = []int32{
+	0, // [0:0] is the sub-list for method output_type
+	0, // [0:0] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_mitosis_evmvalidator_v1_circuit_breaker_proto_init() }
+func file_mitosis_evmvalidator_v1_circuit_breaker_proto_init() {
+	if File_mitosis_evmvalidator_v1_circuit_breaker_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_mitosis_evmvalidator_v1_circuit_breaker_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CircuitBreaker); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mitosis_evmvalidator_v1_circuit_breaker_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PendingExtraVotingPowerUpdate); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_mitosis_evmvalidator_v1_circuit_breaker_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_mitosis_evmvalidator_v1_circuit_breaker_proto_goTypes,
+		DependencyIndexes: file_mitosis_evmvalidator_v1_circuit_breaker_proto_depIdxs,
+		MessageInfos:      file_mitosis_evmvalidator_v1_circuit_breaker_proto_msgTypes,
+	}.Build()
+	File_mitosis_evmvalidator_v1_circuit_breaker_proto = out.File
+	file_mitosis_evmvalidator_v1_circuit_breaker_proto_rawDesc = nil
+	file_mitosis_evmvalidator_v1_circuit_breaker_proto_goTypes = nil
+	file_mitosis_evmvalidator_v1_circuit_breaker_proto_depIdxs = nil
+}
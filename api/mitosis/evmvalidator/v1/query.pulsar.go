@@ -1587,25 +1587,23 @@ func (x *fastReflection_QueryValidatorEntrypointContractAddrResponse) ProtoMetho
 }
 
 var (
-	md_QueryValidatorRequest          protoreflect.MessageDescriptor
-	fd_QueryValidatorRequest_val_addr protoreflect.FieldDescriptor
+	md_QueryCircuitBreakerRequest protoreflect.MessageDescriptor
 )
 
 func init() {
 	file_mitosis_evmvalidator_v1_query_proto_init()
-	md_QueryValidatorRequest = File_mitosis_evmvalidator_v1_query_proto.Messages().ByName("QueryValidatorRequest")
-	fd_QueryValidatorRequest_val_addr = md_QueryValidatorRequest.Fields().ByName("val_addr")
+	md_QueryCircuitBreakerRequest = File_mitosis_evmvalidator_v1_query_proto.Messages().ByName("QueryCircuitBreakerRequest")
 }
 
-var _ protoreflect.Message = (*fastReflection_QueryValidatorRequest)(nil)
+var _ protoreflect.Message = (*fastReflection_QueryCircuitBreakerRequest)(nil)
 
-type fastReflection_QueryValidatorRequest QueryValidatorRequest
+type fastReflection_QueryCircuitBreakerRequest QueryCircuitBreakerRequest
 
-func (x *QueryValidatorRequest) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryValidatorRequest)(x)
+func (x *QueryCircuitBreakerRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryCircuitBreakerRequest)(x)
 }
 
-func (x *QueryValidatorRequest) slowProtoReflect() protoreflect.Message {
+func (x *QueryCircuitBreakerRequest) slowProtoReflect() protoreflect.Message {
 	mi := &file_mitosis_evmvalidator_v1_query_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1617,43 +1615,43 @@ func (x *QueryValidatorRequest) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryValidatorRequest_messageType fastReflection_QueryValidatorRequest_messageType
-var _ protoreflect.MessageType = fastReflection_QueryValidatorRequest_messageType{}
+var _fastReflection_QueryCircuitBreakerRequest_messageType fastReflection_QueryCircuitBreakerRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryCircuitBreakerRequest_messageType{}
 
-type fastReflection_QueryValidatorRequest_messageType struct{}
+type fastReflection_QueryCircuitBreakerRequest_messageType struct{}
 
-func (x fastReflection_QueryValidatorRequest_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryValidatorRequest)(nil)
+func (x fastReflection_QueryCircuitBreakerRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryCircuitBreakerRequest)(nil)
 }
-func (x fastReflection_QueryValidatorRequest_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryValidatorRequest)
+func (x fastReflection_QueryCircuitBreakerRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryCircuitBreakerRequest)
 }
-func (x fastReflection_QueryValidatorRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryValidatorRequest
+func (x fastReflection_QueryCircuitBreakerRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryCircuitBreakerRequest
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryValidatorRequest) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryValidatorRequest
+func (x *fastReflection_QueryCircuitBreakerRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryCircuitBreakerRequest
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryValidatorRequest) Type() protoreflect.MessageType {
-	return _fastReflection_QueryValidatorRequest_messageType
+func (x *fastReflection_QueryCircuitBreakerRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryCircuitBreakerRequest_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryValidatorRequest) New() protoreflect.Message {
-	return new(fastReflection_QueryValidatorRequest)
+func (x *fastReflection_QueryCircuitBreakerRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryCircuitBreakerRequest)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryValidatorRequest) Interface() protoreflect.ProtoMessage {
-	return (*QueryValidatorRequest)(x)
+func (x *fastReflection_QueryCircuitBreakerRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryCircuitBreakerRequest)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -1661,13 +1659,7 @@ func (x *fastReflection_QueryValidatorRequest) Interface() protoreflect.ProtoMes
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryValidatorRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if len(x.ValAddr) != 0 {
-		value := protoreflect.ValueOfBytes(x.ValAddr)
-		if !f(fd_QueryValidatorRequest_val_addr, value) {
-			return
-		}
-	}
+func (x *fastReflection_QueryCircuitBreakerRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
 }
 
 // Has reports whether a field is populated.
@@ -1681,15 +1673,13 @@ func (x *fastReflection_QueryValidatorRequest) Range(f func(protoreflect.FieldDe
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryValidatorRequest) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryCircuitBreakerRequest) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "mitosis.evmvalidator.v1.QueryValidatorRequest.val_addr":
-		return len(x.ValAddr) != 0
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: mitosis.evmvalidator.v1.QueryValidatorRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mitosis.evmvalidator.v1.QueryCircuitBreakerRequest"))
 		}
-		panic(fmt.Errorf("message mitosis.evmvalidator.v1.QueryValidatorRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message mitosis.evmvalidator.v1.QueryCircuitBreakerRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1699,15 +1689,13 @@ func (x *fastReflection_QueryValidatorRequest) Has(fd protoreflect.FieldDescript
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryValidatorRequest) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryCircuitBreakerRequest) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "mitosis.evmvalidator.v1.QueryValidatorRequest.val_addr":
-		x.ValAddr = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: mitosis.evmvalidator.v1.QueryValidatorRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mitosis.evmvalidator.v1.QueryCircuitBreakerRequest"))
 		}
-		panic(fmt.Errorf("message mitosis.evmvalidator.v1.QueryValidatorRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message mitosis.evmvalidator.v1.QueryCircuitBreakerRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1717,16 +1705,13 @@ func (x *fastReflection_QueryValidatorRequest) Clear(fd protoreflect.FieldDescri
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryValidatorRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryCircuitBreakerRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "mitosis.evmvalidator.v1.QueryValidatorRequest.val_addr":
-		value := x.ValAddr
-		return protoreflect.ValueOfBytes(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: mitosis.evmvalidator.v1.QueryValidatorRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mitosis.evmvalidator.v1.QueryCircuitBreakerRequest"))
 		}
-		panic(fmt.Errorf("message mitosis.evmvalidator.v1.QueryValidatorRequest does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message mitosis.evmvalidator.v1.QueryCircuitBreakerRequest does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -1740,15 +1725,13 @@ func (x *fastReflection_QueryValidatorRequest) Get(descriptor protoreflect.Field
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryValidatorRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryCircuitBreakerRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "mitosis.evmvalidator.v1.QueryValidatorRequest.val_addr":
-		x.ValAddr = value.Bytes()
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: mitosis.evmvalidator.v1.QueryValidatorRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mitosis.evmvalidator.v1.QueryCircuitBreakerRequest"))
 		}
-		panic(fmt.Errorf("message mitosis.evmvalidator.v1.QueryValidatorRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message mitosis.evmvalidator.v1.QueryCircuitBreakerRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1762,40 +1745,36 @@ func (x *fastReflection_QueryValidatorRequest) Set(fd protoreflect.FieldDescript
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryValidatorRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryCircuitBreakerRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "mitosis.evmvalidator.v1.QueryValidatorRequest.val_addr":
-		panic(fmt.Errorf("field val_addr of message mitosis.evmvalidator.v1.QueryValidatorRequest is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: mitosis.evmvalidator.v1.QueryValidatorRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mitosis.evmvalidator.v1.QueryCircuitBreakerRequest"))
 		}
-		panic(fmt.Errorf("message mitosis.evmvalidator.v1.QueryValidatorRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message mitosis.evmvalidator.v1.QueryCircuitBreakerRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryValidatorRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryCircuitBreakerRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "mitosis.evmvalidator.v1.QueryValidatorRequest.val_addr":
-		return protoreflect.ValueOfBytes(nil)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: mitosis.evmvalidator.v1.QueryValidatorRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mitosis.evmvalidator.v1.QueryCircuitBreakerRequest"))
 		}
-		panic(fmt.Errorf("message mitosis.evmvalidator.v1.QueryValidatorRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message mitosis.evmvalidator.v1.QueryCircuitBreakerRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryValidatorRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryCircuitBreakerRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in mitosis.evmvalidator.v1.QueryValidatorRequest", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in mitosis.evmvalidator.v1.QueryCircuitBreakerRequest", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -1803,7 +1782,7 @@ func (x *fastReflection_QueryValidatorRequest) WhichOneof(d protoreflect.OneofDe
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryValidatorRequest) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryCircuitBreakerRequest) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -1814,7 +1793,7 @@ func (x *fastReflection_QueryValidatorRequest) GetUnknown() protoreflect.RawFiel
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryValidatorRequest) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryCircuitBreakerRequest) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -1826,7 +1805,7 @@ func (x *fastReflection_QueryValidatorRequest) SetUnknown(fields protoreflect.Ra
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryValidatorRequest) IsValid() bool {
+func (x *fastReflection_QueryCircuitBreakerRequest) IsValid() bool {
 	return x != nil
 }
 
@@ -1836,9 +1815,9 @@ func (x *fastReflection_QueryValidatorRequest) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryValidatorRequest) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryCircuitBreakerRequest) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryValidatorRequest)
+		x := input.Message.Interface().(*QueryCircuitBreakerRequest)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1850,10 +1829,6 @@ func (x *fastReflection_QueryValidatorRequest) ProtoMethods() *protoiface.Method
 		var n int
 		var l int
 		_ = l
-		l = len(x.ValAddr)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -1864,7 +1839,7 @@ func (x *fastReflection_QueryValidatorRequest) ProtoMethods() *protoiface.Method
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryValidatorRequest)
+		x := input.Message.Interface().(*QueryCircuitBreakerRequest)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1883,13 +1858,6 @@ func (x *fastReflection_QueryValidatorRequest) ProtoMethods() *protoiface.Method
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.ValAddr) > 0 {
-			i -= len(x.ValAddr)
-			copy(dAtA[i:], x.ValAddr)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ValAddr)))
-			i--
-			dAtA[i] = 0xa
-		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
 		} else {
@@ -1901,7 +1869,7 @@ func (x *fastReflection_QueryValidatorRequest) ProtoMethods() *protoiface.Method
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryValidatorRequest)
+		x := input.Message.Interface().(*QueryCircuitBreakerRequest)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1933,46 +1901,12 @@ func (x *fastReflection_QueryValidatorRequest) ProtoMethods() *protoiface.Method
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryValidatorRequest: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryCircuitBreakerRequest: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryValidatorRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryCircuitBreakerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
-			case 1:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ValAddr", wireType)
-				}
-				var byteLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					byteLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if byteLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + byteLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.ValAddr = append(x.ValAddr[:0], dAtA[iNdEx:postIndex]...)
-				if x.ValAddr == nil {
-					x.ValAddr = []byte{}
-				}
-				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -2009,25 +1943,25 @@ func (x *fastReflection_QueryValidatorRequest) ProtoMethods() *protoiface.Method
 }
 
 var (
-	md_QueryValidatorResponse           protoreflect.MessageDescriptor
-	fd_QueryValidatorResponse_validator protoreflect.FieldDescriptor
+	md_QueryCircuitBreakerResponse                 protoreflect.MessageDescriptor
+	fd_QueryCircuitBreakerResponse_circuit_breaker protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_mitosis_evmvalidator_v1_query_proto_init()
-	md_QueryValidatorResponse = File_mitosis_evmvalidator_v1_query_proto.Messages().ByName("QueryValidatorResponse")
-	fd_QueryValidatorResponse_validator = md_QueryValidatorResponse.Fields().ByName("validator")
+	md_QueryCircuitBreakerResponse = File_mitosis_evmvalidator_v1_query_proto.Messages().ByName("QueryCircuitBreakerResponse")
+	fd_QueryCircuitBreakerResponse_circuit_breaker = md_QueryCircuitBreakerResponse.Fields().ByName("circuit_breaker")
 }
 
-var _ protoreflect.Message = (*fastReflection_QueryValidatorResponse)(nil)
+var _ protoreflect.Message = (*fastReflection_QueryCircuitBreakerResponse)(nil)
 
-type fastReflection_QueryValidatorResponse QueryValidatorResponse
+type fastReflection_QueryCircuitBreakerResponse QueryCircuitBreakerResponse
 
-func (x *QueryValidatorResponse) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryValidatorResponse)(x)
+func (x *QueryCircuitBreakerResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryCircuitBreakerResponse)(x)
 }
 
-func (x *QueryValidatorResponse) slowProtoReflect() protoreflect.Message {
+func (x *QueryCircuitBreakerResponse) slowProtoReflect() protoreflect.Message {
 	mi := &file_mitosis_evmvalidator_v1_query_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2039,43 +1973,43 @@ func (x *QueryValidatorResponse) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryValidatorResponse_messageType fastReflection_QueryValidatorResponse_messageType
-var _ protoreflect.MessageType = fastReflection_QueryValidatorResponse_messageType{}
+var _fastReflection_QueryCircuitBreakerResponse_messageType fastReflection_QueryCircuitBreakerResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryCircuitBreakerResponse_messageType{}
 
-type fastReflection_QueryValidatorResponse_messageType struct{}
+type fastReflection_QueryCircuitBreakerResponse_messageType struct{}
 
-func (x fastReflection_QueryValidatorResponse_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryValidatorResponse)(nil)
+func (x fastReflection_QueryCircuitBreakerResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryCircuitBreakerResponse)(nil)
 }
-func (x fastReflection_QueryValidatorResponse_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryValidatorResponse)
+func (x fastReflection_QueryCircuitBreakerResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryCircuitBreakerResponse)
 }
-func (x fastReflection_QueryValidatorResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryValidatorResponse
+func (x fastReflection_QueryCircuitBreakerResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryCircuitBreakerResponse
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryValidatorResponse) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryValidatorResponse
+func (x *fastReflection_QueryCircuitBreakerResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryCircuitBreakerResponse
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryValidatorResponse) Type() protoreflect.MessageType {
-	return _fastReflection_QueryValidatorResponse_messageType
+func (x *fastReflection_QueryCircuitBreakerResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryCircuitBreakerResponse_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryValidatorResponse) New() protoreflect.Message {
-	return new(fastReflection_QueryValidatorResponse)
+func (x *fastReflection_QueryCircuitBreakerResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryCircuitBreakerResponse)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryValidatorResponse) Interface() protoreflect.ProtoMessage {
-	return (*QueryValidatorResponse)(x)
+func (x *fastReflection_QueryCircuitBreakerResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryCircuitBreakerResponse)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -2083,10 +2017,10 @@ func (x *fastReflection_QueryValidatorResponse) Interface() protoreflect.ProtoMe
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryValidatorResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Validator != nil {
-		value := protoreflect.ValueOfMessage(x.Validator.ProtoReflect())
-		if !f(fd_QueryValidatorResponse_validator, value) {
+func (x *fastReflection_QueryCircuitBreakerResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.CircuitBreaker != nil {
+		value := protoreflect.ValueOfMessage(x.CircuitBreaker.ProtoReflect())
+		if !f(fd_QueryCircuitBreakerResponse_circuit_breaker, value) {
 			return
 		}
 	}
@@ -2103,15 +2037,15 @@ func (x *fastReflection_QueryValidatorResponse) Range(f func(protoreflect.FieldD
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryValidatorResponse) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryCircuitBreakerResponse) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "mitosis.evmvalidator.v1.QueryValidatorResponse.validator":
-		return x.Validator != nil
+	case "mitosis.evmvalidator.v1.QueryCircuitBreakerResponse.circuit_breaker":
+		return x.CircuitBreaker != nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: mitosis.evmvalidator.v1.QueryValidatorResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mitosis.evmvalidator.v1.QueryCircuitBreakerResponse"))
 		}
-		panic(fmt.Errorf("message mitosis.evmvalidator.v1.QueryValidatorResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message mitosis.evmvalidator.v1.QueryCircuitBreakerResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2121,15 +2055,15 @@ func (x *fastReflection_QueryValidatorResponse) Has(fd protoreflect.FieldDescrip
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryValidatorResponse) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryCircuitBreakerResponse) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "mitosis.evmvalidator.v1.QueryValidatorResponse.validator":
-		x.Validator = nil
+	case "mitosis.evmvalidator.v1.QueryCircuitBreakerResponse.circuit_breaker":
+		x.CircuitBreaker = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: mitosis.evmvalidator.v1.QueryValidatorResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mitosis.evmvalidator.v1.QueryCircuitBreakerResponse"))
 		}
-		panic(fmt.Errorf("message mitosis.evmvalidator.v1.QueryValidatorResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message mitosis.evmvalidator.v1.QueryCircuitBreakerResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2139,16 +2073,16 @@ func (x *fastReflection_QueryValidatorResponse) Clear(fd protoreflect.FieldDescr
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryValidatorResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryCircuitBreakerResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "mitosis.evmvalidator.v1.QueryValidatorResponse.validator":
-		value := x.Validator
+	case "mitosis.evmvalidator.v1.QueryCircuitBreakerResponse.circuit_breaker":
+		value := x.CircuitBreaker
 		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: mitosis.evmvalidator.v1.QueryValidatorResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mitosis.evmvalidator.v1.QueryCircuitBreakerResponse"))
 		}
-		panic(fmt.Errorf("message mitosis.evmvalidator.v1.QueryValidatorResponse does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message mitosis.evmvalidator.v1.QueryCircuitBreakerResponse does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -2162,15 +2096,15 @@ func (x *fastReflection_QueryValidatorResponse) Get(descriptor protoreflect.Fiel
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryValidatorResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryCircuitBreakerResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "mitosis.evmvalidator.v1.QueryValidatorResponse.validator":
-		x.Validator = value.Message().Interface().(*Validator)
+	case "mitosis.evmvalidator.v1.QueryCircuitBreakerResponse.circuit_breaker":
+		x.CircuitBreaker = value.Message().Interface().(*CircuitBreaker)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: mitosis.evmvalidator.v1.QueryValidatorResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mitosis.evmvalidator.v1.QueryCircuitBreakerResponse"))
 		}
-		panic(fmt.Errorf("message mitosis.evmvalidator.v1.QueryValidatorResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message mitosis.evmvalidator.v1.QueryCircuitBreakerResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2184,44 +2118,44 @@ func (x *fastReflection_QueryValidatorResponse) Set(fd protoreflect.FieldDescrip
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryValidatorResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryCircuitBreakerResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "mitosis.evmvalidator.v1.QueryValidatorResponse.validator":
-		if x.Validator == nil {
-			x.Validator = new(Validator)
+	case "mitosis.evmvalidator.v1.QueryCircuitBreakerResponse.circuit_breaker":
+		if x.CircuitBreaker == nil {
+			x.CircuitBreaker = new(CircuitBreaker)
 		}
-		return protoreflect.ValueOfMessage(x.Validator.ProtoReflect())
+		return protoreflect.ValueOfMessage(x.CircuitBreaker.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: mitosis.evmvalidator.v1.QueryValidatorResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mitosis.evmvalidator.v1.QueryCircuitBreakerResponse"))
 		}
-		panic(fmt.Errorf("message mitosis.evmvalidator.v1.QueryValidatorResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message mitosis.evmvalidator.v1.QueryCircuitBreakerResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryValidatorResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryCircuitBreakerResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "mitosis.evmvalidator.v1.QueryValidatorResponse.validator":
-		m := new(Validator)
+	case "mitosis.evmvalidator.v1.QueryCircuitBreakerResponse.circuit_breaker":
+		m := new(CircuitBreaker)
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: mitosis.evmvalidator.v1.QueryValidatorResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mitosis.evmvalidator.v1.QueryCircuitBreakerResponse"))
 		}
-		panic(fmt.Errorf("message mitosis.evmvalidator.v1.QueryValidatorResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message mitosis.evmvalidator.v1.QueryCircuitBreakerResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryValidatorResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryCircuitBreakerResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in mitosis.evmvalidator.v1.QueryValidatorResponse", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in mitosis.evmvalidator.v1.QueryCircuitBreakerResponse", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -2229,7 +2163,7 @@ func (x *fastReflection_QueryValidatorResponse) WhichOneof(d protoreflect.OneofD
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryValidatorResponse) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryCircuitBreakerResponse) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -2240,7 +2174,7 @@ func (x *fastReflection_QueryValidatorResponse) GetUnknown() protoreflect.RawFie
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryValidatorResponse) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryCircuitBreakerResponse) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -2252,7 +2186,7 @@ func (x *fastReflection_QueryValidatorResponse) SetUnknown(fields protoreflect.R
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryValidatorResponse) IsValid() bool {
+func (x *fastReflection_QueryCircuitBreakerResponse) IsValid() bool {
 	return x != nil
 }
 
@@ -2262,9 +2196,9 @@ func (x *fastReflection_QueryValidatorResponse) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryValidatorResponse) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryCircuitBreakerResponse) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryValidatorResponse)
+		x := input.Message.Interface().(*QueryCircuitBreakerResponse)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2276,8 +2210,8 @@ func (x *fastReflection_QueryValidatorResponse) ProtoMethods() *protoiface.Metho
 		var n int
 		var l int
 		_ = l
-		if x.Validator != nil {
-			l = options.Size(x.Validator)
+		if x.CircuitBreaker != nil {
+			l = options.Size(x.CircuitBreaker)
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
 		if x.unknownFields != nil {
@@ -2290,7 +2224,7 @@ func (x *fastReflection_QueryValidatorResponse) ProtoMethods() *protoiface.Metho
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryValidatorResponse)
+		x := input.Message.Interface().(*QueryCircuitBreakerResponse)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2309,8 +2243,8 @@ func (x *fastReflection_QueryValidatorResponse) ProtoMethods() *protoiface.Metho
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.Validator != nil {
-			encoded, err := options.Marshal(x.Validator)
+		if x.CircuitBreaker != nil {
+			encoded, err := options.Marshal(x.CircuitBreaker)
 			if err != nil {
 				return protoiface.MarshalOutput{
 					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2334,7 +2268,7 @@ func (x *fastReflection_QueryValidatorResponse) ProtoMethods() *protoiface.Metho
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryValidatorResponse)
+		x := input.Message.Interface().(*QueryCircuitBreakerResponse)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2366,15 +2300,15 @@ func (x *fastReflection_QueryValidatorResponse) ProtoMethods() *protoiface.Metho
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryValidatorResponse: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryCircuitBreakerResponse: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryValidatorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryCircuitBreakerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CircuitBreaker", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -2401,10 +2335,10 @@ func (x *fastReflection_QueryValidatorResponse) ProtoMethods() *protoiface.Metho
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.Validator == nil {
-					x.Validator = &Validator{}
+				if x.CircuitBreaker == nil {
+					x.CircuitBreaker = &CircuitBreaker{}
 				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Validator); err != nil {
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.CircuitBreaker); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
@@ -2444,25 +2378,25 @@ func (x *fastReflection_QueryValidatorResponse) ProtoMethods() *protoiface.Metho
 }
 
 var (
-	md_QueryValidatorByConsAddrRequest           protoreflect.MessageDescriptor
-	fd_QueryValidatorByConsAddrRequest_cons_addr protoreflect.FieldDescriptor
+	md_QueryValidatorRequest          protoreflect.MessageDescriptor
+	fd_QueryValidatorRequest_val_addr protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_mitosis_evmvalidator_v1_query_proto_init()
-	md_QueryValidatorByConsAddrRequest = File_mitosis_evmvalidator_v1_query_proto.Messages().ByName("QueryValidatorByConsAddrRequest")
-	fd_QueryValidatorByConsAddrRequest_cons_addr = md_QueryValidatorByConsAddrRequest.Fields().ByName("cons_addr")
+	md_QueryValidatorRequest = File_mitosis_evmvalidator_v1_query_proto.Messages().ByName("QueryValidatorRequest")
+	fd_QueryValidatorRequest_val_addr = md_QueryValidatorRequest.Fields().ByName("val_addr")
 }
 
-var _ protoreflect.Message = (*fastReflection_QueryValidatorByConsAddrRequest)(nil)
+var _ protoreflect.Message = (*fastReflection_QueryValidatorRequest)(nil)
 
-type fastReflection_QueryValidatorByConsAddrRequest QueryValidatorByConsAddrRequest
+type fastReflection_QueryValidatorRequest QueryValidatorRequest
 
-func (x *QueryValidatorByConsAddrRequest) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryValidatorByConsAddrRequest)(x)
+func (x *QueryValidatorRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryValidatorRequest)(x)
 }
 
-func (x *QueryValidatorByConsAddrRequest) slowProtoReflect() protoreflect.Message {
+func (x *QueryValidatorRequest) slowProtoReflect() protoreflect.Message {
 	mi := &file_mitosis_evmvalidator_v1_query_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2474,43 +2408,43 @@ func (x *QueryValidatorByConsAddrRequest) slowProtoReflect() protoreflect.Messag
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryValidatorByConsAddrRequest_messageType fastReflection_QueryValidatorByConsAddrRequest_messageType
-var _ protoreflect.MessageType = fastReflection_QueryValidatorByConsAddrRequest_messageType{}
+var _fastReflection_QueryValidatorRequest_messageType fastReflection_QueryValidatorRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryValidatorRequest_messageType{}
 
-type fastReflection_QueryValidatorByConsAddrRequest_messageType struct{}
+type fastReflection_QueryValidatorRequest_messageType struct{}
 
-func (x fastReflection_QueryValidatorByConsAddrRequest_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryValidatorByConsAddrRequest)(nil)
+func (x fastReflection_QueryValidatorRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryValidatorRequest)(nil)
 }
-func (x fastReflection_QueryValidatorByConsAddrRequest_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryValidatorByConsAddrRequest)
+func (x fastReflection_QueryValidatorRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryValidatorRequest)
 }
-func (x fastReflection_QueryValidatorByConsAddrRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryValidatorByConsAddrRequest
+func (x fastReflection_QueryValidatorRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryValidatorRequest
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryValidatorByConsAddrRequest) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryValidatorByConsAddrRequest
+func (x *fastReflection_QueryValidatorRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryValidatorRequest
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryValidatorByConsAddrRequest) Type() protoreflect.MessageType {
-	return _fastReflection_QueryValidatorByConsAddrRequest_messageType
+func (x *fastReflection_QueryValidatorRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryValidatorRequest_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryValidatorByConsAddrRequest) New() protoreflect.Message {
-	return new(fastReflection_QueryValidatorByConsAddrRequest)
+func (x *fastReflection_QueryValidatorRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryValidatorRequest)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryValidatorByConsAddrRequest) Interface() protoreflect.ProtoMessage {
-	return (*QueryValidatorByConsAddrRequest)(x)
+func (x *fastReflection_QueryValidatorRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryValidatorRequest)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -2518,10 +2452,10 @@ func (x *fastReflection_QueryValidatorByConsAddrRequest) Interface() protoreflec
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryValidatorByConsAddrRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.ConsAddr != "" {
-		value := protoreflect.ValueOfString(x.ConsAddr)
-		if !f(fd_QueryValidatorByConsAddrRequest_cons_addr, value) {
+func (x *fastReflection_QueryValidatorRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.ValAddr) != 0 {
+		value := protoreflect.ValueOfBytes(x.ValAddr)
+		if !f(fd_QueryValidatorRequest_val_addr, value) {
 			return
 		}
 	}
@@ -2538,15 +2472,15 @@ func (x *fastReflection_QueryValidatorByConsAddrRequest) Range(f func(protorefle
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryValidatorByConsAddrRequest) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryValidatorRequest) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "mitosis.evmvalidator.v1.QueryValidatorByConsAddrRequest.cons_addr":
-		return x.ConsAddr != ""
+	case "mitosis.evmvalidator.v1.QueryValidatorRequest.val_addr":
+		return len(x.ValAddr) != 0
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: mitosis.evmvalidator.v1.QueryValidatorByConsAddrRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mitosis.evmvalidator.v1.QueryValidatorRequest"))
 		}
-		panic(fmt.Errorf("message mitosis.evmvalidator.v1.QueryValidatorByConsAddrRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message mitosis.evmvalidator.v1.QueryValidatorRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2556,15 +2490,15 @@ func (x *fastReflection_QueryValidatorByConsAddrRequest) Has(fd protoreflect.Fie
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryValidatorByConsAddrRequest) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryValidatorRequest) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "mitosis.evmvalidator.v1.QueryValidatorByConsAddrRequest.cons_addr":
-		x.ConsAddr = ""
+	case "mitosis.evmvalidator.v1.QueryValidatorRequest.val_addr":
+		x.ValAddr = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: mitosis.evmvalidator.v1.QueryValidatorByConsAddrRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mitosis.evmvalidator.v1.QueryValidatorRequest"))
 		}
-		panic(fmt.Errorf("message mitosis.evmvalidator.v1.QueryValidatorByConsAddrRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message mitosis.evmvalidator.v1.QueryValidatorRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2574,16 +2508,16 @@ func (x *fastReflection_QueryValidatorByConsAddrRequest) Clear(fd protoreflect.F
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryValidatorByConsAddrRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryValidatorRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "mitosis.evmvalidator.v1.QueryValidatorByConsAddrRequest.cons_addr":
-		value := x.ConsAddr
-		return protoreflect.ValueOfString(value)
+	case "mitosis.evmvalidator.v1.QueryValidatorRequest.val_addr":
+		value := x.ValAddr
+		return protoreflect.ValueOfBytes(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: mitosis.evmvalidator.v1.QueryValidatorByConsAddrRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mitosis.evmvalidator.v1.QueryValidatorRequest"))
 		}
-		panic(fmt.Errorf("message mitosis.evmvalidator.v1.QueryValidatorByConsAddrRequest does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message mitosis.evmvalidator.v1.QueryValidatorRequest does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -2597,15 +2531,15 @@ func (x *fastReflection_QueryValidatorByConsAddrRequest) Get(descriptor protoref
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryValidatorByConsAddrRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryValidatorRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "mitosis.evmvalidator.v1.QueryValidatorByConsAddrRequest.cons_addr":
-		x.ConsAddr = value.Interface().(string)
+	case "mitosis.evmvalidator.v1.QueryValidatorRequest.val_addr":
+		x.ValAddr = value.Bytes()
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: mitosis.evmvalidator.v1.QueryValidatorByConsAddrRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mitosis.evmvalidator.v1.QueryValidatorRequest"))
 		}
-		panic(fmt.Errorf("message mitosis.evmvalidator.v1.QueryValidatorByConsAddrRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message mitosis.evmvalidator.v1.QueryValidatorRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2619,40 +2553,40 @@ func (x *fastReflection_QueryValidatorByConsAddrRequest) Set(fd protoreflect.Fie
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryValidatorByConsAddrRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryValidatorRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "mitosis.evmvalidator.v1.QueryValidatorByConsAddrRequest.cons_addr":
-		panic(fmt.Errorf("field cons_addr of message mitosis.evmvalidator.v1.QueryValidatorByConsAddrRequest is not mutable"))
+	case "mitosis.evmvalidator.v1.QueryValidatorRequest.val_addr":
+		panic(fmt.Errorf("field val_addr of message mitosis.evmvalidator.v1.QueryValidatorRequest is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: mitosis.evmvalidator.v1.QueryValidatorByConsAddrRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mitosis.evmvalidator.v1.QueryValidatorRequest"))
 		}
-		panic(fmt.Errorf("message mitosis.evmvalidator.v1.QueryValidatorByConsAddrRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message mitosis.evmvalidator.v1.QueryValidatorRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryValidatorByConsAddrRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryValidatorRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "mitosis.evmvalidator.v1.QueryValidatorByConsAddrRequest.cons_addr":
-		return protoreflect.ValueOfString("")
+	case "mitosis.evmvalidator.v1.QueryValidatorRequest.val_addr":
+		return protoreflect.ValueOfBytes(nil)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: mitosis.evmvalidator.v1.QueryValidatorByConsAddrRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mitosis.evmvalidator.v1.QueryValidatorRequest"))
 		}
-		panic(fmt.Errorf("message mitosis.evmvalidator.v1.QueryValidatorByConsAddrRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message mitosis.evmvalidator.v1.QueryValidatorRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryValidatorByConsAddrRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryValidatorRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in mitosis.evmvalidator.v1.QueryValidatorByConsAddrRequest", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in mitosis.evmvalidator.v1.QueryValidatorRequest", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -2660,7 +2594,7 @@ func (x *fastReflection_QueryValidatorByConsAddrRequest) WhichOneof(d protorefle
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryValidatorByConsAddrRequest) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryValidatorRequest) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -2671,7 +2605,7 @@ func (x *fastReflection_QueryValidatorByConsAddrRequest) GetUnknown() protorefle
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryValidatorByConsAddrRequest) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryValidatorRequest) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -2683,7 +2617,7 @@ func (x *fastReflection_QueryValidatorByConsAddrRequest) SetUnknown(fields proto
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryValidatorByConsAddrRequest) IsValid() bool {
+func (x *fastReflection_QueryValidatorRequest) IsValid() bool {
 	return x != nil
 }
 
@@ -2693,9 +2627,9 @@ func (x *fastReflection_QueryValidatorByConsAddrRequest) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryValidatorByConsAddrRequest) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryValidatorRequest) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryValidatorByConsAddrRequest)
+		x := input.Message.Interface().(*QueryValidatorRequest)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2707,7 +2641,7 @@ func (x *fastReflection_QueryValidatorByConsAddrRequest) ProtoMethods() *protoif
 		var n int
 		var l int
 		_ = l
-		l = len(x.ConsAddr)
+		l = len(x.ValAddr)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
@@ -2721,7 +2655,7 @@ func (x *fastReflection_QueryValidatorByConsAddrRequest) ProtoMethods() *protoif
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryValidatorByConsAddrRequest)
+		x := input.Message.Interface().(*QueryValidatorRequest)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2740,10 +2674,10 @@ func (x *fastReflection_QueryValidatorByConsAddrRequest) ProtoMethods() *protoif
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.ConsAddr) > 0 {
-			i -= len(x.ConsAddr)
-			copy(dAtA[i:], x.ConsAddr)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ConsAddr)))
+		if len(x.ValAddr) > 0 {
+			i -= len(x.ValAddr)
+			copy(dAtA[i:], x.ValAddr)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ValAddr)))
 			i--
 			dAtA[i] = 0xa
 		}
@@ -2758,7 +2692,7 @@ func (x *fastReflection_QueryValidatorByConsAddrRequest) ProtoMethods() *protoif
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryValidatorByConsAddrRequest)
+		x := input.Message.Interface().(*QueryValidatorRequest)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2790,17 +2724,17 @@ func (x *fastReflection_QueryValidatorByConsAddrRequest) ProtoMethods() *protoif
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryValidatorByConsAddrRequest: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryValidatorRequest: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryValidatorByConsAddrRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryValidatorRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ConsAddr", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ValAddr", wireType)
 				}
-				var stringLen uint64
+				var byteLen int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -2810,23 +2744,25 @@ func (x *fastReflection_QueryValidatorByConsAddrRequest) ProtoMethods() *protoif
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
+					byteLen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
+				if byteLen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + intStringLen
+				postIndex := iNdEx + byteLen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.ConsAddr = string(dAtA[iNdEx:postIndex])
+				x.ValAddr = append(x.ValAddr[:0], dAtA[iNdEx:postIndex]...)
+				if x.ValAddr == nil {
+					x.ValAddr = []byte{}
+				}
 				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
@@ -2864,25 +2800,25 @@ func (x *fastReflection_QueryValidatorByConsAddrRequest) ProtoMethods() *protoif
 }
 
 var (
-	md_QueryValidatorByConsAddrResponse           protoreflect.MessageDescriptor
-	fd_QueryValidatorByConsAddrResponse_validator protoreflect.FieldDescriptor
+	md_QueryValidatorResponse           protoreflect.MessageDescriptor
+	fd_QueryValidatorResponse_validator protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_mitosis_evmvalidator_v1_query_proto_init()
-	md_QueryValidatorByConsAddrResponse = File_mitosis_evmvalidator_v1_query_proto.Messages().ByName("QueryValidatorByConsAddrResponse")
-	fd_QueryValidatorByConsAddrResponse_validator = md_QueryValidatorByConsAddrResponse.Fields().ByName("validator")
+	md_QueryValidatorResponse = File_mitosis_evmvalidator_v1_query_proto.Messages().ByName("QueryValidatorResponse")
+	fd_QueryValidatorResponse_validator = md_QueryValidatorResponse.Fields().ByName("validator")
 }
 
-var _ protoreflect.Message = (*fastReflection_QueryValidatorByConsAddrResponse)(nil)
+var _ protoreflect.Message = (*fastReflection_QueryValidatorResponse)(nil)
 
-type fastReflection_QueryValidatorByConsAddrResponse QueryValidatorByConsAddrResponse
+type fastReflection_QueryValidatorResponse QueryValidatorResponse
 
-func (x *QueryValidatorByConsAddrResponse) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryValidatorByConsAddrResponse)(x)
+func (x *QueryValidatorResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryValidatorResponse)(x)
 }
 
-func (x *QueryValidatorByConsAddrResponse) slowProtoReflect() protoreflect.Message {
+func (x *QueryValidatorResponse) slowProtoReflect() protoreflect.Message {
 	mi := &file_mitosis_evmvalidator_v1_query_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2894,43 +2830,43 @@ func (x *QueryValidatorByConsAddrResponse) slowProtoReflect() protoreflect.Messa
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryValidatorByConsAddrResponse_messageType fastReflection_QueryValidatorByConsAddrResponse_messageType
-var _ protoreflect.MessageType = fastReflection_QueryValidatorByConsAddrResponse_messageType{}
+var _fastReflection_QueryValidatorResponse_messageType fastReflection_QueryValidatorResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryValidatorResponse_messageType{}
 
-type fastReflection_QueryValidatorByConsAddrResponse_messageType struct{}
+type fastReflection_QueryValidatorResponse_messageType struct{}
 
-func (x fastReflection_QueryValidatorByConsAddrResponse_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryValidatorByConsAddrResponse)(nil)
+func (x fastReflection_QueryValidatorResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryValidatorResponse)(nil)
 }
-func (x fastReflection_QueryValidatorByConsAddrResponse_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryValidatorByConsAddrResponse)
+func (x fastReflection_QueryValidatorResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryValidatorResponse)
 }
-func (x fastReflection_QueryValidatorByConsAddrResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryValidatorByConsAddrResponse
+func (x fastReflection_QueryValidatorResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryValidatorResponse
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryValidatorByConsAddrResponse) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryValidatorByConsAddrResponse
+func (x *fastReflection_QueryValidatorResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryValidatorResponse
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryValidatorByConsAddrResponse) Type() protoreflect.MessageType {
-	return _fastReflection_QueryValidatorByConsAddrResponse_messageType
+func (x *fastReflection_QueryValidatorResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryValidatorResponse_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryValidatorByConsAddrResponse) New() protoreflect.Message {
-	return new(fastReflection_QueryValidatorByConsAddrResponse)
+func (x *fastReflection_QueryValidatorResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryValidatorResponse)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryValidatorByConsAddrResponse) Interface() protoreflect.ProtoMessage {
-	return (*QueryValidatorByConsAddrResponse)(x)
+func (x *fastReflection_QueryValidatorResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryValidatorResponse)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -2938,10 +2874,10 @@ func (x *fastReflection_QueryValidatorByConsAddrResponse) Interface() protorefle
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryValidatorByConsAddrResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+func (x *fastReflection_QueryValidatorResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
 	if x.Validator != nil {
 		value := protoreflect.ValueOfMessage(x.Validator.ProtoReflect())
-		if !f(fd_QueryValidatorByConsAddrResponse_validator, value) {
+		if !f(fd_QueryValidatorResponse_validator, value) {
 			return
 		}
 	}
@@ -2958,15 +2894,15 @@ func (x *fastReflection_QueryValidatorByConsAddrResponse) Range(f func(protorefl
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryValidatorByConsAddrResponse) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryValidatorResponse) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "mitosis.evmvalidator.v1.QueryValidatorByConsAddrResponse.validator":
+	case "mitosis.evmvalidator.v1.QueryValidatorResponse.validator":
 		return x.Validator != nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: mitosis.evmvalidator.v1.QueryValidatorByConsAddrResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mitosis.evmvalidator.v1.QueryValidatorResponse"))
 		}
-		panic(fmt.Errorf("message mitosis.evmvalidator.v1.QueryValidatorByConsAddrResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message mitosis.evmvalidator.v1.QueryValidatorResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2976,15 +2912,15 @@ func (x *fastReflection_QueryValidatorByConsAddrResponse) Has(fd protoreflect.Fi
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryValidatorByConsAddrResponse) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryValidatorResponse) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "mitosis.evmvalidator.v1.QueryValidatorByConsAddrResponse.validator":
+	case "mitosis.evmvalidator.v1.QueryValidatorResponse.validator":
 		x.Validator = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: mitosis.evmvalidator.v1.QueryValidatorByConsAddrResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mitosis.evmvalidator.v1.QueryValidatorResponse"))
 		}
-		panic(fmt.Errorf("message mitosis.evmvalidator.v1.QueryValidatorByConsAddrResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message mitosis.evmvalidator.v1.QueryValidatorResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2994,16 +2930,16 @@ func (x *fastReflection_QueryValidatorByConsAddrResponse) Clear(fd protoreflect.
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryValidatorByConsAddrResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryValidatorResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "mitosis.evmvalidator.v1.QueryValidatorByConsAddrResponse.validator":
+	case "mitosis.evmvalidator.v1.QueryValidatorResponse.validator":
 		value := x.Validator
 		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: mitosis.evmvalidator.v1.QueryValidatorByConsAddrResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mitosis.evmvalidator.v1.QueryValidatorResponse"))
 		}
-		panic(fmt.Errorf("message mitosis.evmvalidator.v1.QueryValidatorByConsAddrResponse does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message mitosis.evmvalidator.v1.QueryValidatorResponse does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -3017,15 +2953,15 @@ func (x *fastReflection_QueryValidatorByConsAddrResponse) Get(descriptor protore
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryValidatorByConsAddrResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryValidatorResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "mitosis.evmvalidator.v1.QueryValidatorByConsAddrResponse.validator":
+	case "mitosis.evmvalidator.v1.QueryValidatorResponse.validator":
 		x.Validator = value.Message().Interface().(*Validator)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: mitosis.evmvalidator.v1.QueryValidatorByConsAddrResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mitosis.evmvalidator.v1.QueryValidatorResponse"))
 		}
-		panic(fmt.Errorf("message mitosis.evmvalidator.v1.QueryValidatorByConsAddrResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message mitosis.evmvalidator.v1.QueryValidatorResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3039,44 +2975,44 @@ func (x *fastReflection_QueryValidatorByConsAddrResponse) Set(fd protoreflect.Fi
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryValidatorByConsAddrResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryValidatorResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "mitosis.evmvalidator.v1.QueryValidatorByConsAddrResponse.validator":
+	case "mitosis.evmvalidator.v1.QueryValidatorResponse.validator":
 		if x.Validator == nil {
 			x.Validator = new(Validator)
 		}
 		return protoreflect.ValueOfMessage(x.Validator.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: mitosis.evmvalidator.v1.QueryValidatorByConsAddrResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mitosis.evmvalidator.v1.QueryValidatorResponse"))
 		}
-		panic(fmt.Errorf("message mitosis.evmvalidator.v1.QueryValidatorByConsAddrResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message mitosis.evmvalidator.v1.QueryValidatorResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryValidatorByConsAddrResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryValidatorResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "mitosis.evmvalidator.v1.QueryValidatorByConsAddrResponse.validator":
+	case "mitosis.evmvalidator.v1.QueryValidatorResponse.validator":
 		m := new(Validator)
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: mitosis.evmvalidator.v1.QueryValidatorByConsAddrResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mitosis.evmvalidator.v1.QueryValidatorResponse"))
 		}
-		panic(fmt.Errorf("message mitosis.evmvalidator.v1.QueryValidatorByConsAddrResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message mitosis.evmvalidator.v1.QueryValidatorResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryValidatorByConsAddrResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryValidatorResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in mitosis.evmvalidator.v1.QueryValidatorByConsAddrResponse", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in mitosis.evmvalidator.v1.QueryValidatorResponse", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -3084,7 +3020,7 @@ func (x *fastReflection_QueryValidatorByConsAddrResponse) WhichOneof(d protorefl
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryValidatorByConsAddrResponse) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryValidatorResponse) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -3095,7 +3031,7 @@ func (x *fastReflection_QueryValidatorByConsAddrResponse) GetUnknown() protorefl
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryValidatorByConsAddrResponse) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryValidatorResponse) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -3107,7 +3043,7 @@ func (x *fastReflection_QueryValidatorByConsAddrResponse) SetUnknown(fields prot
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryValidatorByConsAddrResponse) IsValid() bool {
+func (x *fastReflection_QueryValidatorResponse) IsValid() bool {
 	return x != nil
 }
 
@@ -3117,9 +3053,9 @@ func (x *fastReflection_QueryValidatorByConsAddrResponse) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryValidatorByConsAddrResponse) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryValidatorResponse) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryValidatorByConsAddrResponse)
+		x := input.Message.Interface().(*QueryValidatorResponse)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3145,7 +3081,7 @@ func (x *fastReflection_QueryValidatorByConsAddrResponse) ProtoMethods() *protoi
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryValidatorByConsAddrResponse)
+		x := input.Message.Interface().(*QueryValidatorResponse)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3189,7 +3125,7 @@ func (x *fastReflection_QueryValidatorByConsAddrResponse) ProtoMethods() *protoi
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryValidatorByConsAddrResponse)
+		x := input.Message.Interface().(*QueryValidatorResponse)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3221,10 +3157,10 @@ func (x *fastReflection_QueryValidatorByConsAddrResponse) ProtoMethods() *protoi
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryValidatorByConsAddrResponse: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryValidatorResponse: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryValidatorByConsAddrResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryValidatorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
@@ -3299,25 +3235,25 @@ func (x *fastReflection_QueryValidatorByConsAddrResponse) ProtoMethods() *protoi
 }
 
 var (
-	md_QueryValidatorsRequest            protoreflect.MessageDescriptor
-	fd_QueryValidatorsRequest_pagination protoreflect.FieldDescriptor
+	md_QueryValidatorByConsAddrRequest           protoreflect.MessageDescriptor
+	fd_QueryValidatorByConsAddrRequest_cons_addr protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_mitosis_evmvalidator_v1_query_proto_init()
-	md_QueryValidatorsRequest = File_mitosis_evmvalidator_v1_query_proto.Messages().ByName("QueryValidatorsRequest")
-	fd_QueryValidatorsRequest_pagination = md_QueryValidatorsRequest.Fields().ByName("pagination")
+	md_QueryValidatorByConsAddrRequest = File_mitosis_evmvalidator_v1_query_proto.Messages().ByName("QueryValidatorByConsAddrRequest")
+	fd_QueryValidatorByConsAddrRequest_cons_addr = md_QueryValidatorByConsAddrRequest.Fields().ByName("cons_addr")
 }
 
-var _ protoreflect.Message = (*fastReflection_QueryValidatorsRequest)(nil)
+var _ protoreflect.Message = (*fastReflection_QueryValidatorByConsAddrRequest)(nil)
 
-type fastReflection_QueryValidatorsRequest QueryValidatorsRequest
+type fastReflection_QueryValidatorByConsAddrRequest QueryValidatorByConsAddrRequest
 
-func (x *QueryValidatorsRequest) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryValidatorsRequest)(x)
-}
+func (x *QueryValidatorByConsAddrRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryValidatorByConsAddrRequest)(x)
+}
 
-func (x *QueryValidatorsRequest) slowProtoReflect() protoreflect.Message {
+func (x *QueryValidatorByConsAddrRequest) slowProtoReflect() protoreflect.Message {
 	mi := &file_mitosis_evmvalidator_v1_query_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3329,43 +3265,43 @@ func (x *QueryValidatorsRequest) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryValidatorsRequest_messageType fastReflection_QueryValidatorsRequest_messageType
-var _ protoreflect.MessageType = fastReflection_QueryValidatorsRequest_messageType{}
+var _fastReflection_QueryValidatorByConsAddrRequest_messageType fastReflection_QueryValidatorByConsAddrRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryValidatorByConsAddrRequest_messageType{}
 
-type fastReflection_QueryValidatorsRequest_messageType struct{}
+type fastReflection_QueryValidatorByConsAddrRequest_messageType struct{}
 
-func (x fastReflection_QueryValidatorsRequest_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryValidatorsRequest)(nil)
+func (x fastReflection_QueryValidatorByConsAddrRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryValidatorByConsAddrRequest)(nil)
 }
-func (x fastReflection_QueryValidatorsRequest_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryValidatorsRequest)
+func (x fastReflection_QueryValidatorByConsAddrRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryValidatorByConsAddrRequest)
 }
-func (x fastReflection_QueryValidatorsRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryValidatorsRequest
+func (x fastReflection_QueryValidatorByConsAddrRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryValidatorByConsAddrRequest
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryValidatorsRequest) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryValidatorsRequest
+func (x *fastReflection_QueryValidatorByConsAddrRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryValidatorByConsAddrRequest
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryValidatorsRequest) Type() protoreflect.MessageType {
-	return _fastReflection_QueryValidatorsRequest_messageType
+func (x *fastReflection_QueryValidatorByConsAddrRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryValidatorByConsAddrRequest_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryValidatorsRequest) New() protoreflect.Message {
-	return new(fastReflection_QueryValidatorsRequest)
+func (x *fastReflection_QueryValidatorByConsAddrRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryValidatorByConsAddrRequest)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryValidatorsRequest) Interface() protoreflect.ProtoMessage {
-	return (*QueryValidatorsRequest)(x)
+func (x *fastReflection_QueryValidatorByConsAddrRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryValidatorByConsAddrRequest)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -3373,10 +3309,10 @@ func (x *fastReflection_QueryValidatorsRequest) Interface() protoreflect.ProtoMe
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryValidatorsRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Pagination != nil {
-		value := protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
-		if !f(fd_QueryValidatorsRequest_pagination, value) {
+func (x *fastReflection_QueryValidatorByConsAddrRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.ConsAddr != "" {
+		value := protoreflect.ValueOfString(x.ConsAddr)
+		if !f(fd_QueryValidatorByConsAddrRequest_cons_addr, value) {
 			return
 		}
 	}
@@ -3393,15 +3329,15 @@ func (x *fastReflection_QueryValidatorsRequest) Range(f func(protoreflect.FieldD
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryValidatorsRequest) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryValidatorByConsAddrRequest) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "mitosis.evmvalidator.v1.QueryValidatorsRequest.pagination":
-		return x.Pagination != nil
+	case "mitosis.evmvalidator.v1.QueryValidatorByConsAddrRequest.cons_addr":
+		return x.ConsAddr != ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: mitosis.evmvalidator.v1.QueryValidatorsRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mitosis.evmvalidator.v1.QueryValidatorByConsAddrRequest"))
 		}
-		panic(fmt.Errorf("message mitosis.evmvalidator.v1.QueryValidatorsRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message mitosis.evmvalidator.v1.QueryValidatorByConsAddrRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3411,15 +3347,15 @@ func (x *fastReflection_QueryValidatorsRequest) Has(fd protoreflect.FieldDescrip
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryValidatorsRequest) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryValidatorByConsAddrRequest) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "mitosis.evmvalidator.v1.QueryValidatorsRequest.pagination":
-		x.Pagination = nil
+	case "mitosis.evmvalidator.v1.QueryValidatorByConsAddrRequest.cons_addr":
+		x.ConsAddr = ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: mitosis.evmvalidator.v1.QueryValidatorsRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mitosis.evmvalidator.v1.QueryValidatorByConsAddrRequest"))
 		}
-		panic(fmt.Errorf("message mitosis.evmvalidator.v1.QueryValidatorsRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message mitosis.evmvalidator.v1.QueryValidatorByConsAddrRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3429,16 +3365,16 @@ func (x *fastReflection_QueryValidatorsRequest) Clear(fd protoreflect.FieldDescr
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryValidatorsRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryValidatorByConsAddrRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "mitosis.evmvalidator.v1.QueryValidatorsRequest.pagination":
-		value := x.Pagination
-		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "mitosis.evmvalidator.v1.QueryValidatorByConsAddrRequest.cons_addr":
+		value := x.ConsAddr
+		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: mitosis.evmvalidator.v1.QueryValidatorsRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mitosis.evmvalidator.v1.QueryValidatorByConsAddrRequest"))
 		}
-		panic(fmt.Errorf("message mitosis.evmvalidator.v1.QueryValidatorsRequest does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message mitosis.evmvalidator.v1.QueryValidatorByConsAddrRequest does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -3452,15 +3388,15 @@ func (x *fastReflection_QueryValidatorsRequest) Get(descriptor protoreflect.Fiel
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryValidatorsRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryValidatorByConsAddrRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "mitosis.evmvalidator.v1.QueryValidatorsRequest.pagination":
-		x.Pagination = value.Message().Interface().(*v1beta1.PageRequest)
+	case "mitosis.evmvalidator.v1.QueryValidatorByConsAddrRequest.cons_addr":
+		x.ConsAddr = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: mitosis.evmvalidator.v1.QueryValidatorsRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mitosis.evmvalidator.v1.QueryValidatorByConsAddrRequest"))
 		}
-		panic(fmt.Errorf("message mitosis.evmvalidator.v1.QueryValidatorsRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message mitosis.evmvalidator.v1.QueryValidatorByConsAddrRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3474,44 +3410,40 @@ func (x *fastReflection_QueryValidatorsRequest) Set(fd protoreflect.FieldDescrip
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryValidatorsRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryValidatorByConsAddrRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "mitosis.evmvalidator.v1.QueryValidatorsRequest.pagination":
-		if x.Pagination == nil {
-			x.Pagination = new(v1beta1.PageRequest)
-		}
-		return protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+	case "mitosis.evmvalidator.v1.QueryValidatorByConsAddrRequest.cons_addr":
+		panic(fmt.Errorf("field cons_addr of message mitosis.evmvalidator.v1.QueryValidatorByConsAddrRequest is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: mitosis.evmvalidator.v1.QueryValidatorsRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mitosis.evmvalidator.v1.QueryValidatorByConsAddrRequest"))
 		}
-		panic(fmt.Errorf("message mitosis.evmvalidator.v1.QueryValidatorsRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message mitosis.evmvalidator.v1.QueryValidatorByConsAddrRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryValidatorsRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryValidatorByConsAddrRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "mitosis.evmvalidator.v1.QueryValidatorsRequest.pagination":
-		m := new(v1beta1.PageRequest)
-		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "mitosis.evmvalidator.v1.QueryValidatorByConsAddrRequest.cons_addr":
+		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: mitosis.evmvalidator.v1.QueryValidatorsRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mitosis.evmvalidator.v1.QueryValidatorByConsAddrRequest"))
 		}
-		panic(fmt.Errorf("message mitosis.evmvalidator.v1.QueryValidatorsRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message mitosis.evmvalidator.v1.QueryValidatorByConsAddrRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryValidatorsRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryValidatorByConsAddrRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in mitosis.evmvalidator.v1.QueryValidatorsRequest", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in mitosis.evmvalidator.v1.QueryValidatorByConsAddrRequest", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -3519,7 +3451,7 @@ func (x *fastReflection_QueryValidatorsRequest) WhichOneof(d protoreflect.OneofD
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryValidatorsRequest) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryValidatorByConsAddrRequest) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -3530,7 +3462,7 @@ func (x *fastReflection_QueryValidatorsRequest) GetUnknown() protoreflect.RawFie
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryValidatorsRequest) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryValidatorByConsAddrRequest) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -3542,7 +3474,7 @@ func (x *fastReflection_QueryValidatorsRequest) SetUnknown(fields protoreflect.R
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryValidatorsRequest) IsValid() bool {
+func (x *fastReflection_QueryValidatorByConsAddrRequest) IsValid() bool {
 	return x != nil
 }
 
@@ -3552,9 +3484,9 @@ func (x *fastReflection_QueryValidatorsRequest) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryValidatorsRequest) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryValidatorByConsAddrRequest) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryValidatorsRequest)
+		x := input.Message.Interface().(*QueryValidatorByConsAddrRequest)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3566,8 +3498,8 @@ func (x *fastReflection_QueryValidatorsRequest) ProtoMethods() *protoiface.Metho
 		var n int
 		var l int
 		_ = l
-		if x.Pagination != nil {
-			l = options.Size(x.Pagination)
+		l = len(x.ConsAddr)
+		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
 		if x.unknownFields != nil {
@@ -3580,7 +3512,7 @@ func (x *fastReflection_QueryValidatorsRequest) ProtoMethods() *protoiface.Metho
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryValidatorsRequest)
+		x := input.Message.Interface().(*QueryValidatorByConsAddrRequest)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3599,17 +3531,10 @@ func (x *fastReflection_QueryValidatorsRequest) ProtoMethods() *protoiface.Metho
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.Pagination != nil {
-			encoded, err := options.Marshal(x.Pagination)
-			if err != nil {
-				return protoiface.MarshalOutput{
-					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-					Buf:               input.Buf,
-				}, err
-			}
-			i -= len(encoded)
-			copy(dAtA[i:], encoded)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+		if len(x.ConsAddr) > 0 {
+			i -= len(x.ConsAddr)
+			copy(dAtA[i:], x.ConsAddr)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ConsAddr)))
 			i--
 			dAtA[i] = 0xa
 		}
@@ -3624,7 +3549,7 @@ func (x *fastReflection_QueryValidatorsRequest) ProtoMethods() *protoiface.Metho
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryValidatorsRequest)
+		x := input.Message.Interface().(*QueryValidatorByConsAddrRequest)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3656,17 +3581,17 @@ func (x *fastReflection_QueryValidatorsRequest) ProtoMethods() *protoiface.Metho
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryValidatorsRequest: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryValidatorByConsAddrRequest: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryValidatorsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryValidatorByConsAddrRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ConsAddr", wireType)
 				}
-				var msglen int
+				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -3676,27 +3601,23 @@ func (x *fastReflection_QueryValidatorsRequest) ProtoMethods() *protoiface.Metho
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					msglen |= int(b&0x7F) << shift
+					stringLen |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if msglen < 0 {
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + msglen
+				postIndex := iNdEx + intStringLen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.Pagination == nil {
-					x.Pagination = &v1beta1.PageRequest{}
-				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Pagination); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
+				x.ConsAddr = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
@@ -3733,79 +3654,26 @@ func (x *fastReflection_QueryValidatorsRequest) ProtoMethods() *protoiface.Metho
 	}
 }
 
-var _ protoreflect.List = (*_QueryValidatorsResponse_1_list)(nil)
-
-type _QueryValidatorsResponse_1_list struct {
-	list *[]*Validator
-}
-
-func (x *_QueryValidatorsResponse_1_list) Len() int {
-	if x.list == nil {
-		return 0
-	}
-	return len(*x.list)
-}
-
-func (x *_QueryValidatorsResponse_1_list) Get(i int) protoreflect.Value {
-	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
-}
-
-func (x *_QueryValidatorsResponse_1_list) Set(i int, value protoreflect.Value) {
-	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*Validator)
-	(*x.list)[i] = concreteValue
-}
-
-func (x *_QueryValidatorsResponse_1_list) Append(value protoreflect.Value) {
-	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*Validator)
-	*x.list = append(*x.list, concreteValue)
-}
-
-func (x *_QueryValidatorsResponse_1_list) AppendMutable() protoreflect.Value {
-	v := new(Validator)
-	*x.list = append(*x.list, v)
-	return protoreflect.ValueOfMessage(v.ProtoReflect())
-}
-
-func (x *_QueryValidatorsResponse_1_list) Truncate(n int) {
-	for i := n; i < len(*x.list); i++ {
-		(*x.list)[i] = nil
-	}
-	*x.list = (*x.list)[:n]
-}
-
-func (x *_QueryValidatorsResponse_1_list) NewElement() protoreflect.Value {
-	v := new(Validator)
-	return protoreflect.ValueOfMessage(v.ProtoReflect())
-}
-
-func (x *_QueryValidatorsResponse_1_list) IsValid() bool {
-	return x.list != nil
-}
-
 var (
-	md_QueryValidatorsResponse            protoreflect.MessageDescriptor
-	fd_QueryValidatorsResponse_validators protoreflect.FieldDescriptor
-	fd_QueryValidatorsResponse_pagination protoreflect.FieldDescriptor
+	md_QueryValidatorByConsAddrResponse           protoreflect.MessageDescriptor
+	fd_QueryValidatorByConsAddrResponse_validator protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_mitosis_evmvalidator_v1_query_proto_init()
-	md_QueryValidatorsResponse = File_mitosis_evmvalidator_v1_query_proto.Messages().ByName("QueryValidatorsResponse")
-	fd_QueryValidatorsResponse_validators = md_QueryValidatorsResponse.Fields().ByName("validators")
-	fd_QueryValidatorsResponse_pagination = md_QueryValidatorsResponse.Fields().ByName("pagination")
+	md_QueryValidatorByConsAddrResponse = File_mitosis_evmvalidator_v1_query_proto.Messages().ByName("QueryValidatorByConsAddrResponse")
+	fd_QueryValidatorByConsAddrResponse_validator = md_QueryValidatorByConsAddrResponse.Fields().ByName("validator")
 }
 
-var _ protoreflect.Message = (*fastReflection_QueryValidatorsResponse)(nil)
+var _ protoreflect.Message = (*fastReflection_QueryValidatorByConsAddrResponse)(nil)
 
-type fastReflection_QueryValidatorsResponse QueryValidatorsResponse
+type fastReflection_QueryValidatorByConsAddrResponse QueryValidatorByConsAddrResponse
 
-func (x *QueryValidatorsResponse) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryValidatorsResponse)(x)
+func (x *QueryValidatorByConsAddrResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryValidatorByConsAddrResponse)(x)
 }
 
-func (x *QueryValidatorsResponse) slowProtoReflect() protoreflect.Message {
+func (x *QueryValidatorByConsAddrResponse) slowProtoReflect() protoreflect.Message {
 	mi := &file_mitosis_evmvalidator_v1_query_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3817,43 +3685,43 @@ func (x *QueryValidatorsResponse) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryValidatorsResponse_messageType fastReflection_QueryValidatorsResponse_messageType
-var _ protoreflect.MessageType = fastReflection_QueryValidatorsResponse_messageType{}
+var _fastReflection_QueryValidatorByConsAddrResponse_messageType fastReflection_QueryValidatorByConsAddrResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryValidatorByConsAddrResponse_messageType{}
 
-type fastReflection_QueryValidatorsResponse_messageType struct{}
+type fastReflection_QueryValidatorByConsAddrResponse_messageType struct{}
 
-func (x fastReflection_QueryValidatorsResponse_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryValidatorsResponse)(nil)
-}
-func (x fastReflection_QueryValidatorsResponse_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryValidatorsResponse)
+func (x fastReflection_QueryValidatorByConsAddrResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryValidatorByConsAddrResponse)(nil)
 }
-func (x fastReflection_QueryValidatorsResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryValidatorsResponse
+func (x fastReflection_QueryValidatorByConsAddrResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryValidatorByConsAddrResponse)
+}
+func (x fastReflection_QueryValidatorByConsAddrResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryValidatorByConsAddrResponse
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryValidatorsResponse) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryValidatorsResponse
+func (x *fastReflection_QueryValidatorByConsAddrResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryValidatorByConsAddrResponse
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryValidatorsResponse) Type() protoreflect.MessageType {
-	return _fastReflection_QueryValidatorsResponse_messageType
+func (x *fastReflection_QueryValidatorByConsAddrResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryValidatorByConsAddrResponse_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryValidatorsResponse) New() protoreflect.Message {
-	return new(fastReflection_QueryValidatorsResponse)
+func (x *fastReflection_QueryValidatorByConsAddrResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryValidatorByConsAddrResponse)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryValidatorsResponse) Interface() protoreflect.ProtoMessage {
-	return (*QueryValidatorsResponse)(x)
+func (x *fastReflection_QueryValidatorByConsAddrResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryValidatorByConsAddrResponse)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -3861,16 +3729,10 @@ func (x *fastReflection_QueryValidatorsResponse) Interface() protoreflect.ProtoM
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryValidatorsResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if len(x.Validators) != 0 {
-		value := protoreflect.ValueOfList(&_QueryValidatorsResponse_1_list{list: &x.Validators})
-		if !f(fd_QueryValidatorsResponse_validators, value) {
-			return
-		}
-	}
-	if x.Pagination != nil {
-		value := protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
-		if !f(fd_QueryValidatorsResponse_pagination, value) {
+func (x *fastReflection_QueryValidatorByConsAddrResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Validator != nil {
+		value := protoreflect.ValueOfMessage(x.Validator.ProtoReflect())
+		if !f(fd_QueryValidatorByConsAddrResponse_validator, value) {
 			return
 		}
 	}
@@ -3887,17 +3749,15 @@ func (x *fastReflection_QueryValidatorsResponse) Range(f func(protoreflect.Field
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryValidatorsResponse) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryValidatorByConsAddrResponse) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "mitosis.evmvalidator.v1.QueryValidatorsResponse.validators":
-		return len(x.Validators) != 0
-	case "mitosis.evmvalidator.v1.QueryValidatorsResponse.pagination":
-		return x.Pagination != nil
+	case "mitosis.evmvalidator.v1.QueryValidatorByConsAddrResponse.validator":
+		return x.Validator != nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: mitosis.evmvalidator.v1.QueryValidatorsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mitosis.evmvalidator.v1.QueryValidatorByConsAddrResponse"))
 		}
-		panic(fmt.Errorf("message mitosis.evmvalidator.v1.QueryValidatorsResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message mitosis.evmvalidator.v1.QueryValidatorByConsAddrResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3907,17 +3767,15 @@ func (x *fastReflection_QueryValidatorsResponse) Has(fd protoreflect.FieldDescri
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryValidatorsResponse) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryValidatorByConsAddrResponse) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "mitosis.evmvalidator.v1.QueryValidatorsResponse.validators":
-		x.Validators = nil
-	case "mitosis.evmvalidator.v1.QueryValidatorsResponse.pagination":
-		x.Pagination = nil
+	case "mitosis.evmvalidator.v1.QueryValidatorByConsAddrResponse.validator":
+		x.Validator = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: mitosis.evmvalidator.v1.QueryValidatorsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mitosis.evmvalidator.v1.QueryValidatorByConsAddrResponse"))
 		}
-		panic(fmt.Errorf("message mitosis.evmvalidator.v1.QueryValidatorsResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message mitosis.evmvalidator.v1.QueryValidatorByConsAddrResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3927,22 +3785,16 @@ func (x *fastReflection_QueryValidatorsResponse) Clear(fd protoreflect.FieldDesc
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryValidatorsResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryValidatorByConsAddrResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "mitosis.evmvalidator.v1.QueryValidatorsResponse.validators":
-		if len(x.Validators) == 0 {
-			return protoreflect.ValueOfList(&_QueryValidatorsResponse_1_list{})
-		}
-		listValue := &_QueryValidatorsResponse_1_list{list: &x.Validators}
-		return protoreflect.ValueOfList(listValue)
-	case "mitosis.evmvalidator.v1.QueryValidatorsResponse.pagination":
-		value := x.Pagination
+	case "mitosis.evmvalidator.v1.QueryValidatorByConsAddrResponse.validator":
+		value := x.Validator
 		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: mitosis.evmvalidator.v1.QueryValidatorsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mitosis.evmvalidator.v1.QueryValidatorByConsAddrResponse"))
 		}
-		panic(fmt.Errorf("message mitosis.evmvalidator.v1.QueryValidatorsResponse does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message mitosis.evmvalidator.v1.QueryValidatorByConsAddrResponse does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -3956,19 +3808,15 @@ func (x *fastReflection_QueryValidatorsResponse) Get(descriptor protoreflect.Fie
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryValidatorsResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryValidatorByConsAddrResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "mitosis.evmvalidator.v1.QueryValidatorsResponse.validators":
-		lv := value.List()
-		clv := lv.(*_QueryValidatorsResponse_1_list)
-		x.Validators = *clv.list
-	case "mitosis.evmvalidator.v1.QueryValidatorsResponse.pagination":
-		x.Pagination = value.Message().Interface().(*v1beta1.PageResponse)
+	case "mitosis.evmvalidator.v1.QueryValidatorByConsAddrResponse.validator":
+		x.Validator = value.Message().Interface().(*Validator)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: mitosis.evmvalidator.v1.QueryValidatorsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mitosis.evmvalidator.v1.QueryValidatorByConsAddrResponse"))
 		}
-		panic(fmt.Errorf("message mitosis.evmvalidator.v1.QueryValidatorsResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message mitosis.evmvalidator.v1.QueryValidatorByConsAddrResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3982,53 +3830,44 @@ func (x *fastReflection_QueryValidatorsResponse) Set(fd protoreflect.FieldDescri
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryValidatorsResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryValidatorByConsAddrResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "mitosis.evmvalidator.v1.QueryValidatorsResponse.validators":
-		if x.Validators == nil {
-			x.Validators = []*Validator{}
-		}
-		value := &_QueryValidatorsResponse_1_list{list: &x.Validators}
-		return protoreflect.ValueOfList(value)
-	case "mitosis.evmvalidator.v1.QueryValidatorsResponse.pagination":
-		if x.Pagination == nil {
-			x.Pagination = new(v1beta1.PageResponse)
+	case "mitosis.evmvalidator.v1.QueryValidatorByConsAddrResponse.validator":
+		if x.Validator == nil {
+			x.Validator = new(Validator)
 		}
-		return protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+		return protoreflect.ValueOfMessage(x.Validator.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: mitosis.evmvalidator.v1.QueryValidatorsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mitosis.evmvalidator.v1.QueryValidatorByConsAddrResponse"))
 		}
-		panic(fmt.Errorf("message mitosis.evmvalidator.v1.QueryValidatorsResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message mitosis.evmvalidator.v1.QueryValidatorByConsAddrResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryValidatorsResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryValidatorByConsAddrResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "mitosis.evmvalidator.v1.QueryValidatorsResponse.validators":
-		list := []*Validator{}
-		return protoreflect.ValueOfList(&_QueryValidatorsResponse_1_list{list: &list})
-	case "mitosis.evmvalidator.v1.QueryValidatorsResponse.pagination":
-		m := new(v1beta1.PageResponse)
+	case "mitosis.evmvalidator.v1.QueryValidatorByConsAddrResponse.validator":
+		m := new(Validator)
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: mitosis.evmvalidator.v1.QueryValidatorsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mitosis.evmvalidator.v1.QueryValidatorByConsAddrResponse"))
 		}
-		panic(fmt.Errorf("message mitosis.evmvalidator.v1.QueryValidatorsResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message mitosis.evmvalidator.v1.QueryValidatorByConsAddrResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryValidatorsResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryValidatorByConsAddrResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in mitosis.evmvalidator.v1.QueryValidatorsResponse", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in mitosis.evmvalidator.v1.QueryValidatorByConsAddrResponse", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -4036,7 +3875,7 @@ func (x *fastReflection_QueryValidatorsResponse) WhichOneof(d protoreflect.Oneof
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryValidatorsResponse) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryValidatorByConsAddrResponse) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -4047,7 +3886,7 @@ func (x *fastReflection_QueryValidatorsResponse) GetUnknown() protoreflect.RawFi
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryValidatorsResponse) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryValidatorByConsAddrResponse) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -4059,7 +3898,7 @@ func (x *fastReflection_QueryValidatorsResponse) SetUnknown(fields protoreflect.
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryValidatorsResponse) IsValid() bool {
+func (x *fastReflection_QueryValidatorByConsAddrResponse) IsValid() bool {
 	return x != nil
 }
 
@@ -4069,9 +3908,9 @@ func (x *fastReflection_QueryValidatorsResponse) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryValidatorsResponse) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryValidatorByConsAddrResponse) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryValidatorsResponse)
+		x := input.Message.Interface().(*QueryValidatorByConsAddrResponse)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4083,14 +3922,8 @@ func (x *fastReflection_QueryValidatorsResponse) ProtoMethods() *protoiface.Meth
 		var n int
 		var l int
 		_ = l
-		if len(x.Validators) > 0 {
-			for _, e := range x.Validators {
-				l = options.Size(e)
-				n += 1 + l + runtime.Sov(uint64(l))
-			}
-		}
-		if x.Pagination != nil {
-			l = options.Size(x.Pagination)
+		if x.Validator != nil {
+			l = options.Size(x.Validator)
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
 		if x.unknownFields != nil {
@@ -4103,7 +3936,7 @@ func (x *fastReflection_QueryValidatorsResponse) ProtoMethods() *protoiface.Meth
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryValidatorsResponse)
+		x := input.Message.Interface().(*QueryValidatorByConsAddrResponse)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4122,8 +3955,8 @@ func (x *fastReflection_QueryValidatorsResponse) ProtoMethods() *protoiface.Meth
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.Pagination != nil {
-			encoded, err := options.Marshal(x.Pagination)
+		if x.Validator != nil {
+			encoded, err := options.Marshal(x.Validator)
 			if err != nil {
 				return protoiface.MarshalOutput{
 					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4134,23 +3967,7 @@ func (x *fastReflection_QueryValidatorsResponse) ProtoMethods() *protoiface.Meth
 			copy(dAtA[i:], encoded)
 			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 			i--
-			dAtA[i] = 0x12
-		}
-		if len(x.Validators) > 0 {
-			for iNdEx := len(x.Validators) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.Validators[iNdEx])
-				if err != nil {
-					return protoiface.MarshalOutput{
-						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-						Buf:               input.Buf,
-					}, err
-				}
-				i -= len(encoded)
-				copy(dAtA[i:], encoded)
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-				i--
-				dAtA[i] = 0xa
-			}
+			dAtA[i] = 0xa
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -4163,7 +3980,7 @@ func (x *fastReflection_QueryValidatorsResponse) ProtoMethods() *protoiface.Meth
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryValidatorsResponse)
+		x := input.Message.Interface().(*QueryValidatorByConsAddrResponse)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4195,49 +4012,15 @@ func (x *fastReflection_QueryValidatorsResponse) ProtoMethods() *protoiface.Meth
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryValidatorsResponse: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryValidatorByConsAddrResponse: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryValidatorsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryValidatorByConsAddrResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Validators", wireType)
-				}
-				var msglen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					msglen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if msglen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + msglen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.Validators = append(x.Validators, &Validator{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Validators[len(x.Validators)-1]); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
-				iNdEx = postIndex
-			case 2:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -4264,10 +4047,10 @@ func (x *fastReflection_QueryValidatorsResponse) ProtoMethods() *protoiface.Meth
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.Pagination == nil {
-					x.Pagination = &v1beta1.PageResponse{}
+				if x.Validator == nil {
+					x.Validator = &Validator{}
 				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Pagination); err != nil {
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Validator); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
@@ -4307,25 +4090,25 @@ func (x *fastReflection_QueryValidatorsResponse) ProtoMethods() *protoiface.Meth
 }
 
 var (
-	md_QueryWithdrawalRequest    protoreflect.MessageDescriptor
-	fd_QueryWithdrawalRequest_id protoreflect.FieldDescriptor
+	md_QueryValidatorsRequest            protoreflect.MessageDescriptor
+	fd_QueryValidatorsRequest_pagination protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_mitosis_evmvalidator_v1_query_proto_init()
-	md_QueryWithdrawalRequest = File_mitosis_evmvalidator_v1_query_proto.Messages().ByName("QueryWithdrawalRequest")
-	fd_QueryWithdrawalRequest_id = md_QueryWithdrawalRequest.Fields().ByName("id")
+	md_QueryValidatorsRequest = File_mitosis_evmvalidator_v1_query_proto.Messages().ByName("QueryValidatorsRequest")
+	fd_QueryValidatorsRequest_pagination = md_QueryValidatorsRequest.Fields().ByName("pagination")
 }
 
-var _ protoreflect.Message = (*fastReflection_QueryWithdrawalRequest)(nil)
+var _ protoreflect.Message = (*fastReflection_QueryValidatorsRequest)(nil)
 
-type fastReflection_QueryWithdrawalRequest QueryWithdrawalRequest
+type fastReflection_QueryValidatorsRequest QueryValidatorsRequest
 
-func (x *QueryWithdrawalRequest) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryWithdrawalRequest)(x)
+func (x *QueryValidatorsRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryValidatorsRequest)(x)
 }
 
-func (x *QueryWithdrawalRequest) slowProtoReflect() protoreflect.Message {
+func (x *QueryValidatorsRequest) slowProtoReflect() protoreflect.Message {
 	mi := &file_mitosis_evmvalidator_v1_query_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4337,43 +4120,43 @@ func (x *QueryWithdrawalRequest) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryWithdrawalRequest_messageType fastReflection_QueryWithdrawalRequest_messageType
-var _ protoreflect.MessageType = fastReflection_QueryWithdrawalRequest_messageType{}
+var _fastReflection_QueryValidatorsRequest_messageType fastReflection_QueryValidatorsRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryValidatorsRequest_messageType{}
 
-type fastReflection_QueryWithdrawalRequest_messageType struct{}
+type fastReflection_QueryValidatorsRequest_messageType struct{}
 
-func (x fastReflection_QueryWithdrawalRequest_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryWithdrawalRequest)(nil)
+func (x fastReflection_QueryValidatorsRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryValidatorsRequest)(nil)
 }
-func (x fastReflection_QueryWithdrawalRequest_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryWithdrawalRequest)
+func (x fastReflection_QueryValidatorsRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryValidatorsRequest)
 }
-func (x fastReflection_QueryWithdrawalRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryWithdrawalRequest
+func (x fastReflection_QueryValidatorsRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryValidatorsRequest
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryWithdrawalRequest) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryWithdrawalRequest
+func (x *fastReflection_QueryValidatorsRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryValidatorsRequest
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryWithdrawalRequest) Type() protoreflect.MessageType {
-	return _fastReflection_QueryWithdrawalRequest_messageType
+func (x *fastReflection_QueryValidatorsRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryValidatorsRequest_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryWithdrawalRequest) New() protoreflect.Message {
-	return new(fastReflection_QueryWithdrawalRequest)
+func (x *fastReflection_QueryValidatorsRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryValidatorsRequest)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryWithdrawalRequest) Interface() protoreflect.ProtoMessage {
-	return (*QueryWithdrawalRequest)(x)
+func (x *fastReflection_QueryValidatorsRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryValidatorsRequest)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -4381,10 +4164,10 @@ func (x *fastReflection_QueryWithdrawalRequest) Interface() protoreflect.ProtoMe
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryWithdrawalRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Id != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.Id)
-		if !f(fd_QueryWithdrawalRequest_id, value) {
+func (x *fastReflection_QueryValidatorsRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Pagination != nil {
+		value := protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+		if !f(fd_QueryValidatorsRequest_pagination, value) {
 			return
 		}
 	}
@@ -4401,15 +4184,15 @@ func (x *fastReflection_QueryWithdrawalRequest) Range(f func(protoreflect.FieldD
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryWithdrawalRequest) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryValidatorsRequest) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "mitosis.evmvalidator.v1.QueryWithdrawalRequest.id":
-		return x.Id != uint64(0)
+	case "mitosis.evmvalidator.v1.QueryValidatorsRequest.pagination":
+		return x.Pagination != nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: mitosis.evmvalidator.v1.QueryWithdrawalRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mitosis.evmvalidator.v1.QueryValidatorsRequest"))
 		}
-		panic(fmt.Errorf("message mitosis.evmvalidator.v1.QueryWithdrawalRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message mitosis.evmvalidator.v1.QueryValidatorsRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4419,15 +4202,15 @@ func (x *fastReflection_QueryWithdrawalRequest) Has(fd protoreflect.FieldDescrip
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryWithdrawalRequest) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryValidatorsRequest) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "mitosis.evmvalidator.v1.QueryWithdrawalRequest.id":
-		x.Id = uint64(0)
+	case "mitosis.evmvalidator.v1.QueryValidatorsRequest.pagination":
+		x.Pagination = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: mitosis.evmvalidator.v1.QueryWithdrawalRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mitosis.evmvalidator.v1.QueryValidatorsRequest"))
 		}
-		panic(fmt.Errorf("message mitosis.evmvalidator.v1.QueryWithdrawalRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message mitosis.evmvalidator.v1.QueryValidatorsRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4437,16 +4220,16 @@ func (x *fastReflection_QueryWithdrawalRequest) Clear(fd protoreflect.FieldDescr
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryWithdrawalRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryValidatorsRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "mitosis.evmvalidator.v1.QueryWithdrawalRequest.id":
-		value := x.Id
-		return protoreflect.ValueOfUint64(value)
+	case "mitosis.evmvalidator.v1.QueryValidatorsRequest.pagination":
+		value := x.Pagination
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: mitosis.evmvalidator.v1.QueryWithdrawalRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mitosis.evmvalidator.v1.QueryValidatorsRequest"))
 		}
-		panic(fmt.Errorf("message mitosis.evmvalidator.v1.QueryWithdrawalRequest does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message mitosis.evmvalidator.v1.QueryValidatorsRequest does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -4460,15 +4243,15 @@ func (x *fastReflection_QueryWithdrawalRequest) Get(descriptor protoreflect.Fiel
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryWithdrawalRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryValidatorsRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "mitosis.evmvalidator.v1.QueryWithdrawalRequest.id":
-		x.Id = value.Uint()
+	case "mitosis.evmvalidator.v1.QueryValidatorsRequest.pagination":
+		x.Pagination = value.Message().Interface().(*v1beta1.PageRequest)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: mitosis.evmvalidator.v1.QueryWithdrawalRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mitosis.evmvalidator.v1.QueryValidatorsRequest"))
 		}
-		panic(fmt.Errorf("message mitosis.evmvalidator.v1.QueryWithdrawalRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message mitosis.evmvalidator.v1.QueryValidatorsRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4482,40 +4265,44 @@ func (x *fastReflection_QueryWithdrawalRequest) Set(fd protoreflect.FieldDescrip
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryWithdrawalRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryValidatorsRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "mitosis.evmvalidator.v1.QueryWithdrawalRequest.id":
-		panic(fmt.Errorf("field id of message mitosis.evmvalidator.v1.QueryWithdrawalRequest is not mutable"))
+	case "mitosis.evmvalidator.v1.QueryValidatorsRequest.pagination":
+		if x.Pagination == nil {
+			x.Pagination = new(v1beta1.PageRequest)
+		}
+		return protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: mitosis.evmvalidator.v1.QueryWithdrawalRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mitosis.evmvalidator.v1.QueryValidatorsRequest"))
 		}
-		panic(fmt.Errorf("message mitosis.evmvalidator.v1.QueryWithdrawalRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message mitosis.evmvalidator.v1.QueryValidatorsRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryWithdrawalRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryValidatorsRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "mitosis.evmvalidator.v1.QueryWithdrawalRequest.id":
-		return protoreflect.ValueOfUint64(uint64(0))
+	case "mitosis.evmvalidator.v1.QueryValidatorsRequest.pagination":
+		m := new(v1beta1.PageRequest)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: mitosis.evmvalidator.v1.QueryWithdrawalRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mitosis.evmvalidator.v1.QueryValidatorsRequest"))
 		}
-		panic(fmt.Errorf("message mitosis.evmvalidator.v1.QueryWithdrawalRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message mitosis.evmvalidator.v1.QueryValidatorsRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryWithdrawalRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryValidatorsRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in mitosis.evmvalidator.v1.QueryWithdrawalRequest", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in mitosis.evmvalidator.v1.QueryValidatorsRequest", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -4523,7 +4310,7 @@ func (x *fastReflection_QueryWithdrawalRequest) WhichOneof(d protoreflect.OneofD
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryWithdrawalRequest) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryValidatorsRequest) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -4534,7 +4321,7 @@ func (x *fastReflection_QueryWithdrawalRequest) GetUnknown() protoreflect.RawFie
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryWithdrawalRequest) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryValidatorsRequest) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -4546,7 +4333,7 @@ func (x *fastReflection_QueryWithdrawalRequest) SetUnknown(fields protoreflect.R
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryWithdrawalRequest) IsValid() bool {
+func (x *fastReflection_QueryValidatorsRequest) IsValid() bool {
 	return x != nil
 }
 
@@ -4556,9 +4343,9 @@ func (x *fastReflection_QueryWithdrawalRequest) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryWithdrawalRequest) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryValidatorsRequest) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryWithdrawalRequest)
+		x := input.Message.Interface().(*QueryValidatorsRequest)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4570,8 +4357,9 @@ func (x *fastReflection_QueryWithdrawalRequest) ProtoMethods() *protoiface.Metho
 		var n int
 		var l int
 		_ = l
-		if x.Id != 0 {
-			n += 1 + runtime.Sov(uint64(x.Id))
+		if x.Pagination != nil {
+			l = options.Size(x.Pagination)
+			n += 1 + l + runtime.Sov(uint64(l))
 		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
@@ -4583,7 +4371,7 @@ func (x *fastReflection_QueryWithdrawalRequest) ProtoMethods() *protoiface.Metho
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryWithdrawalRequest)
+		x := input.Message.Interface().(*QueryValidatorsRequest)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4602,10 +4390,19 @@ func (x *fastReflection_QueryWithdrawalRequest) ProtoMethods() *protoiface.Metho
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.Id != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.Id))
+		if x.Pagination != nil {
+			encoded, err := options.Marshal(x.Pagination)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 			i--
-			dAtA[i] = 0x8
+			dAtA[i] = 0xa
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -4618,7 +4415,7 @@ func (x *fastReflection_QueryWithdrawalRequest) ProtoMethods() *protoiface.Metho
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryWithdrawalRequest)
+		x := input.Message.Interface().(*QueryValidatorsRequest)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4650,17 +4447,17 @@ func (x *fastReflection_QueryWithdrawalRequest) ProtoMethods() *protoiface.Metho
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryWithdrawalRequest: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryValidatorsRequest: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryWithdrawalRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryValidatorsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 				}
-				x.Id = 0
+				var msglen int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -4670,11 +4467,28 @@ func (x *fastReflection_QueryWithdrawalRequest) ProtoMethods() *protoiface.Metho
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.Id |= uint64(b&0x7F) << shift
+					msglen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Pagination == nil {
+					x.Pagination = &v1beta1.PageRequest{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Pagination); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -4710,26 +4524,79 @@ func (x *fastReflection_QueryWithdrawalRequest) ProtoMethods() *protoiface.Metho
 	}
 }
 
+var _ protoreflect.List = (*_QueryValidatorsResponse_1_list)(nil)
+
+type _QueryValidatorsResponse_1_list struct {
+	list *[]*Validator
+}
+
+func (x *_QueryValidatorsResponse_1_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_QueryValidatorsResponse_1_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_QueryValidatorsResponse_1_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*Validator)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_QueryValidatorsResponse_1_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*Validator)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_QueryValidatorsResponse_1_list) AppendMutable() protoreflect.Value {
+	v := new(Validator)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryValidatorsResponse_1_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_QueryValidatorsResponse_1_list) NewElement() protoreflect.Value {
+	v := new(Validator)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryValidatorsResponse_1_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
-	md_QueryWithdrawalResponse            protoreflect.MessageDescriptor
-	fd_QueryWithdrawalResponse_withdrawal protoreflect.FieldDescriptor
+	md_QueryValidatorsResponse            protoreflect.MessageDescriptor
+	fd_QueryValidatorsResponse_validators protoreflect.FieldDescriptor
+	fd_QueryValidatorsResponse_pagination protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_mitosis_evmvalidator_v1_query_proto_init()
-	md_QueryWithdrawalResponse = File_mitosis_evmvalidator_v1_query_proto.Messages().ByName("QueryWithdrawalResponse")
-	fd_QueryWithdrawalResponse_withdrawal = md_QueryWithdrawalResponse.Fields().ByName("withdrawal")
+	md_QueryValidatorsResponse = File_mitosis_evmvalidator_v1_query_proto.Messages().ByName("QueryValidatorsResponse")
+	fd_QueryValidatorsResponse_validators = md_QueryValidatorsResponse.Fields().ByName("validators")
+	fd_QueryValidatorsResponse_pagination = md_QueryValidatorsResponse.Fields().ByName("pagination")
 }
 
-var _ protoreflect.Message = (*fastReflection_QueryWithdrawalResponse)(nil)
+var _ protoreflect.Message = (*fastReflection_QueryValidatorsResponse)(nil)
 
-type fastReflection_QueryWithdrawalResponse QueryWithdrawalResponse
+type fastReflection_QueryValidatorsResponse QueryValidatorsResponse
 
-func (x *QueryWithdrawalResponse) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryWithdrawalResponse)(x)
+func (x *QueryValidatorsResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryValidatorsResponse)(x)
 }
 
-func (x *QueryWithdrawalResponse) slowProtoReflect() protoreflect.Message {
+func (x *QueryValidatorsResponse) slowProtoReflect() protoreflect.Message {
 	mi := &file_mitosis_evmvalidator_v1_query_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4741,43 +4608,43 @@ func (x *QueryWithdrawalResponse) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryWithdrawalResponse_messageType fastReflection_QueryWithdrawalResponse_messageType
-var _ protoreflect.MessageType = fastReflection_QueryWithdrawalResponse_messageType{}
+var _fastReflection_QueryValidatorsResponse_messageType fastReflection_QueryValidatorsResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryValidatorsResponse_messageType{}
 
-type fastReflection_QueryWithdrawalResponse_messageType struct{}
+type fastReflection_QueryValidatorsResponse_messageType struct{}
 
-func (x fastReflection_QueryWithdrawalResponse_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryWithdrawalResponse)(nil)
+func (x fastReflection_QueryValidatorsResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryValidatorsResponse)(nil)
 }
-func (x fastReflection_QueryWithdrawalResponse_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryWithdrawalResponse)
+func (x fastReflection_QueryValidatorsResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryValidatorsResponse)
 }
-func (x fastReflection_QueryWithdrawalResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryWithdrawalResponse
+func (x fastReflection_QueryValidatorsResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryValidatorsResponse
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryWithdrawalResponse) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryWithdrawalResponse
+func (x *fastReflection_QueryValidatorsResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryValidatorsResponse
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryWithdrawalResponse) Type() protoreflect.MessageType {
-	return _fastReflection_QueryWithdrawalResponse_messageType
+func (x *fastReflection_QueryValidatorsResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryValidatorsResponse_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryWithdrawalResponse) New() protoreflect.Message {
-	return new(fastReflection_QueryWithdrawalResponse)
+func (x *fastReflection_QueryValidatorsResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryValidatorsResponse)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryWithdrawalResponse) Interface() protoreflect.ProtoMessage {
-	return (*QueryWithdrawalResponse)(x)
+func (x *fastReflection_QueryValidatorsResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryValidatorsResponse)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -4785,10 +4652,16 @@ func (x *fastReflection_QueryWithdrawalResponse) Interface() protoreflect.ProtoM
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryWithdrawalResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Withdrawal != nil {
-		value := protoreflect.ValueOfMessage(x.Withdrawal.ProtoReflect())
-		if !f(fd_QueryWithdrawalResponse_withdrawal, value) {
+func (x *fastReflection_QueryValidatorsResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.Validators) != 0 {
+		value := protoreflect.ValueOfList(&_QueryValidatorsResponse_1_list{list: &x.Validators})
+		if !f(fd_QueryValidatorsResponse_validators, value) {
+			return
+		}
+	}
+	if x.Pagination != nil {
+		value := protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+		if !f(fd_QueryValidatorsResponse_pagination, value) {
 			return
 		}
 	}
@@ -4805,15 +4678,17 @@ func (x *fastReflection_QueryWithdrawalResponse) Range(f func(protoreflect.Field
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryWithdrawalResponse) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryValidatorsResponse) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "mitosis.evmvalidator.v1.QueryWithdrawalResponse.withdrawal":
-		return x.Withdrawal != nil
+	case "mitosis.evmvalidator.v1.QueryValidatorsResponse.validators":
+		return len(x.Validators) != 0
+	case "mitosis.evmvalidator.v1.QueryValidatorsResponse.pagination":
+		return x.Pagination != nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: mitosis.evmvalidator.v1.QueryWithdrawalResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mitosis.evmvalidator.v1.QueryValidatorsResponse"))
 		}
-		panic(fmt.Errorf("message mitosis.evmvalidator.v1.QueryWithdrawalResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message mitosis.evmvalidator.v1.QueryValidatorsResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4823,15 +4698,17 @@ func (x *fastReflection_QueryWithdrawalResponse) Has(fd protoreflect.FieldDescri
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryWithdrawalResponse) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryValidatorsResponse) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "mitosis.evmvalidator.v1.QueryWithdrawalResponse.withdrawal":
-		x.Withdrawal = nil
+	case "mitosis.evmvalidator.v1.QueryValidatorsResponse.validators":
+		x.Validators = nil
+	case "mitosis.evmvalidator.v1.QueryValidatorsResponse.pagination":
+		x.Pagination = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: mitosis.evmvalidator.v1.QueryWithdrawalResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mitosis.evmvalidator.v1.QueryValidatorsResponse"))
 		}
-		panic(fmt.Errorf("message mitosis.evmvalidator.v1.QueryWithdrawalResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message mitosis.evmvalidator.v1.QueryValidatorsResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4841,16 +4718,22 @@ func (x *fastReflection_QueryWithdrawalResponse) Clear(fd protoreflect.FieldDesc
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryWithdrawalResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryValidatorsResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "mitosis.evmvalidator.v1.QueryWithdrawalResponse.withdrawal":
-		value := x.Withdrawal
+	case "mitosis.evmvalidator.v1.QueryValidatorsResponse.validators":
+		if len(x.Validators) == 0 {
+			return protoreflect.ValueOfList(&_QueryValidatorsResponse_1_list{})
+		}
+		listValue := &_QueryValidatorsResponse_1_list{list: &x.Validators}
+		return protoreflect.ValueOfList(listValue)
+	case "mitosis.evmvalidator.v1.QueryValidatorsResponse.pagination":
+		value := x.Pagination
 		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: mitosis.evmvalidator.v1.QueryWithdrawalResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mitosis.evmvalidator.v1.QueryValidatorsResponse"))
 		}
-		panic(fmt.Errorf("message mitosis.evmvalidator.v1.QueryWithdrawalResponse does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message mitosis.evmvalidator.v1.QueryValidatorsResponse does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -4864,15 +4747,19 @@ func (x *fastReflection_QueryWithdrawalResponse) Get(descriptor protoreflect.Fie
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryWithdrawalResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryValidatorsResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "mitosis.evmvalidator.v1.QueryWithdrawalResponse.withdrawal":
-		x.Withdrawal = value.Message().Interface().(*Withdrawal)
+	case "mitosis.evmvalidator.v1.QueryValidatorsResponse.validators":
+		lv := value.List()
+		clv := lv.(*_QueryValidatorsResponse_1_list)
+		x.Validators = *clv.list
+	case "mitosis.evmvalidator.v1.QueryValidatorsResponse.pagination":
+		x.Pagination = value.Message().Interface().(*v1beta1.PageResponse)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: mitosis.evmvalidator.v1.QueryWithdrawalResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mitosis.evmvalidator.v1.QueryValidatorsResponse"))
 		}
-		panic(fmt.Errorf("message mitosis.evmvalidator.v1.QueryWithdrawalResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message mitosis.evmvalidator.v1.QueryValidatorsResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4886,44 +4773,53 @@ func (x *fastReflection_QueryWithdrawalResponse) Set(fd protoreflect.FieldDescri
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryWithdrawalResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryValidatorsResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "mitosis.evmvalidator.v1.QueryWithdrawalResponse.withdrawal":
-		if x.Withdrawal == nil {
-			x.Withdrawal = new(Withdrawal)
+	case "mitosis.evmvalidator.v1.QueryValidatorsResponse.validators":
+		if x.Validators == nil {
+			x.Validators = []*Validator{}
 		}
-		return protoreflect.ValueOfMessage(x.Withdrawal.ProtoReflect())
+		value := &_QueryValidatorsResponse_1_list{list: &x.Validators}
+		return protoreflect.ValueOfList(value)
+	case "mitosis.evmvalidator.v1.QueryValidatorsResponse.pagination":
+		if x.Pagination == nil {
+			x.Pagination = new(v1beta1.PageResponse)
+		}
+		return protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: mitosis.evmvalidator.v1.QueryWithdrawalResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mitosis.evmvalidator.v1.QueryValidatorsResponse"))
 		}
-		panic(fmt.Errorf("message mitosis.evmvalidator.v1.QueryWithdrawalResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message mitosis.evmvalidator.v1.QueryValidatorsResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryWithdrawalResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryValidatorsResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "mitosis.evmvalidator.v1.QueryWithdrawalResponse.withdrawal":
-		m := new(Withdrawal)
+	case "mitosis.evmvalidator.v1.QueryValidatorsResponse.validators":
+		list := []*Validator{}
+		return protoreflect.ValueOfList(&_QueryValidatorsResponse_1_list{list: &list})
+	case "mitosis.evmvalidator.v1.QueryValidatorsResponse.pagination":
+		m := new(v1beta1.PageResponse)
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: mitosis.evmvalidator.v1.QueryWithdrawalResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mitosis.evmvalidator.v1.QueryValidatorsResponse"))
 		}
-		panic(fmt.Errorf("message mitosis.evmvalidator.v1.QueryWithdrawalResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message mitosis.evmvalidator.v1.QueryValidatorsResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryWithdrawalResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryValidatorsResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in mitosis.evmvalidator.v1.QueryWithdrawalResponse", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in mitosis.evmvalidator.v1.QueryValidatorsResponse", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -4931,7 +4827,7 @@ func (x *fastReflection_QueryWithdrawalResponse) WhichOneof(d protoreflect.Oneof
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryWithdrawalResponse) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryValidatorsResponse) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -4942,7 +4838,7 @@ func (x *fastReflection_QueryWithdrawalResponse) GetUnknown() protoreflect.RawFi
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryWithdrawalResponse) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryValidatorsResponse) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -4954,7 +4850,7 @@ func (x *fastReflection_QueryWithdrawalResponse) SetUnknown(fields protoreflect.
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryWithdrawalResponse) IsValid() bool {
+func (x *fastReflection_QueryValidatorsResponse) IsValid() bool {
 	return x != nil
 }
 
@@ -4964,9 +4860,9 @@ func (x *fastReflection_QueryWithdrawalResponse) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryWithdrawalResponse) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryValidatorsResponse) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryWithdrawalResponse)
+		x := input.Message.Interface().(*QueryValidatorsResponse)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4978,8 +4874,14 @@ func (x *fastReflection_QueryWithdrawalResponse) ProtoMethods() *protoiface.Meth
 		var n int
 		var l int
 		_ = l
-		if x.Withdrawal != nil {
-			l = options.Size(x.Withdrawal)
+		if len(x.Validators) > 0 {
+			for _, e := range x.Validators {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.Pagination != nil {
+			l = options.Size(x.Pagination)
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
 		if x.unknownFields != nil {
@@ -4992,7 +4894,7 @@ func (x *fastReflection_QueryWithdrawalResponse) ProtoMethods() *protoiface.Meth
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryWithdrawalResponse)
+		x := input.Message.Interface().(*QueryValidatorsResponse)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5011,8 +4913,8 @@ func (x *fastReflection_QueryWithdrawalResponse) ProtoMethods() *protoiface.Meth
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.Withdrawal != nil {
-			encoded, err := options.Marshal(x.Withdrawal)
+		if x.Pagination != nil {
+			encoded, err := options.Marshal(x.Pagination)
 			if err != nil {
 				return protoiface.MarshalOutput{
 					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5023,7 +4925,23 @@ func (x *fastReflection_QueryWithdrawalResponse) ProtoMethods() *protoiface.Meth
 			copy(dAtA[i:], encoded)
 			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 			i--
-			dAtA[i] = 0xa
+			dAtA[i] = 0x12
+		}
+		if len(x.Validators) > 0 {
+			for iNdEx := len(x.Validators) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Validators[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0xa
+			}
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -5036,7 +4954,7 @@ func (x *fastReflection_QueryWithdrawalResponse) ProtoMethods() *protoiface.Meth
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryWithdrawalResponse)
+		x := input.Message.Interface().(*QueryValidatorsResponse)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5068,15 +4986,15 @@ func (x *fastReflection_QueryWithdrawalResponse) ProtoMethods() *protoiface.Meth
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryWithdrawalResponse: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryValidatorsResponse: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryWithdrawalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryValidatorsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Withdrawal", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Validators", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -5103,10 +5021,44 @@ func (x *fastReflection_QueryWithdrawalResponse) ProtoMethods() *protoiface.Meth
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.Withdrawal == nil {
-					x.Withdrawal = &Withdrawal{}
+				x.Validators = append(x.Validators, &Validator{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Validators[len(x.Validators)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Withdrawal); err != nil {
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Pagination == nil {
+					x.Pagination = &v1beta1.PageResponse{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Pagination); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
@@ -5146,25 +5098,25 @@ func (x *fastReflection_QueryWithdrawalResponse) ProtoMethods() *protoiface.Meth
 }
 
 var (
-	md_QueryWithdrawalsRequest            protoreflect.MessageDescriptor
-	fd_QueryWithdrawalsRequest_pagination protoreflect.FieldDescriptor
+	md_QueryWithdrawalRequest    protoreflect.MessageDescriptor
+	fd_QueryWithdrawalRequest_id protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_mitosis_evmvalidator_v1_query_proto_init()
-	md_QueryWithdrawalsRequest = File_mitosis_evmvalidator_v1_query_proto.Messages().ByName("QueryWithdrawalsRequest")
-	fd_QueryWithdrawalsRequest_pagination = md_QueryWithdrawalsRequest.Fields().ByName("pagination")
+	md_QueryWithdrawalRequest = File_mitosis_evmvalidator_v1_query_proto.Messages().ByName("QueryWithdrawalRequest")
+	fd_QueryWithdrawalRequest_id = md_QueryWithdrawalRequest.Fields().ByName("id")
 }
 
-var _ protoreflect.Message = (*fastReflection_QueryWithdrawalsRequest)(nil)
+var _ protoreflect.Message = (*fastReflection_QueryWithdrawalRequest)(nil)
 
-type fastReflection_QueryWithdrawalsRequest QueryWithdrawalsRequest
+type fastReflection_QueryWithdrawalRequest QueryWithdrawalRequest
 
-func (x *QueryWithdrawalsRequest) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryWithdrawalsRequest)(x)
+func (x *QueryWithdrawalRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryWithdrawalRequest)(x)
 }
 
-func (x *QueryWithdrawalsRequest) slowProtoReflect() protoreflect.Message {
+func (x *QueryWithdrawalRequest) slowProtoReflect() protoreflect.Message {
 	mi := &file_mitosis_evmvalidator_v1_query_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5176,43 +5128,43 @@ func (x *QueryWithdrawalsRequest) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryWithdrawalsRequest_messageType fastReflection_QueryWithdrawalsRequest_messageType
-var _ protoreflect.MessageType = fastReflection_QueryWithdrawalsRequest_messageType{}
+var _fastReflection_QueryWithdrawalRequest_messageType fastReflection_QueryWithdrawalRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryWithdrawalRequest_messageType{}
 
-type fastReflection_QueryWithdrawalsRequest_messageType struct{}
+type fastReflection_QueryWithdrawalRequest_messageType struct{}
 
-func (x fastReflection_QueryWithdrawalsRequest_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryWithdrawalsRequest)(nil)
+func (x fastReflection_QueryWithdrawalRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryWithdrawalRequest)(nil)
 }
-func (x fastReflection_QueryWithdrawalsRequest_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryWithdrawalsRequest)
+func (x fastReflection_QueryWithdrawalRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryWithdrawalRequest)
 }
-func (x fastReflection_QueryWithdrawalsRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryWithdrawalsRequest
+func (x fastReflection_QueryWithdrawalRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryWithdrawalRequest
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryWithdrawalsRequest) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryWithdrawalsRequest
+func (x *fastReflection_QueryWithdrawalRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryWithdrawalRequest
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryWithdrawalsRequest) Type() protoreflect.MessageType {
-	return _fastReflection_QueryWithdrawalsRequest_messageType
+func (x *fastReflection_QueryWithdrawalRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryWithdrawalRequest_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryWithdrawalsRequest) New() protoreflect.Message {
-	return new(fastReflection_QueryWithdrawalsRequest)
+func (x *fastReflection_QueryWithdrawalRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryWithdrawalRequest)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryWithdrawalsRequest) Interface() protoreflect.ProtoMessage {
-	return (*QueryWithdrawalsRequest)(x)
+func (x *fastReflection_QueryWithdrawalRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryWithdrawalRequest)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -5220,10 +5172,10 @@ func (x *fastReflection_QueryWithdrawalsRequest) Interface() protoreflect.ProtoM
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryWithdrawalsRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Pagination != nil {
-		value := protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
-		if !f(fd_QueryWithdrawalsRequest_pagination, value) {
+func (x *fastReflection_QueryWithdrawalRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Id != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Id)
+		if !f(fd_QueryWithdrawalRequest_id, value) {
 			return
 		}
 	}
@@ -5240,15 +5192,15 @@ func (x *fastReflection_QueryWithdrawalsRequest) Range(f func(protoreflect.Field
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryWithdrawalsRequest) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryWithdrawalRequest) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "mitosis.evmvalidator.v1.QueryWithdrawalsRequest.pagination":
-		return x.Pagination != nil
+	case "mitosis.evmvalidator.v1.QueryWithdrawalRequest.id":
+		return x.Id != uint64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: mitosis.evmvalidator.v1.QueryWithdrawalsRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mitosis.evmvalidator.v1.QueryWithdrawalRequest"))
 		}
-		panic(fmt.Errorf("message mitosis.evmvalidator.v1.QueryWithdrawalsRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message mitosis.evmvalidator.v1.QueryWithdrawalRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -5258,15 +5210,15 @@ func (x *fastReflection_QueryWithdrawalsRequest) Has(fd protoreflect.FieldDescri
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryWithdrawalsRequest) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryWithdrawalRequest) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "mitosis.evmvalidator.v1.QueryWithdrawalsRequest.pagination":
-		x.Pagination = nil
+	case "mitosis.evmvalidator.v1.QueryWithdrawalRequest.id":
+		x.Id = uint64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: mitosis.evmvalidator.v1.QueryWithdrawalsRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mitosis.evmvalidator.v1.QueryWithdrawalRequest"))
 		}
-		panic(fmt.Errorf("message mitosis.evmvalidator.v1.QueryWithdrawalsRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message mitosis.evmvalidator.v1.QueryWithdrawalRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -5276,16 +5228,16 @@ func (x *fastReflection_QueryWithdrawalsRequest) Clear(fd protoreflect.FieldDesc
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryWithdrawalsRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryWithdrawalRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "mitosis.evmvalidator.v1.QueryWithdrawalsRequest.pagination":
-		value := x.Pagination
-		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "mitosis.evmvalidator.v1.QueryWithdrawalRequest.id":
+		value := x.Id
+		return protoreflect.ValueOfUint64(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: mitosis.evmvalidator.v1.QueryWithdrawalsRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mitosis.evmvalidator.v1.QueryWithdrawalRequest"))
 		}
-		panic(fmt.Errorf("message mitosis.evmvalidator.v1.QueryWithdrawalsRequest does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message mitosis.evmvalidator.v1.QueryWithdrawalRequest does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -5299,15 +5251,15 @@ func (x *fastReflection_QueryWithdrawalsRequest) Get(descriptor protoreflect.Fie
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryWithdrawalsRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryWithdrawalRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "mitosis.evmvalidator.v1.QueryWithdrawalsRequest.pagination":
-		x.Pagination = value.Message().Interface().(*v1beta1.PageRequest)
+	case "mitosis.evmvalidator.v1.QueryWithdrawalRequest.id":
+		x.Id = value.Uint()
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: mitosis.evmvalidator.v1.QueryWithdrawalsRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mitosis.evmvalidator.v1.QueryWithdrawalRequest"))
 		}
-		panic(fmt.Errorf("message mitosis.evmvalidator.v1.QueryWithdrawalsRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message mitosis.evmvalidator.v1.QueryWithdrawalRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -5321,44 +5273,40 @@ func (x *fastReflection_QueryWithdrawalsRequest) Set(fd protoreflect.FieldDescri
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryWithdrawalsRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryWithdrawalRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "mitosis.evmvalidator.v1.QueryWithdrawalsRequest.pagination":
-		if x.Pagination == nil {
-			x.Pagination = new(v1beta1.PageRequest)
-		}
-		return protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+	case "mitosis.evmvalidator.v1.QueryWithdrawalRequest.id":
+		panic(fmt.Errorf("field id of message mitosis.evmvalidator.v1.QueryWithdrawalRequest is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: mitosis.evmvalidator.v1.QueryWithdrawalsRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mitosis.evmvalidator.v1.QueryWithdrawalRequest"))
 		}
-		panic(fmt.Errorf("message mitosis.evmvalidator.v1.QueryWithdrawalsRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message mitosis.evmvalidator.v1.QueryWithdrawalRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryWithdrawalsRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryWithdrawalRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "mitosis.evmvalidator.v1.QueryWithdrawalsRequest.pagination":
-		m := new(v1beta1.PageRequest)
-		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "mitosis.evmvalidator.v1.QueryWithdrawalRequest.id":
+		return protoreflect.ValueOfUint64(uint64(0))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: mitosis.evmvalidator.v1.QueryWithdrawalsRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mitosis.evmvalidator.v1.QueryWithdrawalRequest"))
 		}
-		panic(fmt.Errorf("message mitosis.evmvalidator.v1.QueryWithdrawalsRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message mitosis.evmvalidator.v1.QueryWithdrawalRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryWithdrawalsRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryWithdrawalRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in mitosis.evmvalidator.v1.QueryWithdrawalsRequest", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in mitosis.evmvalidator.v1.QueryWithdrawalRequest", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -5366,7 +5314,7 @@ func (x *fastReflection_QueryWithdrawalsRequest) WhichOneof(d protoreflect.Oneof
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryWithdrawalsRequest) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryWithdrawalRequest) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -5377,7 +5325,7 @@ func (x *fastReflection_QueryWithdrawalsRequest) GetUnknown() protoreflect.RawFi
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryWithdrawalsRequest) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryWithdrawalRequest) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -5389,7 +5337,7 @@ func (x *fastReflection_QueryWithdrawalsRequest) SetUnknown(fields protoreflect.
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryWithdrawalsRequest) IsValid() bool {
+func (x *fastReflection_QueryWithdrawalRequest) IsValid() bool {
 	return x != nil
 }
 
@@ -5399,9 +5347,9 @@ func (x *fastReflection_QueryWithdrawalsRequest) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryWithdrawalsRequest) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryWithdrawalRequest) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryWithdrawalsRequest)
+		x := input.Message.Interface().(*QueryWithdrawalRequest)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5413,9 +5361,8 @@ func (x *fastReflection_QueryWithdrawalsRequest) ProtoMethods() *protoiface.Meth
 		var n int
 		var l int
 		_ = l
-		if x.Pagination != nil {
-			l = options.Size(x.Pagination)
-			n += 1 + l + runtime.Sov(uint64(l))
+		if x.Id != 0 {
+			n += 1 + runtime.Sov(uint64(x.Id))
 		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
@@ -5427,7 +5374,7 @@ func (x *fastReflection_QueryWithdrawalsRequest) ProtoMethods() *protoiface.Meth
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryWithdrawalsRequest)
+		x := input.Message.Interface().(*QueryWithdrawalRequest)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5446,19 +5393,10 @@ func (x *fastReflection_QueryWithdrawalsRequest) ProtoMethods() *protoiface.Meth
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.Pagination != nil {
-			encoded, err := options.Marshal(x.Pagination)
-			if err != nil {
-				return protoiface.MarshalOutput{
-					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-					Buf:               input.Buf,
-				}, err
-			}
-			i -= len(encoded)
-			copy(dAtA[i:], encoded)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+		if x.Id != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Id))
 			i--
-			dAtA[i] = 0xa
+			dAtA[i] = 0x8
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -5471,7 +5409,7 @@ func (x *fastReflection_QueryWithdrawalsRequest) ProtoMethods() *protoiface.Meth
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryWithdrawalsRequest)
+		x := input.Message.Interface().(*QueryWithdrawalRequest)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5503,17 +5441,17 @@ func (x *fastReflection_QueryWithdrawalsRequest) ProtoMethods() *protoiface.Meth
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryWithdrawalsRequest: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryWithdrawalRequest: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryWithdrawalsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryWithdrawalRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 				}
-				var msglen int
+				x.Id = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -5523,28 +5461,11 @@ func (x *fastReflection_QueryWithdrawalsRequest) ProtoMethods() *protoiface.Meth
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					msglen |= int(b&0x7F) << shift
+					x.Id |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if msglen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + msglen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				if x.Pagination == nil {
-					x.Pagination = &v1beta1.PageRequest{}
-				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Pagination); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
-				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -5580,79 +5501,26 @@ func (x *fastReflection_QueryWithdrawalsRequest) ProtoMethods() *protoiface.Meth
 	}
 }
 
-var _ protoreflect.List = (*_QueryWithdrawalsResponse_1_list)(nil)
-
-type _QueryWithdrawalsResponse_1_list struct {
-	list *[]*Withdrawal
-}
-
-func (x *_QueryWithdrawalsResponse_1_list) Len() int {
-	if x.list == nil {
-		return 0
-	}
-	return len(*x.list)
-}
-
-func (x *_QueryWithdrawalsResponse_1_list) Get(i int) protoreflect.Value {
-	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
-}
-
-func (x *_QueryWithdrawalsResponse_1_list) Set(i int, value protoreflect.Value) {
-	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*Withdrawal)
-	(*x.list)[i] = concreteValue
-}
-
-func (x *_QueryWithdrawalsResponse_1_list) Append(value protoreflect.Value) {
-	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*Withdrawal)
-	*x.list = append(*x.list, concreteValue)
-}
-
-func (x *_QueryWithdrawalsResponse_1_list) AppendMutable() protoreflect.Value {
-	v := new(Withdrawal)
-	*x.list = append(*x.list, v)
-	return protoreflect.ValueOfMessage(v.ProtoReflect())
-}
-
-func (x *_QueryWithdrawalsResponse_1_list) Truncate(n int) {
-	for i := n; i < len(*x.list); i++ {
-		(*x.list)[i] = nil
-	}
-	*x.list = (*x.list)[:n]
-}
-
-func (x *_QueryWithdrawalsResponse_1_list) NewElement() protoreflect.Value {
-	v := new(Withdrawal)
-	return protoreflect.ValueOfMessage(v.ProtoReflect())
-}
-
-func (x *_QueryWithdrawalsResponse_1_list) IsValid() bool {
-	return x.list != nil
-}
-
 var (
-	md_QueryWithdrawalsResponse             protoreflect.MessageDescriptor
-	fd_QueryWithdrawalsResponse_withdrawals protoreflect.FieldDescriptor
-	fd_QueryWithdrawalsResponse_pagination  protoreflect.FieldDescriptor
+	md_QueryWithdrawalResponse            protoreflect.MessageDescriptor
+	fd_QueryWithdrawalResponse_withdrawal protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_mitosis_evmvalidator_v1_query_proto_init()
-	md_QueryWithdrawalsResponse = File_mitosis_evmvalidator_v1_query_proto.Messages().ByName("QueryWithdrawalsResponse")
-	fd_QueryWithdrawalsResponse_withdrawals = md_QueryWithdrawalsResponse.Fields().ByName("withdrawals")
-	fd_QueryWithdrawalsResponse_pagination = md_QueryWithdrawalsResponse.Fields().ByName("pagination")
+	md_QueryWithdrawalResponse = File_mitosis_evmvalidator_v1_query_proto.Messages().ByName("QueryWithdrawalResponse")
+	fd_QueryWithdrawalResponse_withdrawal = md_QueryWithdrawalResponse.Fields().ByName("withdrawal")
 }
 
-var _ protoreflect.Message = (*fastReflection_QueryWithdrawalsResponse)(nil)
+var _ protoreflect.Message = (*fastReflection_QueryWithdrawalResponse)(nil)
 
-type fastReflection_QueryWithdrawalsResponse QueryWithdrawalsResponse
+type fastReflection_QueryWithdrawalResponse QueryWithdrawalResponse
 
-func (x *QueryWithdrawalsResponse) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryWithdrawalsResponse)(x)
+func (x *QueryWithdrawalResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryWithdrawalResponse)(x)
 }
 
-func (x *QueryWithdrawalsResponse) slowProtoReflect() protoreflect.Message {
+func (x *QueryWithdrawalResponse) slowProtoReflect() protoreflect.Message {
 	mi := &file_mitosis_evmvalidator_v1_query_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5664,43 +5532,43 @@ func (x *QueryWithdrawalsResponse) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryWithdrawalsResponse_messageType fastReflection_QueryWithdrawalsResponse_messageType
-var _ protoreflect.MessageType = fastReflection_QueryWithdrawalsResponse_messageType{}
+var _fastReflection_QueryWithdrawalResponse_messageType fastReflection_QueryWithdrawalResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryWithdrawalResponse_messageType{}
 
-type fastReflection_QueryWithdrawalsResponse_messageType struct{}
+type fastReflection_QueryWithdrawalResponse_messageType struct{}
 
-func (x fastReflection_QueryWithdrawalsResponse_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryWithdrawalsResponse)(nil)
+func (x fastReflection_QueryWithdrawalResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryWithdrawalResponse)(nil)
 }
-func (x fastReflection_QueryWithdrawalsResponse_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryWithdrawalsResponse)
+func (x fastReflection_QueryWithdrawalResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryWithdrawalResponse)
 }
-func (x fastReflection_QueryWithdrawalsResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryWithdrawalsResponse
+func (x fastReflection_QueryWithdrawalResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryWithdrawalResponse
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryWithdrawalsResponse) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryWithdrawalsResponse
+func (x *fastReflection_QueryWithdrawalResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryWithdrawalResponse
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryWithdrawalsResponse) Type() protoreflect.MessageType {
-	return _fastReflection_QueryWithdrawalsResponse_messageType
+func (x *fastReflection_QueryWithdrawalResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryWithdrawalResponse_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryWithdrawalsResponse) New() protoreflect.Message {
-	return new(fastReflection_QueryWithdrawalsResponse)
+func (x *fastReflection_QueryWithdrawalResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryWithdrawalResponse)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryWithdrawalsResponse) Interface() protoreflect.ProtoMessage {
-	return (*QueryWithdrawalsResponse)(x)
+func (x *fastReflection_QueryWithdrawalResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryWithdrawalResponse)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -5708,16 +5576,10 @@ func (x *fastReflection_QueryWithdrawalsResponse) Interface() protoreflect.Proto
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryWithdrawalsResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if len(x.Withdrawals) != 0 {
-		value := protoreflect.ValueOfList(&_QueryWithdrawalsResponse_1_list{list: &x.Withdrawals})
-		if !f(fd_QueryWithdrawalsResponse_withdrawals, value) {
-			return
-		}
-	}
-	if x.Pagination != nil {
-		value := protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
-		if !f(fd_QueryWithdrawalsResponse_pagination, value) {
+func (x *fastReflection_QueryWithdrawalResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Withdrawal != nil {
+		value := protoreflect.ValueOfMessage(x.Withdrawal.ProtoReflect())
+		if !f(fd_QueryWithdrawalResponse_withdrawal, value) {
 			return
 		}
 	}
@@ -5734,17 +5596,15 @@ func (x *fastReflection_QueryWithdrawalsResponse) Range(f func(protoreflect.Fiel
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryWithdrawalsResponse) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryWithdrawalResponse) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "mitosis.evmvalidator.v1.QueryWithdrawalsResponse.withdrawals":
-		return len(x.Withdrawals) != 0
-	case "mitosis.evmvalidator.v1.QueryWithdrawalsResponse.pagination":
-		return x.Pagination != nil
+	case "mitosis.evmvalidator.v1.QueryWithdrawalResponse.withdrawal":
+		return x.Withdrawal != nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: mitosis.evmvalidator.v1.QueryWithdrawalsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mitosis.evmvalidator.v1.QueryWithdrawalResponse"))
 		}
-		panic(fmt.Errorf("message mitosis.evmvalidator.v1.QueryWithdrawalsResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message mitosis.evmvalidator.v1.QueryWithdrawalResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -5754,17 +5614,15 @@ func (x *fastReflection_QueryWithdrawalsResponse) Has(fd protoreflect.FieldDescr
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryWithdrawalsResponse) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryWithdrawalResponse) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "mitosis.evmvalidator.v1.QueryWithdrawalsResponse.withdrawals":
-		x.Withdrawals = nil
-	case "mitosis.evmvalidator.v1.QueryWithdrawalsResponse.pagination":
-		x.Pagination = nil
+	case "mitosis.evmvalidator.v1.QueryWithdrawalResponse.withdrawal":
+		x.Withdrawal = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: mitosis.evmvalidator.v1.QueryWithdrawalsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mitosis.evmvalidator.v1.QueryWithdrawalResponse"))
 		}
-		panic(fmt.Errorf("message mitosis.evmvalidator.v1.QueryWithdrawalsResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message mitosis.evmvalidator.v1.QueryWithdrawalResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -5774,22 +5632,16 @@ func (x *fastReflection_QueryWithdrawalsResponse) Clear(fd protoreflect.FieldDes
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryWithdrawalsResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryWithdrawalResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "mitosis.evmvalidator.v1.QueryWithdrawalsResponse.withdrawals":
-		if len(x.Withdrawals) == 0 {
-			return protoreflect.ValueOfList(&_QueryWithdrawalsResponse_1_list{})
-		}
-		listValue := &_QueryWithdrawalsResponse_1_list{list: &x.Withdrawals}
-		return protoreflect.ValueOfList(listValue)
-	case "mitosis.evmvalidator.v1.QueryWithdrawalsResponse.pagination":
-		value := x.Pagination
+	case "mitosis.evmvalidator.v1.QueryWithdrawalResponse.withdrawal":
+		value := x.Withdrawal
 		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: mitosis.evmvalidator.v1.QueryWithdrawalsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mitosis.evmvalidator.v1.QueryWithdrawalResponse"))
 		}
-		panic(fmt.Errorf("message mitosis.evmvalidator.v1.QueryWithdrawalsResponse does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message mitosis.evmvalidator.v1.QueryWithdrawalResponse does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -5803,19 +5655,15 @@ func (x *fastReflection_QueryWithdrawalsResponse) Get(descriptor protoreflect.Fi
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryWithdrawalsResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryWithdrawalResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "mitosis.evmvalidator.v1.QueryWithdrawalsResponse.withdrawals":
-		lv := value.List()
-		clv := lv.(*_QueryWithdrawalsResponse_1_list)
-		x.Withdrawals = *clv.list
-	case "mitosis.evmvalidator.v1.QueryWithdrawalsResponse.pagination":
-		x.Pagination = value.Message().Interface().(*v1beta1.PageResponse)
+	case "mitosis.evmvalidator.v1.QueryWithdrawalResponse.withdrawal":
+		x.Withdrawal = value.Message().Interface().(*Withdrawal)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: mitosis.evmvalidator.v1.QueryWithdrawalsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mitosis.evmvalidator.v1.QueryWithdrawalResponse"))
 		}
-		panic(fmt.Errorf("message mitosis.evmvalidator.v1.QueryWithdrawalsResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message mitosis.evmvalidator.v1.QueryWithdrawalResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -5829,53 +5677,44 @@ func (x *fastReflection_QueryWithdrawalsResponse) Set(fd protoreflect.FieldDescr
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryWithdrawalsResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryWithdrawalResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "mitosis.evmvalidator.v1.QueryWithdrawalsResponse.withdrawals":
-		if x.Withdrawals == nil {
-			x.Withdrawals = []*Withdrawal{}
-		}
-		value := &_QueryWithdrawalsResponse_1_list{list: &x.Withdrawals}
-		return protoreflect.ValueOfList(value)
-	case "mitosis.evmvalidator.v1.QueryWithdrawalsResponse.pagination":
-		if x.Pagination == nil {
-			x.Pagination = new(v1beta1.PageResponse)
+	case "mitosis.evmvalidator.v1.QueryWithdrawalResponse.withdrawal":
+		if x.Withdrawal == nil {
+			x.Withdrawal = new(Withdrawal)
 		}
-		return protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+		return protoreflect.ValueOfMessage(x.Withdrawal.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: mitosis.evmvalidator.v1.QueryWithdrawalsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mitosis.evmvalidator.v1.QueryWithdrawalResponse"))
 		}
-		panic(fmt.Errorf("message mitosis.evmvalidator.v1.QueryWithdrawalsResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message mitosis.evmvalidator.v1.QueryWithdrawalResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryWithdrawalsResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryWithdrawalResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "mitosis.evmvalidator.v1.QueryWithdrawalsResponse.withdrawals":
-		list := []*Withdrawal{}
-		return protoreflect.ValueOfList(&_QueryWithdrawalsResponse_1_list{list: &list})
-	case "mitosis.evmvalidator.v1.QueryWithdrawalsResponse.pagination":
-		m := new(v1beta1.PageResponse)
+	case "mitosis.evmvalidator.v1.QueryWithdrawalResponse.withdrawal":
+		m := new(Withdrawal)
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: mitosis.evmvalidator.v1.QueryWithdrawalsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mitosis.evmvalidator.v1.QueryWithdrawalResponse"))
 		}
-		panic(fmt.Errorf("message mitosis.evmvalidator.v1.QueryWithdrawalsResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message mitosis.evmvalidator.v1.QueryWithdrawalResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryWithdrawalsResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryWithdrawalResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in mitosis.evmvalidator.v1.QueryWithdrawalsResponse", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in mitosis.evmvalidator.v1.QueryWithdrawalResponse", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -5883,7 +5722,7 @@ func (x *fastReflection_QueryWithdrawalsResponse) WhichOneof(d protoreflect.Oneo
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryWithdrawalsResponse) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryWithdrawalResponse) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -5894,7 +5733,7 @@ func (x *fastReflection_QueryWithdrawalsResponse) GetUnknown() protoreflect.RawF
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryWithdrawalsResponse) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryWithdrawalResponse) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -5906,7 +5745,7 @@ func (x *fastReflection_QueryWithdrawalsResponse) SetUnknown(fields protoreflect
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryWithdrawalsResponse) IsValid() bool {
+func (x *fastReflection_QueryWithdrawalResponse) IsValid() bool {
 	return x != nil
 }
 
@@ -5916,9 +5755,9 @@ func (x *fastReflection_QueryWithdrawalsResponse) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryWithdrawalsResponse) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryWithdrawalResponse) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryWithdrawalsResponse)
+		x := input.Message.Interface().(*QueryWithdrawalResponse)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5930,14 +5769,8 @@ func (x *fastReflection_QueryWithdrawalsResponse) ProtoMethods() *protoiface.Met
 		var n int
 		var l int
 		_ = l
-		if len(x.Withdrawals) > 0 {
-			for _, e := range x.Withdrawals {
-				l = options.Size(e)
-				n += 1 + l + runtime.Sov(uint64(l))
-			}
-		}
-		if x.Pagination != nil {
-			l = options.Size(x.Pagination)
+		if x.Withdrawal != nil {
+			l = options.Size(x.Withdrawal)
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
 		if x.unknownFields != nil {
@@ -5950,7 +5783,7 @@ func (x *fastReflection_QueryWithdrawalsResponse) ProtoMethods() *protoiface.Met
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryWithdrawalsResponse)
+		x := input.Message.Interface().(*QueryWithdrawalResponse)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5969,8 +5802,8 @@ func (x *fastReflection_QueryWithdrawalsResponse) ProtoMethods() *protoiface.Met
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.Pagination != nil {
-			encoded, err := options.Marshal(x.Pagination)
+		if x.Withdrawal != nil {
+			encoded, err := options.Marshal(x.Withdrawal)
 			if err != nil {
 				return protoiface.MarshalOutput{
 					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5981,23 +5814,7 @@ func (x *fastReflection_QueryWithdrawalsResponse) ProtoMethods() *protoiface.Met
 			copy(dAtA[i:], encoded)
 			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 			i--
-			dAtA[i] = 0x12
-		}
-		if len(x.Withdrawals) > 0 {
-			for iNdEx := len(x.Withdrawals) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.Withdrawals[iNdEx])
-				if err != nil {
-					return protoiface.MarshalOutput{
-						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-						Buf:               input.Buf,
-					}, err
-				}
-				i -= len(encoded)
-				copy(dAtA[i:], encoded)
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-				i--
-				dAtA[i] = 0xa
-			}
+			dAtA[i] = 0xa
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -6010,7 +5827,7 @@ func (x *fastReflection_QueryWithdrawalsResponse) ProtoMethods() *protoiface.Met
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryWithdrawalsResponse)
+		x := input.Message.Interface().(*QueryWithdrawalResponse)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -6042,49 +5859,15 @@ func (x *fastReflection_QueryWithdrawalsResponse) ProtoMethods() *protoiface.Met
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryWithdrawalsResponse: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryWithdrawalResponse: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryWithdrawalsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryWithdrawalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Withdrawals", wireType)
-				}
-				var msglen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					msglen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if msglen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + msglen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.Withdrawals = append(x.Withdrawals, &Withdrawal{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Withdrawals[len(x.Withdrawals)-1]); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
-				iNdEx = postIndex
-			case 2:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Withdrawal", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -6111,10 +5894,10 @@ func (x *fastReflection_QueryWithdrawalsResponse) ProtoMethods() *protoiface.Met
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.Pagination == nil {
-					x.Pagination = &v1beta1.PageResponse{}
+				if x.Withdrawal == nil {
+					x.Withdrawal = &Withdrawal{}
 				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Pagination); err != nil {
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Withdrawal); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
@@ -6154,27 +5937,25 @@ func (x *fastReflection_QueryWithdrawalsResponse) ProtoMethods() *protoiface.Met
 }
 
 var (
-	md_QueryWithdrawalsByValidatorRequest            protoreflect.MessageDescriptor
-	fd_QueryWithdrawalsByValidatorRequest_val_addr   protoreflect.FieldDescriptor
-	fd_QueryWithdrawalsByValidatorRequest_pagination protoreflect.FieldDescriptor
+	md_QueryWithdrawalsRequest            protoreflect.MessageDescriptor
+	fd_QueryWithdrawalsRequest_pagination protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_mitosis_evmvalidator_v1_query_proto_init()
-	md_QueryWithdrawalsByValidatorRequest = File_mitosis_evmvalidator_v1_query_proto.Messages().ByName("QueryWithdrawalsByValidatorRequest")
-	fd_QueryWithdrawalsByValidatorRequest_val_addr = md_QueryWithdrawalsByValidatorRequest.Fields().ByName("val_addr")
-	fd_QueryWithdrawalsByValidatorRequest_pagination = md_QueryWithdrawalsByValidatorRequest.Fields().ByName("pagination")
+	md_QueryWithdrawalsRequest = File_mitosis_evmvalidator_v1_query_proto.Messages().ByName("QueryWithdrawalsRequest")
+	fd_QueryWithdrawalsRequest_pagination = md_QueryWithdrawalsRequest.Fields().ByName("pagination")
 }
 
-var _ protoreflect.Message = (*fastReflection_QueryWithdrawalsByValidatorRequest)(nil)
+var _ protoreflect.Message = (*fastReflection_QueryWithdrawalsRequest)(nil)
 
-type fastReflection_QueryWithdrawalsByValidatorRequest QueryWithdrawalsByValidatorRequest
+type fastReflection_QueryWithdrawalsRequest QueryWithdrawalsRequest
 
-func (x *QueryWithdrawalsByValidatorRequest) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryWithdrawalsByValidatorRequest)(x)
+func (x *QueryWithdrawalsRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryWithdrawalsRequest)(x)
 }
 
-func (x *QueryWithdrawalsByValidatorRequest) slowProtoReflect() protoreflect.Message {
+func (x *QueryWithdrawalsRequest) slowProtoReflect() protoreflect.Message {
 	mi := &file_mitosis_evmvalidator_v1_query_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -6186,43 +5967,43 @@ func (x *QueryWithdrawalsByValidatorRequest) slowProtoReflect() protoreflect.Mes
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryWithdrawalsByValidatorRequest_messageType fastReflection_QueryWithdrawalsByValidatorRequest_messageType
-var _ protoreflect.MessageType = fastReflection_QueryWithdrawalsByValidatorRequest_messageType{}
+var _fastReflection_QueryWithdrawalsRequest_messageType fastReflection_QueryWithdrawalsRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryWithdrawalsRequest_messageType{}
 
-type fastReflection_QueryWithdrawalsByValidatorRequest_messageType struct{}
+type fastReflection_QueryWithdrawalsRequest_messageType struct{}
 
-func (x fastReflection_QueryWithdrawalsByValidatorRequest_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryWithdrawalsByValidatorRequest)(nil)
+func (x fastReflection_QueryWithdrawalsRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryWithdrawalsRequest)(nil)
 }
-func (x fastReflection_QueryWithdrawalsByValidatorRequest_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryWithdrawalsByValidatorRequest)
+func (x fastReflection_QueryWithdrawalsRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryWithdrawalsRequest)
 }
-func (x fastReflection_QueryWithdrawalsByValidatorRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryWithdrawalsByValidatorRequest
+func (x fastReflection_QueryWithdrawalsRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryWithdrawalsRequest
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryWithdrawalsByValidatorRequest) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryWithdrawalsByValidatorRequest
+func (x *fastReflection_QueryWithdrawalsRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryWithdrawalsRequest
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryWithdrawalsByValidatorRequest) Type() protoreflect.MessageType {
-	return _fastReflection_QueryWithdrawalsByValidatorRequest_messageType
+func (x *fastReflection_QueryWithdrawalsRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryWithdrawalsRequest_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryWithdrawalsByValidatorRequest) New() protoreflect.Message {
-	return new(fastReflection_QueryWithdrawalsByValidatorRequest)
+func (x *fastReflection_QueryWithdrawalsRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryWithdrawalsRequest)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryWithdrawalsByValidatorRequest) Interface() protoreflect.ProtoMessage {
-	return (*QueryWithdrawalsByValidatorRequest)(x)
+func (x *fastReflection_QueryWithdrawalsRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryWithdrawalsRequest)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -6230,16 +6011,10 @@ func (x *fastReflection_QueryWithdrawalsByValidatorRequest) Interface() protoref
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryWithdrawalsByValidatorRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if len(x.ValAddr) != 0 {
-		value := protoreflect.ValueOfBytes(x.ValAddr)
-		if !f(fd_QueryWithdrawalsByValidatorRequest_val_addr, value) {
-			return
-		}
-	}
+func (x *fastReflection_QueryWithdrawalsRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
 	if x.Pagination != nil {
 		value := protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
-		if !f(fd_QueryWithdrawalsByValidatorRequest_pagination, value) {
+		if !f(fd_QueryWithdrawalsRequest_pagination, value) {
 			return
 		}
 	}
@@ -6256,17 +6031,15 @@ func (x *fastReflection_QueryWithdrawalsByValidatorRequest) Range(f func(protore
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryWithdrawalsByValidatorRequest) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryWithdrawalsRequest) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "mitosis.evmvalidator.v1.QueryWithdrawalsByValidatorRequest.val_addr":
-		return len(x.ValAddr) != 0
-	case "mitosis.evmvalidator.v1.QueryWithdrawalsByValidatorRequest.pagination":
+	case "mitosis.evmvalidator.v1.QueryWithdrawalsRequest.pagination":
 		return x.Pagination != nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: mitosis.evmvalidator.v1.QueryWithdrawalsByValidatorRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mitosis.evmvalidator.v1.QueryWithdrawalsRequest"))
 		}
-		panic(fmt.Errorf("message mitosis.evmvalidator.v1.QueryWithdrawalsByValidatorRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message mitosis.evmvalidator.v1.QueryWithdrawalsRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6276,17 +6049,15 @@ func (x *fastReflection_QueryWithdrawalsByValidatorRequest) Has(fd protoreflect.
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryWithdrawalsByValidatorRequest) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryWithdrawalsRequest) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "mitosis.evmvalidator.v1.QueryWithdrawalsByValidatorRequest.val_addr":
-		x.ValAddr = nil
-	case "mitosis.evmvalidator.v1.QueryWithdrawalsByValidatorRequest.pagination":
+	case "mitosis.evmvalidator.v1.QueryWithdrawalsRequest.pagination":
 		x.Pagination = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: mitosis.evmvalidator.v1.QueryWithdrawalsByValidatorRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mitosis.evmvalidator.v1.QueryWithdrawalsRequest"))
 		}
-		panic(fmt.Errorf("message mitosis.evmvalidator.v1.QueryWithdrawalsByValidatorRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message mitosis.evmvalidator.v1.QueryWithdrawalsRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6296,19 +6067,16 @@ func (x *fastReflection_QueryWithdrawalsByValidatorRequest) Clear(fd protoreflec
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryWithdrawalsByValidatorRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryWithdrawalsRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "mitosis.evmvalidator.v1.QueryWithdrawalsByValidatorRequest.val_addr":
-		value := x.ValAddr
-		return protoreflect.ValueOfBytes(value)
-	case "mitosis.evmvalidator.v1.QueryWithdrawalsByValidatorRequest.pagination":
+	case "mitosis.evmvalidator.v1.QueryWithdrawalsRequest.pagination":
 		value := x.Pagination
 		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: mitosis.evmvalidator.v1.QueryWithdrawalsByValidatorRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mitosis.evmvalidator.v1.QueryWithdrawalsRequest"))
 		}
-		panic(fmt.Errorf("message mitosis.evmvalidator.v1.QueryWithdrawalsByValidatorRequest does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message mitosis.evmvalidator.v1.QueryWithdrawalsRequest does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -6322,17 +6090,15 @@ func (x *fastReflection_QueryWithdrawalsByValidatorRequest) Get(descriptor proto
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryWithdrawalsByValidatorRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryWithdrawalsRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "mitosis.evmvalidator.v1.QueryWithdrawalsByValidatorRequest.val_addr":
-		x.ValAddr = value.Bytes()
-	case "mitosis.evmvalidator.v1.QueryWithdrawalsByValidatorRequest.pagination":
+	case "mitosis.evmvalidator.v1.QueryWithdrawalsRequest.pagination":
 		x.Pagination = value.Message().Interface().(*v1beta1.PageRequest)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: mitosis.evmvalidator.v1.QueryWithdrawalsByValidatorRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mitosis.evmvalidator.v1.QueryWithdrawalsRequest"))
 		}
-		panic(fmt.Errorf("message mitosis.evmvalidator.v1.QueryWithdrawalsByValidatorRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message mitosis.evmvalidator.v1.QueryWithdrawalsRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6346,48 +6112,44 @@ func (x *fastReflection_QueryWithdrawalsByValidatorRequest) Set(fd protoreflect.
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryWithdrawalsByValidatorRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryWithdrawalsRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "mitosis.evmvalidator.v1.QueryWithdrawalsByValidatorRequest.pagination":
+	case "mitosis.evmvalidator.v1.QueryWithdrawalsRequest.pagination":
 		if x.Pagination == nil {
 			x.Pagination = new(v1beta1.PageRequest)
 		}
 		return protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
-	case "mitosis.evmvalidator.v1.QueryWithdrawalsByValidatorRequest.val_addr":
-		panic(fmt.Errorf("field val_addr of message mitosis.evmvalidator.v1.QueryWithdrawalsByValidatorRequest is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: mitosis.evmvalidator.v1.QueryWithdrawalsByValidatorRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mitosis.evmvalidator.v1.QueryWithdrawalsRequest"))
 		}
-		panic(fmt.Errorf("message mitosis.evmvalidator.v1.QueryWithdrawalsByValidatorRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message mitosis.evmvalidator.v1.QueryWithdrawalsRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryWithdrawalsByValidatorRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryWithdrawalsRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "mitosis.evmvalidator.v1.QueryWithdrawalsByValidatorRequest.val_addr":
-		return protoreflect.ValueOfBytes(nil)
-	case "mitosis.evmvalidator.v1.QueryWithdrawalsByValidatorRequest.pagination":
+	case "mitosis.evmvalidator.v1.QueryWithdrawalsRequest.pagination":
 		m := new(v1beta1.PageRequest)
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: mitosis.evmvalidator.v1.QueryWithdrawalsByValidatorRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mitosis.evmvalidator.v1.QueryWithdrawalsRequest"))
 		}
-		panic(fmt.Errorf("message mitosis.evmvalidator.v1.QueryWithdrawalsByValidatorRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message mitosis.evmvalidator.v1.QueryWithdrawalsRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryWithdrawalsByValidatorRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryWithdrawalsRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in mitosis.evmvalidator.v1.QueryWithdrawalsByValidatorRequest", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in mitosis.evmvalidator.v1.QueryWithdrawalsRequest", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -6395,7 +6157,7 @@ func (x *fastReflection_QueryWithdrawalsByValidatorRequest) WhichOneof(d protore
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryWithdrawalsByValidatorRequest) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryWithdrawalsRequest) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -6406,7 +6168,7 @@ func (x *fastReflection_QueryWithdrawalsByValidatorRequest) GetUnknown() protore
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryWithdrawalsByValidatorRequest) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryWithdrawalsRequest) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -6418,7 +6180,7 @@ func (x *fastReflection_QueryWithdrawalsByValidatorRequest) SetUnknown(fields pr
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryWithdrawalsByValidatorRequest) IsValid() bool {
+func (x *fastReflection_QueryWithdrawalsRequest) IsValid() bool {
 	return x != nil
 }
 
@@ -6428,9 +6190,9 @@ func (x *fastReflection_QueryWithdrawalsByValidatorRequest) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryWithdrawalsByValidatorRequest) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryWithdrawalsRequest) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryWithdrawalsByValidatorRequest)
+		x := input.Message.Interface().(*QueryWithdrawalsRequest)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -6442,10 +6204,6 @@ func (x *fastReflection_QueryWithdrawalsByValidatorRequest) ProtoMethods() *prot
 		var n int
 		var l int
 		_ = l
-		l = len(x.ValAddr)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
 		if x.Pagination != nil {
 			l = options.Size(x.Pagination)
 			n += 1 + l + runtime.Sov(uint64(l))
@@ -6460,7 +6218,7 @@ func (x *fastReflection_QueryWithdrawalsByValidatorRequest) ProtoMethods() *prot
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryWithdrawalsByValidatorRequest)
+		x := input.Message.Interface().(*QueryWithdrawalsRequest)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -6491,13 +6249,6 @@ func (x *fastReflection_QueryWithdrawalsByValidatorRequest) ProtoMethods() *prot
 			copy(dAtA[i:], encoded)
 			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 			i--
-			dAtA[i] = 0x12
-		}
-		if len(x.ValAddr) > 0 {
-			i -= len(x.ValAddr)
-			copy(dAtA[i:], x.ValAddr)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ValAddr)))
-			i--
 			dAtA[i] = 0xa
 		}
 		if input.Buf != nil {
@@ -6511,7 +6262,7 @@ func (x *fastReflection_QueryWithdrawalsByValidatorRequest) ProtoMethods() *prot
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryWithdrawalsByValidatorRequest)
+		x := input.Message.Interface().(*QueryWithdrawalsRequest)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -6543,47 +6294,13 @@ func (x *fastReflection_QueryWithdrawalsByValidatorRequest) ProtoMethods() *prot
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryWithdrawalsByValidatorRequest: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryWithdrawalsRequest: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryWithdrawalsByValidatorRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryWithdrawalsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ValAddr", wireType)
-				}
-				var byteLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					byteLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if byteLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + byteLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.ValAddr = append(x.ValAddr[:0], dAtA[iNdEx:postIndex]...)
-				if x.ValAddr == nil {
-					x.ValAddr = []byte{}
-				}
-				iNdEx = postIndex
-			case 2:
 				if wireType != 2 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 				}
@@ -6654,79 +6371,79 @@ func (x *fastReflection_QueryWithdrawalsByValidatorRequest) ProtoMethods() *prot
 	}
 }
 
-var _ protoreflect.List = (*_QueryWithdrawalsByValidatorResponse_1_list)(nil)
+var _ protoreflect.List = (*_QueryWithdrawalsResponse_1_list)(nil)
 
-type _QueryWithdrawalsByValidatorResponse_1_list struct {
+type _QueryWithdrawalsResponse_1_list struct {
 	list *[]*Withdrawal
 }
 
-func (x *_QueryWithdrawalsByValidatorResponse_1_list) Len() int {
+func (x *_QueryWithdrawalsResponse_1_list) Len() int {
 	if x.list == nil {
 		return 0
 	}
 	return len(*x.list)
 }
 
-func (x *_QueryWithdrawalsByValidatorResponse_1_list) Get(i int) protoreflect.Value {
+func (x *_QueryWithdrawalsResponse_1_list) Get(i int) protoreflect.Value {
 	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
 }
 
-func (x *_QueryWithdrawalsByValidatorResponse_1_list) Set(i int, value protoreflect.Value) {
+func (x *_QueryWithdrawalsResponse_1_list) Set(i int, value protoreflect.Value) {
 	valueUnwrapped := value.Message()
 	concreteValue := valueUnwrapped.Interface().(*Withdrawal)
 	(*x.list)[i] = concreteValue
 }
 
-func (x *_QueryWithdrawalsByValidatorResponse_1_list) Append(value protoreflect.Value) {
+func (x *_QueryWithdrawalsResponse_1_list) Append(value protoreflect.Value) {
 	valueUnwrapped := value.Message()
 	concreteValue := valueUnwrapped.Interface().(*Withdrawal)
 	*x.list = append(*x.list, concreteValue)
 }
 
-func (x *_QueryWithdrawalsByValidatorResponse_1_list) AppendMutable() protoreflect.Value {
+func (x *_QueryWithdrawalsResponse_1_list) AppendMutable() protoreflect.Value {
 	v := new(Withdrawal)
 	*x.list = append(*x.list, v)
 	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
 
-func (x *_QueryWithdrawalsByValidatorResponse_1_list) Truncate(n int) {
+func (x *_QueryWithdrawalsResponse_1_list) Truncate(n int) {
 	for i := n; i < len(*x.list); i++ {
 		(*x.list)[i] = nil
 	}
 	*x.list = (*x.list)[:n]
 }
 
-func (x *_QueryWithdrawalsByValidatorResponse_1_list) NewElement() protoreflect.Value {
+func (x *_QueryWithdrawalsResponse_1_list) NewElement() protoreflect.Value {
 	v := new(Withdrawal)
 	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
 
-func (x *_QueryWithdrawalsByValidatorResponse_1_list) IsValid() bool {
+func (x *_QueryWithdrawalsResponse_1_list) IsValid() bool {
 	return x.list != nil
 }
 
 var (
-	md_QueryWithdrawalsByValidatorResponse             protoreflect.MessageDescriptor
-	fd_QueryWithdrawalsByValidatorResponse_withdrawals protoreflect.FieldDescriptor
-	fd_QueryWithdrawalsByValidatorResponse_pagination  protoreflect.FieldDescriptor
+	md_QueryWithdrawalsResponse             protoreflect.MessageDescriptor
+	fd_QueryWithdrawalsResponse_withdrawals protoreflect.FieldDescriptor
+	fd_QueryWithdrawalsResponse_pagination  protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_mitosis_evmvalidator_v1_query_proto_init()
-	md_QueryWithdrawalsByValidatorResponse = File_mitosis_evmvalidator_v1_query_proto.Messages().ByName("QueryWithdrawalsByValidatorResponse")
-	fd_QueryWithdrawalsByValidatorResponse_withdrawals = md_QueryWithdrawalsByValidatorResponse.Fields().ByName("withdrawals")
-	fd_QueryWithdrawalsByValidatorResponse_pagination = md_QueryWithdrawalsByValidatorResponse.Fields().ByName("pagination")
+	md_QueryWithdrawalsResponse = File_mitosis_evmvalidator_v1_query_proto.Messages().ByName("QueryWithdrawalsResponse")
+	fd_QueryWithdrawalsResponse_withdrawals = md_QueryWithdrawalsResponse.Fields().ByName("withdrawals")
+	fd_QueryWithdrawalsResponse_pagination = md_QueryWithdrawalsResponse.Fields().ByName("pagination")
 }
 
-var _ protoreflect.Message = (*fastReflection_QueryWithdrawalsByValidatorResponse)(nil)
+var _ protoreflect.Message = (*fastReflection_QueryWithdrawalsResponse)(nil)
 
-type fastReflection_QueryWithdrawalsByValidatorResponse QueryWithdrawalsByValidatorResponse
+type fastReflection_QueryWithdrawalsResponse QueryWithdrawalsResponse
 
-func (x *QueryWithdrawalsByValidatorResponse) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryWithdrawalsByValidatorResponse)(x)
+func (x *QueryWithdrawalsResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryWithdrawalsResponse)(x)
 }
 
-func (x *QueryWithdrawalsByValidatorResponse) slowProtoReflect() protoreflect.Message {
+func (x *QueryWithdrawalsResponse) slowProtoReflect() protoreflect.Message {
 	mi := &file_mitosis_evmvalidator_v1_query_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -6738,43 +6455,43 @@ func (x *QueryWithdrawalsByValidatorResponse) slowProtoReflect() protoreflect.Me
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryWithdrawalsByValidatorResponse_messageType fastReflection_QueryWithdrawalsByValidatorResponse_messageType
-var _ protoreflect.MessageType = fastReflection_QueryWithdrawalsByValidatorResponse_messageType{}
+var _fastReflection_QueryWithdrawalsResponse_messageType fastReflection_QueryWithdrawalsResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryWithdrawalsResponse_messageType{}
 
-type fastReflection_QueryWithdrawalsByValidatorResponse_messageType struct{}
+type fastReflection_QueryWithdrawalsResponse_messageType struct{}
 
-func (x fastReflection_QueryWithdrawalsByValidatorResponse_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryWithdrawalsByValidatorResponse)(nil)
+func (x fastReflection_QueryWithdrawalsResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryWithdrawalsResponse)(nil)
 }
-func (x fastReflection_QueryWithdrawalsByValidatorResponse_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryWithdrawalsByValidatorResponse)
+func (x fastReflection_QueryWithdrawalsResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryWithdrawalsResponse)
 }
-func (x fastReflection_QueryWithdrawalsByValidatorResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryWithdrawalsByValidatorResponse
+func (x fastReflection_QueryWithdrawalsResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryWithdrawalsResponse
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryWithdrawalsByValidatorResponse) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryWithdrawalsByValidatorResponse
+func (x *fastReflection_QueryWithdrawalsResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryWithdrawalsResponse
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryWithdrawalsByValidatorResponse) Type() protoreflect.MessageType {
-	return _fastReflection_QueryWithdrawalsByValidatorResponse_messageType
+func (x *fastReflection_QueryWithdrawalsResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryWithdrawalsResponse_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryWithdrawalsByValidatorResponse) New() protoreflect.Message {
-	return new(fastReflection_QueryWithdrawalsByValidatorResponse)
+func (x *fastReflection_QueryWithdrawalsResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryWithdrawalsResponse)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryWithdrawalsByValidatorResponse) Interface() protoreflect.ProtoMessage {
-	return (*QueryWithdrawalsByValidatorResponse)(x)
+func (x *fastReflection_QueryWithdrawalsResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryWithdrawalsResponse)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -6782,16 +6499,16 @@ func (x *fastReflection_QueryWithdrawalsByValidatorResponse) Interface() protore
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryWithdrawalsByValidatorResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+func (x *fastReflection_QueryWithdrawalsResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
 	if len(x.Withdrawals) != 0 {
-		value := protoreflect.ValueOfList(&_QueryWithdrawalsByValidatorResponse_1_list{list: &x.Withdrawals})
-		if !f(fd_QueryWithdrawalsByValidatorResponse_withdrawals, value) {
+		value := protoreflect.ValueOfList(&_QueryWithdrawalsResponse_1_list{list: &x.Withdrawals})
+		if !f(fd_QueryWithdrawalsResponse_withdrawals, value) {
 			return
 		}
 	}
 	if x.Pagination != nil {
 		value := protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
-		if !f(fd_QueryWithdrawalsByValidatorResponse_pagination, value) {
+		if !f(fd_QueryWithdrawalsResponse_pagination, value) {
 			return
 		}
 	}
@@ -6808,17 +6525,17 @@ func (x *fastReflection_QueryWithdrawalsByValidatorResponse) Range(f func(protor
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryWithdrawalsByValidatorResponse) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryWithdrawalsResponse) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "mitosis.evmvalidator.v1.QueryWithdrawalsByValidatorResponse.withdrawals":
+	case "mitosis.evmvalidator.v1.QueryWithdrawalsResponse.withdrawals":
 		return len(x.Withdrawals) != 0
-	case "mitosis.evmvalidator.v1.QueryWithdrawalsByValidatorResponse.pagination":
+	case "mitosis.evmvalidator.v1.QueryWithdrawalsResponse.pagination":
 		return x.Pagination != nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: mitosis.evmvalidator.v1.QueryWithdrawalsByValidatorResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mitosis.evmvalidator.v1.QueryWithdrawalsResponse"))
 		}
-		panic(fmt.Errorf("message mitosis.evmvalidator.v1.QueryWithdrawalsByValidatorResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message mitosis.evmvalidator.v1.QueryWithdrawalsResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6828,17 +6545,17 @@ func (x *fastReflection_QueryWithdrawalsByValidatorResponse) Has(fd protoreflect
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryWithdrawalsByValidatorResponse) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryWithdrawalsResponse) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "mitosis.evmvalidator.v1.QueryWithdrawalsByValidatorResponse.withdrawals":
+	case "mitosis.evmvalidator.v1.QueryWithdrawalsResponse.withdrawals":
 		x.Withdrawals = nil
-	case "mitosis.evmvalidator.v1.QueryWithdrawalsByValidatorResponse.pagination":
+	case "mitosis.evmvalidator.v1.QueryWithdrawalsResponse.pagination":
 		x.Pagination = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: mitosis.evmvalidator.v1.QueryWithdrawalsByValidatorResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mitosis.evmvalidator.v1.QueryWithdrawalsResponse"))
 		}
-		panic(fmt.Errorf("message mitosis.evmvalidator.v1.QueryWithdrawalsByValidatorResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message mitosis.evmvalidator.v1.QueryWithdrawalsResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6848,22 +6565,22 @@ func (x *fastReflection_QueryWithdrawalsByValidatorResponse) Clear(fd protorefle
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryWithdrawalsByValidatorResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryWithdrawalsResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "mitosis.evmvalidator.v1.QueryWithdrawalsByValidatorResponse.withdrawals":
+	case "mitosis.evmvalidator.v1.QueryWithdrawalsResponse.withdrawals":
 		if len(x.Withdrawals) == 0 {
-			return protoreflect.ValueOfList(&_QueryWithdrawalsByValidatorResponse_1_list{})
+			return protoreflect.ValueOfList(&_QueryWithdrawalsResponse_1_list{})
 		}
-		listValue := &_QueryWithdrawalsByValidatorResponse_1_list{list: &x.Withdrawals}
+		listValue := &_QueryWithdrawalsResponse_1_list{list: &x.Withdrawals}
 		return protoreflect.ValueOfList(listValue)
-	case "mitosis.evmvalidator.v1.QueryWithdrawalsByValidatorResponse.pagination":
+	case "mitosis.evmvalidator.v1.QueryWithdrawalsResponse.pagination":
 		value := x.Pagination
 		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: mitosis.evmvalidator.v1.QueryWithdrawalsByValidatorResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mitosis.evmvalidator.v1.QueryWithdrawalsResponse"))
 		}
-		panic(fmt.Errorf("message mitosis.evmvalidator.v1.QueryWithdrawalsByValidatorResponse does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message mitosis.evmvalidator.v1.QueryWithdrawalsResponse does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -6877,19 +6594,19 @@ func (x *fastReflection_QueryWithdrawalsByValidatorResponse) Get(descriptor prot
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryWithdrawalsByValidatorResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryWithdrawalsResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "mitosis.evmvalidator.v1.QueryWithdrawalsByValidatorResponse.withdrawals":
+	case "mitosis.evmvalidator.v1.QueryWithdrawalsResponse.withdrawals":
 		lv := value.List()
-		clv := lv.(*_QueryWithdrawalsByValidatorResponse_1_list)
+		clv := lv.(*_QueryWithdrawalsResponse_1_list)
 		x.Withdrawals = *clv.list
-	case "mitosis.evmvalidator.v1.QueryWithdrawalsByValidatorResponse.pagination":
+	case "mitosis.evmvalidator.v1.QueryWithdrawalsResponse.pagination":
 		x.Pagination = value.Message().Interface().(*v1beta1.PageResponse)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: mitosis.evmvalidator.v1.QueryWithdrawalsByValidatorResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mitosis.evmvalidator.v1.QueryWithdrawalsResponse"))
 		}
-		panic(fmt.Errorf("message mitosis.evmvalidator.v1.QueryWithdrawalsByValidatorResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message mitosis.evmvalidator.v1.QueryWithdrawalsResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6903,53 +6620,53 @@ func (x *fastReflection_QueryWithdrawalsByValidatorResponse) Set(fd protoreflect
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryWithdrawalsByValidatorResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryWithdrawalsResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "mitosis.evmvalidator.v1.QueryWithdrawalsByValidatorResponse.withdrawals":
+	case "mitosis.evmvalidator.v1.QueryWithdrawalsResponse.withdrawals":
 		if x.Withdrawals == nil {
 			x.Withdrawals = []*Withdrawal{}
 		}
-		value := &_QueryWithdrawalsByValidatorResponse_1_list{list: &x.Withdrawals}
+		value := &_QueryWithdrawalsResponse_1_list{list: &x.Withdrawals}
 		return protoreflect.ValueOfList(value)
-	case "mitosis.evmvalidator.v1.QueryWithdrawalsByValidatorResponse.pagination":
+	case "mitosis.evmvalidator.v1.QueryWithdrawalsResponse.pagination":
 		if x.Pagination == nil {
 			x.Pagination = new(v1beta1.PageResponse)
 		}
 		return protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: mitosis.evmvalidator.v1.QueryWithdrawalsByValidatorResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mitosis.evmvalidator.v1.QueryWithdrawalsResponse"))
 		}
-		panic(fmt.Errorf("message mitosis.evmvalidator.v1.QueryWithdrawalsByValidatorResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message mitosis.evmvalidator.v1.QueryWithdrawalsResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryWithdrawalsByValidatorResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryWithdrawalsResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "mitosis.evmvalidator.v1.QueryWithdrawalsByValidatorResponse.withdrawals":
+	case "mitosis.evmvalidator.v1.QueryWithdrawalsResponse.withdrawals":
 		list := []*Withdrawal{}
-		return protoreflect.ValueOfList(&_QueryWithdrawalsByValidatorResponse_1_list{list: &list})
-	case "mitosis.evmvalidator.v1.QueryWithdrawalsByValidatorResponse.pagination":
+		return protoreflect.ValueOfList(&_QueryWithdrawalsResponse_1_list{list: &list})
+	case "mitosis.evmvalidator.v1.QueryWithdrawalsResponse.pagination":
 		m := new(v1beta1.PageResponse)
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: mitosis.evmvalidator.v1.QueryWithdrawalsByValidatorResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mitosis.evmvalidator.v1.QueryWithdrawalsResponse"))
 		}
-		panic(fmt.Errorf("message mitosis.evmvalidator.v1.QueryWithdrawalsByValidatorResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message mitosis.evmvalidator.v1.QueryWithdrawalsResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryWithdrawalsByValidatorResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryWithdrawalsResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in mitosis.evmvalidator.v1.QueryWithdrawalsByValidatorResponse", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in mitosis.evmvalidator.v1.QueryWithdrawalsResponse", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -6957,7 +6674,7 @@ func (x *fastReflection_QueryWithdrawalsByValidatorResponse) WhichOneof(d protor
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryWithdrawalsByValidatorResponse) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryWithdrawalsResponse) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -6968,7 +6685,7 @@ func (x *fastReflection_QueryWithdrawalsByValidatorResponse) GetUnknown() protor
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryWithdrawalsByValidatorResponse) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryWithdrawalsResponse) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -6980,7 +6697,7 @@ func (x *fastReflection_QueryWithdrawalsByValidatorResponse) SetUnknown(fields p
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryWithdrawalsByValidatorResponse) IsValid() bool {
+func (x *fastReflection_QueryWithdrawalsResponse) IsValid() bool {
 	return x != nil
 }
 
@@ -6990,9 +6707,9 @@ func (x *fastReflection_QueryWithdrawalsByValidatorResponse) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryWithdrawalsByValidatorResponse) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryWithdrawalsResponse) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryWithdrawalsByValidatorResponse)
+		x := input.Message.Interface().(*QueryWithdrawalsResponse)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -7024,7 +6741,7 @@ func (x *fastReflection_QueryWithdrawalsByValidatorResponse) ProtoMethods() *pro
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryWithdrawalsByValidatorResponse)
+		x := input.Message.Interface().(*QueryWithdrawalsResponse)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -7084,7 +6801,7 @@ func (x *fastReflection_QueryWithdrawalsByValidatorResponse) ProtoMethods() *pro
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryWithdrawalsByValidatorResponse)
+		x := input.Message.Interface().(*QueryWithdrawalsResponse)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -7116,10 +6833,10 @@ func (x *fastReflection_QueryWithdrawalsByValidatorResponse) ProtoMethods() *pro
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryWithdrawalsByValidatorResponse: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryWithdrawalsResponse: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryWithdrawalsByValidatorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryWithdrawalsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
@@ -7228,27 +6945,27 @@ func (x *fastReflection_QueryWithdrawalsByValidatorResponse) ProtoMethods() *pro
 }
 
 var (
-	md_CollateralOwnershipWithAmount           protoreflect.MessageDescriptor
-	fd_CollateralOwnershipWithAmount_ownership protoreflect.FieldDescriptor
-	fd_CollateralOwnershipWithAmount_amount    protoreflect.FieldDescriptor
+	md_QueryWithdrawalsByValidatorRequest            protoreflect.MessageDescriptor
+	fd_QueryWithdrawalsByValidatorRequest_val_addr   protoreflect.FieldDescriptor
+	fd_QueryWithdrawalsByValidatorRequest_pagination protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_mitosis_evmvalidator_v1_query_proto_init()
-	md_CollateralOwnershipWithAmount = File_mitosis_evmvalidator_v1_query_proto.Messages().ByName("CollateralOwnershipWithAmount")
-	fd_CollateralOwnershipWithAmount_ownership = md_CollateralOwnershipWithAmount.Fields().ByName("ownership")
-	fd_CollateralOwnershipWithAmount_amount = md_CollateralOwnershipWithAmount.Fields().ByName("amount")
+	md_QueryWithdrawalsByValidatorRequest = File_mitosis_evmvalidator_v1_query_proto.Messages().ByName("QueryWithdrawalsByValidatorRequest")
+	fd_QueryWithdrawalsByValidatorRequest_val_addr = md_QueryWithdrawalsByValidatorRequest.Fields().ByName("val_addr")
+	fd_QueryWithdrawalsByValidatorRequest_pagination = md_QueryWithdrawalsByValidatorRequest.Fields().ByName("pagination")
 }
 
-var _ protoreflect.Message = (*fastReflection_CollateralOwnershipWithAmount)(nil)
+var _ protoreflect.Message = (*fastReflection_QueryWithdrawalsByValidatorRequest)(nil)
 
-type fastReflection_CollateralOwnershipWithAmount CollateralOwnershipWithAmount
+type fastReflection_QueryWithdrawalsByValidatorRequest QueryWithdrawalsByValidatorRequest
 
-func (x *CollateralOwnershipWithAmount) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_CollateralOwnershipWithAmount)(x)
+func (x *QueryWithdrawalsByValidatorRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryWithdrawalsByValidatorRequest)(x)
 }
 
-func (x *CollateralOwnershipWithAmount) slowProtoReflect() protoreflect.Message {
+func (x *QueryWithdrawalsByValidatorRequest) slowProtoReflect() protoreflect.Message {
 	mi := &file_mitosis_evmvalidator_v1_query_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -7260,43 +6977,43 @@ func (x *CollateralOwnershipWithAmount) slowProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_CollateralOwnershipWithAmount_messageType fastReflection_CollateralOwnershipWithAmount_messageType
-var _ protoreflect.MessageType = fastReflection_CollateralOwnershipWithAmount_messageType{}
+var _fastReflection_QueryWithdrawalsByValidatorRequest_messageType fastReflection_QueryWithdrawalsByValidatorRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryWithdrawalsByValidatorRequest_messageType{}
 
-type fastReflection_CollateralOwnershipWithAmount_messageType struct{}
+type fastReflection_QueryWithdrawalsByValidatorRequest_messageType struct{}
 
-func (x fastReflection_CollateralOwnershipWithAmount_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_CollateralOwnershipWithAmount)(nil)
+func (x fastReflection_QueryWithdrawalsByValidatorRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryWithdrawalsByValidatorRequest)(nil)
 }
-func (x fastReflection_CollateralOwnershipWithAmount_messageType) New() protoreflect.Message {
-	return new(fastReflection_CollateralOwnershipWithAmount)
+func (x fastReflection_QueryWithdrawalsByValidatorRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryWithdrawalsByValidatorRequest)
 }
-func (x fastReflection_CollateralOwnershipWithAmount_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_CollateralOwnershipWithAmount
+func (x fastReflection_QueryWithdrawalsByValidatorRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryWithdrawalsByValidatorRequest
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_CollateralOwnershipWithAmount) Descriptor() protoreflect.MessageDescriptor {
-	return md_CollateralOwnershipWithAmount
+func (x *fastReflection_QueryWithdrawalsByValidatorRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryWithdrawalsByValidatorRequest
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_CollateralOwnershipWithAmount) Type() protoreflect.MessageType {
-	return _fastReflection_CollateralOwnershipWithAmount_messageType
+func (x *fastReflection_QueryWithdrawalsByValidatorRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryWithdrawalsByValidatorRequest_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_CollateralOwnershipWithAmount) New() protoreflect.Message {
-	return new(fastReflection_CollateralOwnershipWithAmount)
+func (x *fastReflection_QueryWithdrawalsByValidatorRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryWithdrawalsByValidatorRequest)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_CollateralOwnershipWithAmount) Interface() protoreflect.ProtoMessage {
-	return (*CollateralOwnershipWithAmount)(x)
+func (x *fastReflection_QueryWithdrawalsByValidatorRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryWithdrawalsByValidatorRequest)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -7304,16 +7021,16 @@ func (x *fastReflection_CollateralOwnershipWithAmount) Interface() protoreflect.
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_CollateralOwnershipWithAmount) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Ownership != nil {
-		value := protoreflect.ValueOfMessage(x.Ownership.ProtoReflect())
-		if !f(fd_CollateralOwnershipWithAmount_ownership, value) {
+func (x *fastReflection_QueryWithdrawalsByValidatorRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.ValAddr) != 0 {
+		value := protoreflect.ValueOfBytes(x.ValAddr)
+		if !f(fd_QueryWithdrawalsByValidatorRequest_val_addr, value) {
 			return
 		}
 	}
-	if x.Amount != "" {
-		value := protoreflect.ValueOfString(x.Amount)
-		if !f(fd_CollateralOwnershipWithAmount_amount, value) {
+	if x.Pagination != nil {
+		value := protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+		if !f(fd_QueryWithdrawalsByValidatorRequest_pagination, value) {
 			return
 		}
 	}
@@ -7330,17 +7047,17 @@ func (x *fastReflection_CollateralOwnershipWithAmount) Range(f func(protoreflect
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_CollateralOwnershipWithAmount) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryWithdrawalsByValidatorRequest) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "mitosis.evmvalidator.v1.CollateralOwnershipWithAmount.ownership":
-		return x.Ownership != nil
-	case "mitosis.evmvalidator.v1.CollateralOwnershipWithAmount.amount":
-		return x.Amount != ""
+	case "mitosis.evmvalidator.v1.QueryWithdrawalsByValidatorRequest.val_addr":
+		return len(x.ValAddr) != 0
+	case "mitosis.evmvalidator.v1.QueryWithdrawalsByValidatorRequest.pagination":
+		return x.Pagination != nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: mitosis.evmvalidator.v1.CollateralOwnershipWithAmount"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mitosis.evmvalidator.v1.QueryWithdrawalsByValidatorRequest"))
 		}
-		panic(fmt.Errorf("message mitosis.evmvalidator.v1.CollateralOwnershipWithAmount does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message mitosis.evmvalidator.v1.QueryWithdrawalsByValidatorRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -7350,17 +7067,17 @@ func (x *fastReflection_CollateralOwnershipWithAmount) Has(fd protoreflect.Field
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_CollateralOwnershipWithAmount) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryWithdrawalsByValidatorRequest) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "mitosis.evmvalidator.v1.CollateralOwnershipWithAmount.ownership":
-		x.Ownership = nil
-	case "mitosis.evmvalidator.v1.CollateralOwnershipWithAmount.amount":
-		x.Amount = ""
+	case "mitosis.evmvalidator.v1.QueryWithdrawalsByValidatorRequest.val_addr":
+		x.ValAddr = nil
+	case "mitosis.evmvalidator.v1.QueryWithdrawalsByValidatorRequest.pagination":
+		x.Pagination = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: mitosis.evmvalidator.v1.CollateralOwnershipWithAmount"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mitosis.evmvalidator.v1.QueryWithdrawalsByValidatorRequest"))
 		}
-		panic(fmt.Errorf("message mitosis.evmvalidator.v1.CollateralOwnershipWithAmount does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message mitosis.evmvalidator.v1.QueryWithdrawalsByValidatorRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -7370,19 +7087,19 @@ func (x *fastReflection_CollateralOwnershipWithAmount) Clear(fd protoreflect.Fie
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_CollateralOwnershipWithAmount) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryWithdrawalsByValidatorRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "mitosis.evmvalidator.v1.CollateralOwnershipWithAmount.ownership":
-		value := x.Ownership
+	case "mitosis.evmvalidator.v1.QueryWithdrawalsByValidatorRequest.val_addr":
+		value := x.ValAddr
+		return protoreflect.ValueOfBytes(value)
+	case "mitosis.evmvalidator.v1.QueryWithdrawalsByValidatorRequest.pagination":
+		value := x.Pagination
 		return protoreflect.ValueOfMessage(value.ProtoReflect())
-	case "mitosis.evmvalidator.v1.CollateralOwnershipWithAmount.amount":
-		value := x.Amount
-		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: mitosis.evmvalidator.v1.CollateralOwnershipWithAmount"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mitosis.evmvalidator.v1.QueryWithdrawalsByValidatorRequest"))
 		}
-		panic(fmt.Errorf("message mitosis.evmvalidator.v1.CollateralOwnershipWithAmount does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message mitosis.evmvalidator.v1.QueryWithdrawalsByValidatorRequest does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -7396,17 +7113,17 @@ func (x *fastReflection_CollateralOwnershipWithAmount) Get(descriptor protorefle
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_CollateralOwnershipWithAmount) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryWithdrawalsByValidatorRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "mitosis.evmvalidator.v1.CollateralOwnershipWithAmount.ownership":
-		x.Ownership = value.Message().Interface().(*CollateralOwnership)
-	case "mitosis.evmvalidator.v1.CollateralOwnershipWithAmount.amount":
-		x.Amount = value.Interface().(string)
+	case "mitosis.evmvalidator.v1.QueryWithdrawalsByValidatorRequest.val_addr":
+		x.ValAddr = value.Bytes()
+	case "mitosis.evmvalidator.v1.QueryWithdrawalsByValidatorRequest.pagination":
+		x.Pagination = value.Message().Interface().(*v1beta1.PageRequest)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: mitosis.evmvalidator.v1.CollateralOwnershipWithAmount"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mitosis.evmvalidator.v1.QueryWithdrawalsByValidatorRequest"))
 		}
-		panic(fmt.Errorf("message mitosis.evmvalidator.v1.CollateralOwnershipWithAmount does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message mitosis.evmvalidator.v1.QueryWithdrawalsByValidatorRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -7420,48 +7137,48 @@ func (x *fastReflection_CollateralOwnershipWithAmount) Set(fd protoreflect.Field
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_CollateralOwnershipWithAmount) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryWithdrawalsByValidatorRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "mitosis.evmvalidator.v1.CollateralOwnershipWithAmount.ownership":
-		if x.Ownership == nil {
-			x.Ownership = new(CollateralOwnership)
+	case "mitosis.evmvalidator.v1.QueryWithdrawalsByValidatorRequest.pagination":
+		if x.Pagination == nil {
+			x.Pagination = new(v1beta1.PageRequest)
 		}
-		return protoreflect.ValueOfMessage(x.Ownership.ProtoReflect())
-	case "mitosis.evmvalidator.v1.CollateralOwnershipWithAmount.amount":
-		panic(fmt.Errorf("field amount of message mitosis.evmvalidator.v1.CollateralOwnershipWithAmount is not mutable"))
+		return protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+	case "mitosis.evmvalidator.v1.QueryWithdrawalsByValidatorRequest.val_addr":
+		panic(fmt.Errorf("field val_addr of message mitosis.evmvalidator.v1.QueryWithdrawalsByValidatorRequest is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: mitosis.evmvalidator.v1.CollateralOwnershipWithAmount"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mitosis.evmvalidator.v1.QueryWithdrawalsByValidatorRequest"))
 		}
-		panic(fmt.Errorf("message mitosis.evmvalidator.v1.CollateralOwnershipWithAmount does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message mitosis.evmvalidator.v1.QueryWithdrawalsByValidatorRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_CollateralOwnershipWithAmount) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryWithdrawalsByValidatorRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "mitosis.evmvalidator.v1.CollateralOwnershipWithAmount.ownership":
-		m := new(CollateralOwnership)
+	case "mitosis.evmvalidator.v1.QueryWithdrawalsByValidatorRequest.val_addr":
+		return protoreflect.ValueOfBytes(nil)
+	case "mitosis.evmvalidator.v1.QueryWithdrawalsByValidatorRequest.pagination":
+		m := new(v1beta1.PageRequest)
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
-	case "mitosis.evmvalidator.v1.CollateralOwnershipWithAmount.amount":
-		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: mitosis.evmvalidator.v1.CollateralOwnershipWithAmount"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mitosis.evmvalidator.v1.QueryWithdrawalsByValidatorRequest"))
 		}
-		panic(fmt.Errorf("message mitosis.evmvalidator.v1.CollateralOwnershipWithAmount does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message mitosis.evmvalidator.v1.QueryWithdrawalsByValidatorRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_CollateralOwnershipWithAmount) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryWithdrawalsByValidatorRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in mitosis.evmvalidator.v1.CollateralOwnershipWithAmount", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in mitosis.evmvalidator.v1.QueryWithdrawalsByValidatorRequest", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -7469,7 +7186,7 @@ func (x *fastReflection_CollateralOwnershipWithAmount) WhichOneof(d protoreflect
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_CollateralOwnershipWithAmount) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryWithdrawalsByValidatorRequest) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -7480,7 +7197,7 @@ func (x *fastReflection_CollateralOwnershipWithAmount) GetUnknown() protoreflect
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_CollateralOwnershipWithAmount) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryWithdrawalsByValidatorRequest) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -7492,7 +7209,7 @@ func (x *fastReflection_CollateralOwnershipWithAmount) SetUnknown(fields protore
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_CollateralOwnershipWithAmount) IsValid() bool {
+func (x *fastReflection_QueryWithdrawalsByValidatorRequest) IsValid() bool {
 	return x != nil
 }
 
@@ -7502,9 +7219,9 @@ func (x *fastReflection_CollateralOwnershipWithAmount) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_CollateralOwnershipWithAmount) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryWithdrawalsByValidatorRequest) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*CollateralOwnershipWithAmount)
+		x := input.Message.Interface().(*QueryWithdrawalsByValidatorRequest)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -7516,12 +7233,12 @@ func (x *fastReflection_CollateralOwnershipWithAmount) ProtoMethods() *protoifac
 		var n int
 		var l int
 		_ = l
-		if x.Ownership != nil {
-			l = options.Size(x.Ownership)
+		l = len(x.ValAddr)
+		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		l = len(x.Amount)
-		if l > 0 {
+		if x.Pagination != nil {
+			l = options.Size(x.Pagination)
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
 		if x.unknownFields != nil {
@@ -7534,7 +7251,7 @@ func (x *fastReflection_CollateralOwnershipWithAmount) ProtoMethods() *protoifac
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*CollateralOwnershipWithAmount)
+		x := input.Message.Interface().(*QueryWithdrawalsByValidatorRequest)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -7553,15 +7270,8 @@ func (x *fastReflection_CollateralOwnershipWithAmount) ProtoMethods() *protoifac
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.Amount) > 0 {
-			i -= len(x.Amount)
-			copy(dAtA[i:], x.Amount)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Amount)))
-			i--
-			dAtA[i] = 0x12
-		}
-		if x.Ownership != nil {
-			encoded, err := options.Marshal(x.Ownership)
+		if x.Pagination != nil {
+			encoded, err := options.Marshal(x.Pagination)
 			if err != nil {
 				return protoiface.MarshalOutput{
 					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -7572,6 +7282,13 @@ func (x *fastReflection_CollateralOwnershipWithAmount) ProtoMethods() *protoifac
 			copy(dAtA[i:], encoded)
 			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.ValAddr) > 0 {
+			i -= len(x.ValAddr)
+			copy(dAtA[i:], x.ValAddr)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ValAddr)))
+			i--
 			dAtA[i] = 0xa
 		}
 		if input.Buf != nil {
@@ -7585,7 +7302,7 @@ func (x *fastReflection_CollateralOwnershipWithAmount) ProtoMethods() *protoifac
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*CollateralOwnershipWithAmount)
+		x := input.Message.Interface().(*QueryWithdrawalsByValidatorRequest)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -7617,17 +7334,17 @@ func (x *fastReflection_CollateralOwnershipWithAmount) ProtoMethods() *protoifac
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: CollateralOwnershipWithAmount: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryWithdrawalsByValidatorRequest: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: CollateralOwnershipWithAmount: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryWithdrawalsByValidatorRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Ownership", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ValAddr", wireType)
 				}
-				var msglen int
+				var byteLen int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -7637,33 +7354,31 @@ func (x *fastReflection_CollateralOwnershipWithAmount) ProtoMethods() *protoifac
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					msglen |= int(b&0x7F) << shift
+					byteLen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if msglen < 0 {
+				if byteLen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + msglen
+				postIndex := iNdEx + byteLen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.Ownership == nil {
-					x.Ownership = &CollateralOwnership{}
-				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Ownership); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				x.ValAddr = append(x.ValAddr[:0], dAtA[iNdEx:postIndex]...)
+				if x.ValAddr == nil {
+					x.ValAddr = []byte{}
 				}
 				iNdEx = postIndex
 			case 2:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 				}
-				var stringLen uint64
+				var msglen int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -7673,23 +7388,27 @@ func (x *fastReflection_CollateralOwnershipWithAmount) ProtoMethods() *protoifac
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
+					msglen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
+				if msglen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + intStringLen
+				postIndex := iNdEx + msglen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Amount = string(dAtA[iNdEx:postIndex])
+				if x.Pagination == nil {
+					x.Pagination = &v1beta1.PageRequest{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Pagination); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
 				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
@@ -7726,26 +7445,79 @@ func (x *fastReflection_CollateralOwnershipWithAmount) ProtoMethods() *protoifac
 	}
 }
 
+var _ protoreflect.List = (*_QueryWithdrawalsByValidatorResponse_1_list)(nil)
+
+type _QueryWithdrawalsByValidatorResponse_1_list struct {
+	list *[]*Withdrawal
+}
+
+func (x *_QueryWithdrawalsByValidatorResponse_1_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_QueryWithdrawalsByValidatorResponse_1_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_QueryWithdrawalsByValidatorResponse_1_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*Withdrawal)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_QueryWithdrawalsByValidatorResponse_1_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*Withdrawal)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_QueryWithdrawalsByValidatorResponse_1_list) AppendMutable() protoreflect.Value {
+	v := new(Withdrawal)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryWithdrawalsByValidatorResponse_1_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_QueryWithdrawalsByValidatorResponse_1_list) NewElement() protoreflect.Value {
+	v := new(Withdrawal)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryWithdrawalsByValidatorResponse_1_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
-	md_QueryCollateralOwnershipsRequest            protoreflect.MessageDescriptor
-	fd_QueryCollateralOwnershipsRequest_pagination protoreflect.FieldDescriptor
+	md_QueryWithdrawalsByValidatorResponse             protoreflect.MessageDescriptor
+	fd_QueryWithdrawalsByValidatorResponse_withdrawals protoreflect.FieldDescriptor
+	fd_QueryWithdrawalsByValidatorResponse_pagination  protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_mitosis_evmvalidator_v1_query_proto_init()
-	md_QueryCollateralOwnershipsRequest = File_mitosis_evmvalidator_v1_query_proto.Messages().ByName("QueryCollateralOwnershipsRequest")
-	fd_QueryCollateralOwnershipsRequest_pagination = md_QueryCollateralOwnershipsRequest.Fields().ByName("pagination")
+	md_QueryWithdrawalsByValidatorResponse = File_mitosis_evmvalidator_v1_query_proto.Messages().ByName("QueryWithdrawalsByValidatorResponse")
+	fd_QueryWithdrawalsByValidatorResponse_withdrawals = md_QueryWithdrawalsByValidatorResponse.Fields().ByName("withdrawals")
+	fd_QueryWithdrawalsByValidatorResponse_pagination = md_QueryWithdrawalsByValidatorResponse.Fields().ByName("pagination")
 }
 
-var _ protoreflect.Message = (*fastReflection_QueryCollateralOwnershipsRequest)(nil)
+var _ protoreflect.Message = (*fastReflection_QueryWithdrawalsByValidatorResponse)(nil)
 
-type fastReflection_QueryCollateralOwnershipsRequest QueryCollateralOwnershipsRequest
+type fastReflection_QueryWithdrawalsByValidatorResponse QueryWithdrawalsByValidatorResponse
 
-func (x *QueryCollateralOwnershipsRequest) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryCollateralOwnershipsRequest)(x)
+func (x *QueryWithdrawalsByValidatorResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryWithdrawalsByValidatorResponse)(x)
 }
 
-func (x *QueryCollateralOwnershipsRequest) slowProtoReflect() protoreflect.Message {
+func (x *QueryWithdrawalsByValidatorResponse) slowProtoReflect() protoreflect.Message {
 	mi := &file_mitosis_evmvalidator_v1_query_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -7757,43 +7529,43 @@ func (x *QueryCollateralOwnershipsRequest) slowProtoReflect() protoreflect.Messa
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryCollateralOwnershipsRequest_messageType fastReflection_QueryCollateralOwnershipsRequest_messageType
-var _ protoreflect.MessageType = fastReflection_QueryCollateralOwnershipsRequest_messageType{}
+var _fastReflection_QueryWithdrawalsByValidatorResponse_messageType fastReflection_QueryWithdrawalsByValidatorResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryWithdrawalsByValidatorResponse_messageType{}
 
-type fastReflection_QueryCollateralOwnershipsRequest_messageType struct{}
+type fastReflection_QueryWithdrawalsByValidatorResponse_messageType struct{}
 
-func (x fastReflection_QueryCollateralOwnershipsRequest_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryCollateralOwnershipsRequest)(nil)
+func (x fastReflection_QueryWithdrawalsByValidatorResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryWithdrawalsByValidatorResponse)(nil)
 }
-func (x fastReflection_QueryCollateralOwnershipsRequest_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryCollateralOwnershipsRequest)
+func (x fastReflection_QueryWithdrawalsByValidatorResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryWithdrawalsByValidatorResponse)
 }
-func (x fastReflection_QueryCollateralOwnershipsRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryCollateralOwnershipsRequest
+func (x fastReflection_QueryWithdrawalsByValidatorResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryWithdrawalsByValidatorResponse
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryCollateralOwnershipsRequest) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryCollateralOwnershipsRequest
+func (x *fastReflection_QueryWithdrawalsByValidatorResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryWithdrawalsByValidatorResponse
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryCollateralOwnershipsRequest) Type() protoreflect.MessageType {
-	return _fastReflection_QueryCollateralOwnershipsRequest_messageType
+func (x *fastReflection_QueryWithdrawalsByValidatorResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryWithdrawalsByValidatorResponse_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryCollateralOwnershipsRequest) New() protoreflect.Message {
-	return new(fastReflection_QueryCollateralOwnershipsRequest)
+func (x *fastReflection_QueryWithdrawalsByValidatorResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryWithdrawalsByValidatorResponse)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryCollateralOwnershipsRequest) Interface() protoreflect.ProtoMessage {
-	return (*QueryCollateralOwnershipsRequest)(x)
+func (x *fastReflection_QueryWithdrawalsByValidatorResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryWithdrawalsByValidatorResponse)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -7801,10 +7573,16 @@ func (x *fastReflection_QueryCollateralOwnershipsRequest) Interface() protorefle
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryCollateralOwnershipsRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+func (x *fastReflection_QueryWithdrawalsByValidatorResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.Withdrawals) != 0 {
+		value := protoreflect.ValueOfList(&_QueryWithdrawalsByValidatorResponse_1_list{list: &x.Withdrawals})
+		if !f(fd_QueryWithdrawalsByValidatorResponse_withdrawals, value) {
+			return
+		}
+	}
 	if x.Pagination != nil {
 		value := protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
-		if !f(fd_QueryCollateralOwnershipsRequest_pagination, value) {
+		if !f(fd_QueryWithdrawalsByValidatorResponse_pagination, value) {
 			return
 		}
 	}
@@ -7821,15 +7599,17 @@ func (x *fastReflection_QueryCollateralOwnershipsRequest) Range(f func(protorefl
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryCollateralOwnershipsRequest) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryWithdrawalsByValidatorResponse) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "mitosis.evmvalidator.v1.QueryCollateralOwnershipsRequest.pagination":
+	case "mitosis.evmvalidator.v1.QueryWithdrawalsByValidatorResponse.withdrawals":
+		return len(x.Withdrawals) != 0
+	case "mitosis.evmvalidator.v1.QueryWithdrawalsByValidatorResponse.pagination":
 		return x.Pagination != nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: mitosis.evmvalidator.v1.QueryCollateralOwnershipsRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mitosis.evmvalidator.v1.QueryWithdrawalsByValidatorResponse"))
 		}
-		panic(fmt.Errorf("message mitosis.evmvalidator.v1.QueryCollateralOwnershipsRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message mitosis.evmvalidator.v1.QueryWithdrawalsByValidatorResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -7839,15 +7619,17 @@ func (x *fastReflection_QueryCollateralOwnershipsRequest) Has(fd protoreflect.Fi
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryCollateralOwnershipsRequest) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryWithdrawalsByValidatorResponse) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "mitosis.evmvalidator.v1.QueryCollateralOwnershipsRequest.pagination":
+	case "mitosis.evmvalidator.v1.QueryWithdrawalsByValidatorResponse.withdrawals":
+		x.Withdrawals = nil
+	case "mitosis.evmvalidator.v1.QueryWithdrawalsByValidatorResponse.pagination":
 		x.Pagination = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: mitosis.evmvalidator.v1.QueryCollateralOwnershipsRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mitosis.evmvalidator.v1.QueryWithdrawalsByValidatorResponse"))
 		}
-		panic(fmt.Errorf("message mitosis.evmvalidator.v1.QueryCollateralOwnershipsRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message mitosis.evmvalidator.v1.QueryWithdrawalsByValidatorResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -7857,16 +7639,22 @@ func (x *fastReflection_QueryCollateralOwnershipsRequest) Clear(fd protoreflect.
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryCollateralOwnershipsRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryWithdrawalsByValidatorResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "mitosis.evmvalidator.v1.QueryCollateralOwnershipsRequest.pagination":
+	case "mitosis.evmvalidator.v1.QueryWithdrawalsByValidatorResponse.withdrawals":
+		if len(x.Withdrawals) == 0 {
+			return protoreflect.ValueOfList(&_QueryWithdrawalsByValidatorResponse_1_list{})
+		}
+		listValue := &_QueryWithdrawalsByValidatorResponse_1_list{list: &x.Withdrawals}
+		return protoreflect.ValueOfList(listValue)
+	case "mitosis.evmvalidator.v1.QueryWithdrawalsByValidatorResponse.pagination":
 		value := x.Pagination
 		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: mitosis.evmvalidator.v1.QueryCollateralOwnershipsRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mitosis.evmvalidator.v1.QueryWithdrawalsByValidatorResponse"))
 		}
-		panic(fmt.Errorf("message mitosis.evmvalidator.v1.QueryCollateralOwnershipsRequest does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message mitosis.evmvalidator.v1.QueryWithdrawalsByValidatorResponse does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -7880,15 +7668,19 @@ func (x *fastReflection_QueryCollateralOwnershipsRequest) Get(descriptor protore
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryCollateralOwnershipsRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryWithdrawalsByValidatorResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "mitosis.evmvalidator.v1.QueryCollateralOwnershipsRequest.pagination":
-		x.Pagination = value.Message().Interface().(*v1beta1.PageRequest)
+	case "mitosis.evmvalidator.v1.QueryWithdrawalsByValidatorResponse.withdrawals":
+		lv := value.List()
+		clv := lv.(*_QueryWithdrawalsByValidatorResponse_1_list)
+		x.Withdrawals = *clv.list
+	case "mitosis.evmvalidator.v1.QueryWithdrawalsByValidatorResponse.pagination":
+		x.Pagination = value.Message().Interface().(*v1beta1.PageResponse)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: mitosis.evmvalidator.v1.QueryCollateralOwnershipsRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mitosis.evmvalidator.v1.QueryWithdrawalsByValidatorResponse"))
 		}
-		panic(fmt.Errorf("message mitosis.evmvalidator.v1.QueryCollateralOwnershipsRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message mitosis.evmvalidator.v1.QueryWithdrawalsByValidatorResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -7902,44 +7694,53 @@ func (x *fastReflection_QueryCollateralOwnershipsRequest) Set(fd protoreflect.Fi
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryCollateralOwnershipsRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryWithdrawalsByValidatorResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "mitosis.evmvalidator.v1.QueryCollateralOwnershipsRequest.pagination":
+	case "mitosis.evmvalidator.v1.QueryWithdrawalsByValidatorResponse.withdrawals":
+		if x.Withdrawals == nil {
+			x.Withdrawals = []*Withdrawal{}
+		}
+		value := &_QueryWithdrawalsByValidatorResponse_1_list{list: &x.Withdrawals}
+		return protoreflect.ValueOfList(value)
+	case "mitosis.evmvalidator.v1.QueryWithdrawalsByValidatorResponse.pagination":
 		if x.Pagination == nil {
-			x.Pagination = new(v1beta1.PageRequest)
+			x.Pagination = new(v1beta1.PageResponse)
 		}
 		return protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: mitosis.evmvalidator.v1.QueryCollateralOwnershipsRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mitosis.evmvalidator.v1.QueryWithdrawalsByValidatorResponse"))
 		}
-		panic(fmt.Errorf("message mitosis.evmvalidator.v1.QueryCollateralOwnershipsRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message mitosis.evmvalidator.v1.QueryWithdrawalsByValidatorResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryCollateralOwnershipsRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryWithdrawalsByValidatorResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "mitosis.evmvalidator.v1.QueryCollateralOwnershipsRequest.pagination":
-		m := new(v1beta1.PageRequest)
+	case "mitosis.evmvalidator.v1.QueryWithdrawalsByValidatorResponse.withdrawals":
+		list := []*Withdrawal{}
+		return protoreflect.ValueOfList(&_QueryWithdrawalsByValidatorResponse_1_list{list: &list})
+	case "mitosis.evmvalidator.v1.QueryWithdrawalsByValidatorResponse.pagination":
+		m := new(v1beta1.PageResponse)
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: mitosis.evmvalidator.v1.QueryCollateralOwnershipsRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mitosis.evmvalidator.v1.QueryWithdrawalsByValidatorResponse"))
 		}
-		panic(fmt.Errorf("message mitosis.evmvalidator.v1.QueryCollateralOwnershipsRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message mitosis.evmvalidator.v1.QueryWithdrawalsByValidatorResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryCollateralOwnershipsRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryWithdrawalsByValidatorResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in mitosis.evmvalidator.v1.QueryCollateralOwnershipsRequest", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in mitosis.evmvalidator.v1.QueryWithdrawalsByValidatorResponse", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -7947,7 +7748,7 @@ func (x *fastReflection_QueryCollateralOwnershipsRequest) WhichOneof(d protorefl
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryCollateralOwnershipsRequest) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryWithdrawalsByValidatorResponse) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -7958,7 +7759,7 @@ func (x *fastReflection_QueryCollateralOwnershipsRequest) GetUnknown() protorefl
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryCollateralOwnershipsRequest) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryWithdrawalsByValidatorResponse) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -7970,7 +7771,7 @@ func (x *fastReflection_QueryCollateralOwnershipsRequest) SetUnknown(fields prot
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryCollateralOwnershipsRequest) IsValid() bool {
+func (x *fastReflection_QueryWithdrawalsByValidatorResponse) IsValid() bool {
 	return x != nil
 }
 
@@ -7980,9 +7781,9 @@ func (x *fastReflection_QueryCollateralOwnershipsRequest) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryCollateralOwnershipsRequest) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryWithdrawalsByValidatorResponse) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryCollateralOwnershipsRequest)
+		x := input.Message.Interface().(*QueryWithdrawalsByValidatorResponse)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -7994,6 +7795,12 @@ func (x *fastReflection_QueryCollateralOwnershipsRequest) ProtoMethods() *protoi
 		var n int
 		var l int
 		_ = l
+		if len(x.Withdrawals) > 0 {
+			for _, e := range x.Withdrawals {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
 		if x.Pagination != nil {
 			l = options.Size(x.Pagination)
 			n += 1 + l + runtime.Sov(uint64(l))
@@ -8008,7 +7815,7 @@ func (x *fastReflection_QueryCollateralOwnershipsRequest) ProtoMethods() *protoi
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryCollateralOwnershipsRequest)
+		x := input.Message.Interface().(*QueryWithdrawalsByValidatorResponse)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -8039,20 +7846,36 @@ func (x *fastReflection_QueryCollateralOwnershipsRequest) ProtoMethods() *protoi
 			copy(dAtA[i:], encoded)
 			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 			i--
-			dAtA[i] = 0xa
-		}
-		if input.Buf != nil {
-			input.Buf = append(input.Buf, dAtA...)
-		} else {
-			input.Buf = dAtA
+			dAtA[i] = 0x12
 		}
-		return protoiface.MarshalOutput{
-			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-			Buf:               input.Buf,
-		}, nil
-	}
+		if len(x.Withdrawals) > 0 {
+			for iNdEx := len(x.Withdrawals) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Withdrawals[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0xa
+			}
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryCollateralOwnershipsRequest)
+		x := input.Message.Interface().(*QueryWithdrawalsByValidatorResponse)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -8084,13 +7907,47 @@ func (x *fastReflection_QueryCollateralOwnershipsRequest) ProtoMethods() *protoi
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryCollateralOwnershipsRequest: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryWithdrawalsByValidatorResponse: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryCollateralOwnershipsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryWithdrawalsByValidatorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Withdrawals", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Withdrawals = append(x.Withdrawals, &Withdrawal{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Withdrawals[len(x.Withdrawals)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 2:
 				if wireType != 2 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 				}
@@ -8120,7 +7977,7 @@ func (x *fastReflection_QueryCollateralOwnershipsRequest) ProtoMethods() *protoi
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
 				if x.Pagination == nil {
-					x.Pagination = &v1beta1.PageRequest{}
+					x.Pagination = &v1beta1.PageResponse{}
 				}
 				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Pagination); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
@@ -8161,80 +8018,3096 @@ func (x *fastReflection_QueryCollateralOwnershipsRequest) ProtoMethods() *protoi
 	}
 }
 
-var _ protoreflect.List = (*_QueryCollateralOwnershipsResponse_1_list)(nil)
+var (
+	md_CollateralOwnershipWithAmount           protoreflect.MessageDescriptor
+	fd_CollateralOwnershipWithAmount_ownership protoreflect.FieldDescriptor
+	fd_CollateralOwnershipWithAmount_amount    protoreflect.FieldDescriptor
+)
 
-type _QueryCollateralOwnershipsResponse_1_list struct {
-	list *[]*CollateralOwnershipWithAmount
+func init() {
+	file_mitosis_evmvalidator_v1_query_proto_init()
+	md_CollateralOwnershipWithAmount = File_mitosis_evmvalidator_v1_query_proto.Messages().ByName("CollateralOwnershipWithAmount")
+	fd_CollateralOwnershipWithAmount_ownership = md_CollateralOwnershipWithAmount.Fields().ByName("ownership")
+	fd_CollateralOwnershipWithAmount_amount = md_CollateralOwnershipWithAmount.Fields().ByName("amount")
 }
 
-func (x *_QueryCollateralOwnershipsResponse_1_list) Len() int {
-	if x.list == nil {
-		return 0
+var _ protoreflect.Message = (*fastReflection_CollateralOwnershipWithAmount)(nil)
+
+type fastReflection_CollateralOwnershipWithAmount CollateralOwnershipWithAmount
+
+func (x *CollateralOwnershipWithAmount) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_CollateralOwnershipWithAmount)(x)
+}
+
+func (x *CollateralOwnershipWithAmount) slowProtoReflect() protoreflect.Message {
+	mi := &file_mitosis_evmvalidator_v1_query_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return len(*x.list)
+	return mi.MessageOf(x)
 }
 
-func (x *_QueryCollateralOwnershipsResponse_1_list) Get(i int) protoreflect.Value {
-	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+var _fastReflection_CollateralOwnershipWithAmount_messageType fastReflection_CollateralOwnershipWithAmount_messageType
+var _ protoreflect.MessageType = fastReflection_CollateralOwnershipWithAmount_messageType{}
+
+type fastReflection_CollateralOwnershipWithAmount_messageType struct{}
+
+func (x fastReflection_CollateralOwnershipWithAmount_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_CollateralOwnershipWithAmount)(nil)
+}
+func (x fastReflection_CollateralOwnershipWithAmount_messageType) New() protoreflect.Message {
+	return new(fastReflection_CollateralOwnershipWithAmount)
+}
+func (x fastReflection_CollateralOwnershipWithAmount_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_CollateralOwnershipWithAmount
 }
 
-func (x *_QueryCollateralOwnershipsResponse_1_list) Set(i int, value protoreflect.Value) {
-	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*CollateralOwnershipWithAmount)
-	(*x.list)[i] = concreteValue
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_CollateralOwnershipWithAmount) Descriptor() protoreflect.MessageDescriptor {
+	return md_CollateralOwnershipWithAmount
 }
 
-func (x *_QueryCollateralOwnershipsResponse_1_list) Append(value protoreflect.Value) {
-	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*CollateralOwnershipWithAmount)
-	*x.list = append(*x.list, concreteValue)
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_CollateralOwnershipWithAmount) Type() protoreflect.MessageType {
+	return _fastReflection_CollateralOwnershipWithAmount_messageType
 }
 
-func (x *_QueryCollateralOwnershipsResponse_1_list) AppendMutable() protoreflect.Value {
-	v := new(CollateralOwnershipWithAmount)
-	*x.list = append(*x.list, v)
-	return protoreflect.ValueOfMessage(v.ProtoReflect())
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_CollateralOwnershipWithAmount) New() protoreflect.Message {
+	return new(fastReflection_CollateralOwnershipWithAmount)
 }
 
-func (x *_QueryCollateralOwnershipsResponse_1_list) Truncate(n int) {
-	for i := n; i < len(*x.list); i++ {
-		(*x.list)[i] = nil
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_CollateralOwnershipWithAmount) Interface() protoreflect.ProtoMessage {
+	return (*CollateralOwnershipWithAmount)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_CollateralOwnershipWithAmount) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Ownership != nil {
+		value := protoreflect.ValueOfMessage(x.Ownership.ProtoReflect())
+		if !f(fd_CollateralOwnershipWithAmount_ownership, value) {
+			return
+		}
+	}
+	if x.Amount != "" {
+		value := protoreflect.ValueOfString(x.Amount)
+		if !f(fd_CollateralOwnershipWithAmount_amount, value) {
+			return
+		}
 	}
-	*x.list = (*x.list)[:n]
 }
 
-func (x *_QueryCollateralOwnershipsResponse_1_list) NewElement() protoreflect.Value {
-	v := new(CollateralOwnershipWithAmount)
-	return protoreflect.ValueOfMessage(v.ProtoReflect())
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_CollateralOwnershipWithAmount) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "mitosis.evmvalidator.v1.CollateralOwnershipWithAmount.ownership":
+		return x.Ownership != nil
+	case "mitosis.evmvalidator.v1.CollateralOwnershipWithAmount.amount":
+		return x.Amount != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mitosis.evmvalidator.v1.CollateralOwnershipWithAmount"))
+		}
+		panic(fmt.Errorf("message mitosis.evmvalidator.v1.CollateralOwnershipWithAmount does not contain field %s", fd.FullName()))
+	}
 }
 
-func (x *_QueryCollateralOwnershipsResponse_1_list) IsValid() bool {
-	return x.list != nil
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_CollateralOwnershipWithAmount) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "mitosis.evmvalidator.v1.CollateralOwnershipWithAmount.ownership":
+		x.Ownership = nil
+	case "mitosis.evmvalidator.v1.CollateralOwnershipWithAmount.amount":
+		x.Amount = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mitosis.evmvalidator.v1.CollateralOwnershipWithAmount"))
+		}
+		panic(fmt.Errorf("message mitosis.evmvalidator.v1.CollateralOwnershipWithAmount does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_CollateralOwnershipWithAmount) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "mitosis.evmvalidator.v1.CollateralOwnershipWithAmount.ownership":
+		value := x.Ownership
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "mitosis.evmvalidator.v1.CollateralOwnershipWithAmount.amount":
+		value := x.Amount
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mitosis.evmvalidator.v1.CollateralOwnershipWithAmount"))
+		}
+		panic(fmt.Errorf("message mitosis.evmvalidator.v1.CollateralOwnershipWithAmount does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_CollateralOwnershipWithAmount) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "mitosis.evmvalidator.v1.CollateralOwnershipWithAmount.ownership":
+		x.Ownership = value.Message().Interface().(*CollateralOwnership)
+	case "mitosis.evmvalidator.v1.CollateralOwnershipWithAmount.amount":
+		x.Amount = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mitosis.evmvalidator.v1.CollateralOwnershipWithAmount"))
+		}
+		panic(fmt.Errorf("message mitosis.evmvalidator.v1.CollateralOwnershipWithAmount does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_CollateralOwnershipWithAmount) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "mitosis.evmvalidator.v1.CollateralOwnershipWithAmount.ownership":
+		if x.Ownership == nil {
+			x.Ownership = new(CollateralOwnership)
+		}
+		return protoreflect.ValueOfMessage(x.Ownership.ProtoReflect())
+	case "mitosis.evmvalidator.v1.CollateralOwnershipWithAmount.amount":
+		panic(fmt.Errorf("field amount of message mitosis.evmvalidator.v1.CollateralOwnershipWithAmount is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mitosis.evmvalidator.v1.CollateralOwnershipWithAmount"))
+		}
+		panic(fmt.Errorf("message mitosis.evmvalidator.v1.CollateralOwnershipWithAmount does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_CollateralOwnershipWithAmount) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "mitosis.evmvalidator.v1.CollateralOwnershipWithAmount.ownership":
+		m := new(CollateralOwnership)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "mitosis.evmvalidator.v1.CollateralOwnershipWithAmount.amount":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mitosis.evmvalidator.v1.CollateralOwnershipWithAmount"))
+		}
+		panic(fmt.Errorf("message mitosis.evmvalidator.v1.CollateralOwnershipWithAmount does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_CollateralOwnershipWithAmount) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in mitosis.evmvalidator.v1.CollateralOwnershipWithAmount", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_CollateralOwnershipWithAmount) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_CollateralOwnershipWithAmount) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_CollateralOwnershipWithAmount) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_CollateralOwnershipWithAmount) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*CollateralOwnershipWithAmount)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Ownership != nil {
+			l = options.Size(x.Ownership)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Amount)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*CollateralOwnershipWithAmount)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Amount) > 0 {
+			i -= len(x.Amount)
+			copy(dAtA[i:], x.Amount)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Amount)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.Ownership != nil {
+			encoded, err := options.Marshal(x.Ownership)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*CollateralOwnershipWithAmount)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: CollateralOwnershipWithAmount: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: CollateralOwnershipWithAmount: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Ownership", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Ownership == nil {
+					x.Ownership = &CollateralOwnership{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Ownership); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Amount = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_QueryCollateralOwnershipsRequest            protoreflect.MessageDescriptor
+	fd_QueryCollateralOwnershipsRequest_pagination protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_mitosis_evmvalidator_v1_query_proto_init()
+	md_QueryCollateralOwnershipsRequest = File_mitosis_evmvalidator_v1_query_proto.Messages().ByName("QueryCollateralOwnershipsRequest")
+	fd_QueryCollateralOwnershipsRequest_pagination = md_QueryCollateralOwnershipsRequest.Fields().ByName("pagination")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryCollateralOwnershipsRequest)(nil)
+
+type fastReflection_QueryCollateralOwnershipsRequest QueryCollateralOwnershipsRequest
+
+func (x *QueryCollateralOwnershipsRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryCollateralOwnershipsRequest)(x)
+}
+
+func (x *QueryCollateralOwnershipsRequest) slowProtoReflect() protoreflect.Message {
+	mi := &file_mitosis_evmvalidator_v1_query_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryCollateralOwnershipsRequest_messageType fastReflection_QueryCollateralOwnershipsRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryCollateralOwnershipsRequest_messageType{}
+
+type fastReflection_QueryCollateralOwnershipsRequest_messageType struct{}
+
+func (x fastReflection_QueryCollateralOwnershipsRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryCollateralOwnershipsRequest)(nil)
+}
+func (x fastReflection_QueryCollateralOwnershipsRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryCollateralOwnershipsRequest)
+}
+func (x fastReflection_QueryCollateralOwnershipsRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryCollateralOwnershipsRequest
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryCollateralOwnershipsRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryCollateralOwnershipsRequest
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryCollateralOwnershipsRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryCollateralOwnershipsRequest_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryCollateralOwnershipsRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryCollateralOwnershipsRequest)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryCollateralOwnershipsRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryCollateralOwnershipsRequest)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryCollateralOwnershipsRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Pagination != nil {
+		value := protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+		if !f(fd_QueryCollateralOwnershipsRequest_pagination, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryCollateralOwnershipsRequest) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "mitosis.evmvalidator.v1.QueryCollateralOwnershipsRequest.pagination":
+		return x.Pagination != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mitosis.evmvalidator.v1.QueryCollateralOwnershipsRequest"))
+		}
+		panic(fmt.Errorf("message mitosis.evmvalidator.v1.QueryCollateralOwnershipsRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryCollateralOwnershipsRequest) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "mitosis.evmvalidator.v1.QueryCollateralOwnershipsRequest.pagination":
+		x.Pagination = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mitosis.evmvalidator.v1.QueryCollateralOwnershipsRequest"))
+		}
+		panic(fmt.Errorf("message mitosis.evmvalidator.v1.QueryCollateralOwnershipsRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryCollateralOwnershipsRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "mitosis.evmvalidator.v1.QueryCollateralOwnershipsRequest.pagination":
+		value := x.Pagination
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mitosis.evmvalidator.v1.QueryCollateralOwnershipsRequest"))
+		}
+		panic(fmt.Errorf("message mitosis.evmvalidator.v1.QueryCollateralOwnershipsRequest does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryCollateralOwnershipsRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "mitosis.evmvalidator.v1.QueryCollateralOwnershipsRequest.pagination":
+		x.Pagination = value.Message().Interface().(*v1beta1.PageRequest)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mitosis.evmvalidator.v1.QueryCollateralOwnershipsRequest"))
+		}
+		panic(fmt.Errorf("message mitosis.evmvalidator.v1.QueryCollateralOwnershipsRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryCollateralOwnershipsRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "mitosis.evmvalidator.v1.QueryCollateralOwnershipsRequest.pagination":
+		if x.Pagination == nil {
+			x.Pagination = new(v1beta1.PageRequest)
+		}
+		return protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mitosis.evmvalidator.v1.QueryCollateralOwnershipsRequest"))
+		}
+		panic(fmt.Errorf("message mitosis.evmvalidator.v1.QueryCollateralOwnershipsRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryCollateralOwnershipsRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "mitosis.evmvalidator.v1.QueryCollateralOwnershipsRequest.pagination":
+		m := new(v1beta1.PageRequest)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mitosis.evmvalidator.v1.QueryCollateralOwnershipsRequest"))
+		}
+		panic(fmt.Errorf("message mitosis.evmvalidator.v1.QueryCollateralOwnershipsRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryCollateralOwnershipsRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in mitosis.evmvalidator.v1.QueryCollateralOwnershipsRequest", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryCollateralOwnershipsRequest) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryCollateralOwnershipsRequest) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryCollateralOwnershipsRequest) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryCollateralOwnershipsRequest) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryCollateralOwnershipsRequest)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Pagination != nil {
+			l = options.Size(x.Pagination)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryCollateralOwnershipsRequest)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Pagination != nil {
+			encoded, err := options.Marshal(x.Pagination)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryCollateralOwnershipsRequest)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryCollateralOwnershipsRequest: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryCollateralOwnershipsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Pagination == nil {
+					x.Pagination = &v1beta1.PageRequest{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Pagination); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_QueryCollateralOwnershipsResponse_1_list)(nil)
+
+type _QueryCollateralOwnershipsResponse_1_list struct {
+	list *[]*CollateralOwnershipWithAmount
+}
+
+func (x *_QueryCollateralOwnershipsResponse_1_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_QueryCollateralOwnershipsResponse_1_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_QueryCollateralOwnershipsResponse_1_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*CollateralOwnershipWithAmount)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_QueryCollateralOwnershipsResponse_1_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*CollateralOwnershipWithAmount)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_QueryCollateralOwnershipsResponse_1_list) AppendMutable() protoreflect.Value {
+	v := new(CollateralOwnershipWithAmount)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryCollateralOwnershipsResponse_1_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_QueryCollateralOwnershipsResponse_1_list) NewElement() protoreflect.Value {
+	v := new(CollateralOwnershipWithAmount)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryCollateralOwnershipsResponse_1_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_QueryCollateralOwnershipsResponse                       protoreflect.MessageDescriptor
+	fd_QueryCollateralOwnershipsResponse_collateral_ownerships protoreflect.FieldDescriptor
+	fd_QueryCollateralOwnershipsResponse_pagination            protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_mitosis_evmvalidator_v1_query_proto_init()
+	md_QueryCollateralOwnershipsResponse = File_mitosis_evmvalidator_v1_query_proto.Messages().ByName("QueryCollateralOwnershipsResponse")
+	fd_QueryCollateralOwnershipsResponse_collateral_ownerships = md_QueryCollateralOwnershipsResponse.Fields().ByName("collateral_ownerships")
+	fd_QueryCollateralOwnershipsResponse_pagination = md_QueryCollateralOwnershipsResponse.Fields().ByName("pagination")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryCollateralOwnershipsResponse)(nil)
+
+type fastReflection_QueryCollateralOwnershipsResponse QueryCollateralOwnershipsResponse
+
+func (x *QueryCollateralOwnershipsResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryCollateralOwnershipsResponse)(x)
+}
+
+func (x *QueryCollateralOwnershipsResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_mitosis_evmvalidator_v1_query_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryCollateralOwnershipsResponse_messageType fastReflection_QueryCollateralOwnershipsResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryCollateralOwnershipsResponse_messageType{}
+
+type fastReflection_QueryCollateralOwnershipsResponse_messageType struct{}
+
+func (x fastReflection_QueryCollateralOwnershipsResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryCollateralOwnershipsResponse)(nil)
+}
+func (x fastReflection_QueryCollateralOwnershipsResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryCollateralOwnershipsResponse)
+}
+func (x fastReflection_QueryCollateralOwnershipsResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryCollateralOwnershipsResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryCollateralOwnershipsResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryCollateralOwnershipsResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryCollateralOwnershipsResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryCollateralOwnershipsResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryCollateralOwnershipsResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryCollateralOwnershipsResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryCollateralOwnershipsResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryCollateralOwnershipsResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryCollateralOwnershipsResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.CollateralOwnerships) != 0 {
+		value := protoreflect.ValueOfList(&_QueryCollateralOwnershipsResponse_1_list{list: &x.CollateralOwnerships})
+		if !f(fd_QueryCollateralOwnershipsResponse_collateral_ownerships, value) {
+			return
+		}
+	}
+	if x.Pagination != nil {
+		value := protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+		if !f(fd_QueryCollateralOwnershipsResponse_pagination, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryCollateralOwnershipsResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "mitosis.evmvalidator.v1.QueryCollateralOwnershipsResponse.collateral_ownerships":
+		return len(x.CollateralOwnerships) != 0
+	case "mitosis.evmvalidator.v1.QueryCollateralOwnershipsResponse.pagination":
+		return x.Pagination != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mitosis.evmvalidator.v1.QueryCollateralOwnershipsResponse"))
+		}
+		panic(fmt.Errorf("message mitosis.evmvalidator.v1.QueryCollateralOwnershipsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryCollateralOwnershipsResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "mitosis.evmvalidator.v1.QueryCollateralOwnershipsResponse.collateral_ownerships":
+		x.CollateralOwnerships = nil
+	case "mitosis.evmvalidator.v1.QueryCollateralOwnershipsResponse.pagination":
+		x.Pagination = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mitosis.evmvalidator.v1.QueryCollateralOwnershipsResponse"))
+		}
+		panic(fmt.Errorf("message mitosis.evmvalidator.v1.QueryCollateralOwnershipsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryCollateralOwnershipsResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "mitosis.evmvalidator.v1.QueryCollateralOwnershipsResponse.collateral_ownerships":
+		if len(x.CollateralOwnerships) == 0 {
+			return protoreflect.ValueOfList(&_QueryCollateralOwnershipsResponse_1_list{})
+		}
+		listValue := &_QueryCollateralOwnershipsResponse_1_list{list: &x.CollateralOwnerships}
+		return protoreflect.ValueOfList(listValue)
+	case "mitosis.evmvalidator.v1.QueryCollateralOwnershipsResponse.pagination":
+		value := x.Pagination
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mitosis.evmvalidator.v1.QueryCollateralOwnershipsResponse"))
+		}
+		panic(fmt.Errorf("message mitosis.evmvalidator.v1.QueryCollateralOwnershipsResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryCollateralOwnershipsResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "mitosis.evmvalidator.v1.QueryCollateralOwnershipsResponse.collateral_ownerships":
+		lv := value.List()
+		clv := lv.(*_QueryCollateralOwnershipsResponse_1_list)
+		x.CollateralOwnerships = *clv.list
+	case "mitosis.evmvalidator.v1.QueryCollateralOwnershipsResponse.pagination":
+		x.Pagination = value.Message().Interface().(*v1beta1.PageResponse)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mitosis.evmvalidator.v1.QueryCollateralOwnershipsResponse"))
+		}
+		panic(fmt.Errorf("message mitosis.evmvalidator.v1.QueryCollateralOwnershipsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryCollateralOwnershipsResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "mitosis.evmvalidator.v1.QueryCollateralOwnershipsResponse.collateral_ownerships":
+		if x.CollateralOwnerships == nil {
+			x.CollateralOwnerships = []*CollateralOwnershipWithAmount{}
+		}
+		value := &_QueryCollateralOwnershipsResponse_1_list{list: &x.CollateralOwnerships}
+		return protoreflect.ValueOfList(value)
+	case "mitosis.evmvalidator.v1.QueryCollateralOwnershipsResponse.pagination":
+		if x.Pagination == nil {
+			x.Pagination = new(v1beta1.PageResponse)
+		}
+		return protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mitosis.evmvalidator.v1.QueryCollateralOwnershipsResponse"))
+		}
+		panic(fmt.Errorf("message mitosis.evmvalidator.v1.QueryCollateralOwnershipsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryCollateralOwnershipsResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "mitosis.evmvalidator.v1.QueryCollateralOwnershipsResponse.collateral_ownerships":
+		list := []*CollateralOwnershipWithAmount{}
+		return protoreflect.ValueOfList(&_QueryCollateralOwnershipsResponse_1_list{list: &list})
+	case "mitosis.evmvalidator.v1.QueryCollateralOwnershipsResponse.pagination":
+		m := new(v1beta1.PageResponse)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mitosis.evmvalidator.v1.QueryCollateralOwnershipsResponse"))
+		}
+		panic(fmt.Errorf("message mitosis.evmvalidator.v1.QueryCollateralOwnershipsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryCollateralOwnershipsResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in mitosis.evmvalidator.v1.QueryCollateralOwnershipsResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryCollateralOwnershipsResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryCollateralOwnershipsResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryCollateralOwnershipsResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryCollateralOwnershipsResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryCollateralOwnershipsResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if len(x.CollateralOwnerships) > 0 {
+			for _, e := range x.CollateralOwnerships {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.Pagination != nil {
+			l = options.Size(x.Pagination)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryCollateralOwnershipsResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Pagination != nil {
+			encoded, err := options.Marshal(x.Pagination)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.CollateralOwnerships) > 0 {
+			for iNdEx := len(x.CollateralOwnerships) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.CollateralOwnerships[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0xa
+			}
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryCollateralOwnershipsResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryCollateralOwnershipsResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryCollateralOwnershipsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CollateralOwnerships", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.CollateralOwnerships = append(x.CollateralOwnerships, &CollateralOwnershipWithAmount{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.CollateralOwnerships[len(x.CollateralOwnerships)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Pagination == nil {
+					x.Pagination = &v1beta1.PageResponse{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Pagination); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_QueryCollateralOwnershipsByValidatorRequest            protoreflect.MessageDescriptor
+	fd_QueryCollateralOwnershipsByValidatorRequest_val_addr   protoreflect.FieldDescriptor
+	fd_QueryCollateralOwnershipsByValidatorRequest_pagination protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_mitosis_evmvalidator_v1_query_proto_init()
+	md_QueryCollateralOwnershipsByValidatorRequest = File_mitosis_evmvalidator_v1_query_proto.Messages().ByName("QueryCollateralOwnershipsByValidatorRequest")
+	fd_QueryCollateralOwnershipsByValidatorRequest_val_addr = md_QueryCollateralOwnershipsByValidatorRequest.Fields().ByName("val_addr")
+	fd_QueryCollateralOwnershipsByValidatorRequest_pagination = md_QueryCollateralOwnershipsByValidatorRequest.Fields().ByName("pagination")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryCollateralOwnershipsByValidatorRequest)(nil)
+
+type fastReflection_QueryCollateralOwnershipsByValidatorRequest QueryCollateralOwnershipsByValidatorRequest
+
+func (x *QueryCollateralOwnershipsByValidatorRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryCollateralOwnershipsByValidatorRequest)(x)
+}
+
+func (x *QueryCollateralOwnershipsByValidatorRequest) slowProtoReflect() protoreflect.Message {
+	mi := &file_mitosis_evmvalidator_v1_query_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryCollateralOwnershipsByValidatorRequest_messageType fastReflection_QueryCollateralOwnershipsByValidatorRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryCollateralOwnershipsByValidatorRequest_messageType{}
+
+type fastReflection_QueryCollateralOwnershipsByValidatorRequest_messageType struct{}
+
+func (x fastReflection_QueryCollateralOwnershipsByValidatorRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryCollateralOwnershipsByValidatorRequest)(nil)
+}
+func (x fastReflection_QueryCollateralOwnershipsByValidatorRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryCollateralOwnershipsByValidatorRequest)
+}
+func (x fastReflection_QueryCollateralOwnershipsByValidatorRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryCollateralOwnershipsByValidatorRequest
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryCollateralOwnershipsByValidatorRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryCollateralOwnershipsByValidatorRequest
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryCollateralOwnershipsByValidatorRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryCollateralOwnershipsByValidatorRequest_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryCollateralOwnershipsByValidatorRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryCollateralOwnershipsByValidatorRequest)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryCollateralOwnershipsByValidatorRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryCollateralOwnershipsByValidatorRequest)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryCollateralOwnershipsByValidatorRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.ValAddr) != 0 {
+		value := protoreflect.ValueOfBytes(x.ValAddr)
+		if !f(fd_QueryCollateralOwnershipsByValidatorRequest_val_addr, value) {
+			return
+		}
+	}
+	if x.Pagination != nil {
+		value := protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+		if !f(fd_QueryCollateralOwnershipsByValidatorRequest_pagination, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryCollateralOwnershipsByValidatorRequest) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "mitosis.evmvalidator.v1.QueryCollateralOwnershipsByValidatorRequest.val_addr":
+		return len(x.ValAddr) != 0
+	case "mitosis.evmvalidator.v1.QueryCollateralOwnershipsByValidatorRequest.pagination":
+		return x.Pagination != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mitosis.evmvalidator.v1.QueryCollateralOwnershipsByValidatorRequest"))
+		}
+		panic(fmt.Errorf("message mitosis.evmvalidator.v1.QueryCollateralOwnershipsByValidatorRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryCollateralOwnershipsByValidatorRequest) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "mitosis.evmvalidator.v1.QueryCollateralOwnershipsByValidatorRequest.val_addr":
+		x.ValAddr = nil
+	case "mitosis.evmvalidator.v1.QueryCollateralOwnershipsByValidatorRequest.pagination":
+		x.Pagination = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mitosis.evmvalidator.v1.QueryCollateralOwnershipsByValidatorRequest"))
+		}
+		panic(fmt.Errorf("message mitosis.evmvalidator.v1.QueryCollateralOwnershipsByValidatorRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryCollateralOwnershipsByValidatorRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "mitosis.evmvalidator.v1.QueryCollateralOwnershipsByValidatorRequest.val_addr":
+		value := x.ValAddr
+		return protoreflect.ValueOfBytes(value)
+	case "mitosis.evmvalidator.v1.QueryCollateralOwnershipsByValidatorRequest.pagination":
+		value := x.Pagination
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mitosis.evmvalidator.v1.QueryCollateralOwnershipsByValidatorRequest"))
+		}
+		panic(fmt.Errorf("message mitosis.evmvalidator.v1.QueryCollateralOwnershipsByValidatorRequest does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryCollateralOwnershipsByValidatorRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "mitosis.evmvalidator.v1.QueryCollateralOwnershipsByValidatorRequest.val_addr":
+		x.ValAddr = value.Bytes()
+	case "mitosis.evmvalidator.v1.QueryCollateralOwnershipsByValidatorRequest.pagination":
+		x.Pagination = value.Message().Interface().(*v1beta1.PageRequest)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mitosis.evmvalidator.v1.QueryCollateralOwnershipsByValidatorRequest"))
+		}
+		panic(fmt.Errorf("message mitosis.evmvalidator.v1.QueryCollateralOwnershipsByValidatorRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryCollateralOwnershipsByValidatorRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "mitosis.evmvalidator.v1.QueryCollateralOwnershipsByValidatorRequest.pagination":
+		if x.Pagination == nil {
+			x.Pagination = new(v1beta1.PageRequest)
+		}
+		return protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+	case "mitosis.evmvalidator.v1.QueryCollateralOwnershipsByValidatorRequest.val_addr":
+		panic(fmt.Errorf("field val_addr of message mitosis.evmvalidator.v1.QueryCollateralOwnershipsByValidatorRequest is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mitosis.evmvalidator.v1.QueryCollateralOwnershipsByValidatorRequest"))
+		}
+		panic(fmt.Errorf("message mitosis.evmvalidator.v1.QueryCollateralOwnershipsByValidatorRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryCollateralOwnershipsByValidatorRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "mitosis.evmvalidator.v1.QueryCollateralOwnershipsByValidatorRequest.val_addr":
+		return protoreflect.ValueOfBytes(nil)
+	case "mitosis.evmvalidator.v1.QueryCollateralOwnershipsByValidatorRequest.pagination":
+		m := new(v1beta1.PageRequest)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mitosis.evmvalidator.v1.QueryCollateralOwnershipsByValidatorRequest"))
+		}
+		panic(fmt.Errorf("message mitosis.evmvalidator.v1.QueryCollateralOwnershipsByValidatorRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryCollateralOwnershipsByValidatorRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in mitosis.evmvalidator.v1.QueryCollateralOwnershipsByValidatorRequest", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryCollateralOwnershipsByValidatorRequest) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryCollateralOwnershipsByValidatorRequest) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryCollateralOwnershipsByValidatorRequest) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryCollateralOwnershipsByValidatorRequest) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryCollateralOwnershipsByValidatorRequest)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.ValAddr)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Pagination != nil {
+			l = options.Size(x.Pagination)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryCollateralOwnershipsByValidatorRequest)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Pagination != nil {
+			encoded, err := options.Marshal(x.Pagination)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.ValAddr) > 0 {
+			i -= len(x.ValAddr)
+			copy(dAtA[i:], x.ValAddr)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ValAddr)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryCollateralOwnershipsByValidatorRequest)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryCollateralOwnershipsByValidatorRequest: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryCollateralOwnershipsByValidatorRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ValAddr", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ValAddr = append(x.ValAddr[:0], dAtA[iNdEx:postIndex]...)
+				if x.ValAddr == nil {
+					x.ValAddr = []byte{}
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Pagination == nil {
+					x.Pagination = &v1beta1.PageRequest{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Pagination); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_QueryCollateralOwnershipsByValidatorResponse_1_list)(nil)
+
+type _QueryCollateralOwnershipsByValidatorResponse_1_list struct {
+	list *[]*CollateralOwnershipWithAmount
+}
+
+func (x *_QueryCollateralOwnershipsByValidatorResponse_1_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_QueryCollateralOwnershipsByValidatorResponse_1_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_QueryCollateralOwnershipsByValidatorResponse_1_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*CollateralOwnershipWithAmount)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_QueryCollateralOwnershipsByValidatorResponse_1_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*CollateralOwnershipWithAmount)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_QueryCollateralOwnershipsByValidatorResponse_1_list) AppendMutable() protoreflect.Value {
+	v := new(CollateralOwnershipWithAmount)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryCollateralOwnershipsByValidatorResponse_1_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_QueryCollateralOwnershipsByValidatorResponse_1_list) NewElement() protoreflect.Value {
+	v := new(CollateralOwnershipWithAmount)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryCollateralOwnershipsByValidatorResponse_1_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_QueryCollateralOwnershipsByValidatorResponse                       protoreflect.MessageDescriptor
+	fd_QueryCollateralOwnershipsByValidatorResponse_collateral_ownerships protoreflect.FieldDescriptor
+	fd_QueryCollateralOwnershipsByValidatorResponse_pagination            protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_mitosis_evmvalidator_v1_query_proto_init()
+	md_QueryCollateralOwnershipsByValidatorResponse = File_mitosis_evmvalidator_v1_query_proto.Messages().ByName("QueryCollateralOwnershipsByValidatorResponse")
+	fd_QueryCollateralOwnershipsByValidatorResponse_collateral_ownerships = md_QueryCollateralOwnershipsByValidatorResponse.Fields().ByName("collateral_ownerships")
+	fd_QueryCollateralOwnershipsByValidatorResponse_pagination = md_QueryCollateralOwnershipsByValidatorResponse.Fields().ByName("pagination")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryCollateralOwnershipsByValidatorResponse)(nil)
+
+type fastReflection_QueryCollateralOwnershipsByValidatorResponse QueryCollateralOwnershipsByValidatorResponse
+
+func (x *QueryCollateralOwnershipsByValidatorResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryCollateralOwnershipsByValidatorResponse)(x)
+}
+
+func (x *QueryCollateralOwnershipsByValidatorResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_mitosis_evmvalidator_v1_query_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryCollateralOwnershipsByValidatorResponse_messageType fastReflection_QueryCollateralOwnershipsByValidatorResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryCollateralOwnershipsByValidatorResponse_messageType{}
+
+type fastReflection_QueryCollateralOwnershipsByValidatorResponse_messageType struct{}
+
+func (x fastReflection_QueryCollateralOwnershipsByValidatorResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryCollateralOwnershipsByValidatorResponse)(nil)
+}
+func (x fastReflection_QueryCollateralOwnershipsByValidatorResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryCollateralOwnershipsByValidatorResponse)
+}
+func (x fastReflection_QueryCollateralOwnershipsByValidatorResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryCollateralOwnershipsByValidatorResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryCollateralOwnershipsByValidatorResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryCollateralOwnershipsByValidatorResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryCollateralOwnershipsByValidatorResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryCollateralOwnershipsByValidatorResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryCollateralOwnershipsByValidatorResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryCollateralOwnershipsByValidatorResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryCollateralOwnershipsByValidatorResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryCollateralOwnershipsByValidatorResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryCollateralOwnershipsByValidatorResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.CollateralOwnerships) != 0 {
+		value := protoreflect.ValueOfList(&_QueryCollateralOwnershipsByValidatorResponse_1_list{list: &x.CollateralOwnerships})
+		if !f(fd_QueryCollateralOwnershipsByValidatorResponse_collateral_ownerships, value) {
+			return
+		}
+	}
+	if x.Pagination != nil {
+		value := protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+		if !f(fd_QueryCollateralOwnershipsByValidatorResponse_pagination, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryCollateralOwnershipsByValidatorResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "mitosis.evmvalidator.v1.QueryCollateralOwnershipsByValidatorResponse.collateral_ownerships":
+		return len(x.CollateralOwnerships) != 0
+	case "mitosis.evmvalidator.v1.QueryCollateralOwnershipsByValidatorResponse.pagination":
+		return x.Pagination != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mitosis.evmvalidator.v1.QueryCollateralOwnershipsByValidatorResponse"))
+		}
+		panic(fmt.Errorf("message mitosis.evmvalidator.v1.QueryCollateralOwnershipsByValidatorResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryCollateralOwnershipsByValidatorResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "mitosis.evmvalidator.v1.QueryCollateralOwnershipsByValidatorResponse.collateral_ownerships":
+		x.CollateralOwnerships = nil
+	case "mitosis.evmvalidator.v1.QueryCollateralOwnershipsByValidatorResponse.pagination":
+		x.Pagination = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mitosis.evmvalidator.v1.QueryCollateralOwnershipsByValidatorResponse"))
+		}
+		panic(fmt.Errorf("message mitosis.evmvalidator.v1.QueryCollateralOwnershipsByValidatorResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryCollateralOwnershipsByValidatorResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "mitosis.evmvalidator.v1.QueryCollateralOwnershipsByValidatorResponse.collateral_ownerships":
+		if len(x.CollateralOwnerships) == 0 {
+			return protoreflect.ValueOfList(&_QueryCollateralOwnershipsByValidatorResponse_1_list{})
+		}
+		listValue := &_QueryCollateralOwnershipsByValidatorResponse_1_list{list: &x.CollateralOwnerships}
+		return protoreflect.ValueOfList(listValue)
+	case "mitosis.evmvalidator.v1.QueryCollateralOwnershipsByValidatorResponse.pagination":
+		value := x.Pagination
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mitosis.evmvalidator.v1.QueryCollateralOwnershipsByValidatorResponse"))
+		}
+		panic(fmt.Errorf("message mitosis.evmvalidator.v1.QueryCollateralOwnershipsByValidatorResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryCollateralOwnershipsByValidatorResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "mitosis.evmvalidator.v1.QueryCollateralOwnershipsByValidatorResponse.collateral_ownerships":
+		lv := value.List()
+		clv := lv.(*_QueryCollateralOwnershipsByValidatorResponse_1_list)
+		x.CollateralOwnerships = *clv.list
+	case "mitosis.evmvalidator.v1.QueryCollateralOwnershipsByValidatorResponse.pagination":
+		x.Pagination = value.Message().Interface().(*v1beta1.PageResponse)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mitosis.evmvalidator.v1.QueryCollateralOwnershipsByValidatorResponse"))
+		}
+		panic(fmt.Errorf("message mitosis.evmvalidator.v1.QueryCollateralOwnershipsByValidatorResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryCollateralOwnershipsByValidatorResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "mitosis.evmvalidator.v1.QueryCollateralOwnershipsByValidatorResponse.collateral_ownerships":
+		if x.CollateralOwnerships == nil {
+			x.CollateralOwnerships = []*CollateralOwnershipWithAmount{}
+		}
+		value := &_QueryCollateralOwnershipsByValidatorResponse_1_list{list: &x.CollateralOwnerships}
+		return protoreflect.ValueOfList(value)
+	case "mitosis.evmvalidator.v1.QueryCollateralOwnershipsByValidatorResponse.pagination":
+		if x.Pagination == nil {
+			x.Pagination = new(v1beta1.PageResponse)
+		}
+		return protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mitosis.evmvalidator.v1.QueryCollateralOwnershipsByValidatorResponse"))
+		}
+		panic(fmt.Errorf("message mitosis.evmvalidator.v1.QueryCollateralOwnershipsByValidatorResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryCollateralOwnershipsByValidatorResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "mitosis.evmvalidator.v1.QueryCollateralOwnershipsByValidatorResponse.collateral_ownerships":
+		list := []*CollateralOwnershipWithAmount{}
+		return protoreflect.ValueOfList(&_QueryCollateralOwnershipsByValidatorResponse_1_list{list: &list})
+	case "mitosis.evmvalidator.v1.QueryCollateralOwnershipsByValidatorResponse.pagination":
+		m := new(v1beta1.PageResponse)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mitosis.evmvalidator.v1.QueryCollateralOwnershipsByValidatorResponse"))
+		}
+		panic(fmt.Errorf("message mitosis.evmvalidator.v1.QueryCollateralOwnershipsByValidatorResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryCollateralOwnershipsByValidatorResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in mitosis.evmvalidator.v1.QueryCollateralOwnershipsByValidatorResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryCollateralOwnershipsByValidatorResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryCollateralOwnershipsByValidatorResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryCollateralOwnershipsByValidatorResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryCollateralOwnershipsByValidatorResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryCollateralOwnershipsByValidatorResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if len(x.CollateralOwnerships) > 0 {
+			for _, e := range x.CollateralOwnerships {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.Pagination != nil {
+			l = options.Size(x.Pagination)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryCollateralOwnershipsByValidatorResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Pagination != nil {
+			encoded, err := options.Marshal(x.Pagination)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.CollateralOwnerships) > 0 {
+			for iNdEx := len(x.CollateralOwnerships) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.CollateralOwnerships[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0xa
+			}
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryCollateralOwnershipsByValidatorResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryCollateralOwnershipsByValidatorResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryCollateralOwnershipsByValidatorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CollateralOwnerships", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.CollateralOwnerships = append(x.CollateralOwnerships, &CollateralOwnershipWithAmount{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.CollateralOwnerships[len(x.CollateralOwnerships)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Pagination == nil {
+					x.Pagination = &v1beta1.PageResponse{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Pagination); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_QueryCollateralOwnershipRequest          protoreflect.MessageDescriptor
+	fd_QueryCollateralOwnershipRequest_val_addr protoreflect.FieldDescriptor
+	fd_QueryCollateralOwnershipRequest_owner    protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_mitosis_evmvalidator_v1_query_proto_init()
+	md_QueryCollateralOwnershipRequest = File_mitosis_evmvalidator_v1_query_proto.Messages().ByName("QueryCollateralOwnershipRequest")
+	fd_QueryCollateralOwnershipRequest_val_addr = md_QueryCollateralOwnershipRequest.Fields().ByName("val_addr")
+	fd_QueryCollateralOwnershipRequest_owner = md_QueryCollateralOwnershipRequest.Fields().ByName("owner")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryCollateralOwnershipRequest)(nil)
+
+type fastReflection_QueryCollateralOwnershipRequest QueryCollateralOwnershipRequest
+
+func (x *QueryCollateralOwnershipRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryCollateralOwnershipRequest)(x)
+}
+
+func (x *QueryCollateralOwnershipRequest) slowProtoReflect() protoreflect.Message {
+	mi := &file_mitosis_evmvalidator_v1_query_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryCollateralOwnershipRequest_messageType fastReflection_QueryCollateralOwnershipRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryCollateralOwnershipRequest_messageType{}
+
+type fastReflection_QueryCollateralOwnershipRequest_messageType struct{}
+
+func (x fastReflection_QueryCollateralOwnershipRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryCollateralOwnershipRequest)(nil)
+}
+func (x fastReflection_QueryCollateralOwnershipRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryCollateralOwnershipRequest)
+}
+func (x fastReflection_QueryCollateralOwnershipRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryCollateralOwnershipRequest
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryCollateralOwnershipRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryCollateralOwnershipRequest
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryCollateralOwnershipRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryCollateralOwnershipRequest_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryCollateralOwnershipRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryCollateralOwnershipRequest)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryCollateralOwnershipRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryCollateralOwnershipRequest)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryCollateralOwnershipRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.ValAddr) != 0 {
+		value := protoreflect.ValueOfBytes(x.ValAddr)
+		if !f(fd_QueryCollateralOwnershipRequest_val_addr, value) {
+			return
+		}
+	}
+	if len(x.Owner) != 0 {
+		value := protoreflect.ValueOfBytes(x.Owner)
+		if !f(fd_QueryCollateralOwnershipRequest_owner, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryCollateralOwnershipRequest) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "mitosis.evmvalidator.v1.QueryCollateralOwnershipRequest.val_addr":
+		return len(x.ValAddr) != 0
+	case "mitosis.evmvalidator.v1.QueryCollateralOwnershipRequest.owner":
+		return len(x.Owner) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mitosis.evmvalidator.v1.QueryCollateralOwnershipRequest"))
+		}
+		panic(fmt.Errorf("message mitosis.evmvalidator.v1.QueryCollateralOwnershipRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryCollateralOwnershipRequest) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "mitosis.evmvalidator.v1.QueryCollateralOwnershipRequest.val_addr":
+		x.ValAddr = nil
+	case "mitosis.evmvalidator.v1.QueryCollateralOwnershipRequest.owner":
+		x.Owner = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mitosis.evmvalidator.v1.QueryCollateralOwnershipRequest"))
+		}
+		panic(fmt.Errorf("message mitosis.evmvalidator.v1.QueryCollateralOwnershipRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryCollateralOwnershipRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "mitosis.evmvalidator.v1.QueryCollateralOwnershipRequest.val_addr":
+		value := x.ValAddr
+		return protoreflect.ValueOfBytes(value)
+	case "mitosis.evmvalidator.v1.QueryCollateralOwnershipRequest.owner":
+		value := x.Owner
+		return protoreflect.ValueOfBytes(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mitosis.evmvalidator.v1.QueryCollateralOwnershipRequest"))
+		}
+		panic(fmt.Errorf("message mitosis.evmvalidator.v1.QueryCollateralOwnershipRequest does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryCollateralOwnershipRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "mitosis.evmvalidator.v1.QueryCollateralOwnershipRequest.val_addr":
+		x.ValAddr = value.Bytes()
+	case "mitosis.evmvalidator.v1.QueryCollateralOwnershipRequest.owner":
+		x.Owner = value.Bytes()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mitosis.evmvalidator.v1.QueryCollateralOwnershipRequest"))
+		}
+		panic(fmt.Errorf("message mitosis.evmvalidator.v1.QueryCollateralOwnershipRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryCollateralOwnershipRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "mitosis.evmvalidator.v1.QueryCollateralOwnershipRequest.val_addr":
+		panic(fmt.Errorf("field val_addr of message mitosis.evmvalidator.v1.QueryCollateralOwnershipRequest is not mutable"))
+	case "mitosis.evmvalidator.v1.QueryCollateralOwnershipRequest.owner":
+		panic(fmt.Errorf("field owner of message mitosis.evmvalidator.v1.QueryCollateralOwnershipRequest is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mitosis.evmvalidator.v1.QueryCollateralOwnershipRequest"))
+		}
+		panic(fmt.Errorf("message mitosis.evmvalidator.v1.QueryCollateralOwnershipRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryCollateralOwnershipRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "mitosis.evmvalidator.v1.QueryCollateralOwnershipRequest.val_addr":
+		return protoreflect.ValueOfBytes(nil)
+	case "mitosis.evmvalidator.v1.QueryCollateralOwnershipRequest.owner":
+		return protoreflect.ValueOfBytes(nil)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mitosis.evmvalidator.v1.QueryCollateralOwnershipRequest"))
+		}
+		panic(fmt.Errorf("message mitosis.evmvalidator.v1.QueryCollateralOwnershipRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryCollateralOwnershipRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in mitosis.evmvalidator.v1.QueryCollateralOwnershipRequest", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryCollateralOwnershipRequest) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryCollateralOwnershipRequest) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryCollateralOwnershipRequest) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryCollateralOwnershipRequest) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryCollateralOwnershipRequest)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.ValAddr)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Owner)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryCollateralOwnershipRequest)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Owner) > 0 {
+			i -= len(x.Owner)
+			copy(dAtA[i:], x.Owner)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Owner)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.ValAddr) > 0 {
+			i -= len(x.ValAddr)
+			copy(dAtA[i:], x.ValAddr)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ValAddr)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryCollateralOwnershipRequest)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryCollateralOwnershipRequest: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryCollateralOwnershipRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ValAddr", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ValAddr = append(x.ValAddr[:0], dAtA[iNdEx:postIndex]...)
+				if x.ValAddr == nil {
+					x.ValAddr = []byte{}
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Owner = append(x.Owner[:0], dAtA[iNdEx:postIndex]...)
+				if x.Owner == nil {
+					x.Owner = []byte{}
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
 }
 
 var (
-	md_QueryCollateralOwnershipsResponse                       protoreflect.MessageDescriptor
-	fd_QueryCollateralOwnershipsResponse_collateral_ownerships protoreflect.FieldDescriptor
-	fd_QueryCollateralOwnershipsResponse_pagination            protoreflect.FieldDescriptor
+	md_QueryCollateralOwnershipResponse                      protoreflect.MessageDescriptor
+	fd_QueryCollateralOwnershipResponse_collateral_ownership protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_mitosis_evmvalidator_v1_query_proto_init()
-	md_QueryCollateralOwnershipsResponse = File_mitosis_evmvalidator_v1_query_proto.Messages().ByName("QueryCollateralOwnershipsResponse")
-	fd_QueryCollateralOwnershipsResponse_collateral_ownerships = md_QueryCollateralOwnershipsResponse.Fields().ByName("collateral_ownerships")
-	fd_QueryCollateralOwnershipsResponse_pagination = md_QueryCollateralOwnershipsResponse.Fields().ByName("pagination")
+	md_QueryCollateralOwnershipResponse = File_mitosis_evmvalidator_v1_query_proto.Messages().ByName("QueryCollateralOwnershipResponse")
+	fd_QueryCollateralOwnershipResponse_collateral_ownership = md_QueryCollateralOwnershipResponse.Fields().ByName("collateral_ownership")
 }
 
-var _ protoreflect.Message = (*fastReflection_QueryCollateralOwnershipsResponse)(nil)
+var _ protoreflect.Message = (*fastReflection_QueryCollateralOwnershipResponse)(nil)
 
-type fastReflection_QueryCollateralOwnershipsResponse QueryCollateralOwnershipsResponse
+type fastReflection_QueryCollateralOwnershipResponse QueryCollateralOwnershipResponse
 
-func (x *QueryCollateralOwnershipsResponse) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryCollateralOwnershipsResponse)(x)
+func (x *QueryCollateralOwnershipResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryCollateralOwnershipResponse)(x)
 }
 
-func (x *QueryCollateralOwnershipsResponse) slowProtoReflect() protoreflect.Message {
-	mi := &file_mitosis_evmvalidator_v1_query_proto_msgTypes[18]
+func (x *QueryCollateralOwnershipResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_mitosis_evmvalidator_v1_query_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8245,43 +11118,43 @@ func (x *QueryCollateralOwnershipsResponse) slowProtoReflect() protoreflect.Mess
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryCollateralOwnershipsResponse_messageType fastReflection_QueryCollateralOwnershipsResponse_messageType
-var _ protoreflect.MessageType = fastReflection_QueryCollateralOwnershipsResponse_messageType{}
+var _fastReflection_QueryCollateralOwnershipResponse_messageType fastReflection_QueryCollateralOwnershipResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryCollateralOwnershipResponse_messageType{}
 
-type fastReflection_QueryCollateralOwnershipsResponse_messageType struct{}
+type fastReflection_QueryCollateralOwnershipResponse_messageType struct{}
 
-func (x fastReflection_QueryCollateralOwnershipsResponse_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryCollateralOwnershipsResponse)(nil)
+func (x fastReflection_QueryCollateralOwnershipResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryCollateralOwnershipResponse)(nil)
 }
-func (x fastReflection_QueryCollateralOwnershipsResponse_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryCollateralOwnershipsResponse)
+func (x fastReflection_QueryCollateralOwnershipResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryCollateralOwnershipResponse)
 }
-func (x fastReflection_QueryCollateralOwnershipsResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryCollateralOwnershipsResponse
+func (x fastReflection_QueryCollateralOwnershipResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryCollateralOwnershipResponse
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryCollateralOwnershipsResponse) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryCollateralOwnershipsResponse
+func (x *fastReflection_QueryCollateralOwnershipResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryCollateralOwnershipResponse
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryCollateralOwnershipsResponse) Type() protoreflect.MessageType {
-	return _fastReflection_QueryCollateralOwnershipsResponse_messageType
+func (x *fastReflection_QueryCollateralOwnershipResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryCollateralOwnershipResponse_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryCollateralOwnershipsResponse) New() protoreflect.Message {
-	return new(fastReflection_QueryCollateralOwnershipsResponse)
+func (x *fastReflection_QueryCollateralOwnershipResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryCollateralOwnershipResponse)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryCollateralOwnershipsResponse) Interface() protoreflect.ProtoMessage {
-	return (*QueryCollateralOwnershipsResponse)(x)
+func (x *fastReflection_QueryCollateralOwnershipResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryCollateralOwnershipResponse)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -8289,16 +11162,10 @@ func (x *fastReflection_QueryCollateralOwnershipsResponse) Interface() protorefl
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryCollateralOwnershipsResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if len(x.CollateralOwnerships) != 0 {
-		value := protoreflect.ValueOfList(&_QueryCollateralOwnershipsResponse_1_list{list: &x.CollateralOwnerships})
-		if !f(fd_QueryCollateralOwnershipsResponse_collateral_ownerships, value) {
-			return
-		}
-	}
-	if x.Pagination != nil {
-		value := protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
-		if !f(fd_QueryCollateralOwnershipsResponse_pagination, value) {
+func (x *fastReflection_QueryCollateralOwnershipResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.CollateralOwnership != nil {
+		value := protoreflect.ValueOfMessage(x.CollateralOwnership.ProtoReflect())
+		if !f(fd_QueryCollateralOwnershipResponse_collateral_ownership, value) {
 			return
 		}
 	}
@@ -8315,17 +11182,15 @@ func (x *fastReflection_QueryCollateralOwnershipsResponse) Range(f func(protoref
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryCollateralOwnershipsResponse) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryCollateralOwnershipResponse) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "mitosis.evmvalidator.v1.QueryCollateralOwnershipsResponse.collateral_ownerships":
-		return len(x.CollateralOwnerships) != 0
-	case "mitosis.evmvalidator.v1.QueryCollateralOwnershipsResponse.pagination":
-		return x.Pagination != nil
+	case "mitosis.evmvalidator.v1.QueryCollateralOwnershipResponse.collateral_ownership":
+		return x.CollateralOwnership != nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: mitosis.evmvalidator.v1.QueryCollateralOwnershipsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mitosis.evmvalidator.v1.QueryCollateralOwnershipResponse"))
 		}
-		panic(fmt.Errorf("message mitosis.evmvalidator.v1.QueryCollateralOwnershipsResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message mitosis.evmvalidator.v1.QueryCollateralOwnershipResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -8335,17 +11200,15 @@ func (x *fastReflection_QueryCollateralOwnershipsResponse) Has(fd protoreflect.F
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryCollateralOwnershipsResponse) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryCollateralOwnershipResponse) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "mitosis.evmvalidator.v1.QueryCollateralOwnershipsResponse.collateral_ownerships":
-		x.CollateralOwnerships = nil
-	case "mitosis.evmvalidator.v1.QueryCollateralOwnershipsResponse.pagination":
-		x.Pagination = nil
+	case "mitosis.evmvalidator.v1.QueryCollateralOwnershipResponse.collateral_ownership":
+		x.CollateralOwnership = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: mitosis.evmvalidator.v1.QueryCollateralOwnershipsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mitosis.evmvalidator.v1.QueryCollateralOwnershipResponse"))
 		}
-		panic(fmt.Errorf("message mitosis.evmvalidator.v1.QueryCollateralOwnershipsResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message mitosis.evmvalidator.v1.QueryCollateralOwnershipResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -8355,22 +11218,16 @@ func (x *fastReflection_QueryCollateralOwnershipsResponse) Clear(fd protoreflect
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryCollateralOwnershipsResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryCollateralOwnershipResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "mitosis.evmvalidator.v1.QueryCollateralOwnershipsResponse.collateral_ownerships":
-		if len(x.CollateralOwnerships) == 0 {
-			return protoreflect.ValueOfList(&_QueryCollateralOwnershipsResponse_1_list{})
-		}
-		listValue := &_QueryCollateralOwnershipsResponse_1_list{list: &x.CollateralOwnerships}
-		return protoreflect.ValueOfList(listValue)
-	case "mitosis.evmvalidator.v1.QueryCollateralOwnershipsResponse.pagination":
-		value := x.Pagination
+	case "mitosis.evmvalidator.v1.QueryCollateralOwnershipResponse.collateral_ownership":
+		value := x.CollateralOwnership
 		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: mitosis.evmvalidator.v1.QueryCollateralOwnershipsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mitosis.evmvalidator.v1.QueryCollateralOwnershipResponse"))
 		}
-		panic(fmt.Errorf("message mitosis.evmvalidator.v1.QueryCollateralOwnershipsResponse does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message mitosis.evmvalidator.v1.QueryCollateralOwnershipResponse does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -8384,19 +11241,15 @@ func (x *fastReflection_QueryCollateralOwnershipsResponse) Get(descriptor protor
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryCollateralOwnershipsResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryCollateralOwnershipResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "mitosis.evmvalidator.v1.QueryCollateralOwnershipsResponse.collateral_ownerships":
-		lv := value.List()
-		clv := lv.(*_QueryCollateralOwnershipsResponse_1_list)
-		x.CollateralOwnerships = *clv.list
-	case "mitosis.evmvalidator.v1.QueryCollateralOwnershipsResponse.pagination":
-		x.Pagination = value.Message().Interface().(*v1beta1.PageResponse)
+	case "mitosis.evmvalidator.v1.QueryCollateralOwnershipResponse.collateral_ownership":
+		x.CollateralOwnership = value.Message().Interface().(*CollateralOwnershipWithAmount)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: mitosis.evmvalidator.v1.QueryCollateralOwnershipsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mitosis.evmvalidator.v1.QueryCollateralOwnershipResponse"))
 		}
-		panic(fmt.Errorf("message mitosis.evmvalidator.v1.QueryCollateralOwnershipsResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message mitosis.evmvalidator.v1.QueryCollateralOwnershipResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -8410,53 +11263,44 @@ func (x *fastReflection_QueryCollateralOwnershipsResponse) Set(fd protoreflect.F
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryCollateralOwnershipsResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryCollateralOwnershipResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "mitosis.evmvalidator.v1.QueryCollateralOwnershipsResponse.collateral_ownerships":
-		if x.CollateralOwnerships == nil {
-			x.CollateralOwnerships = []*CollateralOwnershipWithAmount{}
-		}
-		value := &_QueryCollateralOwnershipsResponse_1_list{list: &x.CollateralOwnerships}
-		return protoreflect.ValueOfList(value)
-	case "mitosis.evmvalidator.v1.QueryCollateralOwnershipsResponse.pagination":
-		if x.Pagination == nil {
-			x.Pagination = new(v1beta1.PageResponse)
+	case "mitosis.evmvalidator.v1.QueryCollateralOwnershipResponse.collateral_ownership":
+		if x.CollateralOwnership == nil {
+			x.CollateralOwnership = new(CollateralOwnershipWithAmount)
 		}
-		return protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+		return protoreflect.ValueOfMessage(x.CollateralOwnership.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: mitosis.evmvalidator.v1.QueryCollateralOwnershipsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mitosis.evmvalidator.v1.QueryCollateralOwnershipResponse"))
 		}
-		panic(fmt.Errorf("message mitosis.evmvalidator.v1.QueryCollateralOwnershipsResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message mitosis.evmvalidator.v1.QueryCollateralOwnershipResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryCollateralOwnershipsResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryCollateralOwnershipResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "mitosis.evmvalidator.v1.QueryCollateralOwnershipsResponse.collateral_ownerships":
-		list := []*CollateralOwnershipWithAmount{}
-		return protoreflect.ValueOfList(&_QueryCollateralOwnershipsResponse_1_list{list: &list})
-	case "mitosis.evmvalidator.v1.QueryCollateralOwnershipsResponse.pagination":
-		m := new(v1beta1.PageResponse)
+	case "mitosis.evmvalidator.v1.QueryCollateralOwnershipResponse.collateral_ownership":
+		m := new(CollateralOwnershipWithAmount)
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: mitosis.evmvalidator.v1.QueryCollateralOwnershipsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mitosis.evmvalidator.v1.QueryCollateralOwnershipResponse"))
 		}
-		panic(fmt.Errorf("message mitosis.evmvalidator.v1.QueryCollateralOwnershipsResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message mitosis.evmvalidator.v1.QueryCollateralOwnershipResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryCollateralOwnershipsResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryCollateralOwnershipResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in mitosis.evmvalidator.v1.QueryCollateralOwnershipsResponse", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in mitosis.evmvalidator.v1.QueryCollateralOwnershipResponse", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -8464,7 +11308,7 @@ func (x *fastReflection_QueryCollateralOwnershipsResponse) WhichOneof(d protoref
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryCollateralOwnershipsResponse) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryCollateralOwnershipResponse) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -8475,7 +11319,7 @@ func (x *fastReflection_QueryCollateralOwnershipsResponse) GetUnknown() protoref
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryCollateralOwnershipsResponse) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryCollateralOwnershipResponse) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -8487,7 +11331,7 @@ func (x *fastReflection_QueryCollateralOwnershipsResponse) SetUnknown(fields pro
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryCollateralOwnershipsResponse) IsValid() bool {
+func (x *fastReflection_QueryCollateralOwnershipResponse) IsValid() bool {
 	return x != nil
 }
 
@@ -8497,9 +11341,9 @@ func (x *fastReflection_QueryCollateralOwnershipsResponse) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryCollateralOwnershipsResponse) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryCollateralOwnershipResponse) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryCollateralOwnershipsResponse)
+		x := input.Message.Interface().(*QueryCollateralOwnershipResponse)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -8511,14 +11355,8 @@ func (x *fastReflection_QueryCollateralOwnershipsResponse) ProtoMethods() *proto
 		var n int
 		var l int
 		_ = l
-		if len(x.CollateralOwnerships) > 0 {
-			for _, e := range x.CollateralOwnerships {
-				l = options.Size(e)
-				n += 1 + l + runtime.Sov(uint64(l))
-			}
-		}
-		if x.Pagination != nil {
-			l = options.Size(x.Pagination)
+		if x.CollateralOwnership != nil {
+			l = options.Size(x.CollateralOwnership)
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
 		if x.unknownFields != nil {
@@ -8531,7 +11369,7 @@ func (x *fastReflection_QueryCollateralOwnershipsResponse) ProtoMethods() *proto
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryCollateralOwnershipsResponse)
+		x := input.Message.Interface().(*QueryCollateralOwnershipResponse)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -8550,8 +11388,8 @@ func (x *fastReflection_QueryCollateralOwnershipsResponse) ProtoMethods() *proto
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.Pagination != nil {
-			encoded, err := options.Marshal(x.Pagination)
+		if x.CollateralOwnership != nil {
+			encoded, err := options.Marshal(x.CollateralOwnership)
 			if err != nil {
 				return protoiface.MarshalOutput{
 					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -8562,23 +11400,7 @@ func (x *fastReflection_QueryCollateralOwnershipsResponse) ProtoMethods() *proto
 			copy(dAtA[i:], encoded)
 			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 			i--
-			dAtA[i] = 0x12
-		}
-		if len(x.CollateralOwnerships) > 0 {
-			for iNdEx := len(x.CollateralOwnerships) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.CollateralOwnerships[iNdEx])
-				if err != nil {
-					return protoiface.MarshalOutput{
-						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-						Buf:               input.Buf,
-					}, err
-				}
-				i -= len(encoded)
-				copy(dAtA[i:], encoded)
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-				i--
-				dAtA[i] = 0xa
-			}
+			dAtA[i] = 0xa
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -8591,7 +11413,7 @@ func (x *fastReflection_QueryCollateralOwnershipsResponse) ProtoMethods() *proto
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryCollateralOwnershipsResponse)
+		x := input.Message.Interface().(*QueryCollateralOwnershipResponse)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -8623,49 +11445,15 @@ func (x *fastReflection_QueryCollateralOwnershipsResponse) ProtoMethods() *proto
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryCollateralOwnershipsResponse: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryCollateralOwnershipResponse: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryCollateralOwnershipsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryCollateralOwnershipResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CollateralOwnerships", wireType)
-				}
-				var msglen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					msglen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if msglen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + msglen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.CollateralOwnerships = append(x.CollateralOwnerships, &CollateralOwnershipWithAmount{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.CollateralOwnerships[len(x.CollateralOwnerships)-1]); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
-				iNdEx = postIndex
-			case 2:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CollateralOwnership", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -8692,10 +11480,10 @@ func (x *fastReflection_QueryCollateralOwnershipsResponse) ProtoMethods() *proto
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.Pagination == nil {
-					x.Pagination = &v1beta1.PageResponse{}
+				if x.CollateralOwnership == nil {
+					x.CollateralOwnership = &CollateralOwnershipWithAmount{}
 				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Pagination); err != nil {
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.CollateralOwnership); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
@@ -8735,28 +11523,26 @@ func (x *fastReflection_QueryCollateralOwnershipsResponse) ProtoMethods() *proto
 }
 
 var (
-	md_QueryCollateralOwnershipsByValidatorRequest            protoreflect.MessageDescriptor
-	fd_QueryCollateralOwnershipsByValidatorRequest_val_addr   protoreflect.FieldDescriptor
-	fd_QueryCollateralOwnershipsByValidatorRequest_pagination protoreflect.FieldDescriptor
+	md_QueryEventReceiptRequest                protoreflect.MessageDescriptor
+	fd_QueryEventReceiptRequest_evm_block_hash protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_mitosis_evmvalidator_v1_query_proto_init()
-	md_QueryCollateralOwnershipsByValidatorRequest = File_mitosis_evmvalidator_v1_query_proto.Messages().ByName("QueryCollateralOwnershipsByValidatorRequest")
-	fd_QueryCollateralOwnershipsByValidatorRequest_val_addr = md_QueryCollateralOwnershipsByValidatorRequest.Fields().ByName("val_addr")
-	fd_QueryCollateralOwnershipsByValidatorRequest_pagination = md_QueryCollateralOwnershipsByValidatorRequest.Fields().ByName("pagination")
+	md_QueryEventReceiptRequest = File_mitosis_evmvalidator_v1_query_proto.Messages().ByName("QueryEventReceiptRequest")
+	fd_QueryEventReceiptRequest_evm_block_hash = md_QueryEventReceiptRequest.Fields().ByName("evm_block_hash")
 }
 
-var _ protoreflect.Message = (*fastReflection_QueryCollateralOwnershipsByValidatorRequest)(nil)
+var _ protoreflect.Message = (*fastReflection_QueryEventReceiptRequest)(nil)
 
-type fastReflection_QueryCollateralOwnershipsByValidatorRequest QueryCollateralOwnershipsByValidatorRequest
+type fastReflection_QueryEventReceiptRequest QueryEventReceiptRequest
 
-func (x *QueryCollateralOwnershipsByValidatorRequest) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryCollateralOwnershipsByValidatorRequest)(x)
+func (x *QueryEventReceiptRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryEventReceiptRequest)(x)
 }
 
-func (x *QueryCollateralOwnershipsByValidatorRequest) slowProtoReflect() protoreflect.Message {
-	mi := &file_mitosis_evmvalidator_v1_query_proto_msgTypes[19]
+func (x *QueryEventReceiptRequest) slowProtoReflect() protoreflect.Message {
+	mi := &file_mitosis_evmvalidator_v1_query_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8767,43 +11553,43 @@ func (x *QueryCollateralOwnershipsByValidatorRequest) slowProtoReflect() protore
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryCollateralOwnershipsByValidatorRequest_messageType fastReflection_QueryCollateralOwnershipsByValidatorRequest_messageType
-var _ protoreflect.MessageType = fastReflection_QueryCollateralOwnershipsByValidatorRequest_messageType{}
+var _fastReflection_QueryEventReceiptRequest_messageType fastReflection_QueryEventReceiptRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryEventReceiptRequest_messageType{}
 
-type fastReflection_QueryCollateralOwnershipsByValidatorRequest_messageType struct{}
+type fastReflection_QueryEventReceiptRequest_messageType struct{}
 
-func (x fastReflection_QueryCollateralOwnershipsByValidatorRequest_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryCollateralOwnershipsByValidatorRequest)(nil)
+func (x fastReflection_QueryEventReceiptRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryEventReceiptRequest)(nil)
 }
-func (x fastReflection_QueryCollateralOwnershipsByValidatorRequest_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryCollateralOwnershipsByValidatorRequest)
+func (x fastReflection_QueryEventReceiptRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryEventReceiptRequest)
 }
-func (x fastReflection_QueryCollateralOwnershipsByValidatorRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryCollateralOwnershipsByValidatorRequest
+func (x fastReflection_QueryEventReceiptRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryEventReceiptRequest
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryCollateralOwnershipsByValidatorRequest) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryCollateralOwnershipsByValidatorRequest
+func (x *fastReflection_QueryEventReceiptRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryEventReceiptRequest
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryCollateralOwnershipsByValidatorRequest) Type() protoreflect.MessageType {
-	return _fastReflection_QueryCollateralOwnershipsByValidatorRequest_messageType
+func (x *fastReflection_QueryEventReceiptRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryEventReceiptRequest_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryCollateralOwnershipsByValidatorRequest) New() protoreflect.Message {
-	return new(fastReflection_QueryCollateralOwnershipsByValidatorRequest)
+func (x *fastReflection_QueryEventReceiptRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryEventReceiptRequest)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryCollateralOwnershipsByValidatorRequest) Interface() protoreflect.ProtoMessage {
-	return (*QueryCollateralOwnershipsByValidatorRequest)(x)
+func (x *fastReflection_QueryEventReceiptRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryEventReceiptRequest)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -8811,16 +11597,10 @@ func (x *fastReflection_QueryCollateralOwnershipsByValidatorRequest) Interface()
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryCollateralOwnershipsByValidatorRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if len(x.ValAddr) != 0 {
-		value := protoreflect.ValueOfBytes(x.ValAddr)
-		if !f(fd_QueryCollateralOwnershipsByValidatorRequest_val_addr, value) {
-			return
-		}
-	}
-	if x.Pagination != nil {
-		value := protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
-		if !f(fd_QueryCollateralOwnershipsByValidatorRequest_pagination, value) {
+func (x *fastReflection_QueryEventReceiptRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.EvmBlockHash != "" {
+		value := protoreflect.ValueOfString(x.EvmBlockHash)
+		if !f(fd_QueryEventReceiptRequest_evm_block_hash, value) {
 			return
 		}
 	}
@@ -8837,17 +11617,15 @@ func (x *fastReflection_QueryCollateralOwnershipsByValidatorRequest) Range(f fun
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryCollateralOwnershipsByValidatorRequest) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryEventReceiptRequest) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "mitosis.evmvalidator.v1.QueryCollateralOwnershipsByValidatorRequest.val_addr":
-		return len(x.ValAddr) != 0
-	case "mitosis.evmvalidator.v1.QueryCollateralOwnershipsByValidatorRequest.pagination":
-		return x.Pagination != nil
+	case "mitosis.evmvalidator.v1.QueryEventReceiptRequest.evm_block_hash":
+		return x.EvmBlockHash != ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: mitosis.evmvalidator.v1.QueryCollateralOwnershipsByValidatorRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mitosis.evmvalidator.v1.QueryEventReceiptRequest"))
 		}
-		panic(fmt.Errorf("message mitosis.evmvalidator.v1.QueryCollateralOwnershipsByValidatorRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message mitosis.evmvalidator.v1.QueryEventReceiptRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -8857,17 +11635,15 @@ func (x *fastReflection_QueryCollateralOwnershipsByValidatorRequest) Has(fd prot
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryCollateralOwnershipsByValidatorRequest) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryEventReceiptRequest) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "mitosis.evmvalidator.v1.QueryCollateralOwnershipsByValidatorRequest.val_addr":
-		x.ValAddr = nil
-	case "mitosis.evmvalidator.v1.QueryCollateralOwnershipsByValidatorRequest.pagination":
-		x.Pagination = nil
+	case "mitosis.evmvalidator.v1.QueryEventReceiptRequest.evm_block_hash":
+		x.EvmBlockHash = ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: mitosis.evmvalidator.v1.QueryCollateralOwnershipsByValidatorRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mitosis.evmvalidator.v1.QueryEventReceiptRequest"))
 		}
-		panic(fmt.Errorf("message mitosis.evmvalidator.v1.QueryCollateralOwnershipsByValidatorRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message mitosis.evmvalidator.v1.QueryEventReceiptRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -8877,19 +11653,16 @@ func (x *fastReflection_QueryCollateralOwnershipsByValidatorRequest) Clear(fd pr
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryCollateralOwnershipsByValidatorRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryEventReceiptRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "mitosis.evmvalidator.v1.QueryCollateralOwnershipsByValidatorRequest.val_addr":
-		value := x.ValAddr
-		return protoreflect.ValueOfBytes(value)
-	case "mitosis.evmvalidator.v1.QueryCollateralOwnershipsByValidatorRequest.pagination":
-		value := x.Pagination
-		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "mitosis.evmvalidator.v1.QueryEventReceiptRequest.evm_block_hash":
+		value := x.EvmBlockHash
+		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: mitosis.evmvalidator.v1.QueryCollateralOwnershipsByValidatorRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mitosis.evmvalidator.v1.QueryEventReceiptRequest"))
 		}
-		panic(fmt.Errorf("message mitosis.evmvalidator.v1.QueryCollateralOwnershipsByValidatorRequest does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message mitosis.evmvalidator.v1.QueryEventReceiptRequest does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -8903,17 +11676,15 @@ func (x *fastReflection_QueryCollateralOwnershipsByValidatorRequest) Get(descrip
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryCollateralOwnershipsByValidatorRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryEventReceiptRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "mitosis.evmvalidator.v1.QueryCollateralOwnershipsByValidatorRequest.val_addr":
-		x.ValAddr = value.Bytes()
-	case "mitosis.evmvalidator.v1.QueryCollateralOwnershipsByValidatorRequest.pagination":
-		x.Pagination = value.Message().Interface().(*v1beta1.PageRequest)
+	case "mitosis.evmvalidator.v1.QueryEventReceiptRequest.evm_block_hash":
+		x.EvmBlockHash = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: mitosis.evmvalidator.v1.QueryCollateralOwnershipsByValidatorRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mitosis.evmvalidator.v1.QueryEventReceiptRequest"))
 		}
-		panic(fmt.Errorf("message mitosis.evmvalidator.v1.QueryCollateralOwnershipsByValidatorRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message mitosis.evmvalidator.v1.QueryEventReceiptRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -8927,48 +11698,40 @@ func (x *fastReflection_QueryCollateralOwnershipsByValidatorRequest) Set(fd prot
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryCollateralOwnershipsByValidatorRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryEventReceiptRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "mitosis.evmvalidator.v1.QueryCollateralOwnershipsByValidatorRequest.pagination":
-		if x.Pagination == nil {
-			x.Pagination = new(v1beta1.PageRequest)
-		}
-		return protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
-	case "mitosis.evmvalidator.v1.QueryCollateralOwnershipsByValidatorRequest.val_addr":
-		panic(fmt.Errorf("field val_addr of message mitosis.evmvalidator.v1.QueryCollateralOwnershipsByValidatorRequest is not mutable"))
+	case "mitosis.evmvalidator.v1.QueryEventReceiptRequest.evm_block_hash":
+		panic(fmt.Errorf("field evm_block_hash of message mitosis.evmvalidator.v1.QueryEventReceiptRequest is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: mitosis.evmvalidator.v1.QueryCollateralOwnershipsByValidatorRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mitosis.evmvalidator.v1.QueryEventReceiptRequest"))
 		}
-		panic(fmt.Errorf("message mitosis.evmvalidator.v1.QueryCollateralOwnershipsByValidatorRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message mitosis.evmvalidator.v1.QueryEventReceiptRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryCollateralOwnershipsByValidatorRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryEventReceiptRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "mitosis.evmvalidator.v1.QueryCollateralOwnershipsByValidatorRequest.val_addr":
-		return protoreflect.ValueOfBytes(nil)
-	case "mitosis.evmvalidator.v1.QueryCollateralOwnershipsByValidatorRequest.pagination":
-		m := new(v1beta1.PageRequest)
-		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "mitosis.evmvalidator.v1.QueryEventReceiptRequest.evm_block_hash":
+		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: mitosis.evmvalidator.v1.QueryCollateralOwnershipsByValidatorRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mitosis.evmvalidator.v1.QueryEventReceiptRequest"))
 		}
-		panic(fmt.Errorf("message mitosis.evmvalidator.v1.QueryCollateralOwnershipsByValidatorRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message mitosis.evmvalidator.v1.QueryEventReceiptRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryCollateralOwnershipsByValidatorRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryEventReceiptRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in mitosis.evmvalidator.v1.QueryCollateralOwnershipsByValidatorRequest", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in mitosis.evmvalidator.v1.QueryEventReceiptRequest", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -8976,7 +11739,7 @@ func (x *fastReflection_QueryCollateralOwnershipsByValidatorRequest) WhichOneof(
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryCollateralOwnershipsByValidatorRequest) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryEventReceiptRequest) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -8987,7 +11750,7 @@ func (x *fastReflection_QueryCollateralOwnershipsByValidatorRequest) GetUnknown(
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryCollateralOwnershipsByValidatorRequest) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryEventReceiptRequest) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -8999,7 +11762,7 @@ func (x *fastReflection_QueryCollateralOwnershipsByValidatorRequest) SetUnknown(
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryCollateralOwnershipsByValidatorRequest) IsValid() bool {
+func (x *fastReflection_QueryEventReceiptRequest) IsValid() bool {
 	return x != nil
 }
 
@@ -9009,9 +11772,9 @@ func (x *fastReflection_QueryCollateralOwnershipsByValidatorRequest) IsValid() b
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryCollateralOwnershipsByValidatorRequest) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryEventReceiptRequest) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryCollateralOwnershipsByValidatorRequest)
+		x := input.Message.Interface().(*QueryEventReceiptRequest)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -9023,14 +11786,10 @@ func (x *fastReflection_QueryCollateralOwnershipsByValidatorRequest) ProtoMethod
 		var n int
 		var l int
 		_ = l
-		l = len(x.ValAddr)
+		l = len(x.EvmBlockHash)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		if x.Pagination != nil {
-			l = options.Size(x.Pagination)
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -9041,7 +11800,7 @@ func (x *fastReflection_QueryCollateralOwnershipsByValidatorRequest) ProtoMethod
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryCollateralOwnershipsByValidatorRequest)
+		x := input.Message.Interface().(*QueryEventReceiptRequest)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -9060,24 +11819,10 @@ func (x *fastReflection_QueryCollateralOwnershipsByValidatorRequest) ProtoMethod
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.Pagination != nil {
-			encoded, err := options.Marshal(x.Pagination)
-			if err != nil {
-				return protoiface.MarshalOutput{
-					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-					Buf:               input.Buf,
-				}, err
-			}
-			i -= len(encoded)
-			copy(dAtA[i:], encoded)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-			i--
-			dAtA[i] = 0x12
-		}
-		if len(x.ValAddr) > 0 {
-			i -= len(x.ValAddr)
-			copy(dAtA[i:], x.ValAddr)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ValAddr)))
+		if len(x.EvmBlockHash) > 0 {
+			i -= len(x.EvmBlockHash)
+			copy(dAtA[i:], x.EvmBlockHash)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.EvmBlockHash)))
 			i--
 			dAtA[i] = 0xa
 		}
@@ -9092,7 +11837,7 @@ func (x *fastReflection_QueryCollateralOwnershipsByValidatorRequest) ProtoMethod
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryCollateralOwnershipsByValidatorRequest)
+		x := input.Message.Interface().(*QueryEventReceiptRequest)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -9124,51 +11869,17 @@ func (x *fastReflection_QueryCollateralOwnershipsByValidatorRequest) ProtoMethod
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryCollateralOwnershipsByValidatorRequest: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryEventReceiptRequest: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryCollateralOwnershipsByValidatorRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryEventReceiptRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ValAddr", wireType)
-				}
-				var byteLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					byteLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if byteLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + byteLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.ValAddr = append(x.ValAddr[:0], dAtA[iNdEx:postIndex]...)
-				if x.ValAddr == nil {
-					x.ValAddr = []byte{}
-				}
-				iNdEx = postIndex
-			case 2:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field EvmBlockHash", wireType)
 				}
-				var msglen int
+				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -9178,27 +11889,23 @@ func (x *fastReflection_QueryCollateralOwnershipsByValidatorRequest) ProtoMethod
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					msglen |= int(b&0x7F) << shift
+					stringLen |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if msglen < 0 {
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + msglen
+				postIndex := iNdEx + intStringLen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.Pagination == nil {
-					x.Pagination = &v1beta1.PageRequest{}
-				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Pagination); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
+				x.EvmBlockHash = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
@@ -9235,80 +11942,78 @@ func (x *fastReflection_QueryCollateralOwnershipsByValidatorRequest) ProtoMethod
 	}
 }
 
-var _ protoreflect.List = (*_QueryCollateralOwnershipsByValidatorResponse_1_list)(nil)
+var _ protoreflect.List = (*_QueryEventReceiptResponse_1_list)(nil)
 
-type _QueryCollateralOwnershipsByValidatorResponse_1_list struct {
-	list *[]*CollateralOwnershipWithAmount
+type _QueryEventReceiptResponse_1_list struct {
+	list *[]*EventReceipt
 }
 
-func (x *_QueryCollateralOwnershipsByValidatorResponse_1_list) Len() int {
+func (x *_QueryEventReceiptResponse_1_list) Len() int {
 	if x.list == nil {
 		return 0
 	}
 	return len(*x.list)
 }
 
-func (x *_QueryCollateralOwnershipsByValidatorResponse_1_list) Get(i int) protoreflect.Value {
+func (x *_QueryEventReceiptResponse_1_list) Get(i int) protoreflect.Value {
 	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
 }
 
-func (x *_QueryCollateralOwnershipsByValidatorResponse_1_list) Set(i int, value protoreflect.Value) {
+func (x *_QueryEventReceiptResponse_1_list) Set(i int, value protoreflect.Value) {
 	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*CollateralOwnershipWithAmount)
+	concreteValue := valueUnwrapped.Interface().(*EventReceipt)
 	(*x.list)[i] = concreteValue
 }
 
-func (x *_QueryCollateralOwnershipsByValidatorResponse_1_list) Append(value protoreflect.Value) {
+func (x *_QueryEventReceiptResponse_1_list) Append(value protoreflect.Value) {
 	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*CollateralOwnershipWithAmount)
+	concreteValue := valueUnwrapped.Interface().(*EventReceipt)
 	*x.list = append(*x.list, concreteValue)
 }
 
-func (x *_QueryCollateralOwnershipsByValidatorResponse_1_list) AppendMutable() protoreflect.Value {
-	v := new(CollateralOwnershipWithAmount)
+func (x *_QueryEventReceiptResponse_1_list) AppendMutable() protoreflect.Value {
+	v := new(EventReceipt)
 	*x.list = append(*x.list, v)
 	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
 
-func (x *_QueryCollateralOwnershipsByValidatorResponse_1_list) Truncate(n int) {
+func (x *_QueryEventReceiptResponse_1_list) Truncate(n int) {
 	for i := n; i < len(*x.list); i++ {
 		(*x.list)[i] = nil
 	}
 	*x.list = (*x.list)[:n]
 }
 
-func (x *_QueryCollateralOwnershipsByValidatorResponse_1_list) NewElement() protoreflect.Value {
-	v := new(CollateralOwnershipWithAmount)
+func (x *_QueryEventReceiptResponse_1_list) NewElement() protoreflect.Value {
+	v := new(EventReceipt)
 	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
 
-func (x *_QueryCollateralOwnershipsByValidatorResponse_1_list) IsValid() bool {
+func (x *_QueryEventReceiptResponse_1_list) IsValid() bool {
 	return x.list != nil
 }
 
 var (
-	md_QueryCollateralOwnershipsByValidatorResponse                       protoreflect.MessageDescriptor
-	fd_QueryCollateralOwnershipsByValidatorResponse_collateral_ownerships protoreflect.FieldDescriptor
-	fd_QueryCollateralOwnershipsByValidatorResponse_pagination            protoreflect.FieldDescriptor
+	md_QueryEventReceiptResponse          protoreflect.MessageDescriptor
+	fd_QueryEventReceiptResponse_receipts protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_mitosis_evmvalidator_v1_query_proto_init()
-	md_QueryCollateralOwnershipsByValidatorResponse = File_mitosis_evmvalidator_v1_query_proto.Messages().ByName("QueryCollateralOwnershipsByValidatorResponse")
-	fd_QueryCollateralOwnershipsByValidatorResponse_collateral_ownerships = md_QueryCollateralOwnershipsByValidatorResponse.Fields().ByName("collateral_ownerships")
-	fd_QueryCollateralOwnershipsByValidatorResponse_pagination = md_QueryCollateralOwnershipsByValidatorResponse.Fields().ByName("pagination")
+	md_QueryEventReceiptResponse = File_mitosis_evmvalidator_v1_query_proto.Messages().ByName("QueryEventReceiptResponse")
+	fd_QueryEventReceiptResponse_receipts = md_QueryEventReceiptResponse.Fields().ByName("receipts")
 }
 
-var _ protoreflect.Message = (*fastReflection_QueryCollateralOwnershipsByValidatorResponse)(nil)
+var _ protoreflect.Message = (*fastReflection_QueryEventReceiptResponse)(nil)
 
-type fastReflection_QueryCollateralOwnershipsByValidatorResponse QueryCollateralOwnershipsByValidatorResponse
+type fastReflection_QueryEventReceiptResponse QueryEventReceiptResponse
 
-func (x *QueryCollateralOwnershipsByValidatorResponse) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryCollateralOwnershipsByValidatorResponse)(x)
+func (x *QueryEventReceiptResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryEventReceiptResponse)(x)
 }
 
-func (x *QueryCollateralOwnershipsByValidatorResponse) slowProtoReflect() protoreflect.Message {
-	mi := &file_mitosis_evmvalidator_v1_query_proto_msgTypes[20]
+func (x *QueryEventReceiptResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_mitosis_evmvalidator_v1_query_proto_msgTypes[26]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9319,43 +12024,43 @@ func (x *QueryCollateralOwnershipsByValidatorResponse) slowProtoReflect() protor
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryCollateralOwnershipsByValidatorResponse_messageType fastReflection_QueryCollateralOwnershipsByValidatorResponse_messageType
-var _ protoreflect.MessageType = fastReflection_QueryCollateralOwnershipsByValidatorResponse_messageType{}
+var _fastReflection_QueryEventReceiptResponse_messageType fastReflection_QueryEventReceiptResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryEventReceiptResponse_messageType{}
 
-type fastReflection_QueryCollateralOwnershipsByValidatorResponse_messageType struct{}
+type fastReflection_QueryEventReceiptResponse_messageType struct{}
 
-func (x fastReflection_QueryCollateralOwnershipsByValidatorResponse_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryCollateralOwnershipsByValidatorResponse)(nil)
+func (x fastReflection_QueryEventReceiptResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryEventReceiptResponse)(nil)
 }
-func (x fastReflection_QueryCollateralOwnershipsByValidatorResponse_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryCollateralOwnershipsByValidatorResponse)
+func (x fastReflection_QueryEventReceiptResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryEventReceiptResponse)
 }
-func (x fastReflection_QueryCollateralOwnershipsByValidatorResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryCollateralOwnershipsByValidatorResponse
+func (x fastReflection_QueryEventReceiptResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryEventReceiptResponse
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryCollateralOwnershipsByValidatorResponse) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryCollateralOwnershipsByValidatorResponse
+func (x *fastReflection_QueryEventReceiptResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryEventReceiptResponse
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryCollateralOwnershipsByValidatorResponse) Type() protoreflect.MessageType {
-	return _fastReflection_QueryCollateralOwnershipsByValidatorResponse_messageType
+func (x *fastReflection_QueryEventReceiptResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryEventReceiptResponse_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryCollateralOwnershipsByValidatorResponse) New() protoreflect.Message {
-	return new(fastReflection_QueryCollateralOwnershipsByValidatorResponse)
+func (x *fastReflection_QueryEventReceiptResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryEventReceiptResponse)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryCollateralOwnershipsByValidatorResponse) Interface() protoreflect.ProtoMessage {
-	return (*QueryCollateralOwnershipsByValidatorResponse)(x)
+func (x *fastReflection_QueryEventReceiptResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryEventReceiptResponse)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -9363,16 +12068,10 @@ func (x *fastReflection_QueryCollateralOwnershipsByValidatorResponse) Interface(
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryCollateralOwnershipsByValidatorResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if len(x.CollateralOwnerships) != 0 {
-		value := protoreflect.ValueOfList(&_QueryCollateralOwnershipsByValidatorResponse_1_list{list: &x.CollateralOwnerships})
-		if !f(fd_QueryCollateralOwnershipsByValidatorResponse_collateral_ownerships, value) {
-			return
-		}
-	}
-	if x.Pagination != nil {
-		value := protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
-		if !f(fd_QueryCollateralOwnershipsByValidatorResponse_pagination, value) {
+func (x *fastReflection_QueryEventReceiptResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.Receipts) != 0 {
+		value := protoreflect.ValueOfList(&_QueryEventReceiptResponse_1_list{list: &x.Receipts})
+		if !f(fd_QueryEventReceiptResponse_receipts, value) {
 			return
 		}
 	}
@@ -9389,17 +12088,15 @@ func (x *fastReflection_QueryCollateralOwnershipsByValidatorResponse) Range(f fu
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryCollateralOwnershipsByValidatorResponse) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryEventReceiptResponse) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "mitosis.evmvalidator.v1.QueryCollateralOwnershipsByValidatorResponse.collateral_ownerships":
-		return len(x.CollateralOwnerships) != 0
-	case "mitosis.evmvalidator.v1.QueryCollateralOwnershipsByValidatorResponse.pagination":
-		return x.Pagination != nil
+	case "mitosis.evmvalidator.v1.QueryEventReceiptResponse.receipts":
+		return len(x.Receipts) != 0
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: mitosis.evmvalidator.v1.QueryCollateralOwnershipsByValidatorResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mitosis.evmvalidator.v1.QueryEventReceiptResponse"))
 		}
-		panic(fmt.Errorf("message mitosis.evmvalidator.v1.QueryCollateralOwnershipsByValidatorResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message mitosis.evmvalidator.v1.QueryEventReceiptResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -9409,17 +12106,15 @@ func (x *fastReflection_QueryCollateralOwnershipsByValidatorResponse) Has(fd pro
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryCollateralOwnershipsByValidatorResponse) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryEventReceiptResponse) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "mitosis.evmvalidator.v1.QueryCollateralOwnershipsByValidatorResponse.collateral_ownerships":
-		x.CollateralOwnerships = nil
-	case "mitosis.evmvalidator.v1.QueryCollateralOwnershipsByValidatorResponse.pagination":
-		x.Pagination = nil
+	case "mitosis.evmvalidator.v1.QueryEventReceiptResponse.receipts":
+		x.Receipts = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: mitosis.evmvalidator.v1.QueryCollateralOwnershipsByValidatorResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mitosis.evmvalidator.v1.QueryEventReceiptResponse"))
 		}
-		panic(fmt.Errorf("message mitosis.evmvalidator.v1.QueryCollateralOwnershipsByValidatorResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message mitosis.evmvalidator.v1.QueryEventReceiptResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -9429,22 +12124,19 @@ func (x *fastReflection_QueryCollateralOwnershipsByValidatorResponse) Clear(fd p
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryCollateralOwnershipsByValidatorResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryEventReceiptResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "mitosis.evmvalidator.v1.QueryCollateralOwnershipsByValidatorResponse.collateral_ownerships":
-		if len(x.CollateralOwnerships) == 0 {
-			return protoreflect.ValueOfList(&_QueryCollateralOwnershipsByValidatorResponse_1_list{})
+	case "mitosis.evmvalidator.v1.QueryEventReceiptResponse.receipts":
+		if len(x.Receipts) == 0 {
+			return protoreflect.ValueOfList(&_QueryEventReceiptResponse_1_list{})
 		}
-		listValue := &_QueryCollateralOwnershipsByValidatorResponse_1_list{list: &x.CollateralOwnerships}
+		listValue := &_QueryEventReceiptResponse_1_list{list: &x.Receipts}
 		return protoreflect.ValueOfList(listValue)
-	case "mitosis.evmvalidator.v1.QueryCollateralOwnershipsByValidatorResponse.pagination":
-		value := x.Pagination
-		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: mitosis.evmvalidator.v1.QueryCollateralOwnershipsByValidatorResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mitosis.evmvalidator.v1.QueryEventReceiptResponse"))
 		}
-		panic(fmt.Errorf("message mitosis.evmvalidator.v1.QueryCollateralOwnershipsByValidatorResponse does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message mitosis.evmvalidator.v1.QueryEventReceiptResponse does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -9458,19 +12150,17 @@ func (x *fastReflection_QueryCollateralOwnershipsByValidatorResponse) Get(descri
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryCollateralOwnershipsByValidatorResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryEventReceiptResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "mitosis.evmvalidator.v1.QueryCollateralOwnershipsByValidatorResponse.collateral_ownerships":
+	case "mitosis.evmvalidator.v1.QueryEventReceiptResponse.receipts":
 		lv := value.List()
-		clv := lv.(*_QueryCollateralOwnershipsByValidatorResponse_1_list)
-		x.CollateralOwnerships = *clv.list
-	case "mitosis.evmvalidator.v1.QueryCollateralOwnershipsByValidatorResponse.pagination":
-		x.Pagination = value.Message().Interface().(*v1beta1.PageResponse)
+		clv := lv.(*_QueryEventReceiptResponse_1_list)
+		x.Receipts = *clv.list
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: mitosis.evmvalidator.v1.QueryCollateralOwnershipsByValidatorResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mitosis.evmvalidator.v1.QueryEventReceiptResponse"))
 		}
-		panic(fmt.Errorf("message mitosis.evmvalidator.v1.QueryCollateralOwnershipsByValidatorResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message mitosis.evmvalidator.v1.QueryEventReceiptResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -9484,53 +12174,45 @@ func (x *fastReflection_QueryCollateralOwnershipsByValidatorResponse) Set(fd pro
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryCollateralOwnershipsByValidatorResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryEventReceiptResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "mitosis.evmvalidator.v1.QueryCollateralOwnershipsByValidatorResponse.collateral_ownerships":
-		if x.CollateralOwnerships == nil {
-			x.CollateralOwnerships = []*CollateralOwnershipWithAmount{}
+	case "mitosis.evmvalidator.v1.QueryEventReceiptResponse.receipts":
+		if x.Receipts == nil {
+			x.Receipts = []*EventReceipt{}
 		}
-		value := &_QueryCollateralOwnershipsByValidatorResponse_1_list{list: &x.CollateralOwnerships}
+		value := &_QueryEventReceiptResponse_1_list{list: &x.Receipts}
 		return protoreflect.ValueOfList(value)
-	case "mitosis.evmvalidator.v1.QueryCollateralOwnershipsByValidatorResponse.pagination":
-		if x.Pagination == nil {
-			x.Pagination = new(v1beta1.PageResponse)
-		}
-		return protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: mitosis.evmvalidator.v1.QueryCollateralOwnershipsByValidatorResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mitosis.evmvalidator.v1.QueryEventReceiptResponse"))
 		}
-		panic(fmt.Errorf("message mitosis.evmvalidator.v1.QueryCollateralOwnershipsByValidatorResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message mitosis.evmvalidator.v1.QueryEventReceiptResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryCollateralOwnershipsByValidatorResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryEventReceiptResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "mitosis.evmvalidator.v1.QueryCollateralOwnershipsByValidatorResponse.collateral_ownerships":
-		list := []*CollateralOwnershipWithAmount{}
-		return protoreflect.ValueOfList(&_QueryCollateralOwnershipsByValidatorResponse_1_list{list: &list})
-	case "mitosis.evmvalidator.v1.QueryCollateralOwnershipsByValidatorResponse.pagination":
-		m := new(v1beta1.PageResponse)
-		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "mitosis.evmvalidator.v1.QueryEventReceiptResponse.receipts":
+		list := []*EventReceipt{}
+		return protoreflect.ValueOfList(&_QueryEventReceiptResponse_1_list{list: &list})
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: mitosis.evmvalidator.v1.QueryCollateralOwnershipsByValidatorResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mitosis.evmvalidator.v1.QueryEventReceiptResponse"))
 		}
-		panic(fmt.Errorf("message mitosis.evmvalidator.v1.QueryCollateralOwnershipsByValidatorResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message mitosis.evmvalidator.v1.QueryEventReceiptResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryCollateralOwnershipsByValidatorResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryEventReceiptResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in mitosis.evmvalidator.v1.QueryCollateralOwnershipsByValidatorResponse", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in mitosis.evmvalidator.v1.QueryEventReceiptResponse", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -9538,7 +12220,7 @@ func (x *fastReflection_QueryCollateralOwnershipsByValidatorResponse) WhichOneof
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryCollateralOwnershipsByValidatorResponse) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryEventReceiptResponse) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -9549,7 +12231,7 @@ func (x *fastReflection_QueryCollateralOwnershipsByValidatorResponse) GetUnknown
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryCollateralOwnershipsByValidatorResponse) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryEventReceiptResponse) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -9561,7 +12243,7 @@ func (x *fastReflection_QueryCollateralOwnershipsByValidatorResponse) SetUnknown
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryCollateralOwnershipsByValidatorResponse) IsValid() bool {
+func (x *fastReflection_QueryEventReceiptResponse) IsValid() bool {
 	return x != nil
 }
 
@@ -9571,9 +12253,9 @@ func (x *fastReflection_QueryCollateralOwnershipsByValidatorResponse) IsValid()
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryCollateralOwnershipsByValidatorResponse) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryEventReceiptResponse) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryCollateralOwnershipsByValidatorResponse)
+		x := input.Message.Interface().(*QueryEventReceiptResponse)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -9585,16 +12267,12 @@ func (x *fastReflection_QueryCollateralOwnershipsByValidatorResponse) ProtoMetho
 		var n int
 		var l int
 		_ = l
-		if len(x.CollateralOwnerships) > 0 {
-			for _, e := range x.CollateralOwnerships {
+		if len(x.Receipts) > 0 {
+			for _, e := range x.Receipts {
 				l = options.Size(e)
 				n += 1 + l + runtime.Sov(uint64(l))
 			}
 		}
-		if x.Pagination != nil {
-			l = options.Size(x.Pagination)
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -9605,7 +12283,7 @@ func (x *fastReflection_QueryCollateralOwnershipsByValidatorResponse) ProtoMetho
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryCollateralOwnershipsByValidatorResponse)
+		x := input.Message.Interface().(*QueryEventReceiptResponse)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -9624,23 +12302,9 @@ func (x *fastReflection_QueryCollateralOwnershipsByValidatorResponse) ProtoMetho
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.Pagination != nil {
-			encoded, err := options.Marshal(x.Pagination)
-			if err != nil {
-				return protoiface.MarshalOutput{
-					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-					Buf:               input.Buf,
-				}, err
-			}
-			i -= len(encoded)
-			copy(dAtA[i:], encoded)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-			i--
-			dAtA[i] = 0x12
-		}
-		if len(x.CollateralOwnerships) > 0 {
-			for iNdEx := len(x.CollateralOwnerships) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.CollateralOwnerships[iNdEx])
+		if len(x.Receipts) > 0 {
+			for iNdEx := len(x.Receipts) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Receipts[iNdEx])
 				if err != nil {
 					return protoiface.MarshalOutput{
 						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -9665,7 +12329,7 @@ func (x *fastReflection_QueryCollateralOwnershipsByValidatorResponse) ProtoMetho
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryCollateralOwnershipsByValidatorResponse)
+		x := input.Message.Interface().(*QueryEventReceiptResponse)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -9697,49 +12361,15 @@ func (x *fastReflection_QueryCollateralOwnershipsByValidatorResponse) ProtoMetho
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryCollateralOwnershipsByValidatorResponse: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryEventReceiptResponse: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryCollateralOwnershipsByValidatorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryEventReceiptResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CollateralOwnerships", wireType)
-				}
-				var msglen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					msglen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if msglen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + msglen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.CollateralOwnerships = append(x.CollateralOwnerships, &CollateralOwnershipWithAmount{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.CollateralOwnerships[len(x.CollateralOwnerships)-1]); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
-				iNdEx = postIndex
-			case 2:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Receipts", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -9766,10 +12396,8 @@ func (x *fastReflection_QueryCollateralOwnershipsByValidatorResponse) ProtoMetho
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.Pagination == nil {
-					x.Pagination = &v1beta1.PageResponse{}
-				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Pagination); err != nil {
+				x.Receipts = append(x.Receipts, &EventReceipt{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Receipts[len(x.Receipts)-1]); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
@@ -9809,28 +12437,26 @@ func (x *fastReflection_QueryCollateralOwnershipsByValidatorResponse) ProtoMetho
 }
 
 var (
-	md_QueryCollateralOwnershipRequest          protoreflect.MessageDescriptor
-	fd_QueryCollateralOwnershipRequest_val_addr protoreflect.FieldDescriptor
-	fd_QueryCollateralOwnershipRequest_owner    protoreflect.FieldDescriptor
+	md_QueryTxEventReceiptsRequest         protoreflect.MessageDescriptor
+	fd_QueryTxEventReceiptsRequest_tx_hash protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_mitosis_evmvalidator_v1_query_proto_init()
-	md_QueryCollateralOwnershipRequest = File_mitosis_evmvalidator_v1_query_proto.Messages().ByName("QueryCollateralOwnershipRequest")
-	fd_QueryCollateralOwnershipRequest_val_addr = md_QueryCollateralOwnershipRequest.Fields().ByName("val_addr")
-	fd_QueryCollateralOwnershipRequest_owner = md_QueryCollateralOwnershipRequest.Fields().ByName("owner")
+	md_QueryTxEventReceiptsRequest = File_mitosis_evmvalidator_v1_query_proto.Messages().ByName("QueryTxEventReceiptsRequest")
+	fd_QueryTxEventReceiptsRequest_tx_hash = md_QueryTxEventReceiptsRequest.Fields().ByName("tx_hash")
 }
 
-var _ protoreflect.Message = (*fastReflection_QueryCollateralOwnershipRequest)(nil)
+var _ protoreflect.Message = (*fastReflection_QueryTxEventReceiptsRequest)(nil)
 
-type fastReflection_QueryCollateralOwnershipRequest QueryCollateralOwnershipRequest
+type fastReflection_QueryTxEventReceiptsRequest QueryTxEventReceiptsRequest
 
-func (x *QueryCollateralOwnershipRequest) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryCollateralOwnershipRequest)(x)
+func (x *QueryTxEventReceiptsRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryTxEventReceiptsRequest)(x)
 }
 
-func (x *QueryCollateralOwnershipRequest) slowProtoReflect() protoreflect.Message {
-	mi := &file_mitosis_evmvalidator_v1_query_proto_msgTypes[21]
+func (x *QueryTxEventReceiptsRequest) slowProtoReflect() protoreflect.Message {
+	mi := &file_mitosis_evmvalidator_v1_query_proto_msgTypes[27]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9841,43 +12467,43 @@ func (x *QueryCollateralOwnershipRequest) slowProtoReflect() protoreflect.Messag
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryCollateralOwnershipRequest_messageType fastReflection_QueryCollateralOwnershipRequest_messageType
-var _ protoreflect.MessageType = fastReflection_QueryCollateralOwnershipRequest_messageType{}
+var _fastReflection_QueryTxEventReceiptsRequest_messageType fastReflection_QueryTxEventReceiptsRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryTxEventReceiptsRequest_messageType{}
 
-type fastReflection_QueryCollateralOwnershipRequest_messageType struct{}
+type fastReflection_QueryTxEventReceiptsRequest_messageType struct{}
 
-func (x fastReflection_QueryCollateralOwnershipRequest_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryCollateralOwnershipRequest)(nil)
+func (x fastReflection_QueryTxEventReceiptsRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryTxEventReceiptsRequest)(nil)
 }
-func (x fastReflection_QueryCollateralOwnershipRequest_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryCollateralOwnershipRequest)
+func (x fastReflection_QueryTxEventReceiptsRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryTxEventReceiptsRequest)
 }
-func (x fastReflection_QueryCollateralOwnershipRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryCollateralOwnershipRequest
+func (x fastReflection_QueryTxEventReceiptsRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryTxEventReceiptsRequest
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryCollateralOwnershipRequest) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryCollateralOwnershipRequest
+func (x *fastReflection_QueryTxEventReceiptsRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryTxEventReceiptsRequest
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryCollateralOwnershipRequest) Type() protoreflect.MessageType {
-	return _fastReflection_QueryCollateralOwnershipRequest_messageType
+func (x *fastReflection_QueryTxEventReceiptsRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryTxEventReceiptsRequest_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryCollateralOwnershipRequest) New() protoreflect.Message {
-	return new(fastReflection_QueryCollateralOwnershipRequest)
+func (x *fastReflection_QueryTxEventReceiptsRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryTxEventReceiptsRequest)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryCollateralOwnershipRequest) Interface() protoreflect.ProtoMessage {
-	return (*QueryCollateralOwnershipRequest)(x)
+func (x *fastReflection_QueryTxEventReceiptsRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryTxEventReceiptsRequest)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -9885,16 +12511,10 @@ func (x *fastReflection_QueryCollateralOwnershipRequest) Interface() protoreflec
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryCollateralOwnershipRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if len(x.ValAddr) != 0 {
-		value := protoreflect.ValueOfBytes(x.ValAddr)
-		if !f(fd_QueryCollateralOwnershipRequest_val_addr, value) {
-			return
-		}
-	}
-	if len(x.Owner) != 0 {
-		value := protoreflect.ValueOfBytes(x.Owner)
-		if !f(fd_QueryCollateralOwnershipRequest_owner, value) {
+func (x *fastReflection_QueryTxEventReceiptsRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.TxHash != "" {
+		value := protoreflect.ValueOfString(x.TxHash)
+		if !f(fd_QueryTxEventReceiptsRequest_tx_hash, value) {
 			return
 		}
 	}
@@ -9911,17 +12531,15 @@ func (x *fastReflection_QueryCollateralOwnershipRequest) Range(f func(protorefle
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryCollateralOwnershipRequest) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryTxEventReceiptsRequest) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "mitosis.evmvalidator.v1.QueryCollateralOwnershipRequest.val_addr":
-		return len(x.ValAddr) != 0
-	case "mitosis.evmvalidator.v1.QueryCollateralOwnershipRequest.owner":
-		return len(x.Owner) != 0
+	case "mitosis.evmvalidator.v1.QueryTxEventReceiptsRequest.tx_hash":
+		return x.TxHash != ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: mitosis.evmvalidator.v1.QueryCollateralOwnershipRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mitosis.evmvalidator.v1.QueryTxEventReceiptsRequest"))
 		}
-		panic(fmt.Errorf("message mitosis.evmvalidator.v1.QueryCollateralOwnershipRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message mitosis.evmvalidator.v1.QueryTxEventReceiptsRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -9931,17 +12549,15 @@ func (x *fastReflection_QueryCollateralOwnershipRequest) Has(fd protoreflect.Fie
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryCollateralOwnershipRequest) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryTxEventReceiptsRequest) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "mitosis.evmvalidator.v1.QueryCollateralOwnershipRequest.val_addr":
-		x.ValAddr = nil
-	case "mitosis.evmvalidator.v1.QueryCollateralOwnershipRequest.owner":
-		x.Owner = nil
+	case "mitosis.evmvalidator.v1.QueryTxEventReceiptsRequest.tx_hash":
+		x.TxHash = ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: mitosis.evmvalidator.v1.QueryCollateralOwnershipRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mitosis.evmvalidator.v1.QueryTxEventReceiptsRequest"))
 		}
-		panic(fmt.Errorf("message mitosis.evmvalidator.v1.QueryCollateralOwnershipRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message mitosis.evmvalidator.v1.QueryTxEventReceiptsRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -9951,19 +12567,16 @@ func (x *fastReflection_QueryCollateralOwnershipRequest) Clear(fd protoreflect.F
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryCollateralOwnershipRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryTxEventReceiptsRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "mitosis.evmvalidator.v1.QueryCollateralOwnershipRequest.val_addr":
-		value := x.ValAddr
-		return protoreflect.ValueOfBytes(value)
-	case "mitosis.evmvalidator.v1.QueryCollateralOwnershipRequest.owner":
-		value := x.Owner
-		return protoreflect.ValueOfBytes(value)
+	case "mitosis.evmvalidator.v1.QueryTxEventReceiptsRequest.tx_hash":
+		value := x.TxHash
+		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: mitosis.evmvalidator.v1.QueryCollateralOwnershipRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mitosis.evmvalidator.v1.QueryTxEventReceiptsRequest"))
 		}
-		panic(fmt.Errorf("message mitosis.evmvalidator.v1.QueryCollateralOwnershipRequest does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message mitosis.evmvalidator.v1.QueryTxEventReceiptsRequest does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -9977,17 +12590,15 @@ func (x *fastReflection_QueryCollateralOwnershipRequest) Get(descriptor protoref
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryCollateralOwnershipRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryTxEventReceiptsRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "mitosis.evmvalidator.v1.QueryCollateralOwnershipRequest.val_addr":
-		x.ValAddr = value.Bytes()
-	case "mitosis.evmvalidator.v1.QueryCollateralOwnershipRequest.owner":
-		x.Owner = value.Bytes()
+	case "mitosis.evmvalidator.v1.QueryTxEventReceiptsRequest.tx_hash":
+		x.TxHash = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: mitosis.evmvalidator.v1.QueryCollateralOwnershipRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mitosis.evmvalidator.v1.QueryTxEventReceiptsRequest"))
 		}
-		panic(fmt.Errorf("message mitosis.evmvalidator.v1.QueryCollateralOwnershipRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message mitosis.evmvalidator.v1.QueryTxEventReceiptsRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -10001,44 +12612,40 @@ func (x *fastReflection_QueryCollateralOwnershipRequest) Set(fd protoreflect.Fie
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryCollateralOwnershipRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryTxEventReceiptsRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "mitosis.evmvalidator.v1.QueryCollateralOwnershipRequest.val_addr":
-		panic(fmt.Errorf("field val_addr of message mitosis.evmvalidator.v1.QueryCollateralOwnershipRequest is not mutable"))
-	case "mitosis.evmvalidator.v1.QueryCollateralOwnershipRequest.owner":
-		panic(fmt.Errorf("field owner of message mitosis.evmvalidator.v1.QueryCollateralOwnershipRequest is not mutable"))
+	case "mitosis.evmvalidator.v1.QueryTxEventReceiptsRequest.tx_hash":
+		panic(fmt.Errorf("field tx_hash of message mitosis.evmvalidator.v1.QueryTxEventReceiptsRequest is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: mitosis.evmvalidator.v1.QueryCollateralOwnershipRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mitosis.evmvalidator.v1.QueryTxEventReceiptsRequest"))
 		}
-		panic(fmt.Errorf("message mitosis.evmvalidator.v1.QueryCollateralOwnershipRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message mitosis.evmvalidator.v1.QueryTxEventReceiptsRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryCollateralOwnershipRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryTxEventReceiptsRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "mitosis.evmvalidator.v1.QueryCollateralOwnershipRequest.val_addr":
-		return protoreflect.ValueOfBytes(nil)
-	case "mitosis.evmvalidator.v1.QueryCollateralOwnershipRequest.owner":
-		return protoreflect.ValueOfBytes(nil)
+	case "mitosis.evmvalidator.v1.QueryTxEventReceiptsRequest.tx_hash":
+		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: mitosis.evmvalidator.v1.QueryCollateralOwnershipRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mitosis.evmvalidator.v1.QueryTxEventReceiptsRequest"))
 		}
-		panic(fmt.Errorf("message mitosis.evmvalidator.v1.QueryCollateralOwnershipRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message mitosis.evmvalidator.v1.QueryTxEventReceiptsRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryCollateralOwnershipRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryTxEventReceiptsRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in mitosis.evmvalidator.v1.QueryCollateralOwnershipRequest", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in mitosis.evmvalidator.v1.QueryTxEventReceiptsRequest", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -10046,7 +12653,7 @@ func (x *fastReflection_QueryCollateralOwnershipRequest) WhichOneof(d protorefle
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryCollateralOwnershipRequest) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryTxEventReceiptsRequest) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -10057,7 +12664,7 @@ func (x *fastReflection_QueryCollateralOwnershipRequest) GetUnknown() protorefle
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryCollateralOwnershipRequest) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryTxEventReceiptsRequest) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -10069,7 +12676,7 @@ func (x *fastReflection_QueryCollateralOwnershipRequest) SetUnknown(fields proto
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryCollateralOwnershipRequest) IsValid() bool {
+func (x *fastReflection_QueryTxEventReceiptsRequest) IsValid() bool {
 	return x != nil
 }
 
@@ -10079,9 +12686,9 @@ func (x *fastReflection_QueryCollateralOwnershipRequest) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryCollateralOwnershipRequest) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryTxEventReceiptsRequest) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryCollateralOwnershipRequest)
+		x := input.Message.Interface().(*QueryTxEventReceiptsRequest)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -10093,11 +12700,7 @@ func (x *fastReflection_QueryCollateralOwnershipRequest) ProtoMethods() *protoif
 		var n int
 		var l int
 		_ = l
-		l = len(x.ValAddr)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		l = len(x.Owner)
+		l = len(x.TxHash)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
@@ -10111,7 +12714,7 @@ func (x *fastReflection_QueryCollateralOwnershipRequest) ProtoMethods() *protoif
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryCollateralOwnershipRequest)
+		x := input.Message.Interface().(*QueryTxEventReceiptsRequest)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -10130,17 +12733,10 @@ func (x *fastReflection_QueryCollateralOwnershipRequest) ProtoMethods() *protoif
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.Owner) > 0 {
-			i -= len(x.Owner)
-			copy(dAtA[i:], x.Owner)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Owner)))
-			i--
-			dAtA[i] = 0x12
-		}
-		if len(x.ValAddr) > 0 {
-			i -= len(x.ValAddr)
-			copy(dAtA[i:], x.ValAddr)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ValAddr)))
+		if len(x.TxHash) > 0 {
+			i -= len(x.TxHash)
+			copy(dAtA[i:], x.TxHash)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.TxHash)))
 			i--
 			dAtA[i] = 0xa
 		}
@@ -10155,7 +12751,7 @@ func (x *fastReflection_QueryCollateralOwnershipRequest) ProtoMethods() *protoif
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryCollateralOwnershipRequest)
+		x := input.Message.Interface().(*QueryTxEventReceiptsRequest)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -10166,72 +12762,38 @@ func (x *fastReflection_QueryCollateralOwnershipRequest) ProtoMethods() *protoif
 		_ = options
 		dAtA := input.Buf
 		l := len(dAtA)
-		iNdEx := 0
-		for iNdEx < l {
-			preIndex := iNdEx
-			var wire uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-				}
-				if iNdEx >= l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				wire |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			fieldNum := int32(wire >> 3)
-			wireType := int(wire & 0x7)
-			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryCollateralOwnershipRequest: wiretype end group for non-group")
-			}
-			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryCollateralOwnershipRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-			}
-			switch fieldNum {
-			case 1:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ValAddr", wireType)
-				}
-				var byteLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					byteLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if byteLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + byteLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
 				}
-				if postIndex > l {
+				if iNdEx >= l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.ValAddr = append(x.ValAddr[:0], dAtA[iNdEx:postIndex]...)
-				if x.ValAddr == nil {
-					x.ValAddr = []byte{}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-				iNdEx = postIndex
-			case 2:
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryTxEventReceiptsRequest: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryTxEventReceiptsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TxHash", wireType)
 				}
-				var byteLen int
+				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -10241,25 +12803,23 @@ func (x *fastReflection_QueryCollateralOwnershipRequest) ProtoMethods() *protoif
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					byteLen |= int(b&0x7F) << shift
+					stringLen |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if byteLen < 0 {
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + byteLen
+				postIndex := iNdEx + intStringLen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Owner = append(x.Owner[:0], dAtA[iNdEx:postIndex]...)
-				if x.Owner == nil {
-					x.Owner = []byte{}
-				}
+				x.TxHash = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
@@ -10296,27 +12856,78 @@ func (x *fastReflection_QueryCollateralOwnershipRequest) ProtoMethods() *protoif
 	}
 }
 
+var _ protoreflect.List = (*_QueryTxEventReceiptsResponse_1_list)(nil)
+
+type _QueryTxEventReceiptsResponse_1_list struct {
+	list *[]*EventReceipt
+}
+
+func (x *_QueryTxEventReceiptsResponse_1_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_QueryTxEventReceiptsResponse_1_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_QueryTxEventReceiptsResponse_1_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*EventReceipt)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_QueryTxEventReceiptsResponse_1_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*EventReceipt)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_QueryTxEventReceiptsResponse_1_list) AppendMutable() protoreflect.Value {
+	v := new(EventReceipt)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryTxEventReceiptsResponse_1_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_QueryTxEventReceiptsResponse_1_list) NewElement() protoreflect.Value {
+	v := new(EventReceipt)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryTxEventReceiptsResponse_1_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
-	md_QueryCollateralOwnershipResponse                      protoreflect.MessageDescriptor
-	fd_QueryCollateralOwnershipResponse_collateral_ownership protoreflect.FieldDescriptor
+	md_QueryTxEventReceiptsResponse          protoreflect.MessageDescriptor
+	fd_QueryTxEventReceiptsResponse_receipts protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_mitosis_evmvalidator_v1_query_proto_init()
-	md_QueryCollateralOwnershipResponse = File_mitosis_evmvalidator_v1_query_proto.Messages().ByName("QueryCollateralOwnershipResponse")
-	fd_QueryCollateralOwnershipResponse_collateral_ownership = md_QueryCollateralOwnershipResponse.Fields().ByName("collateral_ownership")
+	md_QueryTxEventReceiptsResponse = File_mitosis_evmvalidator_v1_query_proto.Messages().ByName("QueryTxEventReceiptsResponse")
+	fd_QueryTxEventReceiptsResponse_receipts = md_QueryTxEventReceiptsResponse.Fields().ByName("receipts")
 }
 
-var _ protoreflect.Message = (*fastReflection_QueryCollateralOwnershipResponse)(nil)
+var _ protoreflect.Message = (*fastReflection_QueryTxEventReceiptsResponse)(nil)
 
-type fastReflection_QueryCollateralOwnershipResponse QueryCollateralOwnershipResponse
+type fastReflection_QueryTxEventReceiptsResponse QueryTxEventReceiptsResponse
 
-func (x *QueryCollateralOwnershipResponse) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryCollateralOwnershipResponse)(x)
+func (x *QueryTxEventReceiptsResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryTxEventReceiptsResponse)(x)
 }
 
-func (x *QueryCollateralOwnershipResponse) slowProtoReflect() protoreflect.Message {
-	mi := &file_mitosis_evmvalidator_v1_query_proto_msgTypes[22]
+func (x *QueryTxEventReceiptsResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_mitosis_evmvalidator_v1_query_proto_msgTypes[28]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -10327,43 +12938,43 @@ func (x *QueryCollateralOwnershipResponse) slowProtoReflect() protoreflect.Messa
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryCollateralOwnershipResponse_messageType fastReflection_QueryCollateralOwnershipResponse_messageType
-var _ protoreflect.MessageType = fastReflection_QueryCollateralOwnershipResponse_messageType{}
+var _fastReflection_QueryTxEventReceiptsResponse_messageType fastReflection_QueryTxEventReceiptsResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryTxEventReceiptsResponse_messageType{}
 
-type fastReflection_QueryCollateralOwnershipResponse_messageType struct{}
+type fastReflection_QueryTxEventReceiptsResponse_messageType struct{}
 
-func (x fastReflection_QueryCollateralOwnershipResponse_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryCollateralOwnershipResponse)(nil)
+func (x fastReflection_QueryTxEventReceiptsResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryTxEventReceiptsResponse)(nil)
 }
-func (x fastReflection_QueryCollateralOwnershipResponse_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryCollateralOwnershipResponse)
+func (x fastReflection_QueryTxEventReceiptsResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryTxEventReceiptsResponse)
 }
-func (x fastReflection_QueryCollateralOwnershipResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryCollateralOwnershipResponse
+func (x fastReflection_QueryTxEventReceiptsResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryTxEventReceiptsResponse
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryCollateralOwnershipResponse) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryCollateralOwnershipResponse
+func (x *fastReflection_QueryTxEventReceiptsResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryTxEventReceiptsResponse
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryCollateralOwnershipResponse) Type() protoreflect.MessageType {
-	return _fastReflection_QueryCollateralOwnershipResponse_messageType
+func (x *fastReflection_QueryTxEventReceiptsResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryTxEventReceiptsResponse_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryCollateralOwnershipResponse) New() protoreflect.Message {
-	return new(fastReflection_QueryCollateralOwnershipResponse)
+func (x *fastReflection_QueryTxEventReceiptsResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryTxEventReceiptsResponse)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryCollateralOwnershipResponse) Interface() protoreflect.ProtoMessage {
-	return (*QueryCollateralOwnershipResponse)(x)
+func (x *fastReflection_QueryTxEventReceiptsResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryTxEventReceiptsResponse)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -10371,10 +12982,10 @@ func (x *fastReflection_QueryCollateralOwnershipResponse) Interface() protorefle
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryCollateralOwnershipResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.CollateralOwnership != nil {
-		value := protoreflect.ValueOfMessage(x.CollateralOwnership.ProtoReflect())
-		if !f(fd_QueryCollateralOwnershipResponse_collateral_ownership, value) {
+func (x *fastReflection_QueryTxEventReceiptsResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.Receipts) != 0 {
+		value := protoreflect.ValueOfList(&_QueryTxEventReceiptsResponse_1_list{list: &x.Receipts})
+		if !f(fd_QueryTxEventReceiptsResponse_receipts, value) {
 			return
 		}
 	}
@@ -10391,15 +13002,15 @@ func (x *fastReflection_QueryCollateralOwnershipResponse) Range(f func(protorefl
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryCollateralOwnershipResponse) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryTxEventReceiptsResponse) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "mitosis.evmvalidator.v1.QueryCollateralOwnershipResponse.collateral_ownership":
-		return x.CollateralOwnership != nil
+	case "mitosis.evmvalidator.v1.QueryTxEventReceiptsResponse.receipts":
+		return len(x.Receipts) != 0
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: mitosis.evmvalidator.v1.QueryCollateralOwnershipResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mitosis.evmvalidator.v1.QueryTxEventReceiptsResponse"))
 		}
-		panic(fmt.Errorf("message mitosis.evmvalidator.v1.QueryCollateralOwnershipResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message mitosis.evmvalidator.v1.QueryTxEventReceiptsResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -10409,15 +13020,15 @@ func (x *fastReflection_QueryCollateralOwnershipResponse) Has(fd protoreflect.Fi
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryCollateralOwnershipResponse) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryTxEventReceiptsResponse) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "mitosis.evmvalidator.v1.QueryCollateralOwnershipResponse.collateral_ownership":
-		x.CollateralOwnership = nil
+	case "mitosis.evmvalidator.v1.QueryTxEventReceiptsResponse.receipts":
+		x.Receipts = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: mitosis.evmvalidator.v1.QueryCollateralOwnershipResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mitosis.evmvalidator.v1.QueryTxEventReceiptsResponse"))
 		}
-		panic(fmt.Errorf("message mitosis.evmvalidator.v1.QueryCollateralOwnershipResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message mitosis.evmvalidator.v1.QueryTxEventReceiptsResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -10427,16 +13038,19 @@ func (x *fastReflection_QueryCollateralOwnershipResponse) Clear(fd protoreflect.
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryCollateralOwnershipResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryTxEventReceiptsResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "mitosis.evmvalidator.v1.QueryCollateralOwnershipResponse.collateral_ownership":
-		value := x.CollateralOwnership
-		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "mitosis.evmvalidator.v1.QueryTxEventReceiptsResponse.receipts":
+		if len(x.Receipts) == 0 {
+			return protoreflect.ValueOfList(&_QueryTxEventReceiptsResponse_1_list{})
+		}
+		listValue := &_QueryTxEventReceiptsResponse_1_list{list: &x.Receipts}
+		return protoreflect.ValueOfList(listValue)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: mitosis.evmvalidator.v1.QueryCollateralOwnershipResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mitosis.evmvalidator.v1.QueryTxEventReceiptsResponse"))
 		}
-		panic(fmt.Errorf("message mitosis.evmvalidator.v1.QueryCollateralOwnershipResponse does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message mitosis.evmvalidator.v1.QueryTxEventReceiptsResponse does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -10450,15 +13064,17 @@ func (x *fastReflection_QueryCollateralOwnershipResponse) Get(descriptor protore
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryCollateralOwnershipResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryTxEventReceiptsResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "mitosis.evmvalidator.v1.QueryCollateralOwnershipResponse.collateral_ownership":
-		x.CollateralOwnership = value.Message().Interface().(*CollateralOwnershipWithAmount)
+	case "mitosis.evmvalidator.v1.QueryTxEventReceiptsResponse.receipts":
+		lv := value.List()
+		clv := lv.(*_QueryTxEventReceiptsResponse_1_list)
+		x.Receipts = *clv.list
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: mitosis.evmvalidator.v1.QueryCollateralOwnershipResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mitosis.evmvalidator.v1.QueryTxEventReceiptsResponse"))
 		}
-		panic(fmt.Errorf("message mitosis.evmvalidator.v1.QueryCollateralOwnershipResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message mitosis.evmvalidator.v1.QueryTxEventReceiptsResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -10472,44 +13088,45 @@ func (x *fastReflection_QueryCollateralOwnershipResponse) Set(fd protoreflect.Fi
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryCollateralOwnershipResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryTxEventReceiptsResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "mitosis.evmvalidator.v1.QueryCollateralOwnershipResponse.collateral_ownership":
-		if x.CollateralOwnership == nil {
-			x.CollateralOwnership = new(CollateralOwnershipWithAmount)
+	case "mitosis.evmvalidator.v1.QueryTxEventReceiptsResponse.receipts":
+		if x.Receipts == nil {
+			x.Receipts = []*EventReceipt{}
 		}
-		return protoreflect.ValueOfMessage(x.CollateralOwnership.ProtoReflect())
+		value := &_QueryTxEventReceiptsResponse_1_list{list: &x.Receipts}
+		return protoreflect.ValueOfList(value)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: mitosis.evmvalidator.v1.QueryCollateralOwnershipResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mitosis.evmvalidator.v1.QueryTxEventReceiptsResponse"))
 		}
-		panic(fmt.Errorf("message mitosis.evmvalidator.v1.QueryCollateralOwnershipResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message mitosis.evmvalidator.v1.QueryTxEventReceiptsResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryCollateralOwnershipResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryTxEventReceiptsResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "mitosis.evmvalidator.v1.QueryCollateralOwnershipResponse.collateral_ownership":
-		m := new(CollateralOwnershipWithAmount)
-		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "mitosis.evmvalidator.v1.QueryTxEventReceiptsResponse.receipts":
+		list := []*EventReceipt{}
+		return protoreflect.ValueOfList(&_QueryTxEventReceiptsResponse_1_list{list: &list})
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: mitosis.evmvalidator.v1.QueryCollateralOwnershipResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mitosis.evmvalidator.v1.QueryTxEventReceiptsResponse"))
 		}
-		panic(fmt.Errorf("message mitosis.evmvalidator.v1.QueryCollateralOwnershipResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message mitosis.evmvalidator.v1.QueryTxEventReceiptsResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryCollateralOwnershipResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryTxEventReceiptsResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in mitosis.evmvalidator.v1.QueryCollateralOwnershipResponse", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in mitosis.evmvalidator.v1.QueryTxEventReceiptsResponse", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -10517,7 +13134,7 @@ func (x *fastReflection_QueryCollateralOwnershipResponse) WhichOneof(d protorefl
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryCollateralOwnershipResponse) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryTxEventReceiptsResponse) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -10528,7 +13145,7 @@ func (x *fastReflection_QueryCollateralOwnershipResponse) GetUnknown() protorefl
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryCollateralOwnershipResponse) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryTxEventReceiptsResponse) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -10540,7 +13157,7 @@ func (x *fastReflection_QueryCollateralOwnershipResponse) SetUnknown(fields prot
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryCollateralOwnershipResponse) IsValid() bool {
+func (x *fastReflection_QueryTxEventReceiptsResponse) IsValid() bool {
 	return x != nil
 }
 
@@ -10550,9 +13167,9 @@ func (x *fastReflection_QueryCollateralOwnershipResponse) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryCollateralOwnershipResponse) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryTxEventReceiptsResponse) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryCollateralOwnershipResponse)
+		x := input.Message.Interface().(*QueryTxEventReceiptsResponse)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -10564,9 +13181,11 @@ func (x *fastReflection_QueryCollateralOwnershipResponse) ProtoMethods() *protoi
 		var n int
 		var l int
 		_ = l
-		if x.CollateralOwnership != nil {
-			l = options.Size(x.CollateralOwnership)
-			n += 1 + l + runtime.Sov(uint64(l))
+		if len(x.Receipts) > 0 {
+			for _, e := range x.Receipts {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
 		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
@@ -10578,7 +13197,7 @@ func (x *fastReflection_QueryCollateralOwnershipResponse) ProtoMethods() *protoi
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryCollateralOwnershipResponse)
+		x := input.Message.Interface().(*QueryTxEventReceiptsResponse)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -10597,19 +13216,21 @@ func (x *fastReflection_QueryCollateralOwnershipResponse) ProtoMethods() *protoi
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.CollateralOwnership != nil {
-			encoded, err := options.Marshal(x.CollateralOwnership)
-			if err != nil {
-				return protoiface.MarshalOutput{
-					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-					Buf:               input.Buf,
-				}, err
+		if len(x.Receipts) > 0 {
+			for iNdEx := len(x.Receipts) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Receipts[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0xa
 			}
-			i -= len(encoded)
-			copy(dAtA[i:], encoded)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-			i--
-			dAtA[i] = 0xa
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -10622,7 +13243,7 @@ func (x *fastReflection_QueryCollateralOwnershipResponse) ProtoMethods() *protoi
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryCollateralOwnershipResponse)
+		x := input.Message.Interface().(*QueryTxEventReceiptsResponse)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -10654,15 +13275,15 @@ func (x *fastReflection_QueryCollateralOwnershipResponse) ProtoMethods() *protoi
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryCollateralOwnershipResponse: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryTxEventReceiptsResponse: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryCollateralOwnershipResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryTxEventReceiptsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CollateralOwnership", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Receipts", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -10689,10 +13310,8 @@ func (x *fastReflection_QueryCollateralOwnershipResponse) ProtoMethods() *protoi
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.CollateralOwnership == nil {
-					x.CollateralOwnership = &CollateralOwnershipWithAmount{}
-				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.CollateralOwnership); err != nil {
+				x.Receipts = append(x.Receipts, &EventReceipt{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Receipts[len(x.Receipts)-1]); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
@@ -10800,74 +13419,139 @@ func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
 	return file_mitosis_evmvalidator_v1_query_proto_rawDescGZIP(), []int{1}
 }
 
-func (x *QueryParamsResponse) GetParams() *Params {
+func (x *QueryParamsResponse) GetParams() *Params {
+	if x != nil {
+		return x.Params
+	}
+	return nil
+}
+
+// QueryValidatorEntrypointContractAddrRequest is the request type for the
+// Query/ValidatorEntrypointContractAddr RPC method
+type QueryValidatorEntrypointContractAddrRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *QueryValidatorEntrypointContractAddrRequest) Reset() {
+	*x = QueryValidatorEntrypointContractAddrRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mitosis_evmvalidator_v1_query_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryValidatorEntrypointContractAddrRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryValidatorEntrypointContractAddrRequest) ProtoMessage() {}
+
+// Deprecated: Use QueryValidatorEntrypointContractAddrRequest.ProtoReflect.Descriptor instead.
+func (*QueryValidatorEntrypointContractAddrRequest) Descriptor() ([]byte, []int) {
+	return file_mitosis_evmvalidator_v1_query_proto_rawDescGZIP(), []int{2}
+}
+
+// QueryValidatorEntrypointContractAddrResponse is the response type for the
+// Query/ValidatorEntrypointContractAddr RPC method
+type QueryValidatorEntrypointContractAddrResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Addr []byte `protobuf:"bytes,1,opt,name=addr,proto3" json:"addr,omitempty"`
+}
+
+func (x *QueryValidatorEntrypointContractAddrResponse) Reset() {
+	*x = QueryValidatorEntrypointContractAddrResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mitosis_evmvalidator_v1_query_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryValidatorEntrypointContractAddrResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryValidatorEntrypointContractAddrResponse) ProtoMessage() {}
+
+// Deprecated: Use QueryValidatorEntrypointContractAddrResponse.ProtoReflect.Descriptor instead.
+func (*QueryValidatorEntrypointContractAddrResponse) Descriptor() ([]byte, []int) {
+	return file_mitosis_evmvalidator_v1_query_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *QueryValidatorEntrypointContractAddrResponse) GetAddr() []byte {
 	if x != nil {
-		return x.Params
+		return x.Addr
 	}
 	return nil
 }
 
-// QueryValidatorEntrypointContractAddrRequest is the request type for the
-// Query/ValidatorEntrypointContractAddr RPC method
-type QueryValidatorEntrypointContractAddrRequest struct {
+// QueryCircuitBreakerRequest is the request type for the Query/CircuitBreaker
+// RPC method
+type QueryCircuitBreakerRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *QueryValidatorEntrypointContractAddrRequest) Reset() {
-	*x = QueryValidatorEntrypointContractAddrRequest{}
+func (x *QueryCircuitBreakerRequest) Reset() {
+	*x = QueryCircuitBreakerRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mitosis_evmvalidator_v1_query_proto_msgTypes[2]
+		mi := &file_mitosis_evmvalidator_v1_query_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryValidatorEntrypointContractAddrRequest) String() string {
+func (x *QueryCircuitBreakerRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryValidatorEntrypointContractAddrRequest) ProtoMessage() {}
+func (*QueryCircuitBreakerRequest) ProtoMessage() {}
 
-// Deprecated: Use QueryValidatorEntrypointContractAddrRequest.ProtoReflect.Descriptor instead.
-func (*QueryValidatorEntrypointContractAddrRequest) Descriptor() ([]byte, []int) {
-	return file_mitosis_evmvalidator_v1_query_proto_rawDescGZIP(), []int{2}
+// Deprecated: Use QueryCircuitBreakerRequest.ProtoReflect.Descriptor instead.
+func (*QueryCircuitBreakerRequest) Descriptor() ([]byte, []int) {
+	return file_mitosis_evmvalidator_v1_query_proto_rawDescGZIP(), []int{4}
 }
 
-// QueryValidatorEntrypointContractAddrResponse is the response type for the
-// Query/ValidatorEntrypointContractAddr RPC method
-type QueryValidatorEntrypointContractAddrResponse struct {
+// QueryCircuitBreakerResponse is the response type for the
+// Query/CircuitBreaker RPC method
+type QueryCircuitBreakerResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Addr []byte `protobuf:"bytes,1,opt,name=addr,proto3" json:"addr,omitempty"`
+	CircuitBreaker *CircuitBreaker `protobuf:"bytes,1,opt,name=circuit_breaker,json=circuitBreaker,proto3" json:"circuit_breaker,omitempty"`
 }
 
-func (x *QueryValidatorEntrypointContractAddrResponse) Reset() {
-	*x = QueryValidatorEntrypointContractAddrResponse{}
+func (x *QueryCircuitBreakerResponse) Reset() {
+	*x = QueryCircuitBreakerResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mitosis_evmvalidator_v1_query_proto_msgTypes[3]
+		mi := &file_mitosis_evmvalidator_v1_query_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryValidatorEntrypointContractAddrResponse) String() string {
+func (x *QueryCircuitBreakerResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryValidatorEntrypointContractAddrResponse) ProtoMessage() {}
+func (*QueryCircuitBreakerResponse) ProtoMessage() {}
 
-// Deprecated: Use QueryValidatorEntrypointContractAddrResponse.ProtoReflect.Descriptor instead.
-func (*QueryValidatorEntrypointContractAddrResponse) Descriptor() ([]byte, []int) {
-	return file_mitosis_evmvalidator_v1_query_proto_rawDescGZIP(), []int{3}
+// Deprecated: Use QueryCircuitBreakerResponse.ProtoReflect.Descriptor instead.
+func (*QueryCircuitBreakerResponse) Descriptor() ([]byte, []int) {
+	return file_mitosis_evmvalidator_v1_query_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *QueryValidatorEntrypointContractAddrResponse) GetAddr() []byte {
+func (x *QueryCircuitBreakerResponse) GetCircuitBreaker() *CircuitBreaker {
 	if x != nil {
-		return x.Addr
+		return x.CircuitBreaker
 	}
 	return nil
 }
@@ -10884,7 +13568,7 @@ type QueryValidatorRequest struct {
 func (x *QueryValidatorRequest) Reset() {
 	*x = QueryValidatorRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mitosis_evmvalidator_v1_query_proto_msgTypes[4]
+		mi := &file_mitosis_evmvalidator_v1_query_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -10898,7 +13582,7 @@ func (*QueryValidatorRequest) ProtoMessage() {}
 
 // Deprecated: Use QueryValidatorRequest.ProtoReflect.Descriptor instead.
 func (*QueryValidatorRequest) Descriptor() ([]byte, []int) {
-	return file_mitosis_evmvalidator_v1_query_proto_rawDescGZIP(), []int{4}
+	return file_mitosis_evmvalidator_v1_query_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *QueryValidatorRequest) GetValAddr() []byte {
@@ -10921,7 +13605,7 @@ type QueryValidatorResponse struct {
 func (x *QueryValidatorResponse) Reset() {
 	*x = QueryValidatorResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mitosis_evmvalidator_v1_query_proto_msgTypes[5]
+		mi := &file_mitosis_evmvalidator_v1_query_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -10935,7 +13619,7 @@ func (*QueryValidatorResponse) ProtoMessage() {}
 
 // Deprecated: Use QueryValidatorResponse.ProtoReflect.Descriptor instead.
 func (*QueryValidatorResponse) Descriptor() ([]byte, []int) {
-	return file_mitosis_evmvalidator_v1_query_proto_rawDescGZIP(), []int{5}
+	return file_mitosis_evmvalidator_v1_query_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *QueryValidatorResponse) GetValidator() *Validator {
@@ -10958,7 +13642,7 @@ type QueryValidatorByConsAddrRequest struct {
 func (x *QueryValidatorByConsAddrRequest) Reset() {
 	*x = QueryValidatorByConsAddrRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mitosis_evmvalidator_v1_query_proto_msgTypes[6]
+		mi := &file_mitosis_evmvalidator_v1_query_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -10972,7 +13656,7 @@ func (*QueryValidatorByConsAddrRequest) ProtoMessage() {}
 
 // Deprecated: Use QueryValidatorByConsAddrRequest.ProtoReflect.Descriptor instead.
 func (*QueryValidatorByConsAddrRequest) Descriptor() ([]byte, []int) {
-	return file_mitosis_evmvalidator_v1_query_proto_rawDescGZIP(), []int{6}
+	return file_mitosis_evmvalidator_v1_query_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *QueryValidatorByConsAddrRequest) GetConsAddr() string {
@@ -10995,7 +13679,7 @@ type QueryValidatorByConsAddrResponse struct {
 func (x *QueryValidatorByConsAddrResponse) Reset() {
 	*x = QueryValidatorByConsAddrResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mitosis_evmvalidator_v1_query_proto_msgTypes[7]
+		mi := &file_mitosis_evmvalidator_v1_query_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -11009,7 +13693,7 @@ func (*QueryValidatorByConsAddrResponse) ProtoMessage() {}
 
 // Deprecated: Use QueryValidatorByConsAddrResponse.ProtoReflect.Descriptor instead.
 func (*QueryValidatorByConsAddrResponse) Descriptor() ([]byte, []int) {
-	return file_mitosis_evmvalidator_v1_query_proto_rawDescGZIP(), []int{7}
+	return file_mitosis_evmvalidator_v1_query_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *QueryValidatorByConsAddrResponse) GetValidator() *Validator {
@@ -11033,7 +13717,7 @@ type QueryValidatorsRequest struct {
 func (x *QueryValidatorsRequest) Reset() {
 	*x = QueryValidatorsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mitosis_evmvalidator_v1_query_proto_msgTypes[8]
+		mi := &file_mitosis_evmvalidator_v1_query_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -11047,7 +13731,7 @@ func (*QueryValidatorsRequest) ProtoMessage() {}
 
 // Deprecated: Use QueryValidatorsRequest.ProtoReflect.Descriptor instead.
 func (*QueryValidatorsRequest) Descriptor() ([]byte, []int) {
-	return file_mitosis_evmvalidator_v1_query_proto_rawDescGZIP(), []int{8}
+	return file_mitosis_evmvalidator_v1_query_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *QueryValidatorsRequest) GetPagination() *v1beta1.PageRequest {
@@ -11071,7 +13755,7 @@ type QueryValidatorsResponse struct {
 func (x *QueryValidatorsResponse) Reset() {
 	*x = QueryValidatorsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mitosis_evmvalidator_v1_query_proto_msgTypes[9]
+		mi := &file_mitosis_evmvalidator_v1_query_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -11085,7 +13769,7 @@ func (*QueryValidatorsResponse) ProtoMessage() {}
 
 // Deprecated: Use QueryValidatorsResponse.ProtoReflect.Descriptor instead.
 func (*QueryValidatorsResponse) Descriptor() ([]byte, []int) {
-	return file_mitosis_evmvalidator_v1_query_proto_rawDescGZIP(), []int{9}
+	return file_mitosis_evmvalidator_v1_query_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *QueryValidatorsResponse) GetValidators() []*Validator {
@@ -11115,7 +13799,7 @@ type QueryWithdrawalRequest struct {
 func (x *QueryWithdrawalRequest) Reset() {
 	*x = QueryWithdrawalRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mitosis_evmvalidator_v1_query_proto_msgTypes[10]
+		mi := &file_mitosis_evmvalidator_v1_query_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -11129,7 +13813,7 @@ func (*QueryWithdrawalRequest) ProtoMessage() {}
 
 // Deprecated: Use QueryWithdrawalRequest.ProtoReflect.Descriptor instead.
 func (*QueryWithdrawalRequest) Descriptor() ([]byte, []int) {
-	return file_mitosis_evmvalidator_v1_query_proto_rawDescGZIP(), []int{10}
+	return file_mitosis_evmvalidator_v1_query_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *QueryWithdrawalRequest) GetId() uint64 {
@@ -11152,7 +13836,7 @@ type QueryWithdrawalResponse struct {
 func (x *QueryWithdrawalResponse) Reset() {
 	*x = QueryWithdrawalResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mitosis_evmvalidator_v1_query_proto_msgTypes[11]
+		mi := &file_mitosis_evmvalidator_v1_query_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -11166,7 +13850,7 @@ func (*QueryWithdrawalResponse) ProtoMessage() {}
 
 // Deprecated: Use QueryWithdrawalResponse.ProtoReflect.Descriptor instead.
 func (*QueryWithdrawalResponse) Descriptor() ([]byte, []int) {
-	return file_mitosis_evmvalidator_v1_query_proto_rawDescGZIP(), []int{11}
+	return file_mitosis_evmvalidator_v1_query_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *QueryWithdrawalResponse) GetWithdrawal() *Withdrawal {
@@ -11190,7 +13874,7 @@ type QueryWithdrawalsRequest struct {
 func (x *QueryWithdrawalsRequest) Reset() {
 	*x = QueryWithdrawalsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mitosis_evmvalidator_v1_query_proto_msgTypes[12]
+		mi := &file_mitosis_evmvalidator_v1_query_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -11204,7 +13888,7 @@ func (*QueryWithdrawalsRequest) ProtoMessage() {}
 
 // Deprecated: Use QueryWithdrawalsRequest.ProtoReflect.Descriptor instead.
 func (*QueryWithdrawalsRequest) Descriptor() ([]byte, []int) {
-	return file_mitosis_evmvalidator_v1_query_proto_rawDescGZIP(), []int{12}
+	return file_mitosis_evmvalidator_v1_query_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *QueryWithdrawalsRequest) GetPagination() *v1beta1.PageRequest {
@@ -11228,7 +13912,7 @@ type QueryWithdrawalsResponse struct {
 func (x *QueryWithdrawalsResponse) Reset() {
 	*x = QueryWithdrawalsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mitosis_evmvalidator_v1_query_proto_msgTypes[13]
+		mi := &file_mitosis_evmvalidator_v1_query_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -11242,7 +13926,7 @@ func (*QueryWithdrawalsResponse) ProtoMessage() {}
 
 // Deprecated: Use QueryWithdrawalsResponse.ProtoReflect.Descriptor instead.
 func (*QueryWithdrawalsResponse) Descriptor() ([]byte, []int) {
-	return file_mitosis_evmvalidator_v1_query_proto_rawDescGZIP(), []int{13}
+	return file_mitosis_evmvalidator_v1_query_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *QueryWithdrawalsResponse) GetWithdrawals() []*Withdrawal {
@@ -11274,7 +13958,7 @@ type QueryWithdrawalsByValidatorRequest struct {
 func (x *QueryWithdrawalsByValidatorRequest) Reset() {
 	*x = QueryWithdrawalsByValidatorRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mitosis_evmvalidator_v1_query_proto_msgTypes[14]
+		mi := &file_mitosis_evmvalidator_v1_query_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -11288,7 +13972,7 @@ func (*QueryWithdrawalsByValidatorRequest) ProtoMessage() {}
 
 // Deprecated: Use QueryWithdrawalsByValidatorRequest.ProtoReflect.Descriptor instead.
 func (*QueryWithdrawalsByValidatorRequest) Descriptor() ([]byte, []int) {
-	return file_mitosis_evmvalidator_v1_query_proto_rawDescGZIP(), []int{14}
+	return file_mitosis_evmvalidator_v1_query_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *QueryWithdrawalsByValidatorRequest) GetValAddr() []byte {
@@ -11319,7 +14003,7 @@ type QueryWithdrawalsByValidatorResponse struct {
 func (x *QueryWithdrawalsByValidatorResponse) Reset() {
 	*x = QueryWithdrawalsByValidatorResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mitosis_evmvalidator_v1_query_proto_msgTypes[15]
+		mi := &file_mitosis_evmvalidator_v1_query_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -11333,7 +14017,7 @@ func (*QueryWithdrawalsByValidatorResponse) ProtoMessage() {}
 
 // Deprecated: Use QueryWithdrawalsByValidatorResponse.ProtoReflect.Descriptor instead.
 func (*QueryWithdrawalsByValidatorResponse) Descriptor() ([]byte, []int) {
-	return file_mitosis_evmvalidator_v1_query_proto_rawDescGZIP(), []int{15}
+	return file_mitosis_evmvalidator_v1_query_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *QueryWithdrawalsByValidatorResponse) GetWithdrawals() []*Withdrawal {
@@ -11364,7 +14048,7 @@ type CollateralOwnershipWithAmount struct {
 func (x *CollateralOwnershipWithAmount) Reset() {
 	*x = CollateralOwnershipWithAmount{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mitosis_evmvalidator_v1_query_proto_msgTypes[16]
+		mi := &file_mitosis_evmvalidator_v1_query_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -11378,7 +14062,7 @@ func (*CollateralOwnershipWithAmount) ProtoMessage() {}
 
 // Deprecated: Use CollateralOwnershipWithAmount.ProtoReflect.Descriptor instead.
 func (*CollateralOwnershipWithAmount) Descriptor() ([]byte, []int) {
-	return file_mitosis_evmvalidator_v1_query_proto_rawDescGZIP(), []int{16}
+	return file_mitosis_evmvalidator_v1_query_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *CollateralOwnershipWithAmount) GetOwnership() *CollateralOwnership {
@@ -11409,7 +14093,7 @@ type QueryCollateralOwnershipsRequest struct {
 func (x *QueryCollateralOwnershipsRequest) Reset() {
 	*x = QueryCollateralOwnershipsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mitosis_evmvalidator_v1_query_proto_msgTypes[17]
+		mi := &file_mitosis_evmvalidator_v1_query_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -11423,7 +14107,7 @@ func (*QueryCollateralOwnershipsRequest) ProtoMessage() {}
 
 // Deprecated: Use QueryCollateralOwnershipsRequest.ProtoReflect.Descriptor instead.
 func (*QueryCollateralOwnershipsRequest) Descriptor() ([]byte, []int) {
-	return file_mitosis_evmvalidator_v1_query_proto_rawDescGZIP(), []int{17}
+	return file_mitosis_evmvalidator_v1_query_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *QueryCollateralOwnershipsRequest) GetPagination() *v1beta1.PageRequest {
@@ -11447,7 +14131,7 @@ type QueryCollateralOwnershipsResponse struct {
 func (x *QueryCollateralOwnershipsResponse) Reset() {
 	*x = QueryCollateralOwnershipsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mitosis_evmvalidator_v1_query_proto_msgTypes[18]
+		mi := &file_mitosis_evmvalidator_v1_query_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -11461,7 +14145,7 @@ func (*QueryCollateralOwnershipsResponse) ProtoMessage() {}
 
 // Deprecated: Use QueryCollateralOwnershipsResponse.ProtoReflect.Descriptor instead.
 func (*QueryCollateralOwnershipsResponse) Descriptor() ([]byte, []int) {
-	return file_mitosis_evmvalidator_v1_query_proto_rawDescGZIP(), []int{18}
+	return file_mitosis_evmvalidator_v1_query_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *QueryCollateralOwnershipsResponse) GetCollateralOwnerships() []*CollateralOwnershipWithAmount {
@@ -11490,163 +14174,315 @@ type QueryCollateralOwnershipsByValidatorRequest struct {
 	Pagination *v1beta1.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (x *QueryCollateralOwnershipsByValidatorRequest) Reset() {
-	*x = QueryCollateralOwnershipsByValidatorRequest{}
+func (x *QueryCollateralOwnershipsByValidatorRequest) Reset() {
+	*x = QueryCollateralOwnershipsByValidatorRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mitosis_evmvalidator_v1_query_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryCollateralOwnershipsByValidatorRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryCollateralOwnershipsByValidatorRequest) ProtoMessage() {}
+
+// Deprecated: Use QueryCollateralOwnershipsByValidatorRequest.ProtoReflect.Descriptor instead.
+func (*QueryCollateralOwnershipsByValidatorRequest) Descriptor() ([]byte, []int) {
+	return file_mitosis_evmvalidator_v1_query_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *QueryCollateralOwnershipsByValidatorRequest) GetValAddr() []byte {
+	if x != nil {
+		return x.ValAddr
+	}
+	return nil
+}
+
+func (x *QueryCollateralOwnershipsByValidatorRequest) GetPagination() *v1beta1.PageRequest {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+// QueryCollateralOwnershipsByValidatorResponse is the response type for the
+// Query/CollateralOwnershipsByValidator RPC method
+type QueryCollateralOwnershipsByValidatorResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CollateralOwnerships []*CollateralOwnershipWithAmount `protobuf:"bytes,1,rep,name=collateral_ownerships,json=collateralOwnerships,proto3" json:"collateral_ownerships,omitempty"`
+	Pagination           *v1beta1.PageResponse            `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (x *QueryCollateralOwnershipsByValidatorResponse) Reset() {
+	*x = QueryCollateralOwnershipsByValidatorResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mitosis_evmvalidator_v1_query_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryCollateralOwnershipsByValidatorResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryCollateralOwnershipsByValidatorResponse) ProtoMessage() {}
+
+// Deprecated: Use QueryCollateralOwnershipsByValidatorResponse.ProtoReflect.Descriptor instead.
+func (*QueryCollateralOwnershipsByValidatorResponse) Descriptor() ([]byte, []int) {
+	return file_mitosis_evmvalidator_v1_query_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *QueryCollateralOwnershipsByValidatorResponse) GetCollateralOwnerships() []*CollateralOwnershipWithAmount {
+	if x != nil {
+		return x.CollateralOwnerships
+	}
+	return nil
+}
+
+func (x *QueryCollateralOwnershipsByValidatorResponse) GetPagination() *v1beta1.PageResponse {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+// QueryCollateralOwnershipRequest is the request type for the
+// Query/CollateralOwnership RPC method
+type QueryCollateralOwnershipRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ValAddr []byte `protobuf:"bytes,1,opt,name=val_addr,json=valAddr,proto3" json:"val_addr,omitempty"`
+	Owner   []byte `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+}
+
+func (x *QueryCollateralOwnershipRequest) Reset() {
+	*x = QueryCollateralOwnershipRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mitosis_evmvalidator_v1_query_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryCollateralOwnershipRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryCollateralOwnershipRequest) ProtoMessage() {}
+
+// Deprecated: Use QueryCollateralOwnershipRequest.ProtoReflect.Descriptor instead.
+func (*QueryCollateralOwnershipRequest) Descriptor() ([]byte, []int) {
+	return file_mitosis_evmvalidator_v1_query_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *QueryCollateralOwnershipRequest) GetValAddr() []byte {
+	if x != nil {
+		return x.ValAddr
+	}
+	return nil
+}
+
+func (x *QueryCollateralOwnershipRequest) GetOwner() []byte {
+	if x != nil {
+		return x.Owner
+	}
+	return nil
+}
+
+// QueryCollateralOwnershipResponse is the response type for the
+// Query/CollateralOwnership RPC method
+type QueryCollateralOwnershipResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CollateralOwnership *CollateralOwnershipWithAmount `protobuf:"bytes,1,opt,name=collateral_ownership,json=collateralOwnership,proto3" json:"collateral_ownership,omitempty"`
+}
+
+func (x *QueryCollateralOwnershipResponse) Reset() {
+	*x = QueryCollateralOwnershipResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mitosis_evmvalidator_v1_query_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryCollateralOwnershipResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryCollateralOwnershipResponse) ProtoMessage() {}
+
+// Deprecated: Use QueryCollateralOwnershipResponse.ProtoReflect.Descriptor instead.
+func (*QueryCollateralOwnershipResponse) Descriptor() ([]byte, []int) {
+	return file_mitosis_evmvalidator_v1_query_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *QueryCollateralOwnershipResponse) GetCollateralOwnership() *CollateralOwnershipWithAmount {
+	if x != nil {
+		return x.CollateralOwnership
+	}
+	return nil
+}
+
+// QueryEventReceiptRequest is the request type for the Query/EventReceipt RPC
+// method
+type QueryEventReceiptRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// evm_block_hash is the hex-encoded hash of the EVM block
+	EvmBlockHash string `protobuf:"bytes,1,opt,name=evm_block_hash,json=evmBlockHash,proto3" json:"evm_block_hash,omitempty"`
+}
+
+func (x *QueryEventReceiptRequest) Reset() {
+	*x = QueryEventReceiptRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mitosis_evmvalidator_v1_query_proto_msgTypes[19]
+		mi := &file_mitosis_evmvalidator_v1_query_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryCollateralOwnershipsByValidatorRequest) String() string {
+func (x *QueryEventReceiptRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryCollateralOwnershipsByValidatorRequest) ProtoMessage() {}
-
-// Deprecated: Use QueryCollateralOwnershipsByValidatorRequest.ProtoReflect.Descriptor instead.
-func (*QueryCollateralOwnershipsByValidatorRequest) Descriptor() ([]byte, []int) {
-	return file_mitosis_evmvalidator_v1_query_proto_rawDescGZIP(), []int{19}
-}
+func (*QueryEventReceiptRequest) ProtoMessage() {}
 
-func (x *QueryCollateralOwnershipsByValidatorRequest) GetValAddr() []byte {
-	if x != nil {
-		return x.ValAddr
-	}
-	return nil
+// Deprecated: Use QueryEventReceiptRequest.ProtoReflect.Descriptor instead.
+func (*QueryEventReceiptRequest) Descriptor() ([]byte, []int) {
+	return file_mitosis_evmvalidator_v1_query_proto_rawDescGZIP(), []int{25}
 }
 
-func (x *QueryCollateralOwnershipsByValidatorRequest) GetPagination() *v1beta1.PageRequest {
+func (x *QueryEventReceiptRequest) GetEvmBlockHash() string {
 	if x != nil {
-		return x.Pagination
+		return x.EvmBlockHash
 	}
-	return nil
+	return ""
 }
 
-// QueryCollateralOwnershipsByValidatorResponse is the response type for the
-// Query/CollateralOwnershipsByValidator RPC method
-type QueryCollateralOwnershipsByValidatorResponse struct {
+// QueryEventReceiptResponse is the response type for the Query/EventReceipt
+// RPC method
+type QueryEventReceiptResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	CollateralOwnerships []*CollateralOwnershipWithAmount `protobuf:"bytes,1,rep,name=collateral_ownerships,json=collateralOwnerships,proto3" json:"collateral_ownerships,omitempty"`
-	Pagination           *v1beta1.PageResponse            `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	// receipts are sorted by the log index
+	Receipts []*EventReceipt `protobuf:"bytes,1,rep,name=receipts,proto3" json:"receipts,omitempty"`
 }
 
-func (x *QueryCollateralOwnershipsByValidatorResponse) Reset() {
-	*x = QueryCollateralOwnershipsByValidatorResponse{}
+func (x *QueryEventReceiptResponse) Reset() {
+	*x = QueryEventReceiptResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mitosis_evmvalidator_v1_query_proto_msgTypes[20]
+		mi := &file_mitosis_evmvalidator_v1_query_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryCollateralOwnershipsByValidatorResponse) String() string {
+func (x *QueryEventReceiptResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryCollateralOwnershipsByValidatorResponse) ProtoMessage() {}
-
-// Deprecated: Use QueryCollateralOwnershipsByValidatorResponse.ProtoReflect.Descriptor instead.
-func (*QueryCollateralOwnershipsByValidatorResponse) Descriptor() ([]byte, []int) {
-	return file_mitosis_evmvalidator_v1_query_proto_rawDescGZIP(), []int{20}
-}
+func (*QueryEventReceiptResponse) ProtoMessage() {}
 
-func (x *QueryCollateralOwnershipsByValidatorResponse) GetCollateralOwnerships() []*CollateralOwnershipWithAmount {
-	if x != nil {
-		return x.CollateralOwnerships
-	}
-	return nil
+// Deprecated: Use QueryEventReceiptResponse.ProtoReflect.Descriptor instead.
+func (*QueryEventReceiptResponse) Descriptor() ([]byte, []int) {
+	return file_mitosis_evmvalidator_v1_query_proto_rawDescGZIP(), []int{26}
 }
 
-func (x *QueryCollateralOwnershipsByValidatorResponse) GetPagination() *v1beta1.PageResponse {
+func (x *QueryEventReceiptResponse) GetReceipts() []*EventReceipt {
 	if x != nil {
-		return x.Pagination
+		return x.Receipts
 	}
 	return nil
 }
 
-// QueryCollateralOwnershipRequest is the request type for the
-// Query/CollateralOwnership RPC method
-type QueryCollateralOwnershipRequest struct {
+// QueryTxEventReceiptsRequest is the request type for the
+// Query/TxEventReceipts RPC method
+type QueryTxEventReceiptsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ValAddr []byte `protobuf:"bytes,1,opt,name=val_addr,json=valAddr,proto3" json:"val_addr,omitempty"`
-	Owner   []byte `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+	// tx_hash is the hex-encoded hash of the EVM transaction
+	TxHash string `protobuf:"bytes,1,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`
 }
 
-func (x *QueryCollateralOwnershipRequest) Reset() {
-	*x = QueryCollateralOwnershipRequest{}
+func (x *QueryTxEventReceiptsRequest) Reset() {
+	*x = QueryTxEventReceiptsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mitosis_evmvalidator_v1_query_proto_msgTypes[21]
+		mi := &file_mitosis_evmvalidator_v1_query_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryCollateralOwnershipRequest) String() string {
+func (x *QueryTxEventReceiptsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryCollateralOwnershipRequest) ProtoMessage() {}
-
-// Deprecated: Use QueryCollateralOwnershipRequest.ProtoReflect.Descriptor instead.
-func (*QueryCollateralOwnershipRequest) Descriptor() ([]byte, []int) {
-	return file_mitosis_evmvalidator_v1_query_proto_rawDescGZIP(), []int{21}
-}
+func (*QueryTxEventReceiptsRequest) ProtoMessage() {}
 
-func (x *QueryCollateralOwnershipRequest) GetValAddr() []byte {
-	if x != nil {
-		return x.ValAddr
-	}
-	return nil
+// Deprecated: Use QueryTxEventReceiptsRequest.ProtoReflect.Descriptor instead.
+func (*QueryTxEventReceiptsRequest) Descriptor() ([]byte, []int) {
+	return file_mitosis_evmvalidator_v1_query_proto_rawDescGZIP(), []int{27}
 }
 
-func (x *QueryCollateralOwnershipRequest) GetOwner() []byte {
+func (x *QueryTxEventReceiptsRequest) GetTxHash() string {
 	if x != nil {
-		return x.Owner
+		return x.TxHash
 	}
-	return nil
+	return ""
 }
 
-// QueryCollateralOwnershipResponse is the response type for the
-// Query/CollateralOwnership RPC method
-type QueryCollateralOwnershipResponse struct {
+// QueryTxEventReceiptsResponse is the response type for the
+// Query/TxEventReceipts RPC method
+type QueryTxEventReceiptsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	CollateralOwnership *CollateralOwnershipWithAmount `protobuf:"bytes,1,opt,name=collateral_ownership,json=collateralOwnership,proto3" json:"collateral_ownership,omitempty"`
+	// receipts are sorted by the log index
+	Receipts []*EventReceipt `protobuf:"bytes,1,rep,name=receipts,proto3" json:"receipts,omitempty"`
 }
 
-func (x *QueryCollateralOwnershipResponse) Reset() {
-	*x = QueryCollateralOwnershipResponse{}
+func (x *QueryTxEventReceiptsResponse) Reset() {
+	*x = QueryTxEventReceiptsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mitosis_evmvalidator_v1_query_proto_msgTypes[22]
+		mi := &file_mitosis_evmvalidator_v1_query_proto_msgTypes[28]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryCollateralOwnershipResponse) String() string {
+func (x *QueryTxEventReceiptsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryCollateralOwnershipResponse) ProtoMessage() {}
+func (*QueryTxEventReceiptsResponse) ProtoMessage() {}
 
-// Deprecated: Use QueryCollateralOwnershipResponse.ProtoReflect.Descriptor instead.
-func (*QueryCollateralOwnershipResponse) Descriptor() ([]byte, []int) {
-	return file_mitosis_evmvalidator_v1_query_proto_rawDescGZIP(), []int{22}
+// Deprecated: Use QueryTxEventReceiptsResponse.ProtoReflect.Descriptor instead.
+func (*QueryTxEventReceiptsResponse) Descriptor() ([]byte, []int) {
+	return file_mitosis_evmvalidator_v1_query_proto_rawDescGZIP(), []int{28}
 }
 
-func (x *QueryCollateralOwnershipResponse) GetCollateralOwnership() *CollateralOwnershipWithAmount {
+func (x *QueryTxEventReceiptsResponse) GetReceipts() []*EventReceipt {
 	if x != nil {
-		return x.CollateralOwnership
+		return x.Receipts
 	}
 	return nil
 }
@@ -11671,335 +14507,404 @@ var file_mitosis_evmvalidator_v1_query_proto_rawDesc = []byte{
 	0x6f, 0x72, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x2e, 0x70, 0x72, 0x6f,
 	0x74, 0x6f, 0x1a, 0x27, 0x6d, 0x69, 0x74, 0x6f, 0x73, 0x69, 0x73, 0x2f, 0x65, 0x76, 0x6d, 0x76,
 	0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x2f, 0x76, 0x31, 0x2f, 0x76, 0x61, 0x6c, 0x69,
-	0x64, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x14, 0x0a, 0x12, 0x51,
-	0x75, 0x65, 0x72, 0x79, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x22, 0x59, 0x0a, 0x13, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x42, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x61,
-	0x6d, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6d, 0x69, 0x74, 0x6f, 0x73,
-	0x69, 0x73, 0x2e, 0x65, 0x76, 0x6d, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x2e,
-	0x76, 0x31, 0x2e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8,
-	0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x22, 0x2d, 0x0a, 0x2b,
-	0x51, 0x75, 0x65, 0x72, 0x79, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x45, 0x6e,
-	0x74, 0x72, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74,
-	0x41, 0x64, 0x64, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x79, 0x0a, 0x2c, 0x51,
+	0x64, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x2d, 0x6d, 0x69, 0x74,
+	0x6f, 0x73, 0x69, 0x73, 0x2f, 0x65, 0x76, 0x6d, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f,
+	0x72, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x69, 0x72, 0x63, 0x75, 0x69, 0x74, 0x5f, 0x62, 0x72, 0x65,
+	0x61, 0x6b, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x14, 0x0a, 0x12, 0x51, 0x75,
+	0x65, 0x72, 0x79, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x22, 0x59, 0x0a, 0x13, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x42, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d,
+	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6d, 0x69, 0x74, 0x6f, 0x73, 0x69,
+	0x73, 0x2e, 0x65, 0x76, 0x6d, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x76,
+	0x31, 0x2e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7,
+	0xb0, 0x2a, 0x01, 0x52, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x22, 0x2d, 0x0a, 0x2b, 0x51,
 	0x75, 0x65, 0x72, 0x79, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x45, 0x6e, 0x74,
 	0x72, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x41,
-	0x64, 0x64, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x49, 0x0a, 0x04, 0x61,
-	0x64, 0x64, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x35, 0xc8, 0xde, 0x1f, 0x00, 0xda,
-	0xde, 0x1f, 0x2d, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6d, 0x69,
-	0x74, 0x6f, 0x73, 0x69, 0x73, 0x2d, 0x6f, 0x72, 0x67, 0x2f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f,
-	0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x45, 0x74, 0x68, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
-	0x52, 0x04, 0x61, 0x64, 0x64, 0x72, 0x22, 0x32, 0x0a, 0x15, 0x51, 0x75, 0x65, 0x72, 0x79, 0x56,
-	0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x19, 0x0a, 0x08, 0x76, 0x61, 0x6c, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0c, 0x52, 0x07, 0x76, 0x61, 0x6c, 0x41, 0x64, 0x64, 0x72, 0x22, 0x65, 0x0a, 0x16, 0x51, 0x75,
-	0x65, 0x72, 0x79, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4b, 0x0a, 0x09, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f,
-	0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x6d, 0x69, 0x74, 0x6f, 0x73, 0x69,
-	0x73, 0x2e, 0x65, 0x76, 0x6d, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x76,
-	0x31, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x42, 0x09, 0xc8, 0xde, 0x1f,
-	0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x09, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f,
-	0x72, 0x22, 0x61, 0x0a, 0x1f, 0x51, 0x75, 0x65, 0x72, 0x79, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61,
-	0x74, 0x6f, 0x72, 0x42, 0x79, 0x43, 0x6f, 0x6e, 0x73, 0x41, 0x64, 0x64, 0x72, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x3e, 0x0a, 0x09, 0x63, 0x6f, 0x6e, 0x73, 0x5f, 0x61, 0x64, 0x64,
-	0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x21, 0xd2, 0xb4, 0x2d, 0x1d, 0x63, 0x6f, 0x73,
-	0x6d, 0x6f, 0x73, 0x2e, 0x43, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x73, 0x75, 0x73, 0x41, 0x64, 0x64,
-	0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x08, 0x63, 0x6f, 0x6e, 0x73,
-	0x41, 0x64, 0x64, 0x72, 0x22, 0x6f, 0x0a, 0x20, 0x51, 0x75, 0x65, 0x72, 0x79, 0x56, 0x61, 0x6c,
-	0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x42, 0x79, 0x43, 0x6f, 0x6e, 0x73, 0x41, 0x64, 0x64, 0x72,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4b, 0x0a, 0x09, 0x76, 0x61, 0x6c, 0x69,
-	0x64, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x6d, 0x69,
+	0x64, 0x64, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x79, 0x0a, 0x2c, 0x51, 0x75,
+	0x65, 0x72, 0x79, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x41, 0x64,
+	0x64, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x49, 0x0a, 0x04, 0x61, 0x64,
+	0x64, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x35, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde,
+	0x1f, 0x2d, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6d, 0x69, 0x74,
+	0x6f, 0x73, 0x69, 0x73, 0x2d, 0x6f, 0x72, 0x67, 0x2f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x74,
+	0x79, 0x70, 0x65, 0x73, 0x2e, 0x45, 0x74, 0x68, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52,
+	0x04, 0x61, 0x64, 0x64, 0x72, 0x22, 0x1c, 0x0a, 0x1a, 0x51, 0x75, 0x65, 0x72, 0x79, 0x43, 0x69,
+	0x72, 0x63, 0x75, 0x69, 0x74, 0x42, 0x72, 0x65, 0x61, 0x6b, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x22, 0x7a, 0x0a, 0x1b, 0x51, 0x75, 0x65, 0x72, 0x79, 0x43, 0x69, 0x72, 0x63,
+	0x75, 0x69, 0x74, 0x42, 0x72, 0x65, 0x61, 0x6b, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x5b, 0x0a, 0x0f, 0x63, 0x69, 0x72, 0x63, 0x75, 0x69, 0x74, 0x5f, 0x62, 0x72,
+	0x65, 0x61, 0x6b, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x6d, 0x69,
 	0x74, 0x6f, 0x73, 0x69, 0x73, 0x2e, 0x65, 0x76, 0x6d, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
-	0x6f, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x42,
-	0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x09, 0x76, 0x61, 0x6c, 0x69,
-	0x64, 0x61, 0x74, 0x6f, 0x72, 0x22, 0x60, 0x0a, 0x16, 0x51, 0x75, 0x65, 0x72, 0x79, 0x56, 0x61,
-	0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x46, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73,
-	0x65, 0x2e, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e,
-	0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x0a, 0x70, 0x61, 0x67,
-	0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xb1, 0x01, 0x0a, 0x17, 0x51, 0x75, 0x65, 0x72,
-	0x79, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x4d, 0x0a, 0x0a, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72,
-	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x6d, 0x69, 0x74, 0x6f, 0x73, 0x69,
-	0x73, 0x2e, 0x65, 0x76, 0x6d, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x76,
-	0x31, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x42, 0x09, 0xc8, 0xde, 0x1f,
-	0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0a, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f,
-	0x72, 0x73, 0x12, 0x47, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e,
-	0x62, 0x61, 0x73, 0x65, 0x2e, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74,
-	0x61, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52,
-	0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x28, 0x0a, 0x16, 0x51,
-	0x75, 0x65, 0x72, 0x79, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x61, 0x6c, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x04, 0x52, 0x02, 0x69, 0x64, 0x22, 0x69, 0x0a, 0x17, 0x51, 0x75, 0x65, 0x72, 0x79, 0x57, 0x69,
-	0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x61, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x4e, 0x0a, 0x0a, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x61, 0x6c, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x6d, 0x69, 0x74, 0x6f, 0x73, 0x69, 0x73, 0x2e, 0x65,
-	0x76, 0x6d, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x57,
-	0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x61, 0x6c, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8,
-	0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0a, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x61, 0x6c,
-	0x22, 0x61, 0x0a, 0x17, 0x51, 0x75, 0x65, 0x72, 0x79, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61,
-	0x77, 0x61, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x46, 0x0a, 0x0a, 0x70,
-	0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x26, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x71, 0x75,
+	0x6f, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x69, 0x72, 0x63, 0x75, 0x69, 0x74, 0x42, 0x72, 0x65,
+	0x61, 0x6b, 0x65, 0x72, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52,
+	0x0e, 0x63, 0x69, 0x72, 0x63, 0x75, 0x69, 0x74, 0x42, 0x72, 0x65, 0x61, 0x6b, 0x65, 0x72, 0x22,
+	0x32, 0x0a, 0x15, 0x51, 0x75, 0x65, 0x72, 0x79, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f,
+	0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x76, 0x61, 0x6c, 0x5f,
+	0x61, 0x64, 0x64, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x76, 0x61, 0x6c, 0x41,
+	0x64, 0x64, 0x72, 0x22, 0x65, 0x0a, 0x16, 0x51, 0x75, 0x65, 0x72, 0x79, 0x56, 0x61, 0x6c, 0x69,
+	0x64, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4b, 0x0a,
+	0x09, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x22, 0x2e, 0x6d, 0x69, 0x74, 0x6f, 0x73, 0x69, 0x73, 0x2e, 0x65, 0x76, 0x6d, 0x76, 0x61,
+	0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64,
+	0x61, 0x74, 0x6f, 0x72, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52,
+	0x09, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x22, 0x61, 0x0a, 0x1f, 0x51, 0x75,
+	0x65, 0x72, 0x79, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x42, 0x79, 0x43, 0x6f,
+	0x6e, 0x73, 0x41, 0x64, 0x64, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3e, 0x0a,
+	0x09, 0x63, 0x6f, 0x6e, 0x73, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x21, 0xd2, 0xb4, 0x2d, 0x1d, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x43, 0x6f, 0x6e,
+	0x73, 0x65, 0x6e, 0x73, 0x75, 0x73, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72,
+	0x69, 0x6e, 0x67, 0x52, 0x08, 0x63, 0x6f, 0x6e, 0x73, 0x41, 0x64, 0x64, 0x72, 0x22, 0x6f, 0x0a,
+	0x20, 0x51, 0x75, 0x65, 0x72, 0x79, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x42,
+	0x79, 0x43, 0x6f, 0x6e, 0x73, 0x41, 0x64, 0x64, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x4b, 0x0a, 0x09, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x6d, 0x69, 0x74, 0x6f, 0x73, 0x69, 0x73, 0x2e, 0x65,
+	0x76, 0x6d, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x56,
+	0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7,
+	0xb0, 0x2a, 0x01, 0x52, 0x09, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x22, 0x60,
+	0x0a, 0x16, 0x51, 0x75, 0x65, 0x72, 0x79, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x46, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69,
+	0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x71, 0x75, 0x65, 0x72, 0x79,
+	0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x22, 0xb1, 0x01, 0x0a, 0x17, 0x51, 0x75, 0x65, 0x72, 0x79, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61,
+	0x74, 0x6f, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4d, 0x0a, 0x0a,
+	0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x22, 0x2e, 0x6d, 0x69, 0x74, 0x6f, 0x73, 0x69, 0x73, 0x2e, 0x65, 0x76, 0x6d, 0x76, 0x61,
+	0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64,
+	0x61, 0x74, 0x6f, 0x72, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52,
+	0x0a, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x12, 0x47, 0x0a, 0x0a, 0x70,
+	0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x27, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x71, 0x75,
 	0x65, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x65,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x22, 0xb5, 0x01, 0x0a, 0x18, 0x51, 0x75, 0x65, 0x72, 0x79, 0x57, 0x69, 0x74,
-	0x68, 0x64, 0x72, 0x61, 0x77, 0x61, 0x6c, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x50, 0x0a, 0x0b, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x61, 0x6c, 0x73, 0x18,
-	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x6d, 0x69, 0x74, 0x6f, 0x73, 0x69, 0x73, 0x2e,
-	0x65, 0x76, 0x6d, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x2e,
-	0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x61, 0x6c, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00,
-	0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0b, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x61,
-	0x6c, 0x73, 0x12, 0x47, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e,
-	0x62, 0x61, 0x73, 0x65, 0x2e, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74,
-	0x61, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52,
-	0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x87, 0x01, 0x0a, 0x22,
-	0x51, 0x75, 0x65, 0x72, 0x79, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x61, 0x6c, 0x73,
-	0x42, 0x79, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x76, 0x61, 0x6c, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x76, 0x61, 0x6c, 0x41, 0x64, 0x64, 0x72, 0x12, 0x46, 0x0a,
-	0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x26, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e,
-	0x71, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x50, 0x61,
-	0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xc0, 0x01, 0x0a, 0x23, 0x51, 0x75, 0x65, 0x72, 0x79, 0x57,
-	0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x61, 0x6c, 0x73, 0x42, 0x79, 0x56, 0x61, 0x6c, 0x69,
-	0x64, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x50, 0x0a,
-	0x0b, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x61, 0x6c, 0x73, 0x18, 0x01, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x23, 0x2e, 0x6d, 0x69, 0x74, 0x6f, 0x73, 0x69, 0x73, 0x2e, 0x65, 0x76, 0x6d,
-	0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x69, 0x74,
-	0x68, 0x64, 0x72, 0x61, 0x77, 0x61, 0x6c, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0,
-	0x2a, 0x01, 0x52, 0x0b, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x61, 0x6c, 0x73, 0x12,
-	0x47, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73,
-	0x65, 0x2e, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e,
-	0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x0a, 0x70, 0x61,
-	0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xbd, 0x01, 0x0a, 0x1d, 0x43, 0x6f, 0x6c,
-	0x6c, 0x61, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70,
-	0x57, 0x69, 0x74, 0x68, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x55, 0x0a, 0x09, 0x6f, 0x77,
-	0x6e, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2c, 0x2e,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x22, 0x28, 0x0a, 0x16, 0x51, 0x75, 0x65, 0x72, 0x79, 0x57, 0x69, 0x74,
+	0x68, 0x64, 0x72, 0x61, 0x77, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x22, 0x69,
+	0x0a, 0x17, 0x51, 0x75, 0x65, 0x72, 0x79, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x61,
+	0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4e, 0x0a, 0x0a, 0x77, 0x69, 0x74,
+	0x68, 0x64, 0x72, 0x61, 0x77, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e,
 	0x6d, 0x69, 0x74, 0x6f, 0x73, 0x69, 0x73, 0x2e, 0x65, 0x76, 0x6d, 0x76, 0x61, 0x6c, 0x69, 0x64,
-	0x61, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x65, 0x72,
-	0x61, 0x6c, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x42, 0x09, 0xc8, 0xde, 0x1f,
-	0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x09, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x73, 0x68, 0x69,
-	0x70, 0x12, 0x45, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x42, 0x2d, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x16, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
-	0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x55, 0x69, 0x6e,
-	0x74, 0xd2, 0xb4, 0x2d, 0x0b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x55, 0x69, 0x6e, 0x74,
-	0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x6a, 0x0a, 0x20, 0x51, 0x75, 0x65, 0x72,
-	0x79, 0x43, 0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x4f, 0x77, 0x6e, 0x65, 0x72,
-	0x73, 0x68, 0x69, 0x70, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x46, 0x0a, 0x0a,
-	0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x26, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x71,
-	0x75, 0x65, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x67,
-	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x22, 0xe4, 0x01, 0x0a, 0x21, 0x51, 0x75, 0x65, 0x72, 0x79, 0x43, 0x6f,
-	0x6c, 0x6c, 0x61, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x73, 0x68, 0x69,
-	0x70, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x76, 0x0a, 0x15, 0x63, 0x6f,
-	0x6c, 0x6c, 0x61, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x5f, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x73, 0x68,
-	0x69, 0x70, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x36, 0x2e, 0x6d, 0x69, 0x74, 0x6f,
-	0x73, 0x69, 0x73, 0x2e, 0x65, 0x76, 0x6d, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72,
-	0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x4f, 0x77,
-	0x6e, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x57, 0x69, 0x74, 0x68, 0x41, 0x6d, 0x6f, 0x75, 0x6e,
-	0x74, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x14, 0x63, 0x6f,
-	0x6c, 0x6c, 0x61, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x73, 0x68, 0x69,
-	0x70, 0x73, 0x12, 0x47, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e,
-	0x62, 0x61, 0x73, 0x65, 0x2e, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74,
-	0x61, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52,
-	0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x90, 0x01, 0x0a, 0x2b,
-	0x51, 0x75, 0x65, 0x72, 0x79, 0x43, 0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x4f,
-	0x77, 0x6e, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x73, 0x42, 0x79, 0x56, 0x61, 0x6c, 0x69, 0x64,
+	0x61, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77,
+	0x61, 0x6c, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0a, 0x77,
+	0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x61, 0x6c, 0x22, 0x61, 0x0a, 0x17, 0x51, 0x75, 0x65,
+	0x72, 0x79, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x61, 0x6c, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x46, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x62,
+	0x65, 0x74, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xb5, 0x01, 0x0a,
+	0x18, 0x51, 0x75, 0x65, 0x72, 0x79, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x61, 0x6c,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x50, 0x0a, 0x0b, 0x77, 0x69, 0x74,
+	0x68, 0x64, 0x72, 0x61, 0x77, 0x61, 0x6c, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23,
+	0x2e, 0x6d, 0x69, 0x74, 0x6f, 0x73, 0x69, 0x73, 0x2e, 0x65, 0x76, 0x6d, 0x76, 0x61, 0x6c, 0x69,
+	0x64, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61,
+	0x77, 0x61, 0x6c, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0b,
+	0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x61, 0x6c, 0x73, 0x12, 0x47, 0x0a, 0x0a, 0x70,
+	0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x27, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x71, 0x75,
+	0x65, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x22, 0x87, 0x01, 0x0a, 0x22, 0x51, 0x75, 0x65, 0x72, 0x79, 0x57, 0x69,
+	0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x61, 0x6c, 0x73, 0x42, 0x79, 0x56, 0x61, 0x6c, 0x69, 0x64,
 	0x61, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x76,
 	0x61, 0x6c, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x76,
 	0x61, 0x6c, 0x41, 0x64, 0x64, 0x72, 0x12, 0x46, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61,
 	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x63, 0x6f, 0x73,
 	0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x76,
 	0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xef,
-	0x01, 0x0a, 0x2c, 0x51, 0x75, 0x65, 0x72, 0x79, 0x43, 0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x65, 0x72,
-	0x61, 0x6c, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x73, 0x42, 0x79, 0x56, 0x61,
-	0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x76, 0x0a, 0x15, 0x63, 0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x5f, 0x6f, 0x77,
-	0x6e, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x36,
-	0x2e, 0x6d, 0x69, 0x74, 0x6f, 0x73, 0x69, 0x73, 0x2e, 0x65, 0x76, 0x6d, 0x76, 0x61, 0x6c, 0x69,
-	0x64, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x65,
-	0x72, 0x61, 0x6c, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x57, 0x69, 0x74, 0x68,
-	0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a,
-	0x01, 0x52, 0x14, 0x63, 0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x4f, 0x77, 0x6e,
-	0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x73, 0x12, 0x47, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x63, 0x6f,
-	0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2e,
-	0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x22, 0x52, 0x0a, 0x1f, 0x51, 0x75, 0x65, 0x72, 0x79, 0x43, 0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x65,
-	0x72, 0x61, 0x6c, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x76, 0x61, 0x6c, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x76, 0x61, 0x6c, 0x41, 0x64, 0x64, 0x72, 0x12, 0x14,
-	0x0a, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x6f,
-	0x77, 0x6e, 0x65, 0x72, 0x22, 0x98, 0x01, 0x0a, 0x20, 0x51, 0x75, 0x65, 0x72, 0x79, 0x43, 0x6f,
+	0x73, 0x74, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xc0,
+	0x01, 0x0a, 0x23, 0x51, 0x75, 0x65, 0x72, 0x79, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77,
+	0x61, 0x6c, 0x73, 0x42, 0x79, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x50, 0x0a, 0x0b, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72,
+	0x61, 0x77, 0x61, 0x6c, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x6d, 0x69,
+	0x74, 0x6f, 0x73, 0x69, 0x73, 0x2e, 0x65, 0x76, 0x6d, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
+	0x6f, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x61, 0x6c,
+	0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0b, 0x77, 0x69, 0x74,
+	0x68, 0x64, 0x72, 0x61, 0x77, 0x61, 0x6c, 0x73, 0x12, 0x47, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69,
+	0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x71, 0x75, 0x65, 0x72, 0x79,
+	0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x22, 0xbd, 0x01, 0x0a, 0x1d, 0x43, 0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x65, 0x72, 0x61, 0x6c,
+	0x4f, 0x77, 0x6e, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x57, 0x69, 0x74, 0x68, 0x41, 0x6d, 0x6f,
+	0x75, 0x6e, 0x74, 0x12, 0x55, 0x0a, 0x09, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x6d, 0x69, 0x74, 0x6f, 0x73, 0x69, 0x73,
+	0x2e, 0x65, 0x76, 0x6d, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31,
+	0x2e, 0x43, 0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x4f, 0x77, 0x6e, 0x65, 0x72,
+	0x73, 0x68, 0x69, 0x70, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52,
+	0x09, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x12, 0x45, 0x0a, 0x06, 0x61, 0x6d,
+	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x2d, 0xc8, 0xde, 0x1f, 0x00,
+	0xda, 0xde, 0x1f, 0x16, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f,
+	0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x55, 0x69, 0x6e, 0x74, 0xd2, 0xb4, 0x2d, 0x0b, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x55, 0x69, 0x6e, 0x74, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e,
+	0x74, 0x22, 0x6a, 0x0a, 0x20, 0x51, 0x75, 0x65, 0x72, 0x79, 0x43, 0x6f, 0x6c, 0x6c, 0x61, 0x74,
+	0x65, 0x72, 0x61, 0x6c, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x46, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x76, 0x31,
+	0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xe4, 0x01,
+	0x0a, 0x21, 0x51, 0x75, 0x65, 0x72, 0x79, 0x43, 0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x65, 0x72, 0x61,
+	0x6c, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x76, 0x0a, 0x15, 0x63, 0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x65, 0x72, 0x61,
+	0x6c, 0x5f, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x36, 0x2e, 0x6d, 0x69, 0x74, 0x6f, 0x73, 0x69, 0x73, 0x2e, 0x65, 0x76, 0x6d,
+	0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6c,
+	0x6c, 0x61, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70,
+	0x57, 0x69, 0x74, 0x68, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00,
+	0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x14, 0x63, 0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x65, 0x72, 0x61,
+	0x6c, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x73, 0x12, 0x47, 0x0a, 0x0a, 0x70,
+	0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x27, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x71, 0x75,
+	0x65, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x22, 0x90, 0x01, 0x0a, 0x2b, 0x51, 0x75, 0x65, 0x72, 0x79, 0x43, 0x6f,
 	0x6c, 0x6c, 0x61, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x73, 0x68, 0x69,
-	0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x74, 0x0a, 0x14, 0x63, 0x6f, 0x6c,
-	0x6c, 0x61, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x5f, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x73, 0x68, 0x69,
-	0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x36, 0x2e, 0x6d, 0x69, 0x74, 0x6f, 0x73, 0x69,
+	0x70, 0x73, 0x42, 0x79, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x76, 0x61, 0x6c, 0x5f, 0x61, 0x64, 0x64, 0x72,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x76, 0x61, 0x6c, 0x41, 0x64, 0x64, 0x72, 0x12,
+	0x46, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73,
+	0x65, 0x2e, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e,
+	0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x0a, 0x70, 0x61, 0x67,
+	0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xef, 0x01, 0x0a, 0x2c, 0x51, 0x75, 0x65, 0x72,
+	0x79, 0x43, 0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x4f, 0x77, 0x6e, 0x65, 0x72,
+	0x73, 0x68, 0x69, 0x70, 0x73, 0x42, 0x79, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x76, 0x0a, 0x15, 0x63, 0x6f, 0x6c, 0x6c,
+	0x61, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x5f, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x36, 0x2e, 0x6d, 0x69, 0x74, 0x6f, 0x73, 0x69,
 	0x73, 0x2e, 0x65, 0x76, 0x6d, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x76,
 	0x31, 0x2e, 0x43, 0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x4f, 0x77, 0x6e, 0x65,
 	0x72, 0x73, 0x68, 0x69, 0x70, 0x57, 0x69, 0x74, 0x68, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x42,
-	0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x13, 0x63, 0x6f, 0x6c, 0x6c,
-	0x61, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x32,
-	0xf1, 0x10, 0x0a, 0x05, 0x51, 0x75, 0x65, 0x72, 0x79, 0x12, 0x8c, 0x01, 0x0a, 0x06, 0x50, 0x61,
-	0x72, 0x61, 0x6d, 0x73, 0x12, 0x2b, 0x2e, 0x6d, 0x69, 0x74, 0x6f, 0x73, 0x69, 0x73, 0x2e, 0x65,
-	0x76, 0x6d, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x51,
-	0x75, 0x65, 0x72, 0x79, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x2c, 0x2e, 0x6d, 0x69, 0x74, 0x6f, 0x73, 0x69, 0x73, 0x2e, 0x65, 0x76, 0x6d, 0x76,
-	0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72,
-	0x79, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x27, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x21, 0x12, 0x1f, 0x2f, 0x6d, 0x69, 0x74, 0x6f, 0x73, 0x69,
-	0x73, 0x2f, 0x65, 0x76, 0x6d, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x2f, 0x76,
-	0x31, 0x2f, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0xf3, 0x01, 0x0a, 0x1f, 0x56, 0x61, 0x6c,
-	0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74,
-	0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x41, 0x64, 0x64, 0x72, 0x12, 0x44, 0x2e, 0x6d,
-	0x69, 0x74, 0x6f, 0x73, 0x69, 0x73, 0x2e, 0x65, 0x76, 0x6d, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61,
-	0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x56, 0x61, 0x6c, 0x69,
-	0x64, 0x61, 0x74, 0x6f, 0x72, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x43,
-	0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x41, 0x64, 0x64, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x45, 0x2e, 0x6d, 0x69, 0x74, 0x6f, 0x73, 0x69, 0x73, 0x2e, 0x65, 0x76, 0x6d,
-	0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65,
-	0x72, 0x79, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x45, 0x6e, 0x74, 0x72, 0x79,
-	0x70, 0x6f, 0x69, 0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x41, 0x64, 0x64,
-	0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x43, 0x82, 0xd3, 0xe4, 0x93, 0x02,
-	0x3d, 0x12, 0x3b, 0x2f, 0x6d, 0x69, 0x74, 0x6f, 0x73, 0x69, 0x73, 0x2f, 0x65, 0x76, 0x6d, 0x76,
-	0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x2f, 0x76, 0x31, 0x2f, 0x76, 0x61, 0x6c, 0x69,
-	0x64, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74,
-	0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x12, 0xa4,
-	0x01, 0x0a, 0x09, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x2e, 0x2e, 0x6d,
-	0x69, 0x74, 0x6f, 0x73, 0x69, 0x73, 0x2e, 0x65, 0x76, 0x6d, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61,
-	0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x56, 0x61, 0x6c, 0x69,
-	0x64, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2f, 0x2e, 0x6d,
-	0x69, 0x74, 0x6f, 0x73, 0x69, 0x73, 0x2e, 0x65, 0x76, 0x6d, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61,
-	0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x56, 0x61, 0x6c, 0x69,
-	0x64, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x36, 0x82,
-	0xd3, 0xe4, 0x93, 0x02, 0x30, 0x12, 0x2e, 0x2f, 0x6d, 0x69, 0x74, 0x6f, 0x73, 0x69, 0x73, 0x2f,
-	0x65, 0x76, 0x6d, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x2f, 0x76, 0x31, 0x2f,
-	0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x2f, 0x7b, 0x76, 0x61, 0x6c, 0x5f,
-	0x61, 0x64, 0x64, 0x72, 0x7d, 0x12, 0xd0, 0x01, 0x0a, 0x13, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61,
-	0x74, 0x6f, 0x72, 0x42, 0x79, 0x43, 0x6f, 0x6e, 0x73, 0x41, 0x64, 0x64, 0x72, 0x12, 0x38, 0x2e,
+	0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x14, 0x63, 0x6f, 0x6c, 0x6c,
+	0x61, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x73,
+	0x12, 0x47, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61,
+	0x73, 0x65, 0x2e, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31,
+	0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x0a, 0x70,
+	0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x52, 0x0a, 0x1f, 0x51, 0x75, 0x65,
+	0x72, 0x79, 0x43, 0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x4f, 0x77, 0x6e, 0x65,
+	0x72, 0x73, 0x68, 0x69, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08,
+	0x76, 0x61, 0x6c, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07,
+	0x76, 0x61, 0x6c, 0x41, 0x64, 0x64, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x22, 0x98, 0x01,
+	0x0a, 0x20, 0x51, 0x75, 0x65, 0x72, 0x79, 0x43, 0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x65, 0x72, 0x61,
+	0x6c, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x74, 0x0a, 0x14, 0x63, 0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x65, 0x72, 0x61, 0x6c,
+	0x5f, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x36, 0x2e, 0x6d, 0x69, 0x74, 0x6f, 0x73, 0x69, 0x73, 0x2e, 0x65, 0x76, 0x6d, 0x76, 0x61,
+	0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6c, 0x6c, 0x61,
+	0x74, 0x65, 0x72, 0x61, 0x6c, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x57, 0x69,
+	0x74, 0x68, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7,
+	0xb0, 0x2a, 0x01, 0x52, 0x13, 0x63, 0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x4f,
+	0x77, 0x6e, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x22, 0x40, 0x0a, 0x18, 0x51, 0x75, 0x65, 0x72,
+	0x79, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x24, 0x0a, 0x0e, 0x65, 0x76, 0x6d, 0x5f, 0x62, 0x6c, 0x6f, 0x63,
+	0x6b, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x65, 0x76,
+	0x6d, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x61, 0x73, 0x68, 0x22, 0x69, 0x0a, 0x19, 0x51, 0x75,
+	0x65, 0x72, 0x79, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4c, 0x0a, 0x08, 0x72, 0x65, 0x63, 0x65, 0x69,
+	0x70, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x6d, 0x69, 0x74, 0x6f,
+	0x73, 0x69, 0x73, 0x2e, 0x65, 0x76, 0x6d, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72,
+	0x2e, 0x76, 0x31, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74,
+	0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x08, 0x72, 0x65, 0x63,
+	0x65, 0x69, 0x70, 0x74, 0x73, 0x22, 0x36, 0x0a, 0x1b, 0x51, 0x75, 0x65, 0x72, 0x79, 0x54, 0x78,
+	0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x74, 0x78, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x78, 0x48, 0x61, 0x73, 0x68, 0x22, 0x6c, 0x0a,
+	0x1c, 0x51, 0x75, 0x65, 0x72, 0x79, 0x54, 0x78, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x63,
+	0x65, 0x69, 0x70, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4c, 0x0a,
+	0x08, 0x72, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x25, 0x2e, 0x6d, 0x69, 0x74, 0x6f, 0x73, 0x69, 0x73, 0x2e, 0x65, 0x76, 0x6d, 0x76, 0x61, 0x6c,
+	0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52,
+	0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a,
+	0x01, 0x52, 0x08, 0x72, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x73, 0x32, 0x9a, 0x15, 0x0a, 0x05,
+	0x51, 0x75, 0x65, 0x72, 0x79, 0x12, 0x8c, 0x01, 0x0a, 0x06, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73,
+	0x12, 0x2b, 0x2e, 0x6d, 0x69, 0x74, 0x6f, 0x73, 0x69, 0x73, 0x2e, 0x65, 0x76, 0x6d, 0x76, 0x61,
+	0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79,
+	0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e,
 	0x6d, 0x69, 0x74, 0x6f, 0x73, 0x69, 0x73, 0x2e, 0x65, 0x76, 0x6d, 0x76, 0x61, 0x6c, 0x69, 0x64,
-	0x61, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x56, 0x61, 0x6c,
-	0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x42, 0x79, 0x43, 0x6f, 0x6e, 0x73, 0x41, 0x64, 0x64, 0x72,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x39, 0x2e, 0x6d, 0x69, 0x74, 0x6f, 0x73, 0x69,
-	0x73, 0x2e, 0x65, 0x76, 0x6d, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x76,
-	0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72,
-	0x42, 0x79, 0x43, 0x6f, 0x6e, 0x73, 0x41, 0x64, 0x64, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x44, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x3e, 0x12, 0x3c, 0x2f, 0x6d, 0x69, 0x74,
-	0x6f, 0x73, 0x69, 0x73, 0x2f, 0x65, 0x76, 0x6d, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f,
-	0x72, 0x2f, 0x76, 0x31, 0x2f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x5f,
-	0x62, 0x79, 0x5f, 0x63, 0x6f, 0x6e, 0x73, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x2f, 0x7b, 0x63, 0x6f,
-	0x6e, 0x73, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x7d, 0x12, 0x9c, 0x01, 0x0a, 0x0a, 0x56, 0x61, 0x6c,
-	0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x12, 0x2f, 0x2e, 0x6d, 0x69, 0x74, 0x6f, 0x73, 0x69,
-	0x73, 0x2e, 0x65, 0x76, 0x6d, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x76,
-	0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72,
-	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x30, 0x2e, 0x6d, 0x69, 0x74, 0x6f, 0x73,
+	0x61, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x61, 0x72,
+	0x61, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x27, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x21, 0x12, 0x1f, 0x2f, 0x6d, 0x69, 0x74, 0x6f, 0x73, 0x69, 0x73, 0x2f, 0x65, 0x76,
+	0x6d, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x61,
+	0x72, 0x61, 0x6d, 0x73, 0x12, 0xf3, 0x01, 0x0a, 0x1f, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
+	0x6f, 0x72, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x74,
+	0x72, 0x61, 0x63, 0x74, 0x41, 0x64, 0x64, 0x72, 0x12, 0x44, 0x2e, 0x6d, 0x69, 0x74, 0x6f, 0x73,
 	0x69, 0x73, 0x2e, 0x65, 0x76, 0x6d, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x2e,
 	0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f,
-	0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2b, 0x82, 0xd3, 0xe4, 0x93,
-	0x02, 0x25, 0x12, 0x23, 0x2f, 0x6d, 0x69, 0x74, 0x6f, 0x73, 0x69, 0x73, 0x2f, 0x65, 0x76, 0x6d,
-	0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x2f, 0x76, 0x31, 0x2f, 0x76, 0x61, 0x6c,
-	0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x12, 0xa2, 0x01, 0x0a, 0x0a, 0x57, 0x69, 0x74, 0x68,
-	0x64, 0x72, 0x61, 0x77, 0x61, 0x6c, 0x12, 0x2f, 0x2e, 0x6d, 0x69, 0x74, 0x6f, 0x73, 0x69, 0x73,
-	0x2e, 0x65, 0x76, 0x6d, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31,
-	0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x61, 0x6c,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x30, 0x2e, 0x6d, 0x69, 0x74, 0x6f, 0x73, 0x69,
-	0x73, 0x2e, 0x65, 0x76, 0x6d, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x76,
-	0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x61,
-	0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x31, 0x82, 0xd3, 0xe4, 0x93, 0x02,
-	0x2b, 0x12, 0x29, 0x2f, 0x6d, 0x69, 0x74, 0x6f, 0x73, 0x69, 0x73, 0x2f, 0x65, 0x76, 0x6d, 0x76,
-	0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x2f, 0x76, 0x31, 0x2f, 0x77, 0x69, 0x74, 0x68,
-	0x64, 0x72, 0x61, 0x77, 0x61, 0x6c, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x12, 0xa0, 0x01, 0x0a,
-	0x0b, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x61, 0x6c, 0x73, 0x12, 0x30, 0x2e, 0x6d,
-	0x69, 0x74, 0x6f, 0x73, 0x69, 0x73, 0x2e, 0x65, 0x76, 0x6d, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61,
-	0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x57, 0x69, 0x74, 0x68,
-	0x64, 0x72, 0x61, 0x77, 0x61, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x31,
+	0x72, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x72,
+	0x61, 0x63, 0x74, 0x41, 0x64, 0x64, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x45,
 	0x2e, 0x6d, 0x69, 0x74, 0x6f, 0x73, 0x69, 0x73, 0x2e, 0x65, 0x76, 0x6d, 0x76, 0x61, 0x6c, 0x69,
-	0x64, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x57, 0x69,
-	0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x61, 0x6c, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x2c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x26, 0x12, 0x24, 0x2f, 0x6d, 0x69, 0x74, 0x6f,
-	0x73, 0x69, 0x73, 0x2f, 0x65, 0x76, 0x6d, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72,
-	0x2f, 0x76, 0x31, 0x2f, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x61, 0x6c, 0x73, 0x12,
-	0xd7, 0x01, 0x0a, 0x16, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x61, 0x6c, 0x73, 0x42,
-	0x79, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x3b, 0x2e, 0x6d, 0x69, 0x74,
-	0x6f, 0x73, 0x69, 0x73, 0x2e, 0x65, 0x76, 0x6d, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f,
-	0x72, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72,
-	0x61, 0x77, 0x61, 0x6c, 0x73, 0x42, 0x79, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x3c, 0x2e, 0x6d, 0x69, 0x74, 0x6f, 0x73, 0x69,
-	0x73, 0x2e, 0x65, 0x76, 0x6d, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x76,
-	0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x61,
-	0x6c, 0x73, 0x42, 0x79, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x42, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x3c, 0x12, 0x3a, 0x2f,
+	0x64, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x56, 0x61,
+	0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x70, 0x6f, 0x69, 0x6e,
+	0x74, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x41, 0x64, 0x64, 0x72, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x43, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x3d, 0x12, 0x3b, 0x2f,
 	0x6d, 0x69, 0x74, 0x6f, 0x73, 0x69, 0x73, 0x2f, 0x65, 0x76, 0x6d, 0x76, 0x61, 0x6c, 0x69, 0x64,
 	0x61, 0x74, 0x6f, 0x72, 0x2f, 0x76, 0x31, 0x2f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f,
-	0x72, 0x73, 0x2f, 0x7b, 0x76, 0x61, 0x6c, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x7d, 0x2f, 0x77, 0x69,
-	0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x61, 0x6c, 0x73, 0x12, 0xc5, 0x01, 0x0a, 0x14, 0x43, 0x6f,
-	0x6c, 0x6c, 0x61, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x73, 0x68, 0x69,
-	0x70, 0x73, 0x12, 0x39, 0x2e, 0x6d, 0x69, 0x74, 0x6f, 0x73, 0x69, 0x73, 0x2e, 0x65, 0x76, 0x6d,
-	0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65,
-	0x72, 0x79, 0x43, 0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x4f, 0x77, 0x6e, 0x65,
-	0x72, 0x73, 0x68, 0x69, 0x70, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x3a, 0x2e,
+	0x72, 0x5f, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x5f, 0x63, 0x6f, 0x6e,
+	0x74, 0x72, 0x61, 0x63, 0x74, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x12, 0xad, 0x01, 0x0a, 0x0e, 0x43,
+	0x69, 0x72, 0x63, 0x75, 0x69, 0x74, 0x42, 0x72, 0x65, 0x61, 0x6b, 0x65, 0x72, 0x12, 0x33, 0x2e,
 	0x6d, 0x69, 0x74, 0x6f, 0x73, 0x69, 0x73, 0x2e, 0x65, 0x76, 0x6d, 0x76, 0x61, 0x6c, 0x69, 0x64,
-	0x61, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x43, 0x6f, 0x6c,
-	0x6c, 0x61, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70,
-	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x36, 0x82, 0xd3, 0xe4, 0x93, 0x02,
-	0x30, 0x12, 0x2e, 0x2f, 0x6d, 0x69, 0x74, 0x6f, 0x73, 0x69, 0x73, 0x2f, 0x65, 0x76, 0x6d, 0x76,
-	0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6f, 0x6c, 0x6c,
-	0x61, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x5f, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70,
-	0x73, 0x12, 0xfc, 0x01, 0x0a, 0x1f, 0x43, 0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x65, 0x72, 0x61, 0x6c,
-	0x4f, 0x77, 0x6e, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x73, 0x42, 0x79, 0x56, 0x61, 0x6c, 0x69,
-	0x64, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x44, 0x2e, 0x6d, 0x69, 0x74, 0x6f, 0x73, 0x69, 0x73, 0x2e,
-	0x65, 0x76, 0x6d, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x2e,
-	0x51, 0x75, 0x65, 0x72, 0x79, 0x43, 0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x4f,
-	0x77, 0x6e, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x73, 0x42, 0x79, 0x56, 0x61, 0x6c, 0x69, 0x64,
-	0x61, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x45, 0x2e, 0x6d, 0x69,
-	0x74, 0x6f, 0x73, 0x69, 0x73, 0x2e, 0x65, 0x76, 0x6d, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
-	0x6f, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x43, 0x6f, 0x6c, 0x6c, 0x61,
-	0x74, 0x65, 0x72, 0x61, 0x6c, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x73, 0x42,
-	0x79, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x4c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x46, 0x12, 0x44, 0x2f, 0x6d, 0x69, 0x74,
-	0x6f, 0x73, 0x69, 0x73, 0x2f, 0x65, 0x76, 0x6d, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f,
-	0x72, 0x2f, 0x76, 0x31, 0x2f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x2f,
-	0x7b, 0x76, 0x61, 0x6c, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x7d, 0x2f, 0x63, 0x6f, 0x6c, 0x6c, 0x61,
-	0x74, 0x65, 0x72, 0x61, 0x6c, 0x5f, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x73,
-	0x12, 0xe0, 0x01, 0x0a, 0x13, 0x43, 0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x4f,
-	0x77, 0x6e, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x12, 0x38, 0x2e, 0x6d, 0x69, 0x74, 0x6f, 0x73,
+	0x61, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x43, 0x69, 0x72,
+	0x63, 0x75, 0x69, 0x74, 0x42, 0x72, 0x65, 0x61, 0x6b, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x34, 0x2e, 0x6d, 0x69, 0x74, 0x6f, 0x73, 0x69, 0x73, 0x2e, 0x65, 0x76, 0x6d,
+	0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65,
+	0x72, 0x79, 0x43, 0x69, 0x72, 0x63, 0x75, 0x69, 0x74, 0x42, 0x72, 0x65, 0x61, 0x6b, 0x65, 0x72,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x30, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2a,
+	0x12, 0x28, 0x2f, 0x6d, 0x69, 0x74, 0x6f, 0x73, 0x69, 0x73, 0x2f, 0x65, 0x76, 0x6d, 0x76, 0x61,
+	0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x69, 0x72, 0x63, 0x75,
+	0x69, 0x74, 0x5f, 0x62, 0x72, 0x65, 0x61, 0x6b, 0x65, 0x72, 0x12, 0xa4, 0x01, 0x0a, 0x09, 0x56,
+	0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x2e, 0x2e, 0x6d, 0x69, 0x74, 0x6f, 0x73,
+	0x69, 0x73, 0x2e, 0x65, 0x76, 0x6d, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x2e,
+	0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f,
+	0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2f, 0x2e, 0x6d, 0x69, 0x74, 0x6f, 0x73,
 	0x69, 0x73, 0x2e, 0x65, 0x76, 0x6d, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x2e,
-	0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x43, 0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x65, 0x72,
-	0x61, 0x6c, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x39, 0x2e, 0x6d, 0x69, 0x74, 0x6f, 0x73, 0x69, 0x73, 0x2e, 0x65, 0x76, 0x6d,
+	0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f,
+	0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x36, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x30, 0x12, 0x2e, 0x2f, 0x6d, 0x69, 0x74, 0x6f, 0x73, 0x69, 0x73, 0x2f, 0x65, 0x76, 0x6d, 0x76,
+	0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x2f, 0x76, 0x31, 0x2f, 0x76, 0x61, 0x6c, 0x69,
+	0x64, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x2f, 0x7b, 0x76, 0x61, 0x6c, 0x5f, 0x61, 0x64, 0x64, 0x72,
+	0x7d, 0x12, 0xd0, 0x01, 0x0a, 0x13, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x42,
+	0x79, 0x43, 0x6f, 0x6e, 0x73, 0x41, 0x64, 0x64, 0x72, 0x12, 0x38, 0x2e, 0x6d, 0x69, 0x74, 0x6f,
+	0x73, 0x69, 0x73, 0x2e, 0x65, 0x76, 0x6d, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72,
+	0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
+	0x6f, 0x72, 0x42, 0x79, 0x43, 0x6f, 0x6e, 0x73, 0x41, 0x64, 0x64, 0x72, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x39, 0x2e, 0x6d, 0x69, 0x74, 0x6f, 0x73, 0x69, 0x73, 0x2e, 0x65, 0x76,
+	0x6d, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75,
+	0x65, 0x72, 0x79, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x42, 0x79, 0x43, 0x6f,
+	0x6e, 0x73, 0x41, 0x64, 0x64, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x44,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x3e, 0x12, 0x3c, 0x2f, 0x6d, 0x69, 0x74, 0x6f, 0x73, 0x69, 0x73,
+	0x2f, 0x65, 0x76, 0x6d, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x2f, 0x76, 0x31,
+	0x2f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x5f, 0x62, 0x79, 0x5f, 0x63,
+	0x6f, 0x6e, 0x73, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x2f, 0x7b, 0x63, 0x6f, 0x6e, 0x73, 0x5f, 0x61,
+	0x64, 0x64, 0x72, 0x7d, 0x12, 0x9c, 0x01, 0x0a, 0x0a, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
+	0x6f, 0x72, 0x73, 0x12, 0x2f, 0x2e, 0x6d, 0x69, 0x74, 0x6f, 0x73, 0x69, 0x73, 0x2e, 0x65, 0x76,
+	0x6d, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75,
+	0x65, 0x72, 0x79, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x30, 0x2e, 0x6d, 0x69, 0x74, 0x6f, 0x73, 0x69, 0x73, 0x2e, 0x65,
+	0x76, 0x6d, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x51,
+	0x75, 0x65, 0x72, 0x79, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x25, 0x12, 0x23,
+	0x2f, 0x6d, 0x69, 0x74, 0x6f, 0x73, 0x69, 0x73, 0x2f, 0x65, 0x76, 0x6d, 0x76, 0x61, 0x6c, 0x69,
+	0x64, 0x61, 0x74, 0x6f, 0x72, 0x2f, 0x76, 0x31, 0x2f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
+	0x6f, 0x72, 0x73, 0x12, 0xa2, 0x01, 0x0a, 0x0a, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77,
+	0x61, 0x6c, 0x12, 0x2f, 0x2e, 0x6d, 0x69, 0x74, 0x6f, 0x73, 0x69, 0x73, 0x2e, 0x65, 0x76, 0x6d,
 	0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65,
-	0x72, 0x79, 0x43, 0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x4f, 0x77, 0x6e, 0x65,
-	0x72, 0x73, 0x68, 0x69, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x54, 0x82,
-	0xd3, 0xe4, 0x93, 0x02, 0x4e, 0x12, 0x4c, 0x2f, 0x6d, 0x69, 0x74, 0x6f, 0x73, 0x69, 0x73, 0x2f,
+	0x72, 0x79, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x30, 0x2e, 0x6d, 0x69, 0x74, 0x6f, 0x73, 0x69, 0x73, 0x2e, 0x65, 0x76,
+	0x6d, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75,
+	0x65, 0x72, 0x79, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x61, 0x6c, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x31, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2b, 0x12, 0x29, 0x2f,
+	0x6d, 0x69, 0x74, 0x6f, 0x73, 0x69, 0x73, 0x2f, 0x65, 0x76, 0x6d, 0x76, 0x61, 0x6c, 0x69, 0x64,
+	0x61, 0x74, 0x6f, 0x72, 0x2f, 0x76, 0x31, 0x2f, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77,
+	0x61, 0x6c, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x12, 0xa0, 0x01, 0x0a, 0x0b, 0x57, 0x69, 0x74,
+	0x68, 0x64, 0x72, 0x61, 0x77, 0x61, 0x6c, 0x73, 0x12, 0x30, 0x2e, 0x6d, 0x69, 0x74, 0x6f, 0x73,
+	0x69, 0x73, 0x2e, 0x65, 0x76, 0x6d, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x2e,
+	0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77,
+	0x61, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x31, 0x2e, 0x6d, 0x69, 0x74,
+	0x6f, 0x73, 0x69, 0x73, 0x2e, 0x65, 0x76, 0x6d, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f,
+	0x72, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72,
+	0x61, 0x77, 0x61, 0x6c, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2c, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x26, 0x12, 0x24, 0x2f, 0x6d, 0x69, 0x74, 0x6f, 0x73, 0x69, 0x73, 0x2f,
 	0x65, 0x76, 0x6d, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x2f, 0x76, 0x31, 0x2f,
-	0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x2f, 0x7b, 0x76, 0x61, 0x6c, 0x5f,
-	0x61, 0x64, 0x64, 0x72, 0x7d, 0x2f, 0x63, 0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x65, 0x72, 0x61, 0x6c,
-	0x5f, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x73, 0x2f, 0x7b, 0x6f, 0x77, 0x6e,
-	0x65, 0x72, 0x7d, 0x42, 0xe0, 0x01, 0x0a, 0x1b, 0x63, 0x6f, 0x6d, 0x2e, 0x6d, 0x69, 0x74, 0x6f,
+	0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x61, 0x6c, 0x73, 0x12, 0xd7, 0x01, 0x0a, 0x16,
+	0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x61, 0x6c, 0x73, 0x42, 0x79, 0x56, 0x61, 0x6c,
+	0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x3b, 0x2e, 0x6d, 0x69, 0x74, 0x6f, 0x73, 0x69, 0x73,
+	0x2e, 0x65, 0x76, 0x6d, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31,
+	0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x61, 0x6c,
+	0x73, 0x42, 0x79, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x3c, 0x2e, 0x6d, 0x69, 0x74, 0x6f, 0x73, 0x69, 0x73, 0x2e, 0x65, 0x76,
+	0x6d, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75,
+	0x65, 0x72, 0x79, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x61, 0x6c, 0x73, 0x42, 0x79,
+	0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x42, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x3c, 0x12, 0x3a, 0x2f, 0x6d, 0x69, 0x74, 0x6f,
+	0x73, 0x69, 0x73, 0x2f, 0x65, 0x76, 0x6d, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72,
+	0x2f, 0x76, 0x31, 0x2f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x2f, 0x7b,
+	0x76, 0x61, 0x6c, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x7d, 0x2f, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72,
+	0x61, 0x77, 0x61, 0x6c, 0x73, 0x12, 0xc5, 0x01, 0x0a, 0x14, 0x43, 0x6f, 0x6c, 0x6c, 0x61, 0x74,
+	0x65, 0x72, 0x61, 0x6c, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x73, 0x12, 0x39,
+	0x2e, 0x6d, 0x69, 0x74, 0x6f, 0x73, 0x69, 0x73, 0x2e, 0x65, 0x76, 0x6d, 0x76, 0x61, 0x6c, 0x69,
+	0x64, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x43, 0x6f,
+	0x6c, 0x6c, 0x61, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x73, 0x68, 0x69,
+	0x70, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x3a, 0x2e, 0x6d, 0x69, 0x74, 0x6f,
 	0x73, 0x69, 0x73, 0x2e, 0x65, 0x76, 0x6d, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72,
-	0x2e, 0x76, 0x31, 0x42, 0x0a, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50,
-	0x01, 0x5a, 0x37, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f,
-	0x61, 0x70, 0x69, 0x2f, 0x6d, 0x69, 0x74, 0x6f, 0x73, 0x69, 0x73, 0x2f, 0x65, 0x76, 0x6d, 0x76,
-	0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x2f, 0x76, 0x31, 0x3b, 0x65, 0x76, 0x6d, 0x76,
-	0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x4d, 0x45, 0x58,
-	0xaa, 0x02, 0x17, 0x4d, 0x69, 0x74, 0x6f, 0x73, 0x69, 0x73, 0x2e, 0x45, 0x76, 0x6d, 0x76, 0x61,
-	0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x17, 0x4d, 0x69, 0x74,
-	0x6f, 0x73, 0x69, 0x73, 0x5c, 0x45, 0x76, 0x6d, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f,
-	0x72, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x23, 0x4d, 0x69, 0x74, 0x6f, 0x73, 0x69, 0x73, 0x5c, 0x45,
-	0x76, 0x6d, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x5c, 0x56, 0x31, 0x5c, 0x47,
-	0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x19, 0x4d, 0x69, 0x74,
-	0x6f, 0x73, 0x69, 0x73, 0x3a, 0x3a, 0x45, 0x76, 0x6d, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
-	0x6f, 0x72, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x43, 0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x65,
+	0x72, 0x61, 0x6c, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x36, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x30, 0x12, 0x2e, 0x2f,
+	0x6d, 0x69, 0x74, 0x6f, 0x73, 0x69, 0x73, 0x2f, 0x65, 0x76, 0x6d, 0x76, 0x61, 0x6c, 0x69, 0x64,
+	0x61, 0x74, 0x6f, 0x72, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x65, 0x72,
+	0x61, 0x6c, 0x5f, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x73, 0x12, 0xfc, 0x01,
+	0x0a, 0x1f, 0x43, 0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x4f, 0x77, 0x6e, 0x65,
+	0x72, 0x73, 0x68, 0x69, 0x70, 0x73, 0x42, 0x79, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f,
+	0x72, 0x12, 0x44, 0x2e, 0x6d, 0x69, 0x74, 0x6f, 0x73, 0x69, 0x73, 0x2e, 0x65, 0x76, 0x6d, 0x76,
+	0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72,
+	0x79, 0x43, 0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x4f, 0x77, 0x6e, 0x65, 0x72,
+	0x73, 0x68, 0x69, 0x70, 0x73, 0x42, 0x79, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x45, 0x2e, 0x6d, 0x69, 0x74, 0x6f, 0x73, 0x69,
+	0x73, 0x2e, 0x65, 0x76, 0x6d, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x76,
+	0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x43, 0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x65, 0x72, 0x61,
+	0x6c, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x73, 0x42, 0x79, 0x56, 0x61, 0x6c,
+	0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x4c,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x46, 0x12, 0x44, 0x2f, 0x6d, 0x69, 0x74, 0x6f, 0x73, 0x69, 0x73,
+	0x2f, 0x65, 0x76, 0x6d, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x2f, 0x76, 0x31,
+	0x2f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x2f, 0x7b, 0x76, 0x61, 0x6c,
+	0x5f, 0x61, 0x64, 0x64, 0x72, 0x7d, 0x2f, 0x63, 0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x65, 0x72, 0x61,
+	0x6c, 0x5f, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x73, 0x12, 0xe0, 0x01, 0x0a,
+	0x13, 0x43, 0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x4f, 0x77, 0x6e, 0x65, 0x72,
+	0x73, 0x68, 0x69, 0x70, 0x12, 0x38, 0x2e, 0x6d, 0x69, 0x74, 0x6f, 0x73, 0x69, 0x73, 0x2e, 0x65,
+	0x76, 0x6d, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x51,
+	0x75, 0x65, 0x72, 0x79, 0x43, 0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x4f, 0x77,
+	0x6e, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x39,
+	0x2e, 0x6d, 0x69, 0x74, 0x6f, 0x73, 0x69, 0x73, 0x2e, 0x65, 0x76, 0x6d, 0x76, 0x61, 0x6c, 0x69,
+	0x64, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x43, 0x6f,
+	0x6c, 0x6c, 0x61, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x73, 0x68, 0x69,
+	0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x54, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x4e, 0x12, 0x4c, 0x2f, 0x6d, 0x69, 0x74, 0x6f, 0x73, 0x69, 0x73, 0x2f, 0x65, 0x76, 0x6d, 0x76,
+	0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x2f, 0x76, 0x31, 0x2f, 0x76, 0x61, 0x6c, 0x69,
+	0x64, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x2f, 0x7b, 0x76, 0x61, 0x6c, 0x5f, 0x61, 0x64, 0x64, 0x72,
+	0x7d, 0x2f, 0x63, 0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x5f, 0x6f, 0x77, 0x6e,
+	0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x73, 0x2f, 0x7b, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x7d, 0x12,
+	0xb7, 0x01, 0x0a, 0x0c, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74,
+	0x12, 0x31, 0x2e, 0x6d, 0x69, 0x74, 0x6f, 0x73, 0x69, 0x73, 0x2e, 0x65, 0x76, 0x6d, 0x76, 0x61,
+	0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79,
+	0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x32, 0x2e, 0x6d, 0x69, 0x74, 0x6f, 0x73, 0x69, 0x73, 0x2e, 0x65, 0x76,
+	0x6d, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75,
+	0x65, 0x72, 0x79, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x40, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x3a, 0x12,
+	0x38, 0x2f, 0x6d, 0x69, 0x74, 0x6f, 0x73, 0x69, 0x73, 0x2f, 0x65, 0x76, 0x6d, 0x76, 0x61, 0x6c,
+	0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x2f, 0x76, 0x31, 0x2f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f,
+	0x72, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x73, 0x2f, 0x7b, 0x65, 0x76, 0x6d, 0x5f, 0x62, 0x6c,
+	0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x7d, 0x12, 0xbc, 0x01, 0x0a, 0x0f, 0x54, 0x78,
+	0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x73, 0x12, 0x34, 0x2e,
+	0x6d, 0x69, 0x74, 0x6f, 0x73, 0x69, 0x73, 0x2e, 0x65, 0x76, 0x6d, 0x76, 0x61, 0x6c, 0x69, 0x64,
+	0x61, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x54, 0x78, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x35, 0x2e, 0x6d, 0x69, 0x74, 0x6f, 0x73, 0x69, 0x73, 0x2e, 0x65, 0x76,
+	0x6d, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75,
+	0x65, 0x72, 0x79, 0x54, 0x78, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70,
+	0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3c, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x36, 0x12, 0x34, 0x2f, 0x6d, 0x69, 0x74, 0x6f, 0x73, 0x69, 0x73, 0x2f, 0x65, 0x76, 0x6d,
+	0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x2f, 0x76, 0x31, 0x2f, 0x74, 0x78, 0x5f,
+	0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x72, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x73, 0x2f, 0x7b,
+	0x74, 0x78, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x7d, 0x42, 0xe0, 0x01, 0x0a, 0x1b, 0x63, 0x6f, 0x6d,
+	0x2e, 0x6d, 0x69, 0x74, 0x6f, 0x73, 0x69, 0x73, 0x2e, 0x65, 0x76, 0x6d, 0x76, 0x61, 0x6c, 0x69,
+	0x64, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x42, 0x0a, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50,
+	0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x37, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64,
+	0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6d, 0x69, 0x74, 0x6f, 0x73, 0x69, 0x73,
+	0x2f, 0x65, 0x76, 0x6d, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x2f, 0x76, 0x31,
+	0x3b, 0x65, 0x76, 0x6d, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x76, 0x31, 0xa2,
+	0x02, 0x03, 0x4d, 0x45, 0x58, 0xaa, 0x02, 0x17, 0x4d, 0x69, 0x74, 0x6f, 0x73, 0x69, 0x73, 0x2e,
+	0x45, 0x76, 0x6d, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x56, 0x31, 0xca,
+	0x02, 0x17, 0x4d, 0x69, 0x74, 0x6f, 0x73, 0x69, 0x73, 0x5c, 0x45, 0x76, 0x6d, 0x76, 0x61, 0x6c,
+	0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x23, 0x4d, 0x69, 0x74, 0x6f,
+	0x73, 0x69, 0x73, 0x5c, 0x45, 0x76, 0x6d, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72,
+	0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea,
+	0x02, 0x19, 0x4d, 0x69, 0x74, 0x6f, 0x73, 0x69, 0x73, 0x3a, 0x3a, 0x45, 0x76, 0x6d, 0x76, 0x61,
+	0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x33,
 }
 
 var (
@@ -12014,87 +14919,104 @@ func file_mitosis_evmvalidator_v1_query_proto_rawDescGZIP() []byte {
 	return file_mitosis_evmvalidator_v1_query_proto_rawDescData
 }
 
-var file_mitosis_evmvalidator_v1_query_proto_msgTypes = make([]protoimpl.MessageInfo, 23)
+var file_mitosis_evmvalidator_v1_query_proto_msgTypes = make([]protoimpl.MessageInfo, 29)
 var file_mitosis_evmvalidator_v1_query_proto_goTypes = []interface{}{
 	(*QueryParamsRequest)(nil),                           // 0: mitosis.evmvalidator.v1.QueryParamsRequest
 	(*QueryParamsResponse)(nil),                          // 1: mitosis.evmvalidator.v1.QueryParamsResponse
 	(*QueryValidatorEntrypointContractAddrRequest)(nil),  // 2: mitosis.evmvalidator.v1.QueryValidatorEntrypointContractAddrRequest
 	(*QueryValidatorEntrypointContractAddrResponse)(nil), // 3: mitosis.evmvalidator.v1.QueryValidatorEntrypointContractAddrResponse
-	(*QueryValidatorRequest)(nil),                        // 4: mitosis.evmvalidator.v1.QueryValidatorRequest
-	(*QueryValidatorResponse)(nil),                       // 5: mitosis.evmvalidator.v1.QueryValidatorResponse
-	(*QueryValidatorByConsAddrRequest)(nil),              // 6: mitosis.evmvalidator.v1.QueryValidatorByConsAddrRequest
-	(*QueryValidatorByConsAddrResponse)(nil),             // 7: mitosis.evmvalidator.v1.QueryValidatorByConsAddrResponse
-	(*QueryValidatorsRequest)(nil),                       // 8: mitosis.evmvalidator.v1.QueryValidatorsRequest
-	(*QueryValidatorsResponse)(nil),                      // 9: mitosis.evmvalidator.v1.QueryValidatorsResponse
-	(*QueryWithdrawalRequest)(nil),                       // 10: mitosis.evmvalidator.v1.QueryWithdrawalRequest
-	(*QueryWithdrawalResponse)(nil),                      // 11: mitosis.evmvalidator.v1.QueryWithdrawalResponse
-	(*QueryWithdrawalsRequest)(nil),                      // 12: mitosis.evmvalidator.v1.QueryWithdrawalsRequest
-	(*QueryWithdrawalsResponse)(nil),                     // 13: mitosis.evmvalidator.v1.QueryWithdrawalsResponse
-	(*QueryWithdrawalsByValidatorRequest)(nil),           // 14: mitosis.evmvalidator.v1.QueryWithdrawalsByValidatorRequest
-	(*QueryWithdrawalsByValidatorResponse)(nil),          // 15: mitosis.evmvalidator.v1.QueryWithdrawalsByValidatorResponse
-	(*CollateralOwnershipWithAmount)(nil),                // 16: mitosis.evmvalidator.v1.CollateralOwnershipWithAmount
-	(*QueryCollateralOwnershipsRequest)(nil),             // 17: mitosis.evmvalidator.v1.QueryCollateralOwnershipsRequest
-	(*QueryCollateralOwnershipsResponse)(nil),            // 18: mitosis.evmvalidator.v1.QueryCollateralOwnershipsResponse
-	(*QueryCollateralOwnershipsByValidatorRequest)(nil),  // 19: mitosis.evmvalidator.v1.QueryCollateralOwnershipsByValidatorRequest
-	(*QueryCollateralOwnershipsByValidatorResponse)(nil), // 20: mitosis.evmvalidator.v1.QueryCollateralOwnershipsByValidatorResponse
-	(*QueryCollateralOwnershipRequest)(nil),              // 21: mitosis.evmvalidator.v1.QueryCollateralOwnershipRequest
-	(*QueryCollateralOwnershipResponse)(nil),             // 22: mitosis.evmvalidator.v1.QueryCollateralOwnershipResponse
-	(*Params)(nil),                                       // 23: mitosis.evmvalidator.v1.Params
-	(*Validator)(nil),                                    // 24: mitosis.evmvalidator.v1.Validator
-	(*v1beta1.PageRequest)(nil),                          // 25: cosmos.base.query.v1beta1.PageRequest
-	(*v1beta1.PageResponse)(nil),                         // 26: cosmos.base.query.v1beta1.PageResponse
-	(*Withdrawal)(nil),                                   // 27: mitosis.evmvalidator.v1.Withdrawal
-	(*CollateralOwnership)(nil),                          // 28: mitosis.evmvalidator.v1.CollateralOwnership
+	(*QueryCircuitBreakerRequest)(nil),                   // 4: mitosis.evmvalidator.v1.QueryCircuitBreakerRequest
+	(*QueryCircuitBreakerResponse)(nil),                  // 5: mitosis.evmvalidator.v1.QueryCircuitBreakerResponse
+	(*QueryValidatorRequest)(nil),                        // 6: mitosis.evmvalidator.v1.QueryValidatorRequest
+	(*QueryValidatorResponse)(nil),                       // 7: mitosis.evmvalidator.v1.QueryValidatorResponse
+	(*QueryValidatorByConsAddrRequest)(nil),              // 8: mitosis.evmvalidator.v1.QueryValidatorByConsAddrRequest
+	(*QueryValidatorByConsAddrResponse)(nil),             // 9: mitosis.evmvalidator.v1.QueryValidatorByConsAddrResponse
+	(*QueryValidatorsRequest)(nil),                       // 10: mitosis.evmvalidator.v1.QueryValidatorsRequest
+	(*QueryValidatorsResponse)(nil),                      // 11: mitosis.evmvalidator.v1.QueryValidatorsResponse
+	(*QueryWithdrawalRequest)(nil),                       // 12: mitosis.evmvalidator.v1.QueryWithdrawalRequest
+	(*QueryWithdrawalResponse)(nil),                      // 13: mitosis.evmvalidator.v1.QueryWithdrawalResponse
+	(*QueryWithdrawalsRequest)(nil),                      // 14: mitosis.evmvalidator.v1.QueryWithdrawalsRequest
+	(*QueryWithdrawalsResponse)(nil),                     // 15: mitosis.evmvalidator.v1.QueryWithdrawalsResponse
+	(*QueryWithdrawalsByValidatorRequest)(nil),           // 16: mitosis.evmvalidator.v1.QueryWithdrawalsByValidatorRequest
+	(*QueryWithdrawalsByValidatorResponse)(nil),          // 17: mitosis.evmvalidator.v1.QueryWithdrawalsByValidatorResponse
+	(*CollateralOwnershipWithAmount)(nil),                // 18: mitosis.evmvalidator.v1.CollateralOwnershipWithAmount
+	(*QueryCollateralOwnershipsRequest)(nil),             // 19: mitosis.evmvalidator.v1.QueryCollateralOwnershipsRequest
+	(*QueryCollateralOwnershipsResponse)(nil),            // 20: mitosis.evmvalidator.v1.QueryCollateralOwnershipsResponse
+	(*QueryCollateralOwnershipsByValidatorRequest)(nil),  // 21: mitosis.evmvalidator.v1.QueryCollateralOwnershipsByValidatorRequest
+	(*QueryCollateralOwnershipsByValidatorResponse)(nil), // 22: mitosis.evmvalidator.v1.QueryCollateralOwnershipsByValidatorResponse
+	(*QueryCollateralOwnershipRequest)(nil),              // 23: mitosis.evmvalidator.v1.QueryCollateralOwnershipRequest
+	(*QueryCollateralOwnershipResponse)(nil),             // 24: mitosis.evmvalidator.v1.QueryCollateralOwnershipResponse
+	(*QueryEventReceiptRequest)(nil),                     // 25: mitosis.evmvalidator.v1.QueryEventReceiptRequest
+	(*QueryEventReceiptResponse)(nil),                    // 26: mitosis.evmvalidator.v1.QueryEventReceiptResponse
+	(*QueryTxEventReceiptsRequest)(nil),                  // 27: mitosis.evmvalidator.v1.QueryTxEventReceiptsRequest
+	(*QueryTxEventReceiptsResponse)(nil),                 // 28: mitosis.evmvalidator.v1.QueryTxEventReceiptsResponse
+	(*Params)(nil),                                       // 29: mitosis.evmvalidator.v1.Params
+	(*CircuitBreaker)(nil),                               // 30: mitosis.evmvalidator.v1.CircuitBreaker
+	(*Validator)(nil),                                    // 31: mitosis.evmvalidator.v1.Validator
+	(*v1beta1.PageRequest)(nil),                          // 32: cosmos.base.query.v1beta1.PageRequest
+	(*v1beta1.PageResponse)(nil),                         // 33: cosmos.base.query.v1beta1.PageResponse
+	(*Withdrawal)(nil),                                   // 34: mitosis.evmvalidator.v1.Withdrawal
+	(*CollateralOwnership)(nil),                          // 35: mitosis.evmvalidator.v1.CollateralOwnership
+	(*EventReceipt)(nil),                                 // 36: mitosis.evmvalidator.v1.EventReceipt
 }
 var file_mitosis_evmvalidator_v1_query_proto_depIdxs = []int32{
-	23, // 0: mitosis.evmvalidator.v1.QueryParamsResponse.params:type_name -> mitosis.evmvalidator.v1.Params
-	24, // 1: mitosis.evmvalidator.v1.QueryValidatorResponse.validator:type_name -> mitosis.evmvalidator.v1.Validator
-	24, // 2: mitosis.evmvalidator.v1.QueryValidatorByConsAddrResponse.validator:type_name -> mitosis.evmvalidator.v1.Validator
-	25, // 3: mitosis.evmvalidator.v1.QueryValidatorsRequest.pagination:type_name -> cosmos.base.query.v1beta1.PageRequest
-	24, // 4: mitosis.evmvalidator.v1.QueryValidatorsResponse.validators:type_name -> mitosis.evmvalidator.v1.Validator
-	26, // 5: mitosis.evmvalidator.v1.QueryValidatorsResponse.pagination:type_name -> cosmos.base.query.v1beta1.PageResponse
-	27, // 6: mitosis.evmvalidator.v1.QueryWithdrawalResponse.withdrawal:type_name -> mitosis.evmvalidator.v1.Withdrawal
-	25, // 7: mitosis.evmvalidator.v1.QueryWithdrawalsRequest.pagination:type_name -> cosmos.base.query.v1beta1.PageRequest
-	27, // 8: mitosis.evmvalidator.v1.QueryWithdrawalsResponse.withdrawals:type_name -> mitosis.evmvalidator.v1.Withdrawal
-	26, // 9: mitosis.evmvalidator.v1.QueryWithdrawalsResponse.pagination:type_name -> cosmos.base.query.v1beta1.PageResponse
-	25, // 10: mitosis.evmvalidator.v1.QueryWithdrawalsByValidatorRequest.pagination:type_name -> cosmos.base.query.v1beta1.PageRequest
-	27, // 11: mitosis.evmvalidator.v1.QueryWithdrawalsByValidatorResponse.withdrawals:type_name -> mitosis.evmvalidator.v1.Withdrawal
-	26, // 12: mitosis.evmvalidator.v1.QueryWithdrawalsByValidatorResponse.pagination:type_name -> cosmos.base.query.v1beta1.PageResponse
-	28, // 13: mitosis.evmvalidator.v1.CollateralOwnershipWithAmount.ownership:type_name -> mitosis.evmvalidator.v1.CollateralOwnership
-	25, // 14: mitosis.evmvalidator.v1.QueryCollateralOwnershipsRequest.pagination:type_name -> cosmos.base.query.v1beta1.PageRequest
-	16, // 15: mitosis.evmvalidator.v1.QueryCollateralOwnershipsResponse.collateral_ownerships:type_name -> mitosis.evmvalidator.v1.CollateralOwnershipWithAmount
-	26, // 16: mitosis.evmvalidator.v1.QueryCollateralOwnershipsResponse.pagination:type_name -> cosmos.base.query.v1beta1.PageResponse
-	25, // 17: mitosis.evmvalidator.v1.QueryCollateralOwnershipsByValidatorRequest.pagination:type_name -> cosmos.base.query.v1beta1.PageRequest
-	16, // 18: mitosis.evmvalidator.v1.QueryCollateralOwnershipsByValidatorResponse.collateral_ownerships:type_name -> mitosis.evmvalidator.v1.CollateralOwnershipWithAmount
-	26, // 19: mitosis.evmvalidator.v1.QueryCollateralOwnershipsByValidatorResponse.pagination:type_name -> cosmos.base.query.v1beta1.PageResponse
-	16, // 20: mitosis.evmvalidator.v1.QueryCollateralOwnershipResponse.collateral_ownership:type_name -> mitosis.evmvalidator.v1.CollateralOwnershipWithAmount
-	0,  // 21: mitosis.evmvalidator.v1.Query.Params:input_type -> mitosis.evmvalidator.v1.QueryParamsRequest
-	2,  // 22: mitosis.evmvalidator.v1.Query.ValidatorEntrypointContractAddr:input_type -> mitosis.evmvalidator.v1.QueryValidatorEntrypointContractAddrRequest
-	4,  // 23: mitosis.evmvalidator.v1.Query.Validator:input_type -> mitosis.evmvalidator.v1.QueryValidatorRequest
-	6,  // 24: mitosis.evmvalidator.v1.Query.ValidatorByConsAddr:input_type -> mitosis.evmvalidator.v1.QueryValidatorByConsAddrRequest
-	8,  // 25: mitosis.evmvalidator.v1.Query.Validators:input_type -> mitosis.evmvalidator.v1.QueryValidatorsRequest
-	10, // 26: mitosis.evmvalidator.v1.Query.Withdrawal:input_type -> mitosis.evmvalidator.v1.QueryWithdrawalRequest
-	12, // 27: mitosis.evmvalidator.v1.Query.Withdrawals:input_type -> mitosis.evmvalidator.v1.QueryWithdrawalsRequest
-	14, // 28: mitosis.evmvalidator.v1.Query.WithdrawalsByValidator:input_type -> mitosis.evmvalidator.v1.QueryWithdrawalsByValidatorRequest
-	17, // 29: mitosis.evmvalidator.v1.Query.CollateralOwnerships:input_type -> mitosis.evmvalidator.v1.QueryCollateralOwnershipsRequest
-	19, // 30: mitosis.evmvalidator.v1.Query.CollateralOwnershipsByValidator:input_type -> mitosis.evmvalidator.v1.QueryCollateralOwnershipsByValidatorRequest
-	21, // 31: mitosis.evmvalidator.v1.Query.CollateralOwnership:input_type -> mitosis.evmvalidator.v1.QueryCollateralOwnershipRequest
-	1,  // 32: mitosis.evmvalidator.v1.Query.Params:output_type -> mitosis.evmvalidator.v1.QueryParamsResponse
-	3,  // 33: mitosis.evmvalidator.v1.Query.ValidatorEntrypointContractAddr:output_type -> mitosis.evmvalidator.v1.QueryValidatorEntrypointContractAddrResponse
-	5,  // 34: mitosis.evmvalidator.v1.Query.Validator:output_type -> mitosis.evmvalidator.v1.QueryValidatorResponse
-	7,  // 35: mitosis.evmvalidator.v1.Query.ValidatorByConsAddr:output_type -> mitosis.evmvalidator.v1.QueryValidatorByConsAddrResponse
-	9,  // 36: mitosis.evmvalidator.v1.Query.Validators:output_type -> mitosis.evmvalidator.v1.QueryValidatorsResponse
-	11, // 37: mitosis.evmvalidator.v1.Query.Withdrawal:output_type -> mitosis.evmvalidator.v1.QueryWithdrawalResponse
-	13, // 38: mitosis.evmvalidator.v1.Query.Withdrawals:output_type -> mitosis.evmvalidator.v1.QueryWithdrawalsResponse
-	15, // 39: mitosis.evmvalidator.v1.Query.WithdrawalsByValidator:output_type -> mitosis.evmvalidator.v1.QueryWithdrawalsByValidatorResponse
-	18, // 40: mitosis.evmvalidator.v1.Query.CollateralOwnerships:output_type -> mitosis.evmvalidator.v1.QueryCollateralOwnershipsResponse
-	20, // 41: mitosis.evmvalidator.v1.Query.CollateralOwnershipsByValidator:output_type -> mitosis.evmvalidator.v1.QueryCollateralOwnershipsByValidatorResponse
-	22, // 42: mitosis.evmvalidator.v1.Query.CollateralOwnership:output_type -> mitosis.evmvalidator.v1.QueryCollateralOwnershipResponse
-	32, // [32:43] is the sub-list for method output_type
-	21, // [21:32] is the sub-list for method input_type
-	21, // [21:21] is the sub-list for extension type_name
-	21, // [21:21] is the sub-list for extension extendee
-	0,  // [0:21] is the sub-list for field type_name
+	29, // 0: mitosis.evmvalidator.v1.QueryParamsResponse.params:type_name -> mitosis.evmvalidator.v1.Params
+	30, // 1: mitosis.evmvalidator.v1.QueryCircuitBreakerResponse.circuit_breaker:type_name -> mitosis.evmvalidator.v1.CircuitBreaker
+	31, // 2: mitosis.evmvalidator.v1.QueryValidatorResponse.validator:type_name -> mitosis.evmvalidator.v1.Validator
+	31, // 3: mitosis.evmvalidator.v1.QueryValidatorByConsAddrResponse.validator:type_name -> mitosis.evmvalidator.v1.Validator
+	32, // 4: mitosis.evmvalidator.v1.QueryValidatorsRequest.pagination:type_name -> cosmos.base.query.v1beta1.PageRequest
+	31, // 5: mitosis.evmvalidator.v1.QueryValidatorsResponse.validators:type_name -> mitosis.evmvalidator.v1.Validator
+	33, // 6: mitosis.evmvalidator.v1.QueryValidatorsResponse.pagination:type_name -> cosmos.base.query.v1beta1.PageResponse
+	34, // 7: mitosis.evmvalidator.v1.QueryWithdrawalResponse.withdrawal:type_name -> mitosis.evmvalidator.v1.Withdrawal
+	32, // 8: mitosis.evmvalidator.v1.QueryWithdrawalsRequest.pagination:type_name -> cosmos.base.query.v1beta1.PageRequest
+	34, // 9: mitosis.evmvalidator.v1.QueryWithdrawalsResponse.withdrawals:type_name -> mitosis.evmvalidator.v1.Withdrawal
+	33, // 10: mitosis.evmvalidator.v1.QueryWithdrawalsResponse.pagination:type_name -> cosmos.base.query.v1beta1.PageResponse
+	32, // 11: mitosis.evmvalidator.v1.QueryWithdrawalsByValidatorRequest.pagination:type_name -> cosmos.base.query.v1beta1.PageRequest
+	34, // 12: mitosis.evmvalidator.v1.QueryWithdrawalsByValidatorResponse.withdrawals:type_name -> mitosis.evmvalidator.v1.Withdrawal
+	33, // 13: mitosis.evmvalidator.v1.QueryWithdrawalsByValidatorResponse.pagination:type_name -> cosmos.base.query.v1beta1.PageResponse
+	35, // 14: mitosis.evmvalidator.v1.CollateralOwnershipWithAmount.ownership:type_name -> mitosis.evmvalidator.v1.CollateralOwnership
+	32, // 15: mitosis.evmvalidator.v1.QueryCollateralOwnershipsRequest.pagination:type_name -> cosmos.base.query.v1beta1.PageRequest
+	18, // 16: mitosis.evmvalidator.v1.QueryCollateralOwnershipsResponse.collateral_ownerships:type_name -> mitosis.evmvalidator.v1.CollateralOwnershipWithAmount
+	33, // 17: mitosis.evmvalidator.v1.QueryCollateralOwnershipsResponse.pagination:type_name -> cosmos.base.query.v1beta1.PageResponse
+	32, // 18: mitosis.evmvalidator.v1.QueryCollateralOwnershipsByValidatorRequest.pagination:type_name -> cosmos.base.query.v1beta1.PageRequest
+	18, // 19: mitosis.evmvalidator.v1.QueryCollateralOwnershipsByValidatorResponse.collateral_ownerships:type_name -> mitosis.evmvalidator.v1.CollateralOwnershipWithAmount
+	33, // 20: mitosis.evmvalidator.v1.QueryCollateralOwnershipsByValidatorResponse.pagination:type_name -> cosmos.base.query.v1beta1.PageResponse
+	18, // 21: mitosis.evmvalidator.v1.QueryCollateralOwnershipResponse.collateral_ownership:type_name -> mitosis.evmvalidator.v1.CollateralOwnershipWithAmount
+	36, // 22: mitosis.evmvalidator.v1.QueryEventReceiptResponse.receipts:type_name -> mitosis.evmvalidator.v1.EventReceipt
+	36, // 23: mitosis.evmvalidator.v1.QueryTxEventReceiptsResponse.receipts:type_name -> mitosis.evmvalidator.v1.EventReceipt
+	0,  // 24: mitosis.evmvalidator.v1.Query.Params:input_type -> mitosis.evmvalidator.v1.QueryParamsRequest
+	2,  // 25: mitosis.evmvalidator.v1.Query.ValidatorEntrypointContractAddr:input_type -> mitosis.evmvalidator.v1.QueryValidatorEntrypointContractAddrRequest
+	4,  // 26: mitosis.evmvalidator.v1.Query.CircuitBreaker:input_type -> mitosis.evmvalidator.v1.QueryCircuitBreakerRequest
+	6,  // 27: mitosis.evmvalidator.v1.Query.Validator:input_type -> mitosis.evmvalidator.v1.QueryValidatorRequest
+	8,  // 28: mitosis.evmvalidator.v1.Query.ValidatorByConsAddr:input_type -> mitosis.evmvalidator.v1.QueryValidatorByConsAddrRequest
+	10, // 29: mitosis.evmvalidator.v1.Query.Validators:input_type -> mitosis.evmvalidator.v1.QueryValidatorsRequest
+	12, // 30: mitosis.evmvalidator.v1.Query.Withdrawal:input_type -> mitosis.evmvalidator.v1.QueryWithdrawalRequest
+	14, // 31: mitosis.evmvalidator.v1.Query.Withdrawals:input_type -> mitosis.evmvalidator.v1.QueryWithdrawalsRequest
+	16, // 32: mitosis.evmvalidator.v1.Query.WithdrawalsByValidator:input_type -> mitosis.evmvalidator.v1.QueryWithdrawalsByValidatorRequest
+	19, // 33: mitosis.evmvalidator.v1.Query.CollateralOwnerships:input_type -> mitosis.evmvalidator.v1.QueryCollateralOwnershipsRequest
+	21, // 34: mitosis.evmvalidator.v1.Query.CollateralOwnershipsByValidator:input_type -> mitosis.evmvalidator.v1.QueryCollateralOwnershipsByValidatorRequest
+	23, // 35: mitosis.evmvalidator.v1.Query.CollateralOwnership:input_type -> mitosis.evmvalidator.v1.QueryCollateralOwnershipRequest
+	25, // 36: mitosis.evmvalidator.v1.Query.EventReceipt:input_type -> mitosis.evmvalidator.v1.QueryEventReceiptRequest
+	27, // 37: mitosis.evmvalidator.v1.Query.TxEventReceipts:input_type -> mitosis.evmvalidator.v1.QueryTxEventReceiptsRequest
+	1,  // 38: mitosis.evmvalidator.v1.Query.Params:output_type -> mitosis.evmvalidator.v1.QueryParamsResponse
+	3,  // 39: mitosis.evmvalidator.v1.Query.ValidatorEntrypointContractAddr:output_type -> mitosis.evmvalidator.v1.QueryValidatorEntrypointContractAddrResponse
+	5,  // 40: mitosis.evmvalidator.v1.Query.CircuitBreaker:output_type -> mitosis.evmvalidator.v1.QueryCircuitBreakerResponse
+	7,  // 41: mitosis.evmvalidator.v1.Query.Validator:output_type -> mitosis.evmvalidator.v1.QueryValidatorResponse
+	9,  // 42: mitosis.evmvalidator.v1.Query.ValidatorByConsAddr:output_type -> mitosis.evmvalidator.v1.QueryValidatorByConsAddrResponse
+	11, // 43: mitosis.evmvalidator.v1.Query.Validators:output_type -> mitosis.evmvalidator.v1.QueryValidatorsResponse
+	13, // 44: mitosis.evmvalidator.v1.Query.Withdrawal:output_type -> mitosis.evmvalidator.v1.QueryWithdrawalResponse
+	15, // 45: mitosis.evmvalidator.v1.Query.Withdrawals:output_type -> mitosis.evmvalidator.v1.QueryWithdrawalsResponse
+	17, // 46: mitosis.evmvalidator.v1.Query.WithdrawalsByValidator:output_type -> mitosis.evmvalidator.v1.QueryWithdrawalsByValidatorResponse
+	20, // 47: mitosis.evmvalidator.v1.Query.CollateralOwnerships:output_type -> mitosis.evmvalidator.v1.QueryCollateralOwnershipsResponse
+	22, // 48: mitosis.evmvalidator.v1.Query.CollateralOwnershipsByValidator:output_type -> mitosis.evmvalidator.v1.QueryCollateralOwnershipsByValidatorResponse
+	24, // 49: mitosis.evmvalidator.v1.Query.CollateralOwnership:output_type -> mitosis.evmvalidator.v1.QueryCollateralOwnershipResponse
+	26, // 50: mitosis.evmvalidator.v1.Query.EventReceipt:output_type -> mitosis.evmvalidator.v1.QueryEventReceiptResponse
+	28, // 51: mitosis.evmvalidator.v1.Query.TxEventReceipts:output_type -> mitosis.evmvalidator.v1.QueryTxEventReceiptsResponse
+	38, // [38:52] is the sub-list for method output_type
+	24, // [24:38] is the sub-list for method input_type
+	24, // [24:24] is the sub-list for extension type_name
+	24, // [24:24] is the sub-list for extension extendee
+	0,  // [0:24] is the sub-list for field type_name
 }
 
 func init() { file_mitosis_evmvalidator_v1_query_proto_init() }
@@ -12104,6 +15026,7 @@ func file_mitosis_evmvalidator_v1_query_proto_init() {
 	}
 	file_mitosis_evmvalidator_v1_params_proto_init()
 	file_mitosis_evmvalidator_v1_validator_proto_init()
+	file_mitosis_evmvalidator_v1_circuit_breaker_proto_init()
 	if !protoimpl.UnsafeEnabled {
 		file_mitosis_evmvalidator_v1_query_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*QueryParamsRequest); i {
@@ -12154,7 +15077,7 @@ func file_mitosis_evmvalidator_v1_query_proto_init() {
 			}
 		}
 		file_mitosis_evmvalidator_v1_query_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryValidatorRequest); i {
+			switch v := v.(*QueryCircuitBreakerRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12166,7 +15089,7 @@ func file_mitosis_evmvalidator_v1_query_proto_init() {
 			}
 		}
 		file_mitosis_evmvalidator_v1_query_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryValidatorResponse); i {
+			switch v := v.(*QueryCircuitBreakerResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12178,7 +15101,7 @@ func file_mitosis_evmvalidator_v1_query_proto_init() {
 			}
 		}
 		file_mitosis_evmvalidator_v1_query_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryValidatorByConsAddrRequest); i {
+			switch v := v.(*QueryValidatorRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12190,7 +15113,7 @@ func file_mitosis_evmvalidator_v1_query_proto_init() {
 			}
 		}
 		file_mitosis_evmvalidator_v1_query_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryValidatorByConsAddrResponse); i {
+			switch v := v.(*QueryValidatorResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12202,7 +15125,7 @@ func file_mitosis_evmvalidator_v1_query_proto_init() {
 			}
 		}
 		file_mitosis_evmvalidator_v1_query_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryValidatorsRequest); i {
+			switch v := v.(*QueryValidatorByConsAddrRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12214,7 +15137,7 @@ func file_mitosis_evmvalidator_v1_query_proto_init() {
 			}
 		}
 		file_mitosis_evmvalidator_v1_query_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryValidatorsResponse); i {
+			switch v := v.(*QueryValidatorByConsAddrResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12226,7 +15149,7 @@ func file_mitosis_evmvalidator_v1_query_proto_init() {
 			}
 		}
 		file_mitosis_evmvalidator_v1_query_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryWithdrawalRequest); i {
+			switch v := v.(*QueryValidatorsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12238,7 +15161,7 @@ func file_mitosis_evmvalidator_v1_query_proto_init() {
 			}
 		}
 		file_mitosis_evmvalidator_v1_query_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryWithdrawalResponse); i {
+			switch v := v.(*QueryValidatorsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12250,7 +15173,7 @@ func file_mitosis_evmvalidator_v1_query_proto_init() {
 			}
 		}
 		file_mitosis_evmvalidator_v1_query_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryWithdrawalsRequest); i {
+			switch v := v.(*QueryWithdrawalRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12262,7 +15185,7 @@ func file_mitosis_evmvalidator_v1_query_proto_init() {
 			}
 		}
 		file_mitosis_evmvalidator_v1_query_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryWithdrawalsResponse); i {
+			switch v := v.(*QueryWithdrawalResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12274,7 +15197,7 @@ func file_mitosis_evmvalidator_v1_query_proto_init() {
 			}
 		}
 		file_mitosis_evmvalidator_v1_query_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryWithdrawalsByValidatorRequest); i {
+			switch v := v.(*QueryWithdrawalsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12286,7 +15209,7 @@ func file_mitosis_evmvalidator_v1_query_proto_init() {
 			}
 		}
 		file_mitosis_evmvalidator_v1_query_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryWithdrawalsByValidatorResponse); i {
+			switch v := v.(*QueryWithdrawalsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12298,7 +15221,7 @@ func file_mitosis_evmvalidator_v1_query_proto_init() {
 			}
 		}
 		file_mitosis_evmvalidator_v1_query_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CollateralOwnershipWithAmount); i {
+			switch v := v.(*QueryWithdrawalsByValidatorRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12310,7 +15233,7 @@ func file_mitosis_evmvalidator_v1_query_proto_init() {
 			}
 		}
 		file_mitosis_evmvalidator_v1_query_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryCollateralOwnershipsRequest); i {
+			switch v := v.(*QueryWithdrawalsByValidatorResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12322,7 +15245,7 @@ func file_mitosis_evmvalidator_v1_query_proto_init() {
 			}
 		}
 		file_mitosis_evmvalidator_v1_query_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryCollateralOwnershipsResponse); i {
+			switch v := v.(*CollateralOwnershipWithAmount); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12334,7 +15257,7 @@ func file_mitosis_evmvalidator_v1_query_proto_init() {
 			}
 		}
 		file_mitosis_evmvalidator_v1_query_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryCollateralOwnershipsByValidatorRequest); i {
+			switch v := v.(*QueryCollateralOwnershipsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12346,7 +15269,7 @@ func file_mitosis_evmvalidator_v1_query_proto_init() {
 			}
 		}
 		file_mitosis_evmvalidator_v1_query_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryCollateralOwnershipsByValidatorResponse); i {
+			switch v := v.(*QueryCollateralOwnershipsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12358,7 +15281,7 @@ func file_mitosis_evmvalidator_v1_query_proto_init() {
 			}
 		}
 		file_mitosis_evmvalidator_v1_query_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryCollateralOwnershipRequest); i {
+			switch v := v.(*QueryCollateralOwnershipsByValidatorRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12370,6 +15293,30 @@ func file_mitosis_evmvalidator_v1_query_proto_init() {
 			}
 		}
 		file_mitosis_evmvalidator_v1_query_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryCollateralOwnershipsByValidatorResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mitosis_evmvalidator_v1_query_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryCollateralOwnershipRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mitosis_evmvalidator_v1_query_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*QueryCollateralOwnershipResponse); i {
 			case 0:
 				return &v.state
@@ -12381,6 +15328,54 @@ func file_mitosis_evmvalidator_v1_query_proto_init() {
 				return nil
 			}
 		}
+		file_mitosis_evmvalidator_v1_query_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryEventReceiptRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mitosis_evmvalidator_v1_query_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryEventReceiptResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mitosis_evmvalidator_v1_query_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryTxEventReceiptsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mitosis_evmvalidator_v1_query_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryTxEventReceiptsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -12388,7 +15383,7 @@ func file_mitosis_evmvalidator_v1_query_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_mitosis_evmvalidator_v1_query_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   23,
+			NumMessages:   29,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
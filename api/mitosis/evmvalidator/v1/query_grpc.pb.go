@@ -21,6 +21,7 @@ const _ = grpc.SupportPackageIsVersion7
 const (
 	Query_Params_FullMethodName                          = "/mitosis.evmvalidator.v1.Query/Params"
 	Query_ValidatorEntrypointContractAddr_FullMethodName = "/mitosis.evmvalidator.v1.Query/ValidatorEntrypointContractAddr"
+	Query_CircuitBreaker_FullMethodName                  = "/mitosis.evmvalidator.v1.Query/CircuitBreaker"
 	Query_Validator_FullMethodName                       = "/mitosis.evmvalidator.v1.Query/Validator"
 	Query_ValidatorByConsAddr_FullMethodName             = "/mitosis.evmvalidator.v1.Query/ValidatorByConsAddr"
 	Query_Validators_FullMethodName                      = "/mitosis.evmvalidator.v1.Query/Validators"
@@ -30,6 +31,8 @@ const (
 	Query_CollateralOwnerships_FullMethodName            = "/mitosis.evmvalidator.v1.Query/CollateralOwnerships"
 	Query_CollateralOwnershipsByValidator_FullMethodName = "/mitosis.evmvalidator.v1.Query/CollateralOwnershipsByValidator"
 	Query_CollateralOwnership_FullMethodName             = "/mitosis.evmvalidator.v1.Query/CollateralOwnership"
+	Query_EventReceipt_FullMethodName                    = "/mitosis.evmvalidator.v1.Query/EventReceipt"
+	Query_TxEventReceipts_FullMethodName                 = "/mitosis.evmvalidator.v1.Query/TxEventReceipts"
 )
 
 // QueryClient is the client API for Query service.
@@ -41,6 +44,8 @@ type QueryClient interface {
 	// ValidatorEntrypointContractAddr returns the address of the entrypoint
 	// contract for validators
 	ValidatorEntrypointContractAddr(ctx context.Context, in *QueryValidatorEntrypointContractAddrRequest, opts ...grpc.CallOption) (*QueryValidatorEntrypointContractAddrResponse, error)
+	// CircuitBreaker returns the circuit breaker flags
+	CircuitBreaker(ctx context.Context, in *QueryCircuitBreakerRequest, opts ...grpc.CallOption) (*QueryCircuitBreakerResponse, error)
 	// Validator returns validator details by address
 	Validator(ctx context.Context, in *QueryValidatorRequest, opts ...grpc.CallOption) (*QueryValidatorResponse, error)
 	// ValidatorByConsAddr returns validator details by consensus address
@@ -61,6 +66,12 @@ type QueryClient interface {
 	// CollateralOwnership returns the collateral ownership for a specific
 	// validator and owner
 	CollateralOwnership(ctx context.Context, in *QueryCollateralOwnershipRequest, opts ...grpc.CallOption) (*QueryCollateralOwnershipResponse, error)
+	// EventReceipt returns the receipts of the ConsensusValidatorEntrypoint
+	// event logs delivered from a specific EVM block
+	EventReceipt(ctx context.Context, in *QueryEventReceiptRequest, opts ...grpc.CallOption) (*QueryEventReceiptResponse, error)
+	// TxEventReceipts returns the receipts of the ConsensusValidatorEntrypoint
+	// event logs emitted by a specific EVM transaction
+	TxEventReceipts(ctx context.Context, in *QueryTxEventReceiptsRequest, opts ...grpc.CallOption) (*QueryTxEventReceiptsResponse, error)
 }
 
 type queryClient struct {
@@ -89,6 +100,15 @@ func (c *queryClient) ValidatorEntrypointContractAddr(ctx context.Context, in *Q
 	return out, nil
 }
 
+func (c *queryClient) CircuitBreaker(ctx context.Context, in *QueryCircuitBreakerRequest, opts ...grpc.CallOption) (*QueryCircuitBreakerResponse, error) {
+	out := new(QueryCircuitBreakerResponse)
+	err := c.cc.Invoke(ctx, Query_CircuitBreaker_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) Validator(ctx context.Context, in *QueryValidatorRequest, opts ...grpc.CallOption) (*QueryValidatorResponse, error) {
 	out := new(QueryValidatorResponse)
 	err := c.cc.Invoke(ctx, Query_Validator_FullMethodName, in, out, opts...)
@@ -170,6 +190,24 @@ func (c *queryClient) CollateralOwnership(ctx context.Context, in *QueryCollater
 	return out, nil
 }
 
+func (c *queryClient) EventReceipt(ctx context.Context, in *QueryEventReceiptRequest, opts ...grpc.CallOption) (*QueryEventReceiptResponse, error) {
+	out := new(QueryEventReceiptResponse)
+	err := c.cc.Invoke(ctx, Query_EventReceipt_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) TxEventReceipts(ctx context.Context, in *QueryTxEventReceiptsRequest, opts ...grpc.CallOption) (*QueryTxEventReceiptsResponse, error) {
+	out := new(QueryTxEventReceiptsResponse)
+	err := c.cc.Invoke(ctx, Query_TxEventReceipts_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility
@@ -179,6 +217,8 @@ type QueryServer interface {
 	// ValidatorEntrypointContractAddr returns the address of the entrypoint
 	// contract for validators
 	ValidatorEntrypointContractAddr(context.Context, *QueryValidatorEntrypointContractAddrRequest) (*QueryValidatorEntrypointContractAddrResponse, error)
+	// CircuitBreaker returns the circuit breaker flags
+	CircuitBreaker(context.Context, *QueryCircuitBreakerRequest) (*QueryCircuitBreakerResponse, error)
 	// Validator returns validator details by address
 	Validator(context.Context, *QueryValidatorRequest) (*QueryValidatorResponse, error)
 	// ValidatorByConsAddr returns validator details by consensus address
@@ -199,6 +239,12 @@ type QueryServer interface {
 	// CollateralOwnership returns the collateral ownership for a specific
 	// validator and owner
 	CollateralOwnership(context.Context, *QueryCollateralOwnershipRequest) (*QueryCollateralOwnershipResponse, error)
+	// EventReceipt returns the receipts of the ConsensusValidatorEntrypoint
+	// event logs delivered from a specific EVM block
+	EventReceipt(context.Context, *QueryEventReceiptRequest) (*QueryEventReceiptResponse, error)
+	// TxEventReceipts returns the receipts of the ConsensusValidatorEntrypoint
+	// event logs emitted by a specific EVM transaction
+	TxEventReceipts(context.Context, *QueryTxEventReceiptsRequest) (*QueryTxEventReceiptsResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -212,6 +258,9 @@ func (UnimplementedQueryServer) Params(context.Context, *QueryParamsRequest) (*Q
 func (UnimplementedQueryServer) ValidatorEntrypointContractAddr(context.Context, *QueryValidatorEntrypointContractAddrRequest) (*QueryValidatorEntrypointContractAddrResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ValidatorEntrypointContractAddr not implemented")
 }
+func (UnimplementedQueryServer) CircuitBreaker(context.Context, *QueryCircuitBreakerRequest) (*QueryCircuitBreakerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CircuitBreaker not implemented")
+}
 func (UnimplementedQueryServer) Validator(context.Context, *QueryValidatorRequest) (*QueryValidatorResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Validator not implemented")
 }
@@ -239,6 +288,12 @@ func (UnimplementedQueryServer) CollateralOwnershipsByValidator(context.Context,
 func (UnimplementedQueryServer) CollateralOwnership(context.Context, *QueryCollateralOwnershipRequest) (*QueryCollateralOwnershipResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CollateralOwnership not implemented")
 }
+func (UnimplementedQueryServer) EventReceipt(context.Context, *QueryEventReceiptRequest) (*QueryEventReceiptResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EventReceipt not implemented")
+}
+func (UnimplementedQueryServer) TxEventReceipts(context.Context, *QueryTxEventReceiptsRequest) (*QueryTxEventReceiptsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TxEventReceipts not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 
 // UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
@@ -288,6 +343,24 @@ func _Query_ValidatorEntrypointContractAddr_Handler(srv interface{}, ctx context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_CircuitBreaker_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCircuitBreakerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CircuitBreaker(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_CircuitBreaker_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CircuitBreaker(ctx, req.(*QueryCircuitBreakerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_Validator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryValidatorRequest)
 	if err := dec(in); err != nil {
@@ -450,6 +523,42 @@ func _Query_CollateralOwnership_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_EventReceipt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEventReceiptRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).EventReceipt(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_EventReceipt_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EventReceipt(ctx, req.(*QueryEventReceiptRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TxEventReceipts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTxEventReceiptsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TxEventReceipts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_TxEventReceipts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TxEventReceipts(ctx, req.(*QueryTxEventReceiptsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -465,6 +574,10 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ValidatorEntrypointContractAddr",
 			Handler:    _Query_ValidatorEntrypointContractAddr_Handler,
 		},
+		{
+			MethodName: "CircuitBreaker",
+			Handler:    _Query_CircuitBreaker_Handler,
+		},
 		{
 			MethodName: "Validator",
 			Handler:    _Query_Validator_Handler,
@@ -501,6 +614,14 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CollateralOwnership",
 			Handler:    _Query_CollateralOwnership_Handler,
 		},
+		{
+			MethodName: "EventReceipt",
+			Handler:    _Query_EventReceipt_Handler,
+		},
+		{
+			MethodName: "TxEventReceipts",
+			Handler:    _Query_TxEventReceipts_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "mitosis/evmvalidator/v1/query.proto",
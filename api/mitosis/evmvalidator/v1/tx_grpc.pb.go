@@ -21,6 +21,8 @@ const _ = grpc.SupportPackageIsVersion7
 const (
 	Msg_UpdateParams_FullMethodName                          = "/mitosis.evmvalidator.v1.Msg/UpdateParams"
 	Msg_UpdateValidatorEntrypointContractAddr_FullMethodName = "/mitosis.evmvalidator.v1.Msg/UpdateValidatorEntrypointContractAddr"
+	Msg_UpdateCircuitBreaker_FullMethodName                  = "/mitosis.evmvalidator.v1.Msg/UpdateCircuitBreaker"
+	Msg_UpdateValidatorFeeRecipient_FullMethodName           = "/mitosis.evmvalidator.v1.Msg/UpdateValidatorFeeRecipient"
 )
 
 // MsgClient is the client API for Msg service.
@@ -32,6 +34,11 @@ type MsgClient interface {
 	// UpdateValidatorEntrypointContractAddr updates the address of the
 	// ConsensusValidatorEntrypoint contract
 	UpdateValidatorEntrypointContractAddr(ctx context.Context, in *MsgUpdateValidatorEntrypointContractAddr, opts ...grpc.CallOption) (*MsgUpdateValidatorEntrypointContractAddrResponse, error)
+	// UpdateCircuitBreaker updates the circuit breaker flags
+	UpdateCircuitBreaker(ctx context.Context, in *MsgUpdateCircuitBreaker, opts ...grpc.CallOption) (*MsgUpdateCircuitBreakerResponse, error)
+	// UpdateValidatorFeeRecipient updates the fee recipient registered for a
+	// validator
+	UpdateValidatorFeeRecipient(ctx context.Context, in *MsgUpdateValidatorFeeRecipient, opts ...grpc.CallOption) (*MsgUpdateValidatorFeeRecipientResponse, error)
 }
 
 type msgClient struct {
@@ -60,6 +67,24 @@ func (c *msgClient) UpdateValidatorEntrypointContractAddr(ctx context.Context, i
 	return out, nil
 }
 
+func (c *msgClient) UpdateCircuitBreaker(ctx context.Context, in *MsgUpdateCircuitBreaker, opts ...grpc.CallOption) (*MsgUpdateCircuitBreakerResponse, error) {
+	out := new(MsgUpdateCircuitBreakerResponse)
+	err := c.cc.Invoke(ctx, Msg_UpdateCircuitBreaker_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateValidatorFeeRecipient(ctx context.Context, in *MsgUpdateValidatorFeeRecipient, opts ...grpc.CallOption) (*MsgUpdateValidatorFeeRecipientResponse, error) {
+	out := new(MsgUpdateValidatorFeeRecipientResponse)
+	err := c.cc.Invoke(ctx, Msg_UpdateValidatorFeeRecipient_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 // All implementations must embed UnimplementedMsgServer
 // for forward compatibility
@@ -69,6 +94,11 @@ type MsgServer interface {
 	// UpdateValidatorEntrypointContractAddr updates the address of the
 	// ConsensusValidatorEntrypoint contract
 	UpdateValidatorEntrypointContractAddr(context.Context, *MsgUpdateValidatorEntrypointContractAddr) (*MsgUpdateValidatorEntrypointContractAddrResponse, error)
+	// UpdateCircuitBreaker updates the circuit breaker flags
+	UpdateCircuitBreaker(context.Context, *MsgUpdateCircuitBreaker) (*MsgUpdateCircuitBreakerResponse, error)
+	// UpdateValidatorFeeRecipient updates the fee recipient registered for a
+	// validator
+	UpdateValidatorFeeRecipient(context.Context, *MsgUpdateValidatorFeeRecipient) (*MsgUpdateValidatorFeeRecipientResponse, error)
 	mustEmbedUnimplementedMsgServer()
 }
 
@@ -82,6 +112,12 @@ func (UnimplementedMsgServer) UpdateParams(context.Context, *MsgUpdateParams) (*
 func (UnimplementedMsgServer) UpdateValidatorEntrypointContractAddr(context.Context, *MsgUpdateValidatorEntrypointContractAddr) (*MsgUpdateValidatorEntrypointContractAddrResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateValidatorEntrypointContractAddr not implemented")
 }
+func (UnimplementedMsgServer) UpdateCircuitBreaker(context.Context, *MsgUpdateCircuitBreaker) (*MsgUpdateCircuitBreakerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateCircuitBreaker not implemented")
+}
+func (UnimplementedMsgServer) UpdateValidatorFeeRecipient(context.Context, *MsgUpdateValidatorFeeRecipient) (*MsgUpdateValidatorFeeRecipientResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateValidatorFeeRecipient not implemented")
+}
 func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
 
 // UnsafeMsgServer may be embedded to opt out of forward compatibility for this service.
@@ -131,6 +167,42 @@ func _Msg_UpdateValidatorEntrypointContractAddr_Handler(srv interface{}, ctx con
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_UpdateCircuitBreaker_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateCircuitBreaker)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateCircuitBreaker(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_UpdateCircuitBreaker_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateCircuitBreaker(ctx, req.(*MsgUpdateCircuitBreaker))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateValidatorFeeRecipient_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateValidatorFeeRecipient)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateValidatorFeeRecipient(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_UpdateValidatorFeeRecipient_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateValidatorFeeRecipient(ctx, req.(*MsgUpdateValidatorFeeRecipient))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -146,6 +218,14 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateValidatorEntrypointContractAddr",
 			Handler:    _Msg_UpdateValidatorEntrypointContractAddr_Handler,
 		},
+		{
+			MethodName: "UpdateCircuitBreaker",
+			Handler:    _Msg_UpdateCircuitBreaker_Handler,
+		},
+		{
+			MethodName: "UpdateValidatorFeeRecipient",
+			Handler:    _Msg_UpdateValidatorFeeRecipient_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "mitosis/evmvalidator/v1/tx.proto",
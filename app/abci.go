@@ -17,9 +17,16 @@ import (
 
 type postFinalizeCallback func(sdk.Context) error
 
+// BlockMonitor observes every finalized block with the commit of the last block, e.g. to monitor the local validator.
+// It must not modify the state nor block the finalization.
+type BlockMonitor interface {
+	ObserveBlock(ctx sdk.Context, lastCommit abci.CommitInfo)
+}
+
 type ABCIWrappedApplication struct {
 	servertypes.Application
 	postFinalize postFinalizeCallback
+	monitor      BlockMonitor
 	logger       sdklog.Logger
 }
 
@@ -31,6 +38,11 @@ func NewABCIWrappedApplication(app *MitosisApp) *ABCIWrappedApplication {
 	}
 }
 
+// SetBlockMonitor sets the monitor observing every finalized block.
+func (a *ABCIWrappedApplication) SetBlockMonitor(monitor BlockMonitor) {
+	a.monitor = monitor
+}
+
 func (a ABCIWrappedApplication) Info(info *abci.RequestInfo) (*abci.ResponseInfo, error) {
 	a.logger.Debug("ABCI call: Info")
 
@@ -120,6 +132,11 @@ func (a ABCIWrappedApplication) FinalizeBlock(req *abci.RequestFinalizeBlock) (*
 		return resp, errors.New("FinalizeBlock contains unexpected failed transaction [BUG]")
 	}
 
+	if a.monitor != nil {
+		monitorCtx := sdk.NewContext(a.Application.CommitMultiStore().CacheMultiStore(), header, false, a.logger.With("context", "monitor"))
+		a.monitor.ObserveBlock(monitorCtx, req.DecidedLastCommit)
+	}
+
 	return resp, nil
 }
 
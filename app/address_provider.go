@@ -1,8 +1,13 @@
 package app
 
 import (
+	"sync"
+
 	"github.com/ethereum/go-ethereum/common"
+	mitotypes "github.com/mitosis-org/chain/types"
 	evmengtypes "github.com/omni-network/omni/octane/evmengine/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 var (
@@ -11,17 +16,107 @@ var (
 )
 
 type ValidatorAddressProvider struct {
+	// Addr is the fee recipient configured in app.toml, or the address of the local validator key.
 	Addr common.Address
+	// ConsAddr is the consensus address of the local validator.
+	ConsAddr sdk.ConsAddress
+
+	// registeredFeeRecipient returns the fee recipient registered for the local validator, if any.
+	registeredFeeRecipient func(consAddr sdk.ConsAddress) (mitotypes.EthAddress, bool)
+	// proposal is the proposal being processed, against whose proposer the fee recipients are verified.
+	proposal *proposalScope
 }
 
 func (s ValidatorAddressProvider) LocalAddress() common.Address {
 	return s.Addr
 }
 
+// LocalFeeRecipient returns the fee recipient of the payloads built by the local validator.
+//
+// The fee recipient registered for the local validator takes precedence over the configured one,
+// since the proposals with any other fee recipient are rejected by VerifyFeeRecipient.
 func (s ValidatorAddressProvider) LocalFeeRecipient() common.Address {
+	if s.registeredFeeRecipient != nil && len(s.ConsAddr) > 0 {
+		if feeRecipient, ok := s.registeredFeeRecipient(s.ConsAddr); ok {
+			return feeRecipient.Address()
+		}
+	}
+
 	return s.Addr
 }
 
-func (s ValidatorAddressProvider) VerifyFeeRecipient(_ common.Address) error {
-	return nil
+// VerifyFeeRecipient implements the FeeRecipientProvider hook of x/evmengine.
+//
+// The hook is called without the proposer of the payload, so it verifies the fee recipient against
+// the registration of the proposer of the proposal being processed in ProcessProposal. Outside of
+// ProcessProposal, e.g. in FinalizeBlock, any fee recipient is accepted, so that the verification
+// rule doesn't affect the replay of committed blocks.
+func (s ValidatorAddressProvider) VerifyFeeRecipient(feeRecipient common.Address) error {
+	if s.proposal == nil {
+		return nil
+	}
+
+	return s.proposal.verifyFeeRecipient(mitotypes.EthAddress(feeRecipient))
+}
+
+// ProposerFeeRecipientVerifier verifies the fee recipient of a payload against the one registered for its proposer.
+type ProposerFeeRecipientVerifier interface {
+	VerifyFeeRecipient(ctx sdk.Context, proposer sdk.ConsAddress, feeRecipient mitotypes.EthAddress) error
+}
+
+// proposalScope holds the proposal being processed in ProcessProposal.
+type proposalScope struct {
+	verifier ProposerFeeRecipientVerifier
+
+	mu       sync.Mutex
+	ctx      sdk.Context
+	proposer sdk.ConsAddress
+	active   bool
+}
+
+func newProposalScope(verifier ProposerFeeRecipientVerifier) *proposalScope {
+	return &proposalScope{verifier: verifier}
+}
+
+// begin starts processing the proposal of the proposer. The returned function ends it.
+func (p *proposalScope) begin(ctx sdk.Context, proposer sdk.ConsAddress) (end func()) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.ctx, p.proposer, p.active = ctx, proposer, true
+
+	return func() {
+		p.mu.Lock()
+		defer p.mu.Unlock()
+
+		p.ctx, p.proposer, p.active = sdk.Context{}, nil, false
+	}
+}
+
+func (p *proposalScope) verifyFeeRecipient(feeRecipient mitotypes.EthAddress) error {
+	p.mu.Lock()
+	ctx, proposer, active := p.ctx, p.proposer, p.active
+	p.mu.Unlock()
+
+	if !active {
+		return nil
+	}
+
+	return p.verifier.VerifyFeeRecipient(ctx, proposer, feeRecipient)
+}
+
+// registeredFeeRecipient returns the fee recipient registered for the validator in the latest committed state.
+func (app *MitosisApp) registeredFeeRecipient(consAddr sdk.ConsAddress) (mitotypes.EthAddress, bool) {
+	ctx, err := app.CreateQueryContext(0, false)
+	if err != nil {
+		// No block is committed yet.
+		return mitotypes.EthAddress{}, false
+	}
+
+	validator, found := app.EVMValKeeper.GetValidatorByConsAddr(ctx, consAddr)
+	if !found || validator.FeeRecipient.Equal(mitotypes.EthAddress{}) {
+		return mitotypes.EthAddress{}, false
+	}
+
+	return validator.FeeRecipient, true
 }
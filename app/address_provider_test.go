@@ -0,0 +1,107 @@
+package app_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	cmttypes "github.com/cometbft/cometbft/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mitosis-org/chain/testutil/network"
+	mitotypes "github.com/mitosis-org/chain/types"
+	evmvaltypes "github.com/mitosis-org/chain/x/evmvalidator/types"
+)
+
+// newFeeRecipientNetwork returns a test network where the genesis validator at valIndex registers the fee recipient.
+func newFeeRecipientNetwork(t *testing.T, valIndex int, feeRecipient mitotypes.EthAddress) *network.Network {
+	t.Helper()
+
+	cfg := network.DefaultConfig()
+	cfg.ModifyGenesis = func(cdc codec.Codec, genesis map[string]json.RawMessage) error {
+		var evmvalGenesis evmvaltypes.GenesisState
+		if err := cdc.UnmarshalJSON(genesis[evmvaltypes.ModuleName], &evmvalGenesis); err != nil {
+			return err
+		}
+		evmvalGenesis.Validators[valIndex].FeeRecipient = feeRecipient
+		genesis[evmvaltypes.ModuleName] = cdc.MustMarshalJSON(&evmvalGenesis)
+
+		return nil
+	}
+
+	net, err := network.New(cfg)
+	require.NoError(t, err)
+	require.NoError(t, net.ProduceBlock())
+
+	return net
+}
+
+func TestLocalFeeRecipient_Registered(t *testing.T) {
+	// The registered fee recipient differs from the configured one, which is the validator's address
+	registered := mitotypes.EthAddress(common.HexToAddress("0x00000000000000000000000000000000000000fe"))
+	net := newFeeRecipientNetwork(t, 1, registered)
+
+	node := net.Nodes[1]
+	require.NotEqual(t, node.EthAddr, registered.Address())
+	validator, found := node.App.EVMValKeeper.GetValidatorByConsAddr(node.Context(), node.ConsAddr)
+	require.True(t, found)
+	require.Equal(t, registered, validator.FeeRecipient)
+
+	// Let only the validator propose, whose proposal would be rejected with the configured fee recipient
+	for _, n := range net.Nodes {
+		if n != node {
+			net.StopSigning(n)
+		}
+	}
+	require.NoError(t, net.ProduceBlock())
+	require.Equal(t, registered.Address(), net.Engine.Head().Coinbase())
+}
+
+func TestVerifyFeeRecipient(t *testing.T) {
+	registered := mitotypes.EthAddress(common.HexToAddress("0x00000000000000000000000000000000000000fe"))
+	net := newFeeRecipientNetwork(t, 1, registered)
+
+	// The payload built by a validator without registration pays the tips to its configured fee recipient
+	builder, verifier := net.Nodes[2], net.Nodes[0]
+	var votes []abci.ExtendedVoteInfo
+	for _, v := range net.Validators() {
+		votes = append(votes, abci.ExtendedVoteInfo{Validator: v, BlockIdFlag: cmtproto.BlockIDFlagCommit})
+	}
+	prepRes, err := builder.App.PrepareProposal(&abci.RequestPrepareProposal{
+		MaxTxBytes:      cmttypes.MaxBlockSizeBytes,
+		LocalLastCommit: abci.ExtendedCommitInfo{Votes: votes},
+		Height:          net.Height() + 1,
+		Time:            net.Time(),
+		ProposerAddress: builder.ConsAddr,
+	})
+	require.NoError(t, err)
+	require.Len(t, prepRes.Txs, 1)
+
+	processProposal := func(proposer []byte) abci.ResponseProcessProposal_ProposalStatus {
+		var lastCommit abci.CommitInfo
+		for _, vote := range votes {
+			lastCommit.Votes = append(lastCommit.Votes, abci.VoteInfo{Validator: vote.Validator, BlockIdFlag: vote.BlockIdFlag})
+		}
+
+		res, err := verifier.App.ProcessProposal(&abci.RequestProcessProposal{
+			Txs:                prepRes.Txs,
+			ProposedLastCommit: lastCommit,
+			Height:             net.Height() + 1,
+			Time:               net.Time(),
+			ProposerAddress:    proposer,
+		})
+		require.NoError(t, err)
+
+		return res.Status
+	}
+
+	// The payload is rejected if proposed by the validator who registered another fee recipient
+	require.Equal(t, abci.ResponseProcessProposal_REJECT, processProposal(net.Nodes[1].ConsAddr))
+	require.Equal(t, abci.ResponseProcessProposal_ACCEPT, processProposal(builder.ConsAddr))
+
+	// The verification doesn't apply to the committed blocks
+	require.NoError(t, net.ProduceBlock())
+}
@@ -7,8 +7,11 @@ import (
 	"time"
 
 	abci "github.com/cometbft/cometbft/abci/types"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	"github.com/mitosis-org/chain/app/upgrades"
+	"github.com/mitosis-org/chain/app/voteext"
 	mitotypes "github.com/mitosis-org/chain/types"
 
 	"cosmossdk.io/depinject"
@@ -76,6 +79,12 @@ type MitosisApp struct {
 	// Mitosis keepers
 	EVMValKeeper *evmvalkeeper.Keeper
 	EVMGovKeeper *evmgovkeeper.Keeper
+
+	// VoteExtensions is the registry of the vote extensions contributed by modules.
+	VoteExtensions *voteext.Registry
+
+	// forks are the compiled-in forks merged with the ones in the forks config file.
+	forks []upgrades.Fork
 }
 
 func init() {
@@ -96,6 +105,7 @@ func NewMitosisApp(
 	engineBuildDelay time.Duration,
 	engineBuildOptimistic bool,
 	govEntrypointContractAddr mitotypes.EthAddress,
+	processProposalCfg ProcessProposalConfig,
 	loadLatest bool,
 	appOpts servertypes.AppOptions,
 	baseAppOpts ...func(*baseapp.BaseApp),
@@ -104,6 +114,11 @@ func NewMitosisApp(
 		app        = new(MitosisApp)
 		appBuilder = new(runtime.AppBuilder)
 	)
+
+	// Record the logs fetched by x/evmengine for the receipts of the delivered events.
+	logRecorder := newLogRecordingClient(engineCl)
+	engineCl = logRecorder
+
 	if err := depinject.Inject(
 		depinject.Configs(
 			AppConfig(),
@@ -133,34 +148,75 @@ func NewMitosisApp(
 
 	app.EVMEngKeeper.SetBuildDelay(engineBuildDelay)
 	app.EVMEngKeeper.SetBuildOptimistic(engineBuildOptimistic)
-	app.EVMEngKeeper.SetVoteProvider(NoVoteExtensionProvider{})
+
+	voteExtensions, err := newVoteExtensionRegistry(app, engineCl)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to register vote extensions")
+	}
+	app.VoteExtensions = voteExtensions
+	app.EVMEngKeeper.SetVoteProvider(app.VoteExtensions)
 
 	app.EVMValKeeper.SetSlashingKeeper(app.SlashingKeeper)
 	app.EVMValKeeper.SetEvmEngineKeeper(app.EVMEngKeeper)
+	app.EVMValKeeper.SetEventLogSource(logRecorder)
+
+	// Build the payloads with the fee recipient registered for the local validator rather than the configured one.
+	addrProvider.registeredFeeRecipient = app.registeredFeeRecipient
+	// Verify the fee recipients of the proposed payloads against the ones registered for their proposers.
+	addrProvider.proposal = newProposalScope(app.EVMValKeeper)
+
+	// Deprecated: the governance entrypoint contract address is stored in x/evmgov state.
+	// The address in app.toml is only used as a fallback until it is set in the state.
+	app.EVMGovKeeper.SetFallbackGovEntrypointContractAddr(govEntrypointContractAddr)
 
-	if err := app.EVMGovKeeper.SetGovEntrypointContractAddr(govEntrypointContractAddr); err != nil {
-		return nil, errors.Wrap(err, "failed to set governance entrypoint contract address")
+	rejectedProposalRecorder, err := newRejectedProposalRecorder(appOpts, processProposalCfg.RejectedHistorySize)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create rejected proposal recorder")
 	}
 
 	baseAppOpts = append(baseAppOpts, func(bapp *baseapp.BaseApp) {
 		bapp.SetPrepareProposal(app.EVMEngKeeper.PrepareProposal)
 
 		// Route proposed messages to keepers for verification and external state updates.
-		bapp.SetProcessProposal(makeProcessProposalHandler(makeProcessProposalRouter(app), app.txConfig))
+		bapp.SetProcessProposal(makeProcessProposalHandler(
+			makeProcessProposalRouter(app),
+			app.txConfig,
+			app.VoteExtensions.ProposalMsgCounts(),
+			processProposalCfg.Timeout,
+			rejectedProposalRecorder,
+			addrProvider.proposal,
+		))
+
+		bapp.SetExtendVoteHandler(app.VoteExtensions.ExtendVoteHandler())
+		bapp.SetVerifyVoteExtensionHandler(app.VoteExtensions.VerifyVoteExtensionHandler())
 	})
 
 	app.App = appBuilder.Build(db, traceStore, baseAppOpts...)
 
 	app.SetPreBlocker(app.PreBlocker)
 
+	forks, err := loadForks(appOpts)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to load forks")
+	}
+	app.forks = forks
+
 	// Set handlers and store loaders for upgrades.
 	app.setupUpgradeHandlers()
 	app.setupUpgradeStoreLoaders()
+	app.checkForkUpgradeHandlers(logger)
 
 	if err := app.Load(loadLatest); err != nil {
 		return nil, errors.Wrap(err, "failed to load latest version")
 	}
 
+	if loadLatest {
+		ctx := app.NewUncachedContext(false, cmtproto.Header{})
+		if err := app.EVMGovKeeper.CheckGovEntrypointContractAddrConsistency(ctx); err != nil {
+			return nil, errors.Wrap(err, "inconsistent governance entrypoint contract address")
+		}
+	}
+
 	return app, nil
 }
 
@@ -184,6 +240,11 @@ func (app *MitosisApp) RegisterTendermintService(clientCtx client.Context) {
 	app.EVMEngKeeper.SetCometAPI(NewCometAPI(rpcClient, app.ChainID()))
 }
 
+// AppCodec returns the app codec.
+func (app *MitosisApp) AppCodec() codec.Codec {
+	return app.appCodec
+}
+
 func (app *MitosisApp) LegacyAmino() *codec.LegacyAmino {
 	return nil
 }
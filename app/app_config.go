@@ -69,6 +69,7 @@ var (
 	}
 
 	endBlockers = []string{
+		evmgovtypes.ModuleName, // should be before evmvalidator to apply scheduled param changes to the validator set
 		evmvaltypes.ModuleName,
 	}
 
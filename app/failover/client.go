@@ -0,0 +1,330 @@
+package failover
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"cosmossdk.io/log"
+	"github.com/ethereum/go-ethereum/beacon/engine"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/omni-network/omni/lib/errors"
+	"github.com/omni-network/omni/lib/ethclient"
+)
+
+const (
+	// mirrorQueueSize is the number of Engine API calls which can be queued for a standby endpoint.
+	mirrorQueueSize = 64
+
+	// mirrorTimeout is the timeout of an Engine API call mirrored to a standby endpoint.
+	mirrorTimeout = 10 * time.Second
+
+	// payloadPinTTL is how long a payload being built is pinned to the endpoint building it.
+	payloadPinTTL = time.Minute
+)
+
+var _ ethclient.EngineClient = (*Client)(nil)
+
+// Config is the configuration of the health probing and the failover.
+type Config struct {
+	// ProbeInterval is the interval of probing the health of the endpoints.
+	ProbeInterval time.Duration
+	// MaxHeadLag is the maximum number of blocks an endpoint may lag behind the highest head among the endpoints.
+	MaxHeadLag uint64
+	// MaxErrorRate is the maximum rate of failed calls to an endpoint within a probe interval.
+	MaxErrorRate float64
+}
+
+// DefaultConfig returns the default configuration.
+func DefaultConfig() Config {
+	return Config{
+		ProbeInterval: 2 * time.Second,
+		MaxHeadLag:    8,
+		MaxErrorRate:  0.5,
+	}
+}
+
+// Validate returns an error if the configuration is invalid.
+func (c Config) Validate() error {
+	if c.ProbeInterval <= 0 {
+		return errors.New("probe interval must be positive")
+	}
+	if c.MaxErrorRate < 0 || c.MaxErrorRate > 1 {
+		return errors.New(fmt.Sprintf("max error rate must be between 0 and 1: %v", c.MaxErrorRate))
+	}
+
+	return nil
+}
+
+// Client is an EngineClient which fails over between multiple execution client endpoints.
+//
+// All calls are sent to the active endpoint, which is the primary (the first one) initially.
+// NewPayloadV4 and ForkchoiceUpdatedV3 are also mirrored to the other endpoints, without payload attributes,
+// so that they are kept in sync as hot standbys.
+//
+// The health of the endpoints is probed periodically by Start. If the active endpoint becomes unhealthy,
+// the client fails over to the first healthy endpoint. It stays on that endpoint until it becomes unhealthy,
+// not to flap between the endpoints.
+//
+// A payload is always fetched by GetPayloadV4 from the endpoint which started building it by
+// ForkchoiceUpdatedV3, so failing over never switches endpoints in the middle of building a payload.
+type Client struct {
+	cfg       Config
+	logger    log.Logger
+	endpoints []*endpoint
+
+	mu     sync.RWMutex
+	active int
+	pins   map[engine.PayloadID]payloadPin
+}
+
+type payloadPin struct {
+	endpoint *endpoint
+	time     time.Time
+}
+
+// endpoint is an execution client endpoint with its call statistics and health.
+type endpoint struct {
+	ethclient.EngineClient
+	name   string
+	logger log.Logger
+	mirror chan func(context.Context)
+
+	calls  atomic.Uint64
+	errors atomic.Uint64
+
+	// pending is the number of mirrored calls queued or in flight.
+	pending atomic.Int64
+
+	// health is only accessed by the probing goroutine after Start.
+	health health
+}
+
+// NewClient returns a client failing over between the given endpoints in the order of priority.
+func NewClient(cfg Config, logger log.Logger, clients ...ethclient.EngineClient) (*Client, error) {
+	if len(clients) == 0 {
+		return nil, errors.New("no engine endpoints")
+	}
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+
+	c := &Client{
+		cfg:    cfg,
+		logger: logger,
+		pins:   make(map[engine.PayloadID]payloadPin),
+	}
+	for i, cl := range clients {
+		name := endpointName(i, cl.Address())
+		c.endpoints = append(c.endpoints, &endpoint{
+			EngineClient: cl,
+			name:         name,
+			logger:       logger.With("endpoint", name),
+			mirror:       make(chan func(context.Context), mirrorQueueSize),
+			health:       health{healthy: true},
+		})
+	}
+
+	return c, nil
+}
+
+// endpointName returns the name of the endpoint used in logs and metrics.
+// Only the host is used not to expose credentials or paths in the address.
+func endpointName(i int, address string) string {
+	if u, err := url.Parse(address); err == nil && u.Host != "" {
+		return u.Host
+	}
+
+	return fmt.Sprintf("endpoint%d", i)
+}
+
+// Start starts probing the health of the endpoints and mirroring calls to the standby endpoints
+// until the context is canceled.
+func (c *Client) Start(ctx context.Context) {
+	if len(c.endpoints) == 1 {
+		return
+	}
+
+	for _, ep := range c.endpoints {
+		go ep.runMirror(ctx)
+	}
+	go c.runProbes(ctx)
+}
+
+// Endpoints returns the clients of all the endpoints in the order of priority.
+func (c *Client) Endpoints() []ethclient.EngineClient {
+	clients := make([]ethclient.EngineClient, 0, len(c.endpoints))
+	for _, ep := range c.endpoints {
+		clients = append(clients, ep.EngineClient)
+	}
+
+	return clients
+}
+
+// Active returns the address of the active endpoint.
+func (c *Client) Active() string {
+	return c.activeEndpoint().Address()
+}
+
+func (c *Client) activeEndpoint() *endpoint {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	return c.endpoints[c.active]
+}
+
+// standbys returns the endpoints other than the given one.
+func (c *Client) standbys(active *endpoint) []*endpoint {
+	standbys := make([]*endpoint, 0, len(c.endpoints)-1)
+	for _, ep := range c.endpoints {
+		if ep != active {
+			standbys = append(standbys, ep)
+		}
+	}
+
+	return standbys
+}
+
+// NewPayloadV4 sends the payload to the active endpoint and mirrors it to the standby endpoints.
+func (c *Client) NewPayloadV4(ctx context.Context, params engine.ExecutableData, versionedHashes []common.Hash,
+	beaconRoot *common.Hash, executionRequests []hexutil.Bytes,
+) (engine.PayloadStatusV1, error) {
+	ep := c.activeEndpoint()
+	status, err := ep.NewPayloadV4(ctx, params, versionedHashes, beaconRoot, executionRequests)
+	ep.track(err)
+
+	for _, standby := range c.standbys(ep) {
+		standby.enqueue("new_payload_v4", func(ctx context.Context, cl ethclient.EngineClient) error {
+			_, err := cl.NewPayloadV4(ctx, params, versionedHashes, beaconRoot, executionRequests)
+			return err
+		})
+	}
+
+	return status, err
+}
+
+// ForkchoiceUpdatedV3 updates the forkchoice of the active endpoint and mirrors it to the standby endpoints.
+// If payload attributes are given, the payload being built is pinned to the active endpoint.
+func (c *Client) ForkchoiceUpdatedV3(ctx context.Context, update engine.ForkchoiceStateV1,
+	payloadAttributes *engine.PayloadAttributes,
+) (engine.ForkChoiceResponse, error) {
+	ep := c.activeEndpoint()
+	resp, err := ep.ForkchoiceUpdatedV3(ctx, update, payloadAttributes)
+	ep.track(err)
+
+	if err == nil && resp.PayloadID != nil {
+		c.pin(*resp.PayloadID, ep)
+	}
+
+	// Only the forkchoice is mirrored. The standby endpoints don't need to build payloads.
+	for _, standby := range c.standbys(ep) {
+		standby.enqueue("forkchoice_updated_v3", func(ctx context.Context, cl ethclient.EngineClient) error {
+			_, err := cl.ForkchoiceUpdatedV3(ctx, update, nil)
+			return err
+		})
+	}
+
+	return resp, err
+}
+
+// GetPayloadV4 returns the payload from the endpoint which built it.
+// It falls back to the active endpoint if the payload is unknown, e.g. after a restart.
+func (c *Client) GetPayloadV4(ctx context.Context, payloadID engine.PayloadID) (*engine.ExecutionPayloadEnvelope, error) {
+	ep := c.pinned(payloadID)
+	if ep == nil {
+		ep = c.activeEndpoint()
+	}
+
+	resp, err := ep.GetPayloadV4(ctx, payloadID)
+	ep.track(err)
+
+	return resp, err
+}
+
+func (c *Client) pin(payloadID engine.PayloadID, ep *endpoint) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	now := time.Now()
+	// Optimistically built payloads may never be fetched, so stale pins are pruned here.
+	for id, pin := range c.pins {
+		if now.Sub(pin.time) > payloadPinTTL {
+			delete(c.pins, id)
+		}
+	}
+
+	c.pins[payloadID] = payloadPin{endpoint: ep, time: now}
+}
+
+func (c *Client) pinned(payloadID engine.PayloadID) *endpoint {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	pin, ok := c.pins[payloadID]
+	if !ok {
+		return nil
+	}
+
+	return pin.endpoint
+}
+
+// Close closes all the endpoints.
+func (c *Client) Close() {
+	for _, ep := range c.endpoints {
+		ep.Close()
+	}
+}
+
+// Address returns the address of the active endpoint.
+func (c *Client) Address() string {
+	return c.Active()
+}
+
+// track records the result of a call to the endpoint for the error rate.
+func (e *endpoint) track(err error) {
+	e.calls.Add(1)
+	if err != nil {
+		e.errors.Add(1)
+	}
+}
+
+// enqueue queues the call to be mirrored to the endpoint in order.
+// The call is dropped if the queue is full, e.g. if the endpoint is down.
+// The endpoint then catches up by syncing from its peers once the later forkchoice is mirrored.
+func (e *endpoint) enqueue(method string, call func(context.Context, ethclient.EngineClient) error) {
+	// The call is counted before queued, so that it is never done before counted.
+	e.pending.Add(1)
+
+	select {
+	case e.mirror <- func(ctx context.Context) {
+		defer e.pending.Add(-1)
+
+		ctx, cancel := context.WithTimeout(ctx, mirrorTimeout)
+		defer cancel()
+
+		err := call(ctx, e.EngineClient)
+		e.track(err)
+		if err != nil {
+			e.logger.Debug("Failed to mirror engine call to standby", "method", method, "err", err)
+		}
+	}:
+	default:
+		e.pending.Add(-1)
+		e.logger.Warn("Dropping engine call mirrored to standby", "method", method)
+	}
+}
+
+func (e *endpoint) runMirror(ctx context.Context) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case call := <-e.mirror:
+			call(ctx)
+		}
+	}
+}
@@ -0,0 +1,285 @@
+package failover
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/omni-network/omni/lib/ethclient"
+)
+
+// The methods below delegate the calls to the active endpoint and record their results for the error rate.
+
+func (c *Client) BalanceAt(ctx context.Context, account common.Address, blockNumber *big.Int) (*big.Int, error) {
+	ep := c.activeEndpoint()
+	res, err := ep.BalanceAt(ctx, account, blockNumber)
+	ep.track(err)
+
+	return res, err
+}
+
+func (c *Client) BlockByHash(ctx context.Context, hash common.Hash) (*types.Block, error) {
+	ep := c.activeEndpoint()
+	res, err := ep.BlockByHash(ctx, hash)
+	ep.track(err)
+
+	return res, err
+}
+
+func (c *Client) BlockByNumber(ctx context.Context, number *big.Int) (*types.Block, error) {
+	ep := c.activeEndpoint()
+	res, err := ep.BlockByNumber(ctx, number)
+	ep.track(err)
+
+	return res, err
+}
+
+func (c *Client) BlockNumber(ctx context.Context) (uint64, error) {
+	ep := c.activeEndpoint()
+	res, err := ep.BlockNumber(ctx)
+	ep.track(err)
+
+	return res, err
+}
+
+func (c *Client) CallContext(ctx context.Context, result any, method string, args ...any) error {
+	ep := c.activeEndpoint()
+	err := ep.CallContext(ctx, result, method, args...)
+	ep.track(err)
+
+	return err
+}
+
+func (c *Client) CallContract(ctx context.Context, call ethereum.CallMsg, blockNumber *big.Int) ([]byte, error) {
+	ep := c.activeEndpoint()
+	res, err := ep.CallContract(ctx, call, blockNumber)
+	ep.track(err)
+
+	return res, err
+}
+
+func (c *Client) ChainID(ctx context.Context) (*big.Int, error) {
+	ep := c.activeEndpoint()
+	res, err := ep.ChainID(ctx)
+	ep.track(err)
+
+	return res, err
+}
+
+func (c *Client) CodeAt(ctx context.Context, account common.Address, blockNumber *big.Int) ([]byte, error) {
+	ep := c.activeEndpoint()
+	res, err := ep.CodeAt(ctx, account, blockNumber)
+	ep.track(err)
+
+	return res, err
+}
+
+func (c *Client) EstimateGas(ctx context.Context, call ethereum.CallMsg) (uint64, error) {
+	ep := c.activeEndpoint()
+	res, err := ep.EstimateGas(ctx, call)
+	ep.track(err)
+
+	return res, err
+}
+
+func (c *Client) EtherBalanceAt(ctx context.Context, addr common.Address) (float64, error) {
+	ep := c.activeEndpoint()
+	res, err := ep.EtherBalanceAt(ctx, addr)
+	ep.track(err)
+
+	return res, err
+}
+
+func (c *Client) FilterLogs(ctx context.Context, q ethereum.FilterQuery) ([]types.Log, error) {
+	ep := c.activeEndpoint()
+	res, err := ep.FilterLogs(ctx, q)
+	ep.track(err)
+
+	return res, err
+}
+
+func (c *Client) HeaderByHash(ctx context.Context, hash common.Hash) (*types.Header, error) {
+	ep := c.activeEndpoint()
+	res, err := ep.HeaderByHash(ctx, hash)
+	ep.track(err)
+
+	return res, err
+}
+
+func (c *Client) HeaderByNumber(ctx context.Context, number *big.Int) (*types.Header, error) {
+	ep := c.activeEndpoint()
+	res, err := ep.HeaderByNumber(ctx, number)
+	ep.track(err)
+
+	return res, err
+}
+
+func (c *Client) HeaderByType(ctx context.Context, typ ethclient.HeadType) (*types.Header, error) {
+	ep := c.activeEndpoint()
+	res, err := ep.HeaderByType(ctx, typ)
+	ep.track(err)
+
+	return res, err
+}
+
+func (c *Client) NonceAt(ctx context.Context, account common.Address, blockNumber *big.Int) (uint64, error) {
+	ep := c.activeEndpoint()
+	res, err := ep.NonceAt(ctx, account, blockNumber)
+	ep.track(err)
+
+	return res, err
+}
+
+func (c *Client) PeerCount(ctx context.Context) (uint64, error) {
+	ep := c.activeEndpoint()
+	res, err := ep.PeerCount(ctx)
+	ep.track(err)
+
+	return res, err
+}
+
+func (c *Client) PendingBalanceAt(ctx context.Context, account common.Address) (*big.Int, error) {
+	ep := c.activeEndpoint()
+	res, err := ep.PendingBalanceAt(ctx, account)
+	ep.track(err)
+
+	return res, err
+}
+
+func (c *Client) PendingCodeAt(ctx context.Context, account common.Address) ([]byte, error) {
+	ep := c.activeEndpoint()
+	res, err := ep.PendingCodeAt(ctx, account)
+	ep.track(err)
+
+	return res, err
+}
+
+func (c *Client) PendingNonceAt(ctx context.Context, account common.Address) (uint64, error) {
+	ep := c.activeEndpoint()
+	res, err := ep.PendingNonceAt(ctx, account)
+	ep.track(err)
+
+	return res, err
+}
+
+func (c *Client) PendingStorageAt(ctx context.Context, account common.Address, key common.Hash) ([]byte, error) {
+	ep := c.activeEndpoint()
+	res, err := ep.PendingStorageAt(ctx, account, key)
+	ep.track(err)
+
+	return res, err
+}
+
+func (c *Client) PendingTransactionCount(ctx context.Context) (uint, error) {
+	ep := c.activeEndpoint()
+	res, err := ep.PendingTransactionCount(ctx)
+	ep.track(err)
+
+	return res, err
+}
+
+func (c *Client) ProgressIfSyncing(ctx context.Context) (*ethereum.SyncProgress, bool, error) {
+	ep := c.activeEndpoint()
+	res, ok, err := ep.ProgressIfSyncing(ctx)
+	ep.track(err)
+
+	return res, ok, err
+}
+
+func (c *Client) SendTransaction(ctx context.Context, tx *types.Transaction) error {
+	ep := c.activeEndpoint()
+	err := ep.SendTransaction(ctx, tx)
+	ep.track(err)
+
+	return err
+}
+
+func (c *Client) SetHead(ctx context.Context, height uint64) error {
+	ep := c.activeEndpoint()
+	err := ep.SetHead(ctx, height)
+	ep.track(err)
+
+	return err
+}
+
+func (c *Client) StorageAt(ctx context.Context, account common.Address, key common.Hash, blockNumber *big.Int) ([]byte, error) {
+	ep := c.activeEndpoint()
+	res, err := ep.StorageAt(ctx, account, key, blockNumber)
+	ep.track(err)
+
+	return res, err
+}
+
+func (c *Client) SubscribeFilterLogs(ctx context.Context, q ethereum.FilterQuery, ch chan<- types.Log) (ethereum.Subscription, error) {
+	ep := c.activeEndpoint()
+	res, err := ep.SubscribeFilterLogs(ctx, q, ch)
+	ep.track(err)
+
+	return res, err
+}
+
+func (c *Client) SubscribeNewHead(ctx context.Context, ch chan<- *types.Header) (ethereum.Subscription, error) {
+	ep := c.activeEndpoint()
+	res, err := ep.SubscribeNewHead(ctx, ch)
+	ep.track(err)
+
+	return res, err
+}
+
+func (c *Client) SuggestGasPrice(ctx context.Context) (*big.Int, error) {
+	ep := c.activeEndpoint()
+	res, err := ep.SuggestGasPrice(ctx)
+	ep.track(err)
+
+	return res, err
+}
+
+func (c *Client) SuggestGasTipCap(ctx context.Context) (*big.Int, error) {
+	ep := c.activeEndpoint()
+	res, err := ep.SuggestGasTipCap(ctx)
+	ep.track(err)
+
+	return res, err
+}
+
+func (c *Client) TransactionByHash(ctx context.Context, txHash common.Hash) (*types.Transaction, bool, error) {
+	ep := c.activeEndpoint()
+	res, ok, err := ep.TransactionByHash(ctx, txHash)
+	ep.track(err)
+
+	return res, ok, err
+}
+
+func (c *Client) TransactionCount(ctx context.Context, blockHash common.Hash) (uint, error) {
+	ep := c.activeEndpoint()
+	res, err := ep.TransactionCount(ctx, blockHash)
+	ep.track(err)
+
+	return res, err
+}
+
+func (c *Client) TransactionInBlock(ctx context.Context, blockHash common.Hash, index uint) (*types.Transaction, error) {
+	ep := c.activeEndpoint()
+	res, err := ep.TransactionInBlock(ctx, blockHash, index)
+	ep.track(err)
+
+	return res, err
+}
+
+func (c *Client) TransactionReceipt(ctx context.Context, txHash common.Hash) (*types.Receipt, error) {
+	ep := c.activeEndpoint()
+	res, err := ep.TransactionReceipt(ctx, txHash)
+	ep.track(err)
+
+	return res, err
+}
+
+func (c *Client) TxReceipt(ctx context.Context, txHash common.Hash) (*ethclient.Receipt, error) {
+	ep := c.activeEndpoint()
+	res, err := ep.TxReceipt(ctx, txHash)
+	ep.track(err)
+
+	return res, err
+}
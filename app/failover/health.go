@@ -0,0 +1,159 @@
+package failover
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-metrics"
+	"github.com/omni-network/omni/lib/errors"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+)
+
+// health is the result of the last probe of an endpoint.
+type health struct {
+	healthy   bool
+	syncing   bool
+	head      uint64
+	headLag   uint64
+	errorRate float64
+	err       error
+}
+
+// reason returns why the endpoint is unhealthy.
+func (h health) reason() string {
+	switch {
+	case h.err != nil:
+		return h.err.Error()
+	case h.syncing:
+		return "syncing"
+	default:
+		return fmt.Sprintf("head lag %d, error rate %.2f", h.headLag, h.errorRate)
+	}
+}
+
+func (c *Client) runProbes(ctx context.Context) {
+	ticker := time.NewTicker(c.cfg.ProbeInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			c.probe(ctx)
+		}
+	}
+}
+
+// probe probes the health of all the endpoints and fails over if the active endpoint is unhealthy.
+func (c *Client) probe(ctx context.Context) {
+	ctx, cancel := context.WithTimeout(ctx, c.cfg.ProbeInterval)
+	defer cancel()
+
+	var maxHead uint64
+	for _, ep := range c.endpoints {
+		ep.health = ep.probe(ctx)
+		if ep.health.err == nil && ep.health.head > maxHead {
+			maxHead = ep.health.head
+		}
+	}
+
+	for _, ep := range c.endpoints {
+		h := &ep.health
+		if h.err == nil {
+			h.headLag = maxHead - h.head
+		}
+		h.healthy = h.err == nil && !h.syncing && h.headLag <= c.cfg.MaxHeadLag && h.errorRate <= c.cfg.MaxErrorRate
+	}
+
+	c.maybeFailover()
+	c.emitMetrics()
+}
+
+// probe checks the sync status and the head of the endpoint, and resets the error rate window.
+func (e *endpoint) probe(ctx context.Context) health {
+	var h health
+
+	_, syncing, err := e.ProgressIfSyncing(ctx)
+	e.track(err)
+	if err != nil {
+		h.err = errors.Wrap(err, "probe sync status")
+	}
+	h.syncing = syncing
+
+	if h.err == nil {
+		head, err := e.BlockNumber(ctx)
+		e.track(err)
+		if err != nil {
+			h.err = errors.Wrap(err, "probe head")
+		}
+		h.head = head
+	}
+
+	calls, errs := e.calls.Swap(0), e.errors.Swap(0)
+	if calls > 0 {
+		h.errorRate = float64(errs) / float64(calls)
+	}
+
+	return h
+}
+
+// maybeFailover switches the active endpoint to the first healthy endpoint if the active one is unhealthy.
+// An endpoint which still has mirrored calls queued or in flight is skipped, since it hasn't caught up yet.
+func (c *Client) maybeFailover() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	current := c.endpoints[c.active]
+	if current.health.healthy {
+		return
+	}
+
+	for i, ep := range c.endpoints {
+		if i == c.active || !ep.health.healthy || ep.pending.Load() > 0 {
+			continue
+		}
+
+		c.logger.Warn("Failing over to another engine endpoint",
+			"from", current.name,
+			"to", ep.name,
+			"reason", current.health.reason(),
+		)
+		telemetry.IncrCounterWithLabels(
+			[]string{"engine", "failover"},
+			1,
+			[]metrics.Label{
+				telemetry.NewLabel("from", current.name),
+				telemetry.NewLabel("to", ep.name),
+			},
+		)
+		c.active = i
+
+		return
+	}
+
+	c.logger.Error("No healthy engine endpoint to fail over to", "active", current.name, "reason", current.health.reason())
+}
+
+func (c *Client) emitMetrics() {
+	active := c.activeEndpoint()
+	for _, ep := range c.endpoints {
+		labels := []metrics.Label{telemetry.NewLabel("endpoint", ep.name)}
+		telemetry.SetGaugeWithLabels([]string{"engine", "endpoint", "healthy"}, boolToFloat(ep.health.healthy), labels)
+		telemetry.SetGaugeWithLabels([]string{"engine", "endpoint", "active"}, boolToFloat(ep == active), labels)
+		telemetry.SetGaugeWithLabels([]string{"engine", "endpoint", "syncing"}, boolToFloat(ep.health.syncing), labels)
+		telemetry.SetGaugeWithLabels([]string{"engine", "endpoint", "head"}, float32(ep.health.head), labels)
+		telemetry.SetGaugeWithLabels([]string{"engine", "endpoint", "head_lag"}, float32(ep.health.headLag), labels)
+		telemetry.SetGaugeWithLabels([]string{"engine", "endpoint", "error_rate"}, float32(ep.health.errorRate), labels)
+	}
+}
+
+func boolToFloat(b bool) float32 {
+	if b {
+		return 1
+	}
+
+	return 0
+}
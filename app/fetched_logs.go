@@ -0,0 +1,99 @@
+package app
+
+import (
+	"context"
+	"sync"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/omni-network/omni/lib/ethclient"
+
+	evmvaltypes "github.com/mitosis-org/chain/x/evmvalidator/types"
+)
+
+// maxFetchedLogBlocks is the number of the last EVM blocks whose fetched logs are kept.
+const maxFetchedLogBlocks = 16
+
+var _ evmvaltypes.EventLogSource = (*logRecordingClient)(nil)
+
+type fetchedLogsKey struct {
+	blockHash common.Hash
+	addr      common.Address
+}
+
+// logRecordingClient is an execution client recording the logs fetched by block hash.
+//
+// x/evmengine fetches the logs of a finalized EVM block right before delivering them as events, but the delivered
+// events don't include the tx hash and the log index. The recorded logs provide them without calling the execution
+// client again, so the receipts of the delivered events are derived from the same logs as the events.
+type logRecordingClient struct {
+	ethclient.EngineClient
+
+	mu     sync.Mutex
+	logs   map[fetchedLogsKey][]ethtypes.Log
+	blocks []common.Hash // in the order of fetching
+}
+
+func newLogRecordingClient(engineCl ethclient.EngineClient) *logRecordingClient {
+	return &logRecordingClient{
+		EngineClient: engineCl,
+		logs:         make(map[fetchedLogsKey][]ethtypes.Log),
+	}
+}
+
+// FilterLogs returns the logs matching the query and records them if queried by block hash.
+func (c *logRecordingClient) FilterLogs(ctx context.Context, q ethereum.FilterQuery) ([]ethtypes.Log, error) {
+	logs, err := c.EngineClient.FilterLogs(ctx, q)
+	if err != nil || q.BlockHash == nil {
+		return logs, err
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.touchBlock(*q.BlockHash)
+	for _, addr := range q.Addresses {
+		var addrLogs []ethtypes.Log
+		for _, l := range logs {
+			if l.Address == addr {
+				addrLogs = append(addrLogs, l)
+			}
+		}
+		c.logs[fetchedLogsKey{blockHash: *q.BlockHash, addr: addr}] = addrLogs
+	}
+
+	return logs, nil
+}
+
+// FetchedLogs implements evmvaltypes.EventLogSource.
+func (c *logRecordingClient) FetchedLogs(blockHash common.Hash, contractAddr common.Address) ([]ethtypes.Log, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	logs, ok := c.logs[fetchedLogsKey{blockHash: blockHash, addr: contractAddr}]
+
+	return logs, ok
+}
+
+// touchBlock records the fetching of the block, forgetting the logs of the oldest block if too many are kept.
+func (c *logRecordingClient) touchBlock(blockHash common.Hash) {
+	for _, b := range c.blocks {
+		if b == blockHash {
+			return
+		}
+	}
+
+	c.blocks = append(c.blocks, blockHash)
+	if len(c.blocks) <= maxFetchedLogBlocks {
+		return
+	}
+
+	oldest := c.blocks[0]
+	c.blocks = c.blocks[1:]
+	for key := range c.logs {
+		if key.blockHash == oldest {
+			delete(c.logs, key)
+		}
+	}
+}
@@ -0,0 +1,133 @@
+package app
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/mitosis-org/chain/app/upgrades"
+	"github.com/omni-network/omni/lib/errors"
+	"github.com/pelletier/go-toml/v2"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+)
+
+// ForksConfigFileName is the name of the file in the config directory of the node home
+// which defines height-triggered forks in addition to the compiled-in Forks.
+//
+// It is intended for devnets and testnets to rehearse hard forks without rebuilding the binary.
+const ForksConfigFileName = "forks.toml"
+
+// ForkConfig defines a height-triggered fork in the forks config file.
+type ForkConfig struct {
+	Name   string `toml:"name"`
+	Height int64  `toml:"height"`
+	Info   string `toml:"info"`
+}
+
+// ForksConfig defines the forks config file.
+//
+// Example:
+//
+//	[[forks]]
+//	name = "v2"
+//	height = 1000
+//	info = "rehearsal of the v2 hard fork"
+type ForksConfig struct {
+	Forks []ForkConfig `toml:"forks"`
+}
+
+// LoadForksConfig loads the forks config file from the given path.
+// It returns an empty config if the file does not exist.
+func LoadForksConfig(path string) (ForksConfig, error) {
+	bz, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return ForksConfig{}, nil
+	} else if err != nil {
+		return ForksConfig{}, errors.Wrap(err, "failed to read forks config file")
+	}
+
+	var cfg ForksConfig
+	if err := toml.NewDecoder(bytes.NewReader(bz)).DisallowUnknownFields().Decode(&cfg); err != nil {
+		return ForksConfig{}, errors.Wrap(err, fmt.Sprintf("failed to parse forks config file %s", path))
+	}
+
+	return cfg, nil
+}
+
+// MergeForks validates the configured forks and merges them into the compiled-in forks.
+//
+// A configured fork conflicts with a compiled-in fork if they share the name or the height but differ
+// in any other field. A configured fork identical to a compiled-in one is ignored.
+func MergeForks(compiled []upgrades.Fork, configured []ForkConfig) ([]upgrades.Fork, error) {
+	forks := make([]upgrades.Fork, len(compiled))
+	copy(forks, compiled)
+
+	names := make(map[string]bool)
+	heights := make(map[int64]bool)
+	for _, fc := range configured {
+		if fc.Name == "" {
+			return nil, errors.New("invalid fork: empty name")
+		}
+		if fc.Height <= 0 {
+			return nil, errors.New(fmt.Sprintf("invalid fork %q: height must be positive, got %d", fc.Name, fc.Height))
+		}
+		if names[fc.Name] {
+			return nil, errors.New(fmt.Sprintf("duplicate fork name %q", fc.Name))
+		}
+		if heights[fc.Height] {
+			return nil, errors.New(fmt.Sprintf("duplicate fork height %d", fc.Height))
+		}
+		names[fc.Name] = true
+		heights[fc.Height] = true
+
+		fork := upgrades.Fork{
+			UpgradeName:   fc.Name,
+			UpgradeHeight: fc.Height,
+			UpgradeInfo:   fc.Info,
+		}
+
+		duplicated := false
+		for _, c := range compiled {
+			if c.UpgradeName != fork.UpgradeName && c.UpgradeHeight != fork.UpgradeHeight {
+				continue
+			}
+			if c != fork {
+				return nil, errors.New(fmt.Sprintf(
+					"fork %q at height %d conflicts with compiled-in fork %q at height %d",
+					fork.UpgradeName, fork.UpgradeHeight, c.UpgradeName, c.UpgradeHeight,
+				))
+			}
+			duplicated = true
+		}
+
+		if !duplicated {
+			forks = append(forks, fork)
+		}
+	}
+
+	return forks, nil
+}
+
+// loadForks returns the compiled-in forks merged with the ones in the forks config file of the node home.
+func loadForks(appOpts servertypes.AppOptions) ([]upgrades.Fork, error) {
+	homePath, ok := appOpts.Get(flags.FlagHome).(string)
+	if !ok || homePath == "" {
+		return Forks, nil
+	}
+
+	path := filepath.Join(homePath, "config", ForksConfigFileName)
+	cfg, err := LoadForksConfig(path)
+	if err != nil {
+		return nil, err
+	}
+
+	forks, err := MergeForks(Forks, cfg.Forks)
+	if err != nil {
+		return nil, errors.Wrap(err, fmt.Sprintf("invalid forks config file %s", path))
+	}
+
+	return forks, nil
+}
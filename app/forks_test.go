@@ -0,0 +1,118 @@
+package app_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/mitosis-org/chain/app"
+	"github.com/mitosis-org/chain/app/upgrades"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoadForksConfig(t *testing.T) {
+	dir := t.TempDir()
+
+	// A missing file results in no forks.
+	cfg, err := app.LoadForksConfig(filepath.Join(dir, app.ForksConfigFileName))
+	require.NoError(t, err)
+	require.Empty(t, cfg.Forks)
+
+	path := filepath.Join(dir, app.ForksConfigFileName)
+	require.NoError(t, os.WriteFile(path, []byte(`
+[[forks]]
+name = "v2"
+height = 100
+info = "rehearsal"
+
+[[forks]]
+name = "v3"
+height = 200
+`), 0o600))
+
+	cfg, err = app.LoadForksConfig(path)
+	require.NoError(t, err)
+	require.Equal(t, []app.ForkConfig{
+		{Name: "v2", Height: 100, Info: "rehearsal"},
+		{Name: "v3", Height: 200},
+	}, cfg.Forks)
+
+	require.NoError(t, os.WriteFile(path, []byte("[[forks]]\nname = \"v2\"\nheigth = 100\n"), 0o600))
+	_, err = app.LoadForksConfig(path)
+	require.Error(t, err)
+}
+
+func TestMergeForks(t *testing.T) {
+	compiled := []upgrades.Fork{
+		{UpgradeName: "v2", UpgradeHeight: 100, UpgradeInfo: "info"},
+	}
+
+	tests := []struct {
+		name       string
+		configured []app.ForkConfig
+		expected   []upgrades.Fork
+		errMsg     string
+	}{
+		{
+			name:     "no configured forks",
+			expected: compiled,
+		},
+		{
+			name:       "new fork",
+			configured: []app.ForkConfig{{Name: "v3", Height: 200}},
+			expected:   append(compiled, upgrades.Fork{UpgradeName: "v3", UpgradeHeight: 200}),
+		},
+		{
+			name:       "identical to compiled-in fork",
+			configured: []app.ForkConfig{{Name: "v2", Height: 100, Info: "info"}},
+			expected:   compiled,
+		},
+		{
+			name:       "same name with different height",
+			configured: []app.ForkConfig{{Name: "v2", Height: 101, Info: "info"}},
+			errMsg:     "conflicts with compiled-in fork",
+		},
+		{
+			name:       "same height with different name",
+			configured: []app.ForkConfig{{Name: "v3", Height: 100}},
+			errMsg:     "conflicts with compiled-in fork",
+		},
+		{
+			name:       "same name with different info",
+			configured: []app.ForkConfig{{Name: "v2", Height: 100}},
+			errMsg:     "conflicts with compiled-in fork",
+		},
+		{
+			name:       "empty name",
+			configured: []app.ForkConfig{{Height: 200}},
+			errMsg:     "empty name",
+		},
+		{
+			name:       "non-positive height",
+			configured: []app.ForkConfig{{Name: "v3"}},
+			errMsg:     "height must be positive",
+		},
+		{
+			name:       "duplicate name",
+			configured: []app.ForkConfig{{Name: "v3", Height: 200}, {Name: "v3", Height: 300}},
+			errMsg:     "duplicate fork name",
+		},
+		{
+			name:       "duplicate height",
+			configured: []app.ForkConfig{{Name: "v3", Height: 200}, {Name: "v4", Height: 200}},
+			errMsg:     "duplicate fork height",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			forks, err := app.MergeForks(compiled, tc.configured)
+			if tc.errMsg != "" {
+				require.ErrorContains(t, err, tc.errMsg)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, forks)
+		})
+	}
+}
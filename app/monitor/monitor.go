@@ -0,0 +1,361 @@
+package monitor
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"cosmossdk.io/log"
+	"github.com/hashicorp/go-metrics"
+	"github.com/omni-network/omni/lib/errors"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
+
+	evmvaltypes "github.com/mitosis-org/chain/x/evmvalidator/types"
+)
+
+// Kinds of the alerts. They are used as a metric label, so they must have a low cardinality.
+const (
+	AlertConsecutiveMissed = "consecutive_missed_blocks"
+	AlertMissedBlocks      = "missed_blocks"
+	AlertLowVotingPower    = "low_voting_power"
+	AlertJailed            = "jailed"
+)
+
+// ValidatorKeeper is the subset of the x/evmvalidator keeper used by the monitor.
+type ValidatorKeeper interface {
+	GetParams(ctx sdk.Context) evmvaltypes.Params
+	GetValidatorByConsAddr(ctx sdk.Context, consAddr sdk.ConsAddress) (evmvaltypes.Validator, bool)
+}
+
+// SlashingKeeper is the subset of the x/slashing keeper used by the monitor.
+type SlashingKeeper interface {
+	GetValidatorSigningInfo(ctx context.Context, consAddr sdk.ConsAddress) (slashingtypes.ValidatorSigningInfo, error)
+	SignedBlocksWindow(ctx context.Context) (int64, error)
+	MinSignedPerWindow(ctx context.Context) (int64, error)
+}
+
+// Config is the configuration of the monitor.
+type Config struct {
+	// WebhookURL is the URL the alerts are posted to as JSON. If it is empty, the alerts are only logged.
+	WebhookURL string
+	// ConsecutiveMissed is the number of consecutive missed blocks which triggers an alert.
+	ConsecutiveMissed uint64
+	// MissedBlocksRatio is the ratio of the missed blocks in the slashing window to the missed blocks
+	// allowed before the validator is jailed, which triggers an alert.
+	MissedBlocksRatio float64
+	// VotingPowerMargin triggers an alert when the voting power is at most (1 + VotingPowerMargin) * MinVotingPower,
+	// since the validator is jailed once its voting power falls below MinVotingPower.
+	VotingPowerMargin float64
+	// AlertInterval is the minimum interval of repeating an alert which is still firing.
+	AlertInterval time.Duration
+}
+
+// DefaultConfig returns the default configuration.
+func DefaultConfig() Config {
+	return Config{
+		WebhookURL:        "",
+		ConsecutiveMissed: 5,
+		MissedBlocksRatio: 0.5,
+		VotingPowerMargin: 0.1,
+		AlertInterval:     10 * time.Minute,
+	}
+}
+
+// Validate returns an error if the configuration is invalid.
+func (c Config) Validate() error {
+	if c.ConsecutiveMissed == 0 {
+		return errors.New("consecutive missed blocks must be positive")
+	}
+	if c.MissedBlocksRatio <= 0 || c.MissedBlocksRatio > 1 {
+		return errors.New(fmt.Sprintf("missed blocks ratio must be in (0, 1]: %v", c.MissedBlocksRatio))
+	}
+	if c.VotingPowerMargin < 0 {
+		return errors.New(fmt.Sprintf("voting power margin must not be negative: %v", c.VotingPowerMargin))
+	}
+	if c.AlertInterval <= 0 {
+		return errors.New("alert interval must be positive")
+	}
+
+	return nil
+}
+
+// Status is the status of the local validator observed at a block.
+type Status struct {
+	Height int64
+	// Active is whether the validator is in the validator set of the last commit.
+	Active bool
+	// Signed is whether the validator signed the last commit.
+	Signed            bool
+	ConsecutiveMissed uint64
+
+	// MissedBlocks is the number of the missed blocks in the slashing window.
+	MissedBlocks int64
+	// MaxMissedBlocks is the number of the missed blocks in the slashing window allowed before being jailed.
+	MaxMissedBlocks int64
+	// Uptime is the ratio of the signed blocks in the slashing window.
+	Uptime float64
+
+	Registered     bool
+	Jailed         bool
+	VotingPower    int64
+	MinVotingPower int64
+}
+
+// Monitor monitors the local validator at every finalized block.
+//
+// It checks whether the validator signed the last commit, its missed blocks in the slashing window
+// and its voting power, which jails the validator once it falls below MinVotingPower.
+// The status is exposed as metrics, and alerts are logged and posted to the webhook if configured.
+//
+// An alert fires once when its condition is met, repeats every AlertInterval while the condition holds,
+// and is resolved once when the condition no longer holds.
+type Monitor struct {
+	cfg            Config
+	logger         log.Logger
+	consAddr       sdk.ConsAddress
+	valKeeper      ValidatorKeeper
+	slashingKeeper SlashingKeeper
+	webhook        *webhook
+
+	mu                sync.Mutex
+	consecutiveMissed uint64
+	// firing is the last time each firing alert was sent
+	firing map[string]time.Time
+	now    func() time.Time
+}
+
+// New returns a monitor of the validator with the consensus address.
+func New(
+	cfg Config,
+	logger log.Logger,
+	consAddr sdk.ConsAddress,
+	valKeeper ValidatorKeeper,
+	slashingKeeper SlashingKeeper,
+) (*Monitor, error) {
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+
+	m := &Monitor{
+		cfg:            cfg,
+		logger:         logger,
+		consAddr:       consAddr,
+		valKeeper:      valKeeper,
+		slashingKeeper: slashingKeeper,
+		firing:         make(map[string]time.Time),
+		now:            time.Now,
+	}
+	if cfg.WebhookURL != "" {
+		m.webhook = newWebhook(cfg.WebhookURL, logger)
+	}
+
+	return m, nil
+}
+
+// Start posts the alerts to the webhook in the background until the context is done.
+func (m *Monitor) Start(ctx context.Context) {
+	if m.webhook != nil {
+		go m.webhook.run(ctx)
+	}
+}
+
+// ObserveBlock observes the local validator in the commit of the last block and the state after the block.
+// It is called at every finalized block, so it never fails or panics not to affect the consensus.
+func (m *Monitor) ObserveBlock(ctx sdk.Context, lastCommit abci.CommitInfo) {
+	defer func() {
+		if r := recover(); r != nil {
+			m.logger.Error("Monitoring the validator panicked [BUG]", "height", ctx.BlockHeight(), "panic", r)
+		}
+	}()
+
+	// There is no commit to check at the first block.
+	if len(lastCommit.Votes) == 0 {
+		return
+	}
+
+	status, err := m.observe(ctx, lastCommit)
+	if err != nil {
+		m.logger.Error("Failed to monitor the validator", "height", ctx.BlockHeight(), "err", err)
+		return
+	}
+
+	emitMetrics(status)
+	m.checkAlerts(status)
+}
+
+func (m *Monitor) observe(ctx sdk.Context, lastCommit abci.CommitInfo) (Status, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	status := Status{Height: ctx.BlockHeight()}
+
+	for _, vote := range lastCommit.Votes {
+		if bytes.Equal(vote.Validator.Address, m.consAddr) {
+			status.Active = true
+			status.Signed = vote.BlockIdFlag == cmtproto.BlockIDFlagCommit
+			break
+		}
+	}
+
+	switch {
+	case !status.Active:
+		m.consecutiveMissed = 0
+	case status.Signed:
+		m.consecutiveMissed = 0
+		telemetry.IncrCounter(1, "monitor", "blocks", "signed")
+	default:
+		m.consecutiveMissed++
+		telemetry.IncrCounter(1, "monitor", "blocks", "missed")
+	}
+	status.ConsecutiveMissed = m.consecutiveMissed
+
+	validator, found := m.valKeeper.GetValidatorByConsAddr(ctx, m.consAddr)
+	if found {
+		status.Registered = true
+		status.Jailed = validator.Jailed
+		status.VotingPower = validator.VotingPower
+	}
+	status.MinVotingPower = m.valKeeper.GetParams(ctx).MinVotingPower
+
+	// The signing info only exists once the validator has been bonded.
+	signingInfo, err := m.slashingKeeper.GetValidatorSigningInfo(ctx, m.consAddr)
+	if errors.Is(err, slashingtypes.ErrNoSigningInfoFound) {
+		return status, nil
+	} else if err != nil {
+		return Status{}, errors.Wrap(err, "get signing info")
+	}
+
+	window, err := m.slashingKeeper.SignedBlocksWindow(ctx)
+	if err != nil {
+		return Status{}, errors.Wrap(err, "get signed blocks window")
+	}
+	minSigned, err := m.slashingKeeper.MinSignedPerWindow(ctx)
+	if err != nil {
+		return Status{}, errors.Wrap(err, "get min signed per window")
+	}
+
+	status.MissedBlocks = signingInfo.MissedBlocksCounter
+	status.MaxMissedBlocks = window - minSigned
+	if window > 0 {
+		status.Uptime = 1 - float64(signingInfo.MissedBlocksCounter)/float64(window)
+	}
+
+	return status, nil
+}
+
+// alert is an alert of the local validator.
+type alert struct {
+	kind    string
+	message string
+}
+
+// alerts returns the alerts whose conditions are met by the status, and the kinds of all the alerts checked.
+func (m *Monitor) alerts(status Status) (firing []alert, checked []string) {
+	checked = []string{AlertConsecutiveMissed, AlertMissedBlocks}
+	if status.ConsecutiveMissed >= m.cfg.ConsecutiveMissed {
+		firing = append(firing, alert{
+			kind:    AlertConsecutiveMissed,
+			message: fmt.Sprintf("validator missed the last %d blocks", status.ConsecutiveMissed),
+		})
+	}
+	if status.MaxMissedBlocks > 0 && float64(status.MissedBlocks) >= m.cfg.MissedBlocksRatio*float64(status.MaxMissedBlocks) {
+		firing = append(firing, alert{
+			kind:    AlertMissedBlocks,
+			message: fmt.Sprintf("validator missed %d blocks in the slashing window, jailed after missing %d", status.MissedBlocks, status.MaxMissedBlocks),
+		})
+	}
+
+	// The voting power is only checked for a registered validator.
+	if !status.Registered {
+		return firing, checked
+	}
+
+	checked = append(checked, AlertJailed, AlertLowVotingPower)
+	if status.Jailed {
+		firing = append(firing, alert{
+			kind:    AlertJailed,
+			message: "validator is jailed",
+		})
+	} else if float64(status.VotingPower) <= (1+m.cfg.VotingPowerMargin)*float64(status.MinVotingPower) {
+		firing = append(firing, alert{
+			kind:    AlertLowVotingPower,
+			message: fmt.Sprintf("validator voting power %d is close to the minimum %d, jailed below it", status.VotingPower, status.MinVotingPower),
+		})
+	}
+
+	return firing, checked
+}
+
+// checkAlerts sends the alerts which start firing or are due to repeat, and resolves the alerts which stopped firing.
+func (m *Monitor) checkAlerts(status Status) {
+	firing, checked := m.alerts(status)
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	now := m.now()
+	isFiring := make(map[string]bool)
+	for _, a := range firing {
+		isFiring[a.kind] = true
+
+		if last, ok := m.firing[a.kind]; ok && now.Sub(last) < m.cfg.AlertInterval {
+			continue
+		}
+		m.firing[a.kind] = now
+
+		m.logger.Warn("Validator alert", "alert", a.kind, "height", status.Height, "msg", a.message)
+		telemetry.IncrCounterWithLabels([]string{"monitor", "alerts"}, 1, []metrics.Label{telemetry.NewLabel("alert", a.kind)})
+		m.send(Alert{Kind: a.kind, Status: AlertStatusFiring, Message: a.message}, status)
+	}
+
+	for _, kind := range checked {
+		if _, ok := m.firing[kind]; !ok || isFiring[kind] {
+			continue
+		}
+		delete(m.firing, kind)
+
+		m.logger.Info("Validator alert resolved", "alert", kind, "height", status.Height)
+		m.send(Alert{Kind: kind, Status: AlertStatusResolved, Message: "resolved"}, status)
+	}
+}
+
+func (m *Monitor) send(a Alert, status Status) {
+	if m.webhook == nil {
+		return
+	}
+
+	a.Validator = m.consAddr.String()
+	a.Height = status.Height
+	a.Time = m.now().UTC()
+	a.MissedBlocks = status.MissedBlocks
+	a.MaxMissedBlocks = status.MaxMissedBlocks
+	a.VotingPower = status.VotingPower
+	a.MinVotingPower = status.MinVotingPower
+	m.webhook.enqueue(a)
+}
+
+func emitMetrics(status Status) {
+	telemetry.SetGauge(boolToFloat(status.Active), "monitor", "active")
+	telemetry.SetGauge(float32(status.ConsecutiveMissed), "monitor", "consecutive_missed_blocks")
+	telemetry.SetGauge(float32(status.MissedBlocks), "monitor", "missed_blocks")
+	telemetry.SetGauge(float32(status.MaxMissedBlocks), "monitor", "max_missed_blocks")
+	telemetry.SetGauge(float32(status.Uptime), "monitor", "uptime")
+	telemetry.SetGauge(boolToFloat(status.Jailed), "monitor", "jailed")
+	telemetry.SetGauge(float32(status.VotingPower), "monitor", "voting_power")
+	telemetry.SetGauge(float32(status.MinVotingPower), "monitor", "min_voting_power")
+}
+
+func boolToFloat(b bool) float32 {
+	if b {
+		return 1
+	}
+
+	return 0
+}
@@ -0,0 +1,335 @@
+package monitor
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"cosmossdk.io/log"
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mitosis-org/chain/testutil/network"
+	evmvaltypes "github.com/mitosis-org/chain/x/evmvalidator/types"
+)
+
+var (
+	testConsAddr  = sdk.ConsAddress("validator-consaddr-")
+	otherConsAddr = sdk.ConsAddress("other-consaddr-0000")
+)
+
+type fakeValidatorKeeper struct {
+	validator *evmvaltypes.Validator
+	params    evmvaltypes.Params
+}
+
+func (k *fakeValidatorKeeper) GetParams(sdk.Context) evmvaltypes.Params {
+	return k.params
+}
+
+func (k *fakeValidatorKeeper) GetValidatorByConsAddr(sdk.Context, sdk.ConsAddress) (evmvaltypes.Validator, bool) {
+	if k.validator == nil {
+		return evmvaltypes.Validator{}, false
+	}
+
+	return *k.validator, true
+}
+
+type fakeSlashingKeeper struct {
+	signingInfo *slashingtypes.ValidatorSigningInfo
+	window      int64
+	minSigned   int64
+}
+
+func (k *fakeSlashingKeeper) GetValidatorSigningInfo(context.Context, sdk.ConsAddress) (slashingtypes.ValidatorSigningInfo, error) {
+	if k.signingInfo == nil {
+		return slashingtypes.ValidatorSigningInfo{}, slashingtypes.ErrNoSigningInfoFound
+	}
+
+	return *k.signingInfo, nil
+}
+
+func (k *fakeSlashingKeeper) SignedBlocksWindow(context.Context) (int64, error) {
+	return k.window, nil
+}
+
+func (k *fakeSlashingKeeper) MinSignedPerWindow(context.Context) (int64, error) {
+	return k.minSigned, nil
+}
+
+type testMonitor struct {
+	*Monitor
+	valKeeper      *fakeValidatorKeeper
+	slashingKeeper *fakeSlashingKeeper
+	alerts         chan Alert
+	now            time.Time
+	height         int64
+}
+
+func newTestMonitor(t *testing.T) *testMonitor {
+	t.Helper()
+
+	alerts := make(chan Alert, 16)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var a Alert
+		if err := json.NewDecoder(r.Body).Decode(&a); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		alerts <- a
+	}))
+	t.Cleanup(server.Close)
+
+	tm := &testMonitor{
+		valKeeper: &fakeValidatorKeeper{
+			validator: &evmvaltypes.Validator{VotingPower: 100},
+			params:    evmvaltypes.Params{MinVotingPower: 10},
+		},
+		slashingKeeper: &fakeSlashingKeeper{
+			signingInfo: &slashingtypes.ValidatorSigningInfo{},
+			window:      100,
+			minSigned:   50,
+		},
+		alerts: alerts,
+		now:    time.Unix(1_700_000_000, 0),
+	}
+
+	cfg := DefaultConfig()
+	cfg.WebhookURL = server.URL
+	m, err := New(cfg, log.NewNopLogger(), testConsAddr, tm.valKeeper, tm.slashingKeeper)
+	require.NoError(t, err)
+	m.now = func() time.Time { return tm.now }
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+	m.Start(ctx)
+
+	tm.Monitor = m
+
+	return tm
+}
+
+// observe observes a block whose last commit is signed by the validator or not.
+func (tm *testMonitor) observe(signed bool) {
+	flag := cmtproto.BlockIDFlagCommit
+	if !signed {
+		flag = cmtproto.BlockIDFlagAbsent
+	}
+
+	tm.height++
+	tm.now = tm.now.Add(time.Second)
+	tm.ObserveBlock(sdk.Context{}.WithBlockHeight(tm.height), abci.CommitInfo{Votes: []abci.VoteInfo{
+		{Validator: abci.Validator{Address: otherConsAddr, Power: 100}, BlockIdFlag: cmtproto.BlockIDFlagCommit},
+		{Validator: abci.Validator{Address: testConsAddr, Power: 100}, BlockIdFlag: flag},
+	}})
+}
+
+func (tm *testMonitor) requireAlert(t *testing.T, kind string, status string) Alert {
+	t.Helper()
+
+	select {
+	case a := <-tm.alerts:
+		require.Equal(t, kind, a.Kind)
+		require.Equal(t, status, a.Status)
+		require.Equal(t, testConsAddr.String(), a.Validator)
+		require.Equal(t, tm.height, a.Height)
+
+		return a
+	case <-time.After(5 * time.Second):
+		require.FailNow(t, "no alert posted", "expected %s %s", kind, status)
+		return Alert{}
+	}
+}
+
+func (tm *testMonitor) requireNoAlert(t *testing.T) {
+	t.Helper()
+
+	select {
+	case a := <-tm.alerts:
+		require.FailNow(t, "unexpected alert posted", "%s %s", a.Kind, a.Status)
+	case <-time.After(100 * time.Millisecond):
+	}
+}
+
+func TestMonitor_ConsecutiveMissedBlocks(t *testing.T) {
+	tm := newTestMonitor(t)
+
+	tm.observe(true)
+	for i := 0; i < 4; i++ {
+		tm.observe(false)
+	}
+	tm.requireNoAlert(t)
+
+	// The 5th missed block triggers the alert, which is not repeated within the alert interval
+	tm.observe(false)
+	a := tm.requireAlert(t, AlertConsecutiveMissed, AlertStatusFiring)
+	assert.Equal(t, "validator missed the last 5 blocks", a.Message)
+	tm.observe(false)
+	tm.requireNoAlert(t)
+
+	// It is repeated after the alert interval
+	tm.now = tm.now.Add(DefaultConfig().AlertInterval)
+	tm.observe(false)
+	a = tm.requireAlert(t, AlertConsecutiveMissed, AlertStatusFiring)
+	assert.Equal(t, "validator missed the last 7 blocks", a.Message)
+
+	// It is resolved once the validator signs again
+	tm.observe(true)
+	tm.requireAlert(t, AlertConsecutiveMissed, AlertStatusResolved)
+	tm.observe(true)
+	tm.requireNoAlert(t)
+}
+
+func TestMonitor_MissedBlocksInWindow(t *testing.T) {
+	tm := newTestMonitor(t)
+
+	// 25 of the 50 allowed missed blocks
+	tm.slashingKeeper.signingInfo.MissedBlocksCounter = 24
+	tm.observe(true)
+	tm.requireNoAlert(t)
+
+	tm.slashingKeeper.signingInfo.MissedBlocksCounter = 25
+	tm.observe(true)
+	a := tm.requireAlert(t, AlertMissedBlocks, AlertStatusFiring)
+	assert.Equal(t, int64(25), a.MissedBlocks)
+	assert.Equal(t, int64(50), a.MaxMissedBlocks)
+
+	status, err := tm.Monitor.observe(sdk.Context{}, abci.CommitInfo{})
+	require.NoError(t, err)
+	assert.InDelta(t, 0.75, status.Uptime, 1e-9)
+
+	tm.slashingKeeper.signingInfo.MissedBlocksCounter = 0
+	tm.observe(true)
+	tm.requireAlert(t, AlertMissedBlocks, AlertStatusResolved)
+}
+
+func TestMonitor_VotingPower(t *testing.T) {
+	tm := newTestMonitor(t)
+
+	// The voting power is at most (1 + 0.1) * MinVotingPower
+	tm.valKeeper.validator.VotingPower = 11
+	tm.observe(true)
+	a := tm.requireAlert(t, AlertLowVotingPower, AlertStatusFiring)
+	assert.Equal(t, int64(11), a.VotingPower)
+	assert.Equal(t, int64(10), a.MinVotingPower)
+
+	// Only the jailed alert fires once the validator is jailed
+	tm.valKeeper.validator.VotingPower = 9
+	tm.valKeeper.validator.Jailed = true
+	tm.observe(true)
+	tm.requireAlert(t, AlertJailed, AlertStatusFiring)
+	tm.requireAlert(t, AlertLowVotingPower, AlertStatusResolved)
+
+	tm.valKeeper.validator.VotingPower = 100
+	tm.valKeeper.validator.Jailed = false
+	tm.observe(true)
+	tm.requireAlert(t, AlertJailed, AlertStatusResolved)
+	tm.requireNoAlert(t)
+}
+
+func TestMonitor_InactiveValidator(t *testing.T) {
+	tm := newTestMonitor(t)
+
+	// The validator is neither registered nor bonded
+	tm.valKeeper.validator = nil
+	tm.slashingKeeper.signingInfo = nil
+
+	status, err := tm.Monitor.observe(sdk.Context{}, abci.CommitInfo{Votes: []abci.VoteInfo{
+		{Validator: abci.Validator{Address: otherConsAddr}, BlockIdFlag: cmtproto.BlockIDFlagCommit},
+	}})
+	require.NoError(t, err)
+	assert.Equal(t, Status{MinVotingPower: 10}, status)
+
+	// Blocks without the validator in the commit are not missed blocks
+	for i := 0; i < 10; i++ {
+		tm.height++
+		tm.ObserveBlock(sdk.Context{}.WithBlockHeight(tm.height), abci.CommitInfo{Votes: []abci.VoteInfo{
+			{Validator: abci.Validator{Address: otherConsAddr}, BlockIdFlag: cmtproto.BlockIDFlagCommit},
+		}})
+	}
+	tm.requireNoAlert(t)
+}
+
+func TestConfig_Validate(t *testing.T) {
+	require.NoError(t, DefaultConfig().Validate())
+
+	tests := []struct {
+		name        string
+		modify      func(*Config)
+		expectedErr string
+	}{
+		{
+			name:        "zero consecutive missed blocks",
+			modify:      func(c *Config) { c.ConsecutiveMissed = 0 },
+			expectedErr: "consecutive missed blocks must be positive",
+		},
+		{
+			name:        "too high missed blocks ratio",
+			modify:      func(c *Config) { c.MissedBlocksRatio = 1.5 },
+			expectedErr: "missed blocks ratio must be in (0, 1]",
+		},
+		{
+			name:        "negative voting power margin",
+			modify:      func(c *Config) { c.VotingPowerMargin = -0.1 },
+			expectedErr: "voting power margin must not be negative",
+		},
+		{
+			name:        "zero alert interval",
+			modify:      func(c *Config) { c.AlertInterval = 0 },
+			expectedErr: "alert interval must be positive",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := DefaultConfig()
+			tt.modify(&cfg)
+			require.ErrorContains(t, cfg.Validate(), tt.expectedErr)
+		})
+	}
+}
+
+func TestMonitor_Network(t *testing.T) {
+	net, err := network.New(network.DefaultConfig())
+	require.NoError(t, err)
+	require.NoError(t, net.ProduceBlock())
+
+	val := net.Nodes[len(net.Nodes)-1]
+	net.StopSigning(val)
+	require.NoError(t, net.ProduceBlocks(3))
+
+	// The monitor of the validator reads the state of another node
+	node := net.Nodes[0]
+	m, err := New(DefaultConfig(), log.NewNopLogger(), val.ConsAddr, node.App.EVMValKeeper, node.App.SlashingKeeper)
+	require.NoError(t, err)
+
+	var lastCommit abci.CommitInfo
+	for _, v := range net.Validators() {
+		flag := cmtproto.BlockIDFlagCommit
+		if bytes.Equal(v.Address, val.ConsAddr) {
+			flag = cmtproto.BlockIDFlagAbsent
+		}
+		lastCommit.Votes = append(lastCommit.Votes, abci.VoteInfo{Validator: v, BlockIdFlag: flag})
+	}
+
+	status, err := m.observe(node.Context(), lastCommit)
+	require.NoError(t, err)
+	assert.True(t, status.Active)
+	assert.False(t, status.Signed)
+	assert.True(t, status.Registered)
+	assert.False(t, status.Jailed)
+	// The commit of the block produced before stopping signing was signed
+	assert.Equal(t, int64(2), status.MissedBlocks)
+	assert.Equal(t, int64(50), status.MaxMissedBlocks)
+	assert.InDelta(t, 0.98, status.Uptime, 1e-9)
+	assert.Equal(t, evmvaltypes.DefaultMinVotingPower, status.MinVotingPower)
+	assert.Greater(t, status.VotingPower, status.MinVotingPower)
+}
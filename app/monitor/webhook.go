@@ -0,0 +1,107 @@
+package monitor
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"cosmossdk.io/log"
+	"github.com/omni-network/omni/lib/errors"
+)
+
+const (
+	// webhookQueueSize is the number of alerts which can be queued to be posted.
+	webhookQueueSize = 64
+
+	// webhookTimeout is the timeout of posting an alert.
+	webhookTimeout = 10 * time.Second
+)
+
+// Statuses of the alerts.
+const (
+	AlertStatusFiring   = "firing"
+	AlertStatusResolved = "resolved"
+)
+
+// Alert is the JSON body posted to the webhook.
+type Alert struct {
+	Kind            string    `json:"kind"`
+	Status          string    `json:"status"`
+	Message         string    `json:"message"`
+	Validator       string    `json:"validator"`
+	Height          int64     `json:"height"`
+	Time            time.Time `json:"time"`
+	MissedBlocks    int64     `json:"missed_blocks"`
+	MaxMissedBlocks int64     `json:"max_missed_blocks"`
+	VotingPower     int64     `json:"voting_power"`
+	MinVotingPower  int64     `json:"min_voting_power"`
+}
+
+// webhook posts the alerts to the URL in the background, so that the finalization of blocks is never blocked.
+type webhook struct {
+	url    string
+	client *http.Client
+	logger log.Logger
+	queue  chan Alert
+}
+
+func newWebhook(url string, logger log.Logger) *webhook {
+	return &webhook{
+		url:    url,
+		client: &http.Client{Timeout: webhookTimeout},
+		logger: logger,
+		queue:  make(chan Alert, webhookQueueSize),
+	}
+}
+
+// enqueue queues the alert to be posted. The alert is dropped if the queue is full.
+func (w *webhook) enqueue(a Alert) {
+	select {
+	case w.queue <- a:
+	default:
+		w.logger.Warn("Dropping the alert since the webhook queue is full", "alert", a.Kind, "status", a.Status)
+	}
+}
+
+func (w *webhook) run(ctx context.Context) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case a := <-w.queue:
+			if err := w.post(ctx, a); err != nil {
+				w.logger.Error("Failed to post the alert to the webhook", "alert", a.Kind, "status", a.Status, "err", err)
+			}
+		}
+	}
+}
+
+func (w *webhook) post(ctx context.Context, a Alert) error {
+	body, err := json.Marshal(a)
+	if err != nil {
+		return errors.Wrap(err, "marshal alert")
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, w.url, bytes.NewReader(body))
+	if err != nil {
+		return errors.Wrap(err, "create request")
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := w.client.Do(req)
+	if err != nil {
+		return errors.Wrap(err, "post alert")
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, resp.Body)
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return errors.New(fmt.Sprintf("unexpected status %s", resp.Status))
+	}
+
+	return nil
+}
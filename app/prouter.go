@@ -3,8 +3,11 @@ package app
 import (
 	"bytes"
 	"context"
+	"fmt"
 	"time"
 
+	evmvaltypes "github.com/mitosis-org/chain/x/evmvalidator/types"
+
 	"github.com/cosmos/cosmos-sdk/x/auth/signing"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 
@@ -19,18 +22,36 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
-// processTimeout is the maximum time to process a proposal.
+// DefaultProcessTimeout is the default maximum time to process a proposal.
 // Timeout results in rejecting the proposal, which could negatively affect liveness.
 // But it avoids blocking forever, which also negatively affects liveness.
 // This mitigates against malicious proposals that take forever to process (e.g. due to retryForever).
-const processTimeout = time.Second * 10
+const DefaultProcessTimeout = time.Second * 10
+
+// ProcessProposalConfig defines the configuration of processing proposals.
+type ProcessProposalConfig struct {
+	// Timeout is the maximum time to process a proposal. See DefaultProcessTimeout.
+	Timeout time.Duration
+	// RejectedHistorySize is the number of the last rejected proposals persisted
+	// to RejectedProposalsFileName in the data directory. Zero disables it.
+	RejectedHistorySize int
+}
+
+// DefaultProcessProposalConfig returns the default configuration of processing proposals.
+func DefaultProcessProposalConfig() ProcessProposalConfig {
+	return ProcessProposalConfig{
+		Timeout:             DefaultProcessTimeout,
+		RejectedHistorySize: 0,
+	}
+}
 
 // makeProcessProposalRouter creates a new process proposal router that only routes
 // expected messages to expected modules.
 func makeProcessProposalRouter(app *MitosisApp) *baseapp.MsgServiceRouter {
 	router := baseapp.NewMsgServiceRouter()
 	router.SetInterfaceRegistry(app.interfaceRegistry)
-	app.EVMEngKeeper.RegisterProposalService(router) // EVMEngine calls NewPayload on proposals to verify it.
+	app.EVMEngKeeper.RegisterProposalService(router)    // EVMEngine calls NewPayload on proposals to verify it.
+	app.VoteExtensions.RegisterProposalServices(router) // Vote extensions verify their aggregated messages.
 
 	return router
 }
@@ -38,20 +59,35 @@ func makeProcessProposalRouter(app *MitosisApp) *baseapp.MsgServiceRouter {
 // makeProcessProposalHandler creates a new process proposal handler.
 // It ensures all messages included in a cpayload proposal are valid.
 // It also updates some external state.
-func makeProcessProposalHandler(router *baseapp.MsgServiceRouter, txConfig client.TxConfig) sdk.ProcessProposalHandler {
+func makeProcessProposalHandler(
+	router *baseapp.MsgServiceRouter,
+	txConfig client.TxConfig,
+	voteExtMsgCounts map[string]int,
+	processTimeout time.Duration,
+	recorder *RejectedProposalRecorder,
+	proposal *proposalScope,
+) sdk.ProcessProposalHandler {
 	return func(ctx sdk.Context, req *abci.RequestProcessProposal) (*abci.ResponseProcessProposal, error) {
 		timeoutCtx, timeoutCancel := context.WithTimeout(ctx.Context(), processTimeout)
 		defer timeoutCancel()
 		ctx = ctx.WithContext(timeoutCtx)
 
+		rejectProposal := func(reason string, err error) (*abci.ResponseProcessProposal, error) {
+			if timeoutCtx.Err() != nil {
+				reason = rejectReasonTimeout
+			}
+
+			return rejectProposal(ctx, req, recorder, reason, err)
+		}
+
 		if req.Height == 1 {
 			if len(req.Txs) > 0 { // First proposal must be empty.
-				return rejectProposal(ctx, errors.New("first proposal not empty"))
+				return rejectProposal(rejectReasonFirstProposalNotEmpty, errors.New("first proposal not empty"))
 			}
 
 			return &abci.ResponseProcessProposal{Status: abci.ResponseProcessProposal_ACCEPT}, nil
 		} else if len(req.Txs) > 1 {
-			return rejectProposal(ctx, errors.New("unexpected transactions in proposal"))
+			return rejectProposal(rejectReasonUnexpectedTxs, errors.New("unexpected transactions in proposal"))
 		}
 
 		// Ensure the proposal includes quorum votes.
@@ -64,22 +100,29 @@ func makeProcessProposalHandler(router *baseapp.MsgServiceRouter, txConfig clien
 			votedPower += vote.Validator.Power
 		}
 		if totalPower*2/3 >= votedPower {
-			return rejectProposal(ctx, errors.New("proposed doesn't include quorum votes extensions"))
+			return rejectProposal(rejectReasonNoQuorum, errors.New("proposed doesn't include quorum votes extensions"))
 		}
 
+		// Verify the fee recipient of the proposed payload against the proposer's registration.
+		endProposal := proposal.begin(ctx, req.ProposerAddress)
+		defer endProposal()
+
 		// Ensure only expected messages types are included the expected number of times.
 		allowedMsgCounts := map[string]int{
 			sdk.MsgTypeURL(&etypes.MsgExecutionPayload{}): 1, // Only a single EVM execution payload is allowed.
 		}
+		for typeURL, count := range voteExtMsgCounts { // Messages aggregated from vote extensions.
+			allowedMsgCounts[typeURL] = count
+		}
 
 		for _, rawTX := range req.Txs {
 			tx, err := txConfig.TxDecoder()(rawTX)
 			if err != nil {
-				return rejectProposal(ctx, errors.Wrap(err, "decode transaction"))
+				return rejectProposal(rejectReasonDecodeTx, errors.Wrap(err, "decode transaction"))
 			}
 
 			if err = validateTx(tx); err != nil {
-				return rejectProposal(ctx, errors.Wrap(err, "validate tx"))
+				return rejectProposal(rejectReasonValidateTx, errors.Wrap(err, "validate tx"))
 			}
 
 			for _, msg := range tx.GetMsgs() {
@@ -87,20 +130,23 @@ func makeProcessProposalHandler(router *baseapp.MsgServiceRouter, txConfig clien
 
 				// Ensure the message type is expected and not included too many times.
 				if i, ok := allowedMsgCounts[typeURL]; !ok {
-					return rejectProposal(ctx, errors.New("unexpected message type", "msg_type", typeURL))
+					return rejectProposal(rejectReasonUnexpectedMsg, errors.New("unexpected message type: "+typeURL))
 				} else if i <= 0 {
-					return rejectProposal(ctx, errors.New("message type included too many times", "msg_type", typeURL))
+					return rejectProposal(rejectReasonTooManyMsgs, errors.New("message type included too many times: "+typeURL))
 				}
 				allowedMsgCounts[typeURL]--
 
 				handler := router.Handler(msg)
 				if handler == nil {
-					return rejectProposal(ctx, errors.New("msg handler not found [BUG]", "msg_type", typeURL))
+					return rejectProposal(rejectReasonHandlerNotFound, errors.New("msg handler not found [BUG]: "+typeURL))
 				}
 
 				_, err := handler(ctx, msg)
-				if err != nil {
-					return rejectProposal(ctx, errors.Wrap(err, "execute message"))
+				if errors.Is(err, evmvaltypes.ErrInvalidFeeRecipient) {
+					// The proposer redirects the EVM gas fee tips.
+					return rejectProposal(rejectReasonInvalidFeeRecipient, errors.Wrap(err, "verify fee recipient"))
+				} else if err != nil {
+					return rejectProposal(rejectReasonExecuteMsg, errors.Wrap(err, "execute message"))
 				}
 			}
 		}
@@ -109,8 +155,27 @@ func makeProcessProposalHandler(router *baseapp.MsgServiceRouter, txConfig clien
 	}
 }
 
-func rejectProposal(ctx sdk.Context, err error) (*abci.ResponseProcessProposal, error) {
-	ctx.Logger().Error("Rejecting process proposal", "err", err)
+func rejectProposal(
+	ctx sdk.Context,
+	req *abci.RequestProcessProposal,
+	recorder *RejectedProposalRecorder,
+	reason string,
+	err error,
+) (*abci.ResponseProcessProposal, error) {
+	proposer := fmt.Sprintf("%X", req.ProposerAddress)
+	ctx.Logger().Error("Rejecting process proposal", "height", req.Height, "proposer", proposer, "reason", reason, "err", err)
+
+	if err := recorder.Record(RejectedProposal{
+		Height:   req.Height,
+		Time:     req.Time,
+		Proposer: proposer,
+		Reason:   reason,
+		Error:    err.Error(),
+		TxHashes: txHashes(req.Txs),
+	}); err != nil {
+		ctx.Logger().Error("Failed to record rejected proposal", "err", err)
+	}
+
 	return &abci.ResponseProcessProposal{Status: abci.ResponseProcessProposal_REJECT}, nil
 }
 
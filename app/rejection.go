@@ -0,0 +1,170 @@
+package app
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/go-metrics"
+	"github.com/omni-network/omni/lib/errors"
+
+	cmttypes "github.com/cometbft/cometbft/types"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	"github.com/cosmos/cosmos-sdk/telemetry"
+)
+
+// RejectedProposalsFileName is the name of the file in the data directory of the node home
+// which persists the last rejected proposals for post-mortems.
+const RejectedProposalsFileName = "rejected_proposals.json"
+
+// Reasons of rejecting a proposal. They are used as a metric label, so they must have a low cardinality.
+const (
+	rejectReasonFirstProposalNotEmpty = "first_proposal_not_empty"
+	rejectReasonUnexpectedTxs         = "unexpected_txs"
+	rejectReasonNoQuorum              = "no_quorum"
+	rejectReasonDecodeTx              = "decode_tx"
+	rejectReasonValidateTx            = "validate_tx"
+	rejectReasonUnexpectedMsg         = "unexpected_msg"
+	rejectReasonTooManyMsgs           = "too_many_msgs"
+	rejectReasonHandlerNotFound       = "handler_not_found"
+	rejectReasonExecuteMsg            = "execute_msg"
+	rejectReasonInvalidFeeRecipient   = "invalid_fee_recipient"
+	rejectReasonTimeout               = "timeout"
+)
+
+// RejectedProposal is a record of a rejected proposal.
+type RejectedProposal struct {
+	Height   int64     `json:"height"`
+	Time     time.Time `json:"time"`
+	Proposer string    `json:"proposer"`
+	Reason   string    `json:"reason"`
+	Error    string    `json:"error"`
+	TxHashes []string  `json:"tx_hashes"`
+}
+
+// RejectedProposalRecorder records rejected proposals as metrics and,
+// if enabled, persists the last N of them to a local file.
+type RejectedProposalRecorder struct {
+	mu      sync.Mutex
+	path    string
+	size    int
+	records []RejectedProposal
+}
+
+// NewRejectedProposalRecorder returns a recorder persisting the last size rejected proposals to the file at path.
+// Rejected proposals are not persisted if size is zero or path is empty.
+// The records already in the file are kept, so that they survive restarts.
+func NewRejectedProposalRecorder(path string, size int) (*RejectedProposalRecorder, error) {
+	r := &RejectedProposalRecorder{path: path, size: size}
+	if !r.persistent() {
+		return r, nil
+	}
+
+	bz, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return r, nil
+	} else if err != nil {
+		return nil, errors.Wrap(err, "failed to read rejected proposals file")
+	}
+
+	if err := json.Unmarshal(bz, &r.records); err != nil {
+		return nil, errors.Wrap(err, fmt.Sprintf("failed to parse rejected proposals file %s", path))
+	}
+	r.trim()
+
+	return r, nil
+}
+
+// Record records the rejected proposal. Failing to persist it is only logged by the caller,
+// since it must not affect the consensus.
+func (r *RejectedProposalRecorder) Record(record RejectedProposal) error {
+	telemetry.IncrCounterWithLabels(
+		[]string{"process_proposal", "rejected"},
+		1,
+		[]metrics.Label{
+			telemetry.NewLabel("reason", record.Reason),
+			telemetry.NewLabel("proposer", record.Proposer),
+		},
+	)
+
+	if !r.persistent() {
+		return nil
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.records = append(r.records, record)
+	r.trim()
+
+	return r.write()
+}
+
+// Records returns the persisted rejected proposals from the oldest.
+func (r *RejectedProposalRecorder) Records() []RejectedProposal {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	records := make([]RejectedProposal, len(r.records))
+	copy(records, r.records)
+
+	return records
+}
+
+func (r *RejectedProposalRecorder) persistent() bool {
+	return r.size > 0 && r.path != ""
+}
+
+func (r *RejectedProposalRecorder) trim() {
+	if len(r.records) > r.size {
+		r.records = r.records[len(r.records)-r.size:]
+	}
+}
+
+// write writes the records to a temporary file first and then renames it,
+// not to leave a corrupted file if the node crashes while writing.
+func (r *RejectedProposalRecorder) write() error {
+	bz, err := json.MarshalIndent(r.records, "", "  ")
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal rejected proposals")
+	}
+
+	if err := os.MkdirAll(filepath.Dir(r.path), 0o755); err != nil {
+		return errors.Wrap(err, "failed to create directory of rejected proposals file")
+	}
+
+	tmpPath := r.path + ".tmp"
+	if err := os.WriteFile(tmpPath, bz, 0o600); err != nil {
+		return errors.Wrap(err, "failed to write rejected proposals file")
+	}
+
+	if err := os.Rename(tmpPath, r.path); err != nil {
+		return errors.Wrap(err, "failed to rename rejected proposals file")
+	}
+
+	return nil
+}
+
+// txHashes returns the hashes of the transactions in the same format as CometBFT.
+func txHashes(txs [][]byte) []string {
+	hashes := make([]string, 0, len(txs))
+	for _, tx := range txs {
+		hashes = append(hashes, fmt.Sprintf("%X", cmttypes.Tx(tx).Hash()))
+	}
+
+	return hashes
+}
+
+// newRejectedProposalRecorder creates a recorder persisting rejected proposals to the data directory of the node home.
+func newRejectedProposalRecorder(appOpts servertypes.AppOptions, size int) (*RejectedProposalRecorder, error) {
+	homePath, ok := appOpts.Get(flags.FlagHome).(string)
+	if !ok || homePath == "" {
+		return NewRejectedProposalRecorder("", 0)
+	}
+
+	return NewRejectedProposalRecorder(filepath.Join(homePath, "data", RejectedProposalsFileName), size)
+}
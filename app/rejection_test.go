@@ -0,0 +1,58 @@
+package app_test
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/mitosis-org/chain/app"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRejectedProposalRecorder(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "data", app.RejectedProposalsFileName)
+
+	r, err := app.NewRejectedProposalRecorder(path, 2)
+	require.NoError(t, err)
+	require.Empty(t, r.Records())
+
+	records := make([]app.RejectedProposal, 3)
+	for i := range records {
+		records[i] = app.RejectedProposal{
+			Height:   int64(i + 1),
+			Time:     time.Unix(int64(i), 0).UTC(),
+			Proposer: "ABCDEF",
+			Reason:   "execute_msg",
+			Error:    fmt.Sprintf("error %d", i),
+			TxHashes: []string{"0123"},
+		}
+		require.NoError(t, r.Record(records[i]))
+	}
+
+	// Only the last 2 records are kept.
+	require.Equal(t, records[1:], r.Records())
+
+	// The records survive restarts.
+	r, err = app.NewRejectedProposalRecorder(path, 2)
+	require.NoError(t, err)
+	require.Equal(t, records[1:], r.Records())
+
+	// A smaller size trims the loaded records.
+	r, err = app.NewRejectedProposalRecorder(path, 1)
+	require.NoError(t, err)
+	require.Equal(t, records[2:], r.Records())
+}
+
+func TestRejectedProposalRecorder_Disabled(t *testing.T) {
+	path := filepath.Join(t.TempDir(), app.RejectedProposalsFileName)
+
+	r, err := app.NewRejectedProposalRecorder(path, 0)
+	require.NoError(t, err)
+	require.NoError(t, r.Record(app.RejectedProposal{Height: 1, Reason: "no_quorum"}))
+	require.Empty(t, r.Records())
+
+	_, err = os.Stat(path)
+	require.True(t, os.IsNotExist(err))
+}
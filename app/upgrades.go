@@ -4,16 +4,21 @@ import (
 	"fmt"
 
 	"github.com/mitosis-org/chain/app/upgrades"
+	v2 "github.com/mitosis-org/chain/app/upgrades/v2"
 
 	upgradetypes "cosmossdk.io/x/upgrade/types"
 
+	"cosmossdk.io/log"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 var (
 	// Upgrades defines the upgrade handlers and store loaders for the application.
 	// New upgrades should be added to this slice after they are implemented.
-	Upgrades = []upgrades.Upgrade{}
+	Upgrades = []upgrades.Upgrade{
+		v2.Upgrade,
+	}
 
 	// Forks are for hard forks that breaks backward compatibility.
 	Forks = []upgrades.Fork{}
@@ -52,6 +57,20 @@ func (app *MitosisApp) setupUpgradeStoreLoaders() {
 	}
 }
 
+// checkForkUpgradeHandlers warns about forks whose upgrade handler is not registered in this binary.
+// The chain halts at the height of such a fork until it is restarted with a binary registering the handler.
+func (app *MitosisApp) checkForkUpgradeHandlers(logger log.Logger) {
+	for _, fork := range app.forks {
+		if !app.UpgradeKeeper.HasHandler(fork.UpgradeName) {
+			logger.Warn(
+				"No upgrade handler registered for fork; the chain will halt at the fork height",
+				"name", fork.UpgradeName,
+				"height", fork.UpgradeHeight,
+			)
+		}
+	}
+}
+
 // ScheduleForkUpgrade executes any necessary fork logic for based upon the current
 // block height. It sets an upgrade plan once the chain reaches the pre-defined upgrade height.
 //
@@ -61,7 +80,7 @@ func (app *MitosisApp) setupUpgradeStoreLoaders() {
 //  2. Release the software defined in the upgrade-info.
 func (app *MitosisApp) scheduleForkUpgrade(ctx sdk.Context) {
 	currentBlockHeight := ctx.BlockHeight()
-	for _, fork := range Forks {
+	for _, fork := range app.forks {
 		if currentBlockHeight == fork.UpgradeHeight {
 			upgradePlan := upgradetypes.Plan{
 				Height: currentBlockHeight,
@@ -1,6 +1,8 @@
 package upgrades
 
 import (
+	"context"
+
 	store "cosmossdk.io/store/types"
 	upgradetypes "cosmossdk.io/x/upgrade/types"
 
@@ -22,6 +24,14 @@ type Upgrade struct {
 	StoreUpgrades store.StoreUpgrades
 }
 
+// CreateDefaultUpgradeHandler creates an upgrade handler which only runs the in-place store migrations
+// of all modules whose consensus version has been bumped.
+func CreateDefaultUpgradeHandler(mm *module.Manager, configurator module.Configurator) upgradetypes.UpgradeHandler {
+	return func(ctx context.Context, _ upgradetypes.Plan, fromVM module.VersionMap) (module.VersionMap, error) {
+		return mm.RunMigrations(ctx, configurator, fromVM)
+	}
+}
+
 // Fork defines a struct containing the requisite fields for a non-software upgrade proposal
 // Hard Fork at a given height to implement.
 type Fork struct {
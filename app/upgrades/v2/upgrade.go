@@ -0,0 +1,25 @@
+package v2
+
+import (
+	store "cosmossdk.io/store/types"
+	"github.com/mitosis-org/chain/app/upgrades"
+	evmgovtypes "github.com/mitosis-org/chain/x/evmgov/types"
+)
+
+// UpgradeName defines the on-chain upgrade name for the v2 upgrade.
+const UpgradeName = "v2"
+
+// Upgrade runs the in-place store migrations of the following modules:
+//   - evmgov (1 -> 2): initializes the parameters in the store introduced by this upgrade. The governance
+//     entrypoint contract address is not migrated from app.toml, and must be set via MsgUpdateGovEntrypointContractAddr.
+//   - evmvalidator (1 -> 2): backfills the withdrawal index by ID. The other new prefixes of the module
+//     (event receipts, the circuit breaker and the pending extra voting power updates) are written lazily, so they don't need any migration.
+var Upgrade = upgrades.Upgrade{
+	UpgradeName:          UpgradeName,
+	CreateUpgradeHandler: upgrades.CreateDefaultUpgradeHandler,
+	StoreUpgrades: store.StoreUpgrades{
+		// evmgov had no store before this upgrade.
+		Added:   []string{evmgovtypes.StoreKey},
+		Deleted: []string{},
+	},
+}
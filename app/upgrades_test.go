@@ -0,0 +1,50 @@
+package app_test
+
+import (
+	"testing"
+
+	upgradetypes "cosmossdk.io/x/upgrade/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mitosis-org/chain/app"
+	v2 "github.com/mitosis-org/chain/app/upgrades/v2"
+	"github.com/mitosis-org/chain/testutil/network"
+	evmgovtypes "github.com/mitosis-org/chain/x/evmgov/types"
+	evmvaltypes "github.com/mitosis-org/chain/x/evmvalidator/types"
+)
+
+func TestUpgrades_Unique(t *testing.T) {
+	names := make(map[string]bool)
+	for _, upgrade := range app.Upgrades {
+		require.False(t, names[upgrade.UpgradeName], "duplicate upgrade %s", upgrade.UpgradeName)
+		names[upgrade.UpgradeName] = true
+	}
+	require.True(t, names[v2.UpgradeName])
+}
+
+func TestUpgradeV2(t *testing.T) {
+	require.Equal(t, []string{evmgovtypes.StoreKey}, v2.Upgrade.StoreUpgrades.Added)
+
+	net, err := network.New(network.DefaultConfig())
+	require.NoError(t, err)
+	require.NoError(t, net.ProduceBlock())
+
+	node := net.Nodes[0]
+	ctx := node.Context()
+	currentVM := node.App.ModuleManager.GetVersionMap()
+
+	// The modules migrated by the upgrade were at version 1
+	fromVM := make(map[string]uint64, len(currentVM))
+	for name, version := range currentVM {
+		fromVM[name] = version
+	}
+	fromVM[evmgovtypes.ModuleName] = 1
+	fromVM[evmvaltypes.ModuleName] = 1
+
+	handler := v2.Upgrade.CreateUpgradeHandler(node.App.ModuleManager, node.App.Configurator())
+	toVM, err := handler(ctx, upgradetypes.Plan{Name: v2.UpgradeName, Height: ctx.BlockHeight()}, fromVM)
+	require.NoError(t, err)
+	require.Equal(t, currentVM, toVM)
+
+	require.Equal(t, evmgovtypes.DefaultParams(), node.App.EVMGovKeeper.GetParams(ctx))
+}
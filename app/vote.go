@@ -1,17 +1,18 @@
 package app
 
 import (
-	"context"
-
-	abci "github.com/cometbft/cometbft/abci/types"
-	sdk "github.com/cosmos/cosmos-sdk/types"
-	evmengtypes "github.com/omni-network/omni/octane/evmengine/types"
+	"github.com/mitosis-org/chain/app/voteext"
+	"github.com/omni-network/omni/lib/ethclient"
 )
 
-var _ evmengtypes.VoteExtensionProvider = &NoVoteExtensionProvider{}
+// newVoteExtensionRegistry creates the registry of the vote extensions contributed by modules.
+// Extensions should be registered here, before the process proposal handler is created.
+func newVoteExtensionRegistry(_ *MitosisApp, engineCl ethclient.EngineClient) (*voteext.Registry, error) {
+	registry := voteext.NewRegistry(voteext.DefaultMaxVoteExtensionSize)
 
-type NoVoteExtensionProvider struct{}
+	if err := registry.Register(voteext.NewFinalizedBlockExtension(engineCl)); err != nil {
+		return nil, err
+	}
 
-func (n NoVoteExtensionProvider) PrepareVotes(ctx context.Context, commit abci.ExtendedCommitInfo, commitHeight uint64) ([]sdk.Msg, error) {
-	return nil, nil
+	return registry, nil
 }
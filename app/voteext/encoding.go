@@ -0,0 +1,74 @@
+package voteext
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	"github.com/omni-network/omni/lib/errors"
+)
+
+// entry is the data of an extension in a vote extension.
+type entry struct {
+	Name string
+	Data []byte
+}
+
+// encodeVoteExtension encodes the entries into a vote extension.
+// Each entry is encoded as `uvarint(len(name)) | name | uvarint(len(data)) | data`.
+// The entries must be sorted by name so that the encoding is deterministic.
+func encodeVoteExtension(entries []entry) []byte {
+	var bz []byte
+	for _, e := range entries {
+		bz = binary.AppendUvarint(bz, uint64(len(e.Name)))
+		bz = append(bz, e.Name...)
+		bz = binary.AppendUvarint(bz, uint64(len(e.Data)))
+		bz = append(bz, e.Data...)
+	}
+
+	return bz
+}
+
+// decodeVoteExtension strictly decodes a vote extension encoded by encodeVoteExtension.
+// It rejects entries which are not sorted by name, duplicated, or empty.
+func decodeVoteExtension(bz []byte) ([]entry, error) {
+	var entries []entry
+	for len(bz) > 0 {
+		name, rest, err := readBytes(bz)
+		if err != nil {
+			return nil, errors.Wrap(err, "read name")
+		}
+		data, rest, err := readBytes(rest)
+		if err != nil {
+			return nil, errors.Wrap(err, fmt.Sprintf("read data of extension %q", name))
+		}
+		bz = rest
+
+		if len(name) == 0 {
+			return nil, errors.New("empty extension name")
+		}
+		if len(data) == 0 {
+			return nil, errors.New(fmt.Sprintf("empty data of extension %q", name))
+		}
+		if len(entries) > 0 && entries[len(entries)-1].Name >= string(name) {
+			return nil, errors.New(fmt.Sprintf("extension %q is not sorted or duplicated", name))
+		}
+
+		entries = append(entries, entry{Name: string(name), Data: data})
+	}
+
+	return entries, nil
+}
+
+func readBytes(bz []byte) (value []byte, rest []byte, err error) {
+	n, read := binary.Uvarint(bz)
+	if read <= 0 {
+		return nil, nil, errors.New("invalid length prefix")
+	}
+	bz = bz[read:]
+
+	if n > uint64(len(bz)) {
+		return nil, nil, errors.New(fmt.Sprintf("length %d exceeds remaining %d bytes", n, len(bz)))
+	}
+
+	return bz[:n], bz[n:], nil
+}
@@ -0,0 +1,53 @@
+package voteext
+
+import (
+	"github.com/cosmos/gogoproto/grpc"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Extension is implemented by modules to contribute data to the vote extension of each validator,
+// e.g. an attestation to the latest finalized EVM block hash (see FinalizedBlockExtension) or to external price data.
+//
+// The data of all registered extensions are combined into a single vote extension.
+// The proposer of the next block aggregates the data from the votes in the last commit
+// into messages which are included in the proposal alongside MsgExecutionPayload.
+type Extension interface {
+	// Name uniquely identifies the extension in the vote extension.
+	Name() string
+
+	// MaxSize is the maximum size in bytes of the data contributed by the extension.
+	MaxSize() int
+
+	// Extend returns the data of the extension for the vote of the local validator at the given height.
+	// Returning empty data means the validator contributes nothing to the extension for this vote.
+	Extend(ctx sdk.Context, height int64) ([]byte, error)
+
+	// Verify verifies the data of the extension in the vote of the given validator at the given height.
+	// The vote is rejected if it returns an error.
+	Verify(ctx sdk.Context, height int64, valAddr []byte, data []byte) error
+
+	// Aggregate aggregates the data of the extension from the votes in the commit at the given height
+	// into messages to be included in the proposal of the next block.
+	// The votes contain only the validators which contributed data to the extension,
+	// while totalPower is the voting power of all the validators in the commit.
+	Aggregate(ctx sdk.Context, commitHeight uint64, votes []Vote, totalPower int64) ([]sdk.Msg, error)
+}
+
+// HasProposalMsgs is implemented by extensions whose Aggregate returns messages.
+// The messages are verified and executed in ProcessProposal like MsgExecutionPayload.
+type HasProposalMsgs interface {
+	// ProposalMsgCounts returns the type URLs of the messages returned by Aggregate
+	// and the maximum number of times each may be included in a proposal.
+	ProposalMsgCounts() map[string]int
+
+	// RegisterProposalService registers the msg server which verifies the messages in ProcessProposal.
+	RegisterProposalService(server grpc.Server)
+}
+
+// Vote is the data of an extension in the vote of a validator.
+type Vote struct {
+	ValidatorAddress []byte
+	Power            int64
+	Data             []byte
+}
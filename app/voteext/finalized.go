@@ -0,0 +1,144 @@
+package voteext
+
+import (
+	"encoding/binary"
+	"math/big"
+	"sync"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/omni-network/omni/lib/errors"
+	"github.com/omni-network/omni/lib/ethclient"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// FinalizedBlockExtensionName is the name of FinalizedBlockExtension in the vote extension.
+const FinalizedBlockExtensionName = "evm_finalized"
+
+// finalizedBlockSize is the size of the encoded finalized block: `uint64(number) | hash`.
+const finalizedBlockSize = 8 + common.HashLength
+
+var _ Extension = (*FinalizedBlockExtension)(nil)
+
+// FinalizedBlock is the finalized EVM block attested by a validator.
+type FinalizedBlock struct {
+	Number uint64
+	Hash   common.Hash
+}
+
+// FinalizedBlockExtension attests to the latest finalized EVM block of the execution client of each validator.
+//
+// The proposer aggregates the attestations of the last commit into the block attested by more than 2/3 of
+// the voting power. If the local execution client has another block at the same number, it has diverged from
+// the other validators, which is reported as an error. No message is included in the proposal.
+type FinalizedBlockExtension struct {
+	engineCl ethclient.EngineClient
+
+	mu       sync.Mutex
+	attested *FinalizedBlock
+}
+
+// NewFinalizedBlockExtension returns a new FinalizedBlockExtension reading the local execution client.
+func NewFinalizedBlockExtension(engineCl ethclient.EngineClient) *FinalizedBlockExtension {
+	return &FinalizedBlockExtension{engineCl: engineCl}
+}
+
+func (*FinalizedBlockExtension) Name() string { return FinalizedBlockExtensionName }
+
+func (*FinalizedBlockExtension) MaxSize() int { return finalizedBlockSize }
+
+// Extend returns the latest finalized block of the local execution client.
+func (e *FinalizedBlockExtension) Extend(ctx sdk.Context, _ int64) ([]byte, error) {
+	header, err := e.engineCl.HeaderByType(ctx, ethclient.HeadFinalized)
+	if err != nil {
+		return nil, errors.Wrap(err, "get finalized header")
+	}
+
+	return encodeFinalizedBlock(FinalizedBlock{Number: header.Number.Uint64(), Hash: header.Hash()}), nil
+}
+
+// Verify only checks the format of the attestation, so that a validator whose execution client is
+// behind or ahead of the others can still vote.
+func (*FinalizedBlockExtension) Verify(_ sdk.Context, _ int64, _ []byte, data []byte) error {
+	_, err := decodeFinalizedBlock(data)
+	return err
+}
+
+// Aggregate records the block attested by more than 2/3 of the voting power of the commit, if any.
+func (e *FinalizedBlockExtension) Aggregate(ctx sdk.Context, commitHeight uint64, votes []Vote, totalPower int64) ([]sdk.Msg, error) {
+	powers := make(map[FinalizedBlock]int64)
+	for _, vote := range votes {
+		block, err := decodeFinalizedBlock(vote.Data)
+		if err != nil {
+			return nil, errors.Wrap(err, "decode finalized block")
+		}
+		powers[block] += vote.Power
+	}
+
+	var attested *FinalizedBlock
+	for block, power := range powers {
+		if power*3 > totalPower*2 {
+			b := block
+			attested = &b
+			break
+		}
+	}
+	if attested == nil {
+		ctx.Logger().Debug("No quorum on the finalized EVM block", "commit_height", commitHeight)
+		return nil, nil
+	}
+
+	e.mu.Lock()
+	e.attested = attested
+	e.mu.Unlock()
+
+	telemetry.SetGauge(float32(attested.Number), "voteext", FinalizedBlockExtensionName, "attested_number")
+
+	local, err := e.engineCl.HeaderByNumber(ctx, new(big.Int).SetUint64(attested.Number))
+	if err != nil {
+		ctx.Logger().Warn("Failed to get the attested finalized EVM block from the execution client", "number", attested.Number, "err", err)
+	} else if local.Hash() != attested.Hash {
+		ctx.Logger().Error("Execution client diverged from the finalized EVM block attested by the validators",
+			"number", attested.Number,
+			"attested_hash", attested.Hash.Hex(),
+			"local_hash", local.Hash().Hex(),
+		)
+	}
+
+	return nil, nil
+}
+
+// LastAttested returns the finalized block attested by more than 2/3 of the voting power
+// in the last commit aggregated by the local validator.
+func (e *FinalizedBlockExtension) LastAttested() (FinalizedBlock, bool) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	if e.attested == nil {
+		return FinalizedBlock{}, false
+	}
+
+	return *e.attested, true
+}
+
+func encodeFinalizedBlock(block FinalizedBlock) []byte {
+	bz := binary.BigEndian.AppendUint64(nil, block.Number)
+	return append(bz, block.Hash.Bytes()...)
+}
+
+func decodeFinalizedBlock(bz []byte) (FinalizedBlock, error) {
+	if len(bz) != finalizedBlockSize {
+		return FinalizedBlock{}, errors.New("invalid finalized block size", "size", len(bz))
+	}
+
+	block := FinalizedBlock{
+		Number: binary.BigEndian.Uint64(bz[:8]),
+		Hash:   common.BytesToHash(bz[8:]),
+	}
+	if block.Hash == (common.Hash{}) {
+		return FinalizedBlock{}, errors.New("empty finalized block hash")
+	}
+
+	return block, nil
+}
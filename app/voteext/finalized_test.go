@@ -0,0 +1,81 @@
+package voteext_test
+
+import (
+	"context"
+	"testing"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/mitosis-org/chain/app/voteext"
+	"github.com/omni-network/omni/lib/ethclient"
+	"github.com/stretchr/testify/require"
+)
+
+// finalizedEngine serves the latest block of the engine mock as the finalized one,
+// since CometBFT finalizes the EVM blocks instantly.
+type finalizedEngine struct {
+	ethclient.EngineClient
+}
+
+func (e finalizedEngine) HeaderByType(ctx context.Context, _ ethclient.HeadType) (*types.Header, error) {
+	return e.EngineClient.HeaderByType(ctx, ethclient.HeadLatest)
+}
+
+func TestFinalizedBlockExtension(t *testing.T) {
+	ctx := newContext()
+
+	engineCl, err := ethclient.NewEngineMock()
+	require.NoError(t, err)
+	header, err := engineCl.HeaderByType(ctx, ethclient.HeadLatest)
+	require.NoError(t, err)
+
+	r := voteext.NewRegistry(voteext.DefaultMaxVoteExtensionSize)
+	ext := voteext.NewFinalizedBlockExtension(finalizedEngine{engineCl})
+	require.NoError(t, r.Register(ext))
+
+	vote := extendVote(t, r, ctx)
+	require.Equal(t, abci.ResponseVerifyVoteExtension_ACCEPT, verifyVote(t, r, ctx, vote))
+
+	for name, data := range map[string][]byte{
+		"invalid size": make([]byte, 8+common.HashLength-1),
+		"empty hash":   make([]byte, 8+common.HashLength),
+	} {
+		t.Run(name, func(t *testing.T) {
+			require.Error(t, ext.Verify(ctx, 10, nil, data))
+		})
+	}
+
+	otherVote := voteextFor(t, voteext.FinalizedBlockExtensionName, append(make([]byte, 8), common.Hash{0x01}.Bytes()...))
+	commit := abci.ExtendedCommitInfo{
+		Votes: []abci.ExtendedVoteInfo{
+			{Validator: abci.Validator{Address: []byte{1}, Power: 10}, VoteExtension: vote, BlockIdFlag: cmtproto.BlockIDFlagCommit},
+			{Validator: abci.Validator{Address: []byte{2}, Power: 10}, VoteExtension: vote, BlockIdFlag: cmtproto.BlockIDFlagCommit},
+			{Validator: abci.Validator{Address: []byte{3}, Power: 10}, VoteExtension: vote, BlockIdFlag: cmtproto.BlockIDFlagCommit},
+			{Validator: abci.Validator{Address: []byte{4}, Power: 5}, VoteExtension: otherVote, BlockIdFlag: cmtproto.BlockIDFlagCommit},
+			{Validator: abci.Validator{Address: []byte{5}, Power: 5}, BlockIdFlag: cmtproto.BlockIDFlagAbsent},
+		},
+	}
+
+	// No message is proposed, but the attested block is recorded.
+	msgs, err := r.PrepareVotes(ctx, commit, 9)
+	require.NoError(t, err)
+	require.Empty(t, msgs)
+
+	attested, ok := ext.LastAttested()
+	require.True(t, ok)
+	require.Equal(t, voteext.FinalizedBlock{Number: header.Number.Uint64(), Hash: header.Hash()}, attested)
+
+	// The absent validators count towards the total voting power.
+	ext = voteext.NewFinalizedBlockExtension(finalizedEngine{engineCl})
+	r = voteext.NewRegistry(voteext.DefaultMaxVoteExtensionSize)
+	require.NoError(t, r.Register(ext))
+
+	commit.Votes[4].Validator.Power = 20
+	_, err = r.PrepareVotes(ctx, commit, 9)
+	require.NoError(t, err)
+
+	_, ok = ext.LastAttested()
+	require.False(t, ok)
+}
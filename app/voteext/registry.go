@@ -0,0 +1,246 @@
+package voteext
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmttypes "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/cosmos/gogoproto/grpc"
+	"github.com/omni-network/omni/lib/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	evmengtypes "github.com/omni-network/omni/octane/evmengine/types"
+)
+
+// DefaultMaxVoteExtensionSize is the default maximum size in bytes of the whole vote extension.
+const DefaultMaxVoteExtensionSize = 4 * 1024
+
+var _ evmengtypes.VoteExtensionProvider = (*Registry)(nil)
+
+// Registry combines the registered extensions into the vote extension of each validator.
+//
+// It provides the ExtendVote and VerifyVoteExtension handlers, and implements VoteExtensionProvider
+// of x/evmengine to aggregate the vote extensions of the last commit into the messages of the proposal.
+//
+// Note that CometBFT calls ExtendVote and VerifyVoteExtension only after the vote extensions are enabled
+// by `consensus_params.abci.vote_extensions_enable_height`.
+type Registry struct {
+	maxSize    int
+	extensions map[string]Extension
+	names      []string // sorted
+}
+
+// NewRegistry returns a new registry limiting the size of the whole vote extension to maxSize bytes.
+func NewRegistry(maxSize int) *Registry {
+	return &Registry{
+		maxSize:    maxSize,
+		extensions: make(map[string]Extension),
+	}
+}
+
+// Register registers the extension. It must be called before the application starts.
+func (r *Registry) Register(ext Extension) error {
+	name := ext.Name()
+	if name == "" {
+		return errors.New("empty extension name")
+	}
+	if _, ok := r.extensions[name]; ok {
+		return errors.New(fmt.Sprintf("duplicate extension %q", name))
+	}
+	if ext.MaxSize() <= 0 || ext.MaxSize() > r.maxSize {
+		return errors.New(fmt.Sprintf("invalid max size of extension %q: %d (limit: %d)", name, ext.MaxSize(), r.maxSize))
+	}
+
+	r.extensions[name] = ext
+	r.names = append(r.names, name)
+	sort.Strings(r.names)
+
+	return nil
+}
+
+// ProposalMsgCounts returns the type URLs of the messages which the extensions may include in a proposal
+// and the maximum number of times each may be included.
+func (r *Registry) ProposalMsgCounts() map[string]int {
+	counts := make(map[string]int)
+	for _, name := range r.names {
+		ext, ok := r.extensions[name].(HasProposalMsgs)
+		if !ok {
+			continue
+		}
+		for typeURL, count := range ext.ProposalMsgCounts() {
+			counts[typeURL] += count
+		}
+	}
+
+	return counts
+}
+
+// RegisterProposalServices registers the msg servers of the extensions which verify their messages in ProcessProposal.
+func (r *Registry) RegisterProposalServices(server grpc.Server) {
+	for _, name := range r.names {
+		if ext, ok := r.extensions[name].(HasProposalMsgs); ok {
+			ext.RegisterProposalService(server)
+		}
+	}
+}
+
+// ExtendVoteHandler returns the handler which combines the data of the extensions into the vote extension.
+// An extension failing or exceeding its size limit is skipped, so that it doesn't prevent the validator from voting.
+func (r *Registry) ExtendVoteHandler() sdk.ExtendVoteHandler {
+	return func(ctx sdk.Context, req *abci.RequestExtendVote) (*abci.ResponseExtendVote, error) {
+		var entries []entry
+		size := 0
+		for _, name := range r.names {
+			ext := r.extensions[name]
+
+			data, err := ext.Extend(ctx, req.Height)
+			if err != nil {
+				ctx.Logger().Error("Failed to extend vote", "extension", name, "err", err)
+				continue
+			} else if len(data) == 0 {
+				continue
+			} else if len(data) > ext.MaxSize() {
+				ctx.Logger().Error("Vote extension exceeds max size", "extension", name, "size", len(data), "max_size", ext.MaxSize())
+				continue
+			}
+
+			e := entry{Name: name, Data: data}
+			entrySize := len(encodeVoteExtension([]entry{e}))
+			if size+entrySize > r.maxSize {
+				ctx.Logger().Error("Vote extension exceeds max total size", "extension", name, "size", size+entrySize, "max_size", r.maxSize)
+				continue
+			}
+
+			size += entrySize
+			entries = append(entries, e)
+		}
+
+		return &abci.ResponseExtendVote{VoteExtension: encodeVoteExtension(entries)}, nil
+	}
+}
+
+// VerifyVoteExtensionHandler returns the handler which verifies the vote extension of other validators.
+func (r *Registry) VerifyVoteExtensionHandler() sdk.VerifyVoteExtensionHandler {
+	return func(ctx sdk.Context, req *abci.RequestVerifyVoteExtension) (*abci.ResponseVerifyVoteExtension, error) {
+		if err := r.verify(ctx, req.Height, req.ValidatorAddress, req.VoteExtension); err != nil {
+			ctx.Logger().Error("Rejecting vote extension", "height", req.Height, "err", err)
+			return &abci.ResponseVerifyVoteExtension{Status: abci.ResponseVerifyVoteExtension_REJECT}, nil
+		}
+
+		return &abci.ResponseVerifyVoteExtension{Status: abci.ResponseVerifyVoteExtension_ACCEPT}, nil
+	}
+}
+
+func (r *Registry) verify(ctx sdk.Context, height int64, valAddr []byte, bz []byte) error {
+	if len(bz) > r.maxSize {
+		return errors.New(fmt.Sprintf("vote extension exceeds max size: %d > %d", len(bz), r.maxSize))
+	}
+
+	entries, err := decodeVoteExtension(bz)
+	if err != nil {
+		return errors.Wrap(err, "decode vote extension")
+	}
+
+	for _, e := range entries {
+		// Unknown extensions are accepted so that a validator running a newer version with more extensions
+		// isn't rejected during a rolling upgrade. Their size is still bounded by the limit of the whole vote extension,
+		// and they are never aggregated.
+		ext, ok := r.extensions[e.Name]
+		if !ok {
+			continue
+		}
+		if len(e.Data) > ext.MaxSize() {
+			return errors.New(fmt.Sprintf("extension %q exceeds max size: %d > %d", e.Name, len(e.Data), ext.MaxSize()))
+		}
+		if err := ext.Verify(ctx, height, valAddr, e.Data); err != nil {
+			return errors.Wrap(err, fmt.Sprintf("verify extension %q", e.Name))
+		}
+	}
+
+	return nil
+}
+
+// PrepareVotes aggregates the vote extensions of the given commit into the messages of the proposal.
+// It implements VoteExtensionProvider of x/evmengine.
+// The messages are proposed alongside MsgExecutionPayload in the same transaction.
+func (r *Registry) PrepareVotes(ctx context.Context, commit abci.ExtendedCommitInfo, commitHeight uint64) ([]sdk.Msg, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	var totalPower int64
+	votesByExt := make(map[string][]Vote)
+	for _, vote := range commit.Votes {
+		totalPower += vote.Validator.Power
+		if vote.BlockIdFlag != cmttypes.BlockIDFlagCommit || len(vote.VoteExtension) == 0 {
+			continue
+		}
+
+		// CometBFT only includes vote extensions which are verified by VerifyVoteExtension.
+		// Decoding is still done strictly not to propose messages from malformed data.
+		entries, err := decodeVoteExtension(vote.VoteExtension)
+		if err != nil {
+			sdkCtx.Logger().Error("Skipping malformed vote extension", "validator", fmt.Sprintf("%X", vote.Validator.Address), "err", err)
+			continue
+		}
+
+		for _, e := range entries {
+			votesByExt[e.Name] = append(votesByExt[e.Name], Vote{
+				ValidatorAddress: vote.Validator.Address,
+				Power:            vote.Validator.Power,
+				Data:             e.Data,
+			})
+		}
+	}
+
+	var msgs []sdk.Msg
+	for _, name := range r.names {
+		votes, ok := votesByExt[name]
+		if !ok {
+			continue
+		}
+
+		// An extension failing to aggregate is skipped, so that it doesn't prevent the block from being proposed.
+		ext := r.extensions[name]
+		extMsgs, err := ext.Aggregate(sdkCtx, commitHeight, votes, totalPower)
+		if err != nil {
+			sdkCtx.Logger().Error("Failed to aggregate vote extensions", "extension", name, "err", err)
+			continue
+		} else if err := checkProposalMsgs(ext, extMsgs); err != nil {
+			sdkCtx.Logger().Error("Invalid messages aggregated from vote extensions [BUG]", "extension", name, "err", err)
+			continue
+		}
+
+		msgs = append(msgs, extMsgs...)
+	}
+
+	return msgs, nil
+}
+
+// checkProposalMsgs ensures the aggregated messages are allowed in ProcessProposal,
+// not to propose a block which is rejected by other validators.
+func checkProposalMsgs(ext Extension, msgs []sdk.Msg) error {
+	if len(msgs) == 0 {
+		return nil
+	}
+
+	withMsgs, ok := ext.(HasProposalMsgs)
+	if !ok {
+		return errors.New("extension doesn't declare proposal messages")
+	}
+
+	counts := make(map[string]int)
+	for typeURL, count := range withMsgs.ProposalMsgCounts() {
+		counts[typeURL] = count
+	}
+
+	for _, msg := range msgs {
+		typeURL := sdk.MsgTypeURL(msg)
+		if counts[typeURL] <= 0 {
+			return errors.New(fmt.Sprintf("message type %s not allowed or included too many times", typeURL))
+		}
+		counts[typeURL]--
+	}
+
+	return nil
+}
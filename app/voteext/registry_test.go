@@ -0,0 +1,238 @@
+package voteext_test
+
+import (
+	"bytes"
+	"testing"
+
+	"cosmossdk.io/log"
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/cosmos/gogoproto/grpc"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/mitosis-org/chain/app/voteext"
+	"github.com/omni-network/omni/lib/errors"
+	"github.com/omni-network/omni/lib/ethclient"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// evmHeadExtension attests to the latest EVM block hash of the engine.
+// The hash voted by more than 2/3 of the voting power is aggregated into a message.
+type evmHeadExtension struct {
+	engineCl ethclient.EngineClient
+}
+
+var (
+	_ voteext.Extension       = evmHeadExtension{}
+	_ voteext.HasProposalMsgs = evmHeadExtension{}
+)
+
+func (evmHeadExtension) Name() string { return "evm_head" }
+
+func (evmHeadExtension) MaxSize() int { return common.HashLength }
+
+func (e evmHeadExtension) Extend(ctx sdk.Context, _ int64) ([]byte, error) {
+	header, err := e.engineCl.HeaderByType(ctx, ethclient.HeadLatest)
+	if err != nil {
+		return nil, err
+	}
+
+	return header.Hash().Bytes(), nil
+}
+
+func (evmHeadExtension) Verify(_ sdk.Context, _ int64, _ []byte, data []byte) error {
+	if len(data) != common.HashLength {
+		return errors.New("invalid hash length")
+	}
+
+	return nil
+}
+
+func (evmHeadExtension) Aggregate(_ sdk.Context, _ uint64, votes []voteext.Vote, _ int64) ([]sdk.Msg, error) {
+	var totalPower int64
+	powers := make(map[common.Hash]int64)
+	for _, vote := range votes {
+		totalPower += vote.Power
+		powers[common.BytesToHash(vote.Data)] += vote.Power
+	}
+
+	for hash, power := range powers {
+		if power*3 > totalPower*2 {
+			return []sdk.Msg{&testdata.TestMsg{Signers: []string{hash.Hex()}}}, nil
+		}
+	}
+
+	return nil, nil
+}
+
+func (evmHeadExtension) ProposalMsgCounts() map[string]int {
+	return map[string]int{sdk.MsgTypeURL(&testdata.TestMsg{}): 1}
+}
+
+func (evmHeadExtension) RegisterProposalService(grpc.Server) {}
+
+// staticExtension contributes fixed data, or fails if err is set.
+type staticExtension struct {
+	name    string
+	maxSize int
+	data    []byte
+	err     error
+	msgs    []sdk.Msg
+}
+
+func (e staticExtension) Name() string { return e.name }
+
+func (e staticExtension) MaxSize() int { return e.maxSize }
+
+func (e staticExtension) Extend(sdk.Context, int64) ([]byte, error) { return e.data, e.err }
+
+func (e staticExtension) Verify(_ sdk.Context, _ int64, _ []byte, data []byte) error {
+	if !bytes.Equal(data, e.data) {
+		return errors.New("unexpected data")
+	}
+
+	return nil
+}
+
+func (e staticExtension) Aggregate(sdk.Context, uint64, []voteext.Vote, int64) ([]sdk.Msg, error) {
+	return e.msgs, e.err
+}
+
+func newContext() sdk.Context {
+	return sdk.NewContext(nil, cmtproto.Header{}, false, log.NewNopLogger())
+}
+
+func extendVote(t *testing.T, r *voteext.Registry, ctx sdk.Context) []byte {
+	t.Helper()
+
+	resp, err := r.ExtendVoteHandler()(ctx, &abci.RequestExtendVote{Height: 10})
+	require.NoError(t, err)
+
+	return resp.VoteExtension
+}
+
+func verifyVote(t *testing.T, r *voteext.Registry, ctx sdk.Context, ext []byte) abci.ResponseVerifyVoteExtension_VerifyStatus {
+	t.Helper()
+
+	resp, err := r.VerifyVoteExtensionHandler()(ctx, &abci.RequestVerifyVoteExtension{Height: 10, VoteExtension: ext})
+	require.NoError(t, err)
+
+	return resp.Status
+}
+
+func TestRegistry_Register(t *testing.T) {
+	r := voteext.NewRegistry(64)
+
+	require.NoError(t, r.Register(staticExtension{name: "a", maxSize: 32}))
+	require.ErrorContains(t, r.Register(staticExtension{name: "a", maxSize: 32}), "duplicate extension")
+	require.ErrorContains(t, r.Register(staticExtension{name: "", maxSize: 32}), "empty extension name")
+	require.ErrorContains(t, r.Register(staticExtension{name: "b", maxSize: 0}), "invalid max size")
+	require.ErrorContains(t, r.Register(staticExtension{name: "b", maxSize: 65}), "invalid max size")
+}
+
+func TestRegistry_ExtendAndVerify(t *testing.T) {
+	ctx := newContext()
+
+	r := voteext.NewRegistry(64)
+	require.NoError(t, r.Register(staticExtension{name: "b", maxSize: 8, data: []byte("bbbb")}))
+	require.NoError(t, r.Register(staticExtension{name: "a", maxSize: 8, data: []byte("aaaa")}))
+	require.NoError(t, r.Register(staticExtension{name: "empty", maxSize: 8}))
+	require.NoError(t, r.Register(staticExtension{name: "failing", maxSize: 8, data: []byte("ffff"), err: errors.New("failed")}))
+	require.NoError(t, r.Register(staticExtension{name: "too_large", maxSize: 2, data: []byte("tttt")}))
+
+	ext := extendVote(t, r, ctx)
+
+	// Only "a" and "b" are included, sorted by name.
+	expected := []byte{1, 'a', 4, 'a', 'a', 'a', 'a', 1, 'b', 4, 'b', 'b', 'b', 'b'}
+	require.Equal(t, expected, ext)
+	require.Equal(t, abci.ResponseVerifyVoteExtension_ACCEPT, verifyVote(t, r, ctx, ext))
+
+	// An empty vote extension is valid.
+	require.Equal(t, abci.ResponseVerifyVoteExtension_ACCEPT, verifyVote(t, r, ctx, nil))
+
+	// An unknown extension, e.g. from a validator running a newer version, is accepted.
+	require.Equal(t, abci.ResponseVerifyVoteExtension_ACCEPT, verifyVote(t, r, ctx, append(append([]byte{}, ext...), 1, 'x', 4, 'x', 'x', 'x', 'x')))
+
+	for name, ext := range map[string][]byte{
+		"unsorted":         {1, 'b', 4, 'b', 'b', 'b', 'b', 1, 'a', 4, 'a', 'a', 'a', 'a'},
+		"duplicated":       {1, 'a', 4, 'a', 'a', 'a', 'a', 1, 'a', 4, 'a', 'a', 'a', 'a'},
+		"truncated":        {1, 'a', 4, 'a', 'a'},
+		"empty data":       {1, 'a', 0},
+		"exceeds max size": {9, 't', 'o', 'o', '_', 'l', 'a', 'r', 'g', 'e', 4, 't', 't', 't', 't'},
+		"rejected by ext":  {1, 'a', 4, 'x', 'x', 'x', 'x'},
+		"exceeds total":    bytes.Repeat([]byte{1}, 65),
+	} {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, abci.ResponseVerifyVoteExtension_REJECT, verifyVote(t, r, ctx, ext))
+		})
+	}
+}
+
+func TestRegistry_ExtendMaxTotalSize(t *testing.T) {
+	ctx := newContext()
+
+	r := voteext.NewRegistry(10)
+	require.NoError(t, r.Register(staticExtension{name: "a", maxSize: 6, data: []byte("aaaaaa")}))
+	require.NoError(t, r.Register(staticExtension{name: "b", maxSize: 6, data: []byte("bbbbbb")}))
+
+	// "b" is skipped as the total size would exceed the limit.
+	require.Equal(t, []byte{1, 'a', 6, 'a', 'a', 'a', 'a', 'a', 'a'}, extendVote(t, r, ctx))
+}
+
+func TestRegistry_PrepareVotes(t *testing.T) {
+	ctx := newContext()
+
+	engineCl, err := ethclient.NewEngineMock()
+	require.NoError(t, err)
+
+	r := voteext.NewRegistry(voteext.DefaultMaxVoteExtensionSize)
+	head := evmHeadExtension{engineCl: engineCl}
+	require.NoError(t, r.Register(head))
+	require.NoError(t, r.Register(staticExtension{
+		name: "unexpected_msgs", maxSize: 8, data: []byte("data"),
+		msgs: []sdk.Msg{&testdata.TestMsg{}},
+	}))
+
+	require.Equal(t, map[string]int{sdk.MsgTypeURL(&testdata.TestMsg{}): 1}, r.ProposalMsgCounts())
+
+	ext := extendVote(t, r, ctx)
+	require.Equal(t, abci.ResponseVerifyVoteExtension_ACCEPT, verifyVote(t, r, ctx, ext))
+
+	header, err := engineCl.HeaderByType(ctx, ethclient.HeadLatest)
+	require.NoError(t, err)
+
+	otherHead := voteextFor(t, "evm_head", common.Hash{0x01}.Bytes())
+	commit := abci.ExtendedCommitInfo{
+		Votes: []abci.ExtendedVoteInfo{
+			{Validator: abci.Validator{Address: []byte{1}, Power: 10}, VoteExtension: ext, BlockIdFlag: cmtproto.BlockIDFlagCommit},
+			{Validator: abci.Validator{Address: []byte{2}, Power: 10}, VoteExtension: ext, BlockIdFlag: cmtproto.BlockIDFlagCommit},
+			{Validator: abci.Validator{Address: []byte{3}, Power: 10}, VoteExtension: ext, BlockIdFlag: cmtproto.BlockIDFlagCommit},
+			{Validator: abci.Validator{Address: []byte{4}, Power: 5}, VoteExtension: otherHead, BlockIdFlag: cmtproto.BlockIDFlagCommit},
+			// Not committed votes and malformed vote extensions are ignored.
+			{Validator: abci.Validator{Address: []byte{5}, Power: 50}, VoteExtension: otherHead, BlockIdFlag: cmtproto.BlockIDFlagNil},
+			{Validator: abci.Validator{Address: []byte{6}, Power: 50}, VoteExtension: []byte{0xff}, BlockIdFlag: cmtproto.BlockIDFlagCommit},
+		},
+	}
+
+	// "unexpected_msgs" is skipped as its messages are not allowed in proposals.
+	msgs, err := r.PrepareVotes(ctx, commit, 9)
+	require.NoError(t, err)
+	require.Equal(t, []sdk.Msg{&testdata.TestMsg{Signers: []string{header.Hash().Hex()}}}, msgs)
+
+	// No quorum on the EVM head.
+	commit.Votes[3].Validator.Power = 20
+	msgs, err = r.PrepareVotes(ctx, commit, 9)
+	require.NoError(t, err)
+	require.Empty(t, msgs)
+}
+
+func voteextFor(t *testing.T, name string, data []byte) []byte {
+	t.Helper()
+
+	r := voteext.NewRegistry(voteext.DefaultMaxVoteExtensionSize)
+	require.NoError(t, r.Register(staticExtension{name: name, maxSize: len(data), data: data}))
+
+	return extendVote(t, r, newContext())
+}
@@ -18,6 +18,7 @@ func NewConfigCmd() *cobra.Command {
 
 	cmd.AddCommand(
 		newSetRPCCmd(),
+		newSetNodeCmd(),
 		newSetContractCmd(),
 		newShowConfigCmd(),
 	)
@@ -67,6 +68,48 @@ func newSetRPCCmd() *cobra.Command {
 	return cmd
 }
 
+// newSetNodeCmd creates the set-node command
+func newSetNodeCmd() *cobra.Command {
+	var network string
+
+	cmd := &cobra.Command{
+		Use:   "set-node <node-url>",
+		Short: "Set the default consensus node URL",
+		Long:  "Set the default CometBFT RPC URL of a consensus node for querying the consensus layer",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			nodeURL := args[0]
+
+			cfg, err := config.Load()
+			if err != nil {
+				return fmt.Errorf("failed to load config: %w", err)
+			}
+
+			// Get current network config
+			networkConfig := cfg.GetNetworkConfig(network)
+			networkConfig.NodeURL = nodeURL
+
+			// Set the updated network config
+			cfg.SetNetworkConfig(network, networkConfig)
+
+			if err := config.Save(cfg); err != nil {
+				return fmt.Errorf("failed to save config: %w", err)
+			}
+
+			networkDisplay := network
+			if networkDisplay == "" || networkDisplay == config.DefaultNetworkName {
+				networkDisplay = config.DefaultNetworkName
+			}
+			fmt.Printf("✅ Node URL set to: %s (network: %s)\n", nodeURL, networkDisplay)
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&network, "network", "", "Network name (defaults to '"+config.DefaultNetworkName+"')")
+
+	return cmd
+}
+
 // newSetContractCmd creates the set-contract command
 func newSetContractCmd() *cobra.Command {
 	var network string
@@ -144,6 +187,12 @@ func newShowConfigCmd() *cobra.Command {
 				fmt.Printf("rpc-url                                = (not set)\n")
 			}
 
+			if cfg.Default.NodeURL != "" {
+				fmt.Printf("node-url                               = %s\n", cfg.Default.NodeURL)
+			} else {
+				fmt.Printf("node-url                               = (not set)\n")
+			}
+
 			if cfg.Default.ValidatorManagerContractAddr != "" {
 				fmt.Printf("validator-manager-contract-address     = %s\n", cfg.Default.ValidatorManagerContractAddr)
 			} else {
@@ -160,6 +209,12 @@ func newShowConfigCmd() *cobra.Command {
 					fmt.Printf("rpc-url                                = (not set)\n")
 				}
 
+				if networkConfig.NodeURL != "" {
+					fmt.Printf("node-url                               = %s\n", networkConfig.NodeURL)
+				} else {
+					fmt.Printf("node-url                               = (not set)\n")
+				}
+
 				if networkConfig.ValidatorManagerContractAddr != "" {
 					fmt.Printf("validator-manager-contract-address     = %s\n", networkConfig.ValidatorManagerContractAddr)
 				} else {
@@ -0,0 +1,84 @@
+package query
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/mitosis-org/chain/cmd/mito/internal/config"
+	"github.com/mitosis-org/chain/cmd/mito/internal/container"
+	"github.com/mitosis-org/chain/cmd/mito/internal/flags"
+	evmvaltypes "github.com/mitosis-org/chain/x/evmvalidator/types"
+	"github.com/spf13/cobra"
+)
+
+// NewReceiptCmd creates the receipt command
+func NewReceiptCmd() *cobra.Command {
+	var commonFlags flags.CommonFlags
+
+	cmd := &cobra.Command{
+		Use:   "receipt [tx-hash]",
+		Short: "Query the consensus-layer outcome of a validator transaction",
+		Long: `Query the receipts of the validator entrypoint events emitted by an EVM transaction
+
+Each receipt shows whether the event was applied, ignored, refunded or queued by the
+consensus layer, together with the reason if it was not applied.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.SilenceUsage = true
+
+			txHash := args[0]
+			if len(common.FromHex(txHash)) != common.HashLength {
+				return fmt.Errorf("invalid tx hash: %s", txHash)
+			}
+
+			// Resolve configuration
+			resolver, err := config.NewResolver()
+			if err != nil {
+				return err
+			}
+			resolvedConfig := resolver.ResolveFlags(&commonFlags)
+
+			// Validate required fields
+			if resolvedConfig.NodeURL == "" {
+				return fmt.Errorf("node URL is required (use --node or set with 'mito config set-node')")
+			}
+
+			// Create container
+			container, err := container.NewContainer(resolvedConfig)
+			if err != nil {
+				return fmt.Errorf("failed to initialize container: %w", err)
+			}
+			defer container.Close()
+
+			receipts, err := container.ConsensusClient.GetTxEventReceipts(cmd.Context(), txHash)
+			if err != nil {
+				return fmt.Errorf("failed to query event receipts: %w", err)
+			}
+
+			printEventReceipts(receipts)
+			return nil
+		},
+	}
+
+	flags.AddNodeFlags(cmd, &commonFlags)
+
+	return cmd
+}
+
+func printEventReceipts(receipts []evmvaltypes.EventReceipt) {
+	for i, r := range receipts {
+		if i > 0 {
+			fmt.Println()
+		}
+		fmt.Printf("%-20s %s\n", "event", r.EventName)
+		fmt.Printf("%-20s %s\n", "status", strings.ToLower(strings.TrimPrefix(r.Status.String(), "EVENT_RECEIPT_STATUS_")))
+		if r.Reason != "" {
+			fmt.Printf("%-20s %s\n", "reason", r.Reason)
+		}
+		fmt.Printf("%-20s %d\n", "log index", r.LogIndex)
+		fmt.Printf("%-20s %s\n", "tx hash", r.TxHash)
+		fmt.Printf("%-20s %s\n", "evm block hash", r.EvmBlockHash)
+		fmt.Printf("%-20s %d\n", "height", r.Height)
+	}
+}
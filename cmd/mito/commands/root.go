@@ -121,13 +121,15 @@ func newQueryCmd() *cobra.Command {
 
 This command provides access to read-only operations for:
 - query validator: Query validator information
-- query contract: Query contract-related information`,
+- query contract: Query contract-related information
+- query receipt: Query the consensus-layer outcome of validator transactions`,
 	}
 
 	// Add subcommands
 	cmd.AddCommand(
 		query.NewValidatorCmd(),
 		query.NewContractCmd(),
+		query.NewReceiptCmd(),
 	)
 
 	return cmd
@@ -0,0 +1,54 @@
+package client
+
+import (
+	"context"
+	"fmt"
+
+	rpchttp "github.com/cometbft/cometbft/rpc/client/http"
+	sdkclient "github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	evmvaltypes "github.com/mitosis-org/chain/x/evmvalidator/types"
+)
+
+// ConsensusClient queries the consensus layer through the CometBFT RPC of a consensus node
+type ConsensusClient struct {
+	evmvalidator evmvaltypes.QueryClient
+	nodeURL      string
+}
+
+// NewConsensusClient creates and returns a new consensus client
+func NewConsensusClient(nodeURL string) (*ConsensusClient, error) {
+	if nodeURL == "" {
+		return nil, fmt.Errorf("node URL is required")
+	}
+
+	rpcClient, err := rpchttp.New(nodeURL, "/websocket")
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to consensus node at %s: %w", nodeURL, err)
+	}
+
+	clientCtx := sdkclient.Context{}.
+		WithClient(rpcClient).
+		WithCodec(codec.NewProtoCodec(codectypes.NewInterfaceRegistry()))
+
+	return &ConsensusClient{
+		evmvalidator: evmvaltypes.NewQueryClient(clientCtx),
+		nodeURL:      nodeURL,
+	}, nil
+}
+
+// GetNodeURL returns the node URL used by this client
+func (c *ConsensusClient) GetNodeURL() string {
+	return c.nodeURL
+}
+
+// GetTxEventReceipts returns the receipts of the validator entrypoint events emitted by the EVM transaction
+func (c *ConsensusClient) GetTxEventReceipts(ctx context.Context, txHash string) ([]evmvaltypes.EventReceipt, error) {
+	res, err := c.evmvalidator.TxEventReceipts(ctx, &evmvaltypes.QueryTxEventReceiptsRequest{TxHash: txHash})
+	if err != nil {
+		return nil, err
+	}
+
+	return res.Receipts, nil
+}
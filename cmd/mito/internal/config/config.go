@@ -15,6 +15,7 @@ const (
 // NetworkConfig represents configuration for a specific network
 type NetworkConfig struct {
 	RPCURL                       string `toml:"rpc-url"`
+	NodeURL                      string `toml:"node-url"`
 	ValidatorManagerContractAddr string `toml:"validator-manager-contract-address"`
 }
 
@@ -80,6 +81,13 @@ func Load() (*Config, error) {
 				}
 			}
 
+			// Parse node-url
+			if nodeURL, exists := sectionMap["node-url"]; exists {
+				if nodeStr, ok := nodeURL.(string); ok {
+					networkConfig.NodeURL = nodeStr
+				}
+			}
+
 			// Parse validator-manager-contract-address
 			if contractAddr, exists := sectionMap["validator-manager-contract-address"]; exists {
 				if contractStr, ok := contractAddr.(string); ok {
@@ -112,6 +120,7 @@ func Save(config *Config) error {
 	// Add default section
 	rawData[DefaultNetworkName] = map[string]interface{}{
 		"rpc-url":                            config.Default.RPCURL,
+		"node-url":                           config.Default.NodeURL,
 		"validator-manager-contract-address": config.Default.ValidatorManagerContractAddr,
 	}
 
@@ -119,6 +128,7 @@ func Save(config *Config) error {
 	for name, networkConfig := range config.networks {
 		rawData[name] = map[string]interface{}{
 			"rpc-url":                            networkConfig.RPCURL,
+			"node-url":                           networkConfig.NodeURL,
 			"validator-manager-contract-address": networkConfig.ValidatorManagerContractAddr,
 		}
 	}
@@ -28,6 +28,7 @@ func (r *Resolver) ResolveFlags(commonFlags *flags.CommonFlags) *ResolvedConfig
 	return &ResolvedConfig{
 		Network:                      commonFlags.Network,
 		RPCURL:                       resolveValue(commonFlags.RPCURL, networkConfig.RPCURL),
+		NodeURL:                      resolveValue(commonFlags.NodeURL, networkConfig.NodeURL),
 		ValidatorManagerContractAddr: resolveValue(commonFlags.ValidatorManagerContractAddr, networkConfig.ValidatorManagerContractAddr),
 		ChainID:                      commonFlags.ChainID,
 		PrivateKey:                   commonFlags.PrivateKey,
@@ -52,6 +53,7 @@ func (r *Resolver) ResolveFlags(commonFlags *flags.CommonFlags) *ResolvedConfig
 type ResolvedConfig struct {
 	Network                      string
 	RPCURL                       string
+	NodeURL                      string
 	ValidatorManagerContractAddr string
 	ChainID                      string
 	PrivateKey                   string
@@ -13,6 +13,7 @@ import (
 type Container struct {
 	Config                   *config.ResolvedConfig
 	EthClient                *client.EthereumClient
+	ConsensusClient          *client.ConsensusClient
 	ValidatorManagerContract *client.ValidatorManagerContract
 	TxBuilder                *tx.Builder
 	TxSender                 *tx.Sender
@@ -32,6 +33,15 @@ func NewContainer(resolvedConfig *config.ResolvedConfig) (*Container, error) {
 		}
 	}
 
+	// Create consensus client - allow nil if not querying the consensus layer
+	var consensusClient *client.ConsensusClient
+	if resolvedConfig.NodeURL != "" {
+		consensusClient, err = client.NewConsensusClient(resolvedConfig.NodeURL)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create consensus client: %w", err)
+		}
+	}
+
 	// Create contract instance - allow nil for offline mode
 	var contract *client.ValidatorManagerContract
 	if resolvedConfig.ValidatorManagerContractAddr != "" && ethClient != nil {
@@ -63,6 +73,7 @@ func NewContainer(resolvedConfig *config.ResolvedConfig) (*Container, error) {
 	return &Container{
 		Config:                   resolvedConfig,
 		EthClient:                ethClient,
+		ConsensusClient:          consensusClient,
 		ValidatorManagerContract: contract,
 		TxBuilder:                txBuilder,
 		TxSender:                 txSender,
@@ -9,6 +9,7 @@ type CommonFlags struct {
 	// Network flags
 	Network                      string
 	RPCURL                       string
+	NodeURL                      string
 	ChainID                      string
 	ValidatorManagerContractAddr string
 
@@ -72,6 +73,12 @@ func AddNetworkFlags(cmd *cobra.Command, flags *CommonFlags) {
 	cmd.Flags().StringVar(&flags.ValidatorManagerContractAddr, "contract", "", "ValidatorManager contract address")
 }
 
+// AddNodeFlags adds flags for the consensus node
+func AddNodeFlags(cmd *cobra.Command, flags *CommonFlags) {
+	cmd.Flags().StringVar(&flags.Network, "network", "", "Network name for configuration")
+	cmd.Flags().StringVar(&flags.NodeURL, "node", "", "CometBFT RPC URL of a consensus node")
+}
+
 // AddTransactionFlags adds transaction-related flags
 func AddTransactionFlags(cmd *cobra.Command, flags *CommonFlags) {
 	cmd.Flags().Uint64Var(&flags.GasLimit, "gas-limit", 0, "Gas limit for transaction")
@@ -40,7 +40,8 @@ func NewAddContractCmd() *cobra.Command {
 Usage:
    mitosisd genesis add-contract 0x123... out/MyContract.sol/MyContract.json
 
-The command will add the contract to the alloc section of the genesis file with the deployed bytecode.`,
+The command will add the contract to the alloc section of the genesis file with the deployed bytecode.
+Use add-proxy-contract for upgradeable contracts deployed behind an ERC-1967 proxy.`,
 		Args: cobra.ExactArgs(2),
 		RunE: runAddContract,
 	}
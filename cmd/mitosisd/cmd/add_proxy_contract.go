@@ -0,0 +1,338 @@
+package cmd
+
+import (
+	"fmt"
+	"math/big"
+	"path/filepath"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/spf13/cobra"
+)
+
+const (
+	flagStorageLayout      = "storage-layout"
+	flagSet                = "set"
+	flagStorage            = "storage"
+	flagOwner              = "owner"
+	flagAdmin              = "admin"
+	flagInitializedVersion = "initialized-version"
+)
+
+var (
+	// erc1967ImplementationSlot is the slot of the implementation address of ERC-1967 proxies
+	erc1967ImplementationSlot = erc1967Slot("eip1967.proxy.implementation")
+
+	// erc1967AdminSlot is the slot of the admin address of ERC-1967 transparent proxies
+	erc1967AdminSlot = erc1967Slot("eip1967.proxy.admin")
+
+	// ozInitializableSlot is the namespaced storage of OpenZeppelin v5 Initializable,
+	// which starts with `uint64 _initialized`.
+	ozInitializableSlot = erc7201Slot("openzeppelin.storage.Initializable")
+
+	// ozOwnableSlot is the namespaced storage of OpenZeppelin v5 OwnableUpgradeable,
+	// which starts with `address _owner`.
+	ozOwnableSlot = erc7201Slot("openzeppelin.storage.Ownable")
+)
+
+// ProxyContractOptions describes an ERC-1967 proxy and its implementation to add to the genesis
+type ProxyContractOptions struct {
+	ProxyAddress          string
+	ProxyCode             string
+	ImplementationAddress string
+	ImplementationCode    string
+	Balance               string
+
+	// Layout is the storage layout of the implementation used to resolve Sets
+	Layout *StorageLayout
+	// Sets are `<variable>=<value>` assignments of the variables in Layout
+	Sets []string
+	// Storage are `<slot>=<value>` assignments of raw storage slots, applied last
+	Storage []string
+
+	Owner              string
+	Admin              string
+	InitializedVersion uint64
+}
+
+func NewAddProxyContractCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "add-proxy-contract [proxy-address] [proxy-artifact] [implementation-address] [implementation-artifact]",
+		Short: "Add an upgradeable proxy and its implementation to the genesis file from Foundry artifacts",
+		Long: `Add an ERC-1967 proxy (e.g. ERC1967Proxy of a UUPS contract) and its implementation to the genesis file
+from Foundry compilation artifacts.
+
+The implementation address is written to the ERC-1967 implementation slot of the proxy. As constructors and
+initializers are not run at genesis, the proxy is marked as initialized with --initialized-version and the
+initializers of the implementation are disabled, following OpenZeppelin Initializable.
+
+The initial state is set on the proxy by:
+  --owner:   the owner of OpenZeppelin OwnableUpgradeable (or the _owner variable in the storage layout)
+  --set:     a variable in the storage layout of the implementation, e.g. _permittedCallers[0x12...]=true
+  --storage: a raw storage slot, e.g. for ERC-7201 namespaced storage
+
+The storage layout is the output of 'forge inspect <contract> storageLayout --json', or an artifact
+compiled with extra_output = ["storageLayout"].
+
+Usage:
+   mitosisd genesis add-proxy-contract 0x9866... out/ERC1967Proxy.sol/ERC1967Proxy.json \
+     0x1234... out/ConsensusValidatorEntrypoint.sol/ConsensusValidatorEntrypoint.json \
+     --storage-layout layout.json --owner 0xabcd... --set '_permittedCallers[0xef01...]=true'`,
+		Args: cobra.ExactArgs(4),
+		RunE: runAddProxyContract,
+	}
+
+	cmd.Flags().String("balance", "0", "Initial balance for the proxy account")
+	cmd.Flags().String(flagStorageLayout, "", "Storage layout JSON of the implementation")
+	cmd.Flags().StringArray(flagSet, nil, "Initial value of a variable in the storage layout as <variable>=<value> (repeatable)")
+	cmd.Flags().StringArray(flagStorage, nil, "Initial value of a raw storage slot of the proxy as <slot>=<value> (repeatable)")
+	cmd.Flags().String(flagOwner, "", "Owner of the proxy (OpenZeppelin OwnableUpgradeable)")
+	cmd.Flags().String(flagAdmin, "", "Admin of the proxy written to the ERC-1967 admin slot (only for transparent proxies)")
+	cmd.Flags().Uint64(flagInitializedVersion, 1, "Initialized version of the proxy (OpenZeppelin Initializable), 0 to leave it uninitialized")
+
+	return cmd
+}
+
+func runAddProxyContract(cmd *cobra.Command, args []string) error {
+	clientCtx, err := client.GetClientQueryContext(cmd)
+	if err != nil {
+		clientCtx = client.Context{}
+	}
+
+	homeDir, _ := cmd.Flags().GetString(flags.FlagHome)
+	if homeDir == "" {
+		homeDir = clientCtx.HomeDir
+	}
+	genesisFile := filepath.Join(homeDir, "config", "eth_genesis.json")
+
+	opts := ProxyContractOptions{
+		ProxyAddress:          args[0],
+		ImplementationAddress: args[2],
+	}
+
+	for i, artifactFile := range []string{args[1], args[3]} {
+		if err := validateArtifactFile(artifactFile); err != nil {
+			return fmt.Errorf("invalid artifact file: %w", err)
+		}
+
+		bytecode, err := readBytecodeFromArtifact(artifactFile, false)
+		if err != nil {
+			return fmt.Errorf("failed to read bytecode from artifact: %w", err)
+		}
+
+		if i == 0 {
+			opts.ProxyCode = bytecode
+		} else {
+			opts.ImplementationCode = bytecode
+		}
+	}
+
+	opts.Balance, _ = cmd.Flags().GetString("balance")
+	opts.Sets, _ = cmd.Flags().GetStringArray(flagSet)
+	opts.Storage, _ = cmd.Flags().GetStringArray(flagStorage)
+	opts.Owner, _ = cmd.Flags().GetString(flagOwner)
+	opts.Admin, _ = cmd.Flags().GetString(flagAdmin)
+	opts.InitializedVersion, _ = cmd.Flags().GetUint64(flagInitializedVersion)
+
+	if layoutFile, _ := cmd.Flags().GetString(flagStorageLayout); layoutFile != "" {
+		opts.Layout, err = readStorageLayout(layoutFile)
+		if err != nil {
+			return err
+		}
+	}
+
+	genesis, err := readGenesisFile(genesisFile)
+	if err != nil {
+		return fmt.Errorf("failed to read genesis file: %w", err)
+	}
+
+	if err := addProxyContract(genesis, opts); err != nil {
+		return err
+	}
+
+	if err := writeGenesisFile(genesisFile, genesis); err != nil {
+		return fmt.Errorf("failed to write genesis file: %w", err)
+	}
+
+	_, _ = cmd.OutOrStdout().Write([]byte(fmt.Sprintf("Successfully added proxy %s with implementation %s to genesis file %s\n",
+		opts.ProxyAddress, opts.ImplementationAddress, genesisFile)))
+
+	return nil
+}
+
+// addProxyContract adds the proxy and its implementation to the alloc of the genesis
+func addProxyContract(genesis *EthereumGenesisSpec, opts ProxyContractOptions) error {
+	proxyAddress, err := normalizeAddress(opts.ProxyAddress)
+	if err != nil {
+		return fmt.Errorf("invalid proxy address: %w", err)
+	}
+	implAddress, err := normalizeAddress(opts.ImplementationAddress)
+	if err != nil {
+		return fmt.Errorf("invalid implementation address: %w", err)
+	}
+	if proxyAddress == implAddress {
+		return fmt.Errorf("proxy and implementation must have different addresses")
+	}
+
+	proxyCode, err := normalizeBytecode(opts.ProxyCode)
+	if err != nil {
+		return fmt.Errorf("invalid proxy bytecode: %w", err)
+	}
+	implCode, err := normalizeBytecode(opts.ImplementationCode)
+	if err != nil {
+		return fmt.Errorf("invalid implementation bytecode: %w", err)
+	}
+
+	balance, err := normalizeBalance(opts.Balance)
+	if err != nil {
+		return err
+	}
+
+	proxyStorage := make(map[string]string)
+	implStorage := make(map[string]string)
+
+	// ERC-1967 slots
+	setStorageBytes(proxyStorage, erc1967ImplementationSlot, 0, common.HexToAddress(implAddress).Bytes())
+	if opts.Admin != "" {
+		if !common.IsHexAddress(opts.Admin) {
+			return fmt.Errorf("invalid admin address: %s", opts.Admin)
+		}
+		setStorageBytes(proxyStorage, erc1967AdminSlot, 0, common.HexToAddress(opts.Admin).Bytes())
+	}
+
+	// Initializers are run neither on the proxy nor on the implementation at genesis.
+	if err := setInitialized(proxyStorage, implStorage, opts.Layout, opts.InitializedVersion); err != nil {
+		return err
+	}
+
+	if opts.Owner != "" {
+		if !common.IsHexAddress(opts.Owner) {
+			return fmt.Errorf("invalid owner address: %s", opts.Owner)
+		}
+		if _, ok := layoutVariable(opts.Layout, "_owner"); ok {
+			if err := opts.Layout.Set(proxyStorage, "_owner", opts.Owner); err != nil {
+				return err
+			}
+		} else {
+			setStorageBytes(proxyStorage, ozOwnableSlot, 0, common.HexToAddress(opts.Owner).Bytes())
+		}
+	}
+
+	for _, set := range opts.Sets {
+		if opts.Layout == nil {
+			return fmt.Errorf("storage layout is required to set %s", set)
+		}
+
+		path, value, ok := strings.Cut(set, "=")
+		if !ok {
+			return fmt.Errorf("invalid assignment %s (must be <variable>=<value>)", set)
+		}
+		if err := opts.Layout.Set(proxyStorage, strings.TrimSpace(path), strings.TrimSpace(value)); err != nil {
+			return err
+		}
+	}
+
+	for _, assignment := range opts.Storage {
+		key, value, ok := strings.Cut(assignment, "=")
+		if !ok {
+			return fmt.Errorf("invalid storage assignment %s (must be <slot>=<value>)", assignment)
+		}
+
+		slot, err := normalizeStorageWord(strings.TrimSpace(key))
+		if err != nil {
+			return fmt.Errorf("invalid storage slot %s: %w", key, err)
+		}
+		word, err := normalizeStorageWord(strings.TrimSpace(value))
+		if err != nil {
+			return fmt.Errorf("invalid storage value of slot %s: %w", key, err)
+		}
+
+		proxyStorage[slot] = word
+	}
+
+	if genesis.Alloc == nil {
+		genesis.Alloc = make(map[string]AllocatedAccount)
+	}
+
+	setAllocAccount(genesis.Alloc, proxyAddress, AllocatedAccount{
+		Balance: balance,
+		Code:    proxyCode,
+		Storage: proxyStorage,
+	})
+
+	implAccount := AllocatedAccount{Balance: "0", Code: implCode}
+	if len(implStorage) > 0 {
+		implAccount.Storage = implStorage
+	}
+	setAllocAccount(genesis.Alloc, implAddress, implAccount)
+
+	return nil
+}
+
+// setInitialized marks the proxy as initialized with the version and disables the initializers of the implementation,
+// as the constructor of the implementation would do by `_disableInitializers()`.
+// OpenZeppelin v4 keeps `uint8 _initialized` in the storage layout, while v5 keeps `uint64 _initialized`
+// in the namespaced storage.
+func setInitialized(proxyStorage, implStorage map[string]string, layout *StorageLayout, version uint64) error {
+	if _, ok := layoutVariable(layout, "_initialized"); ok {
+		if err := layout.Set(implStorage, "_initialized", "255"); err != nil {
+			return err
+		}
+		if version == 0 {
+			return nil
+		}
+
+		return layout.Set(proxyStorage, "_initialized", fmt.Sprintf("%d", version))
+	}
+
+	maxVersion := common.Hash{}
+	for i := common.HashLength - 8; i < common.HashLength; i++ {
+		maxVersion[i] = 0xff
+	}
+	implStorage[ozInitializableSlot.Hex()] = maxVersion.Hex()
+
+	if version != 0 {
+		proxyStorage[ozInitializableSlot.Hex()] = common.BigToHash(new(big.Int).SetUint64(version)).Hex()
+	}
+
+	return nil
+}
+
+func layoutVariable(layout *StorageLayout, label string) (StorageVariable, bool) {
+	if layout == nil {
+		return StorageVariable{}, false
+	}
+
+	return layout.Variable(label)
+}
+
+// normalizeBytecode validates a non-empty hex bytecode and returns it with 0x prefix
+func normalizeBytecode(code string) (string, error) {
+	if !strings.HasPrefix(code, "0x") {
+		code = "0x" + code
+	}
+	if code == "0x" {
+		return "", fmt.Errorf("empty bytecode provided")
+	}
+
+	bytecode, err := hexutil.Decode(code)
+	if err != nil {
+		return "", err
+	}
+
+	return hexutil.Encode(bytecode), nil
+}
+
+// setAllocAccount sets the account to the alloc, replacing the account of the same address in a different case
+func setAllocAccount(alloc map[string]AllocatedAccount, address string, account AllocatedAccount) {
+	for existing := range alloc {
+		if strings.EqualFold(existing, address) {
+			delete(alloc, existing)
+		}
+	}
+
+	alloc[address] = account
+}
@@ -0,0 +1,166 @@
+package cmd
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testProxyAddress = "0x9866D79EF3e9c0c22Db2b55877013e13a60AD478"
+	testImplAddress  = "0x1234567890123456789012345678901234567890"
+	testOwnerAddress = "0x2222222222222222222222222222222222222222"
+)
+
+func TestNewAddProxyContractCmd(t *testing.T) {
+	cmd := NewAddProxyContractCmd()
+
+	assert.Contains(t, cmd.Use, "add-proxy-contract")
+	for _, name := range []string{"balance", flagStorageLayout, flagSet, flagStorage, flagOwner, flagAdmin, flagInitializedVersion} {
+		require.NotNil(t, cmd.Flags().Lookup(name), name)
+	}
+	assert.Equal(t, "1", cmd.Flags().Lookup(flagInitializedVersion).DefValue)
+}
+
+func TestAddProxyContract(t *testing.T) {
+	genesis := &EthereumGenesisSpec{
+		Alloc: map[string]AllocatedAccount{
+			// The existing account of the proxy address is replaced.
+			"0x9866d79ef3e9c0c22db2b55877013e13a60ad478": {Balance: "1", Code: "0x00"},
+		},
+	}
+
+	caller := "0x1111111111111111111111111111111111111111"
+	err := addProxyContract(genesis, ProxyContractOptions{
+		ProxyAddress:          testProxyAddress,
+		ProxyCode:             "0x6080",
+		ImplementationAddress: testImplAddress,
+		ImplementationCode:    "6080604052",
+		Balance:               "10",
+		Layout:                testStorageLayout(t),
+		Sets:                  []string{"_permittedCallers[" + caller + "]=true"},
+		Storage:               []string{"0x10=0x20"},
+		Owner:                 testOwnerAddress,
+		InitializedVersion:    2,
+	})
+	require.NoError(t, err)
+	require.Len(t, genesis.Alloc, 2)
+
+	proxy := genesis.Alloc[testProxyAddress]
+	assert.Equal(t, "10", proxy.Balance)
+	assert.Equal(t, "0x6080", proxy.Code)
+
+	// ERC-1967 implementation slot
+	assert.Equal(t, common.BytesToHash(common.FromHex(testImplAddress)).Hex(), proxy.Storage[erc1967ImplementationSlot.Hex()])
+
+	// OpenZeppelin v5 Initializable of the proxy
+	assert.Equal(t, common.BigToHash(common.Big2).Hex(), proxy.Storage[ozInitializableSlot.Hex()])
+
+	// The owner is set to _owner as it exists in the storage layout
+	assert.Equal(t, common.BytesToHash(common.FromHex(testOwnerAddress)).Hex(), proxy.Storage[common.Hash{}.Hex()])
+	assert.NotContains(t, proxy.Storage, ozOwnableSlot.Hex())
+
+	// Raw storage slot
+	assert.Equal(t, common.BigToHash(common.Big32).Hex(), proxy.Storage[common.HexToHash("0x10").Hex()])
+	assert.Len(t, proxy.Storage, 5)
+
+	// Initializers of the implementation are disabled
+	impl := genesis.Alloc[testImplAddress]
+	assert.Equal(t, "0", impl.Balance)
+	assert.Equal(t, "0x6080604052", impl.Code)
+	assert.Equal(t, "0x000000000000000000000000000000000000000000000000ffffffffffffffff", impl.Storage[ozInitializableSlot.Hex()])
+
+	// The result is a valid genesis JSON
+	_, err = json.Marshal(genesis)
+	require.NoError(t, err)
+}
+
+func TestAddProxyContract_WithoutLayout(t *testing.T) {
+	genesis := &EthereumGenesisSpec{}
+
+	err := addProxyContract(genesis, ProxyContractOptions{
+		ProxyAddress:          testProxyAddress,
+		ProxyCode:             "0x6080",
+		ImplementationAddress: testImplAddress,
+		ImplementationCode:    "0x6080604052",
+		Owner:                 testOwnerAddress,
+		Admin:                 testOwnerAddress,
+	})
+	require.NoError(t, err)
+
+	proxy := genesis.Alloc[testProxyAddress]
+	assert.Equal(t, "0", proxy.Balance)
+	assert.Equal(t, common.BytesToHash(common.FromHex(testOwnerAddress)).Hex(), proxy.Storage[ozOwnableSlot.Hex()])
+	assert.Equal(t, common.BytesToHash(common.FromHex(testOwnerAddress)).Hex(), proxy.Storage[erc1967AdminSlot.Hex()])
+
+	// The proxy is left uninitialized as the initialized version is 0
+	assert.NotContains(t, proxy.Storage, ozInitializableSlot.Hex())
+}
+
+func TestAddProxyContract_Invalid(t *testing.T) {
+	valid := ProxyContractOptions{
+		ProxyAddress:          testProxyAddress,
+		ProxyCode:             "0x6080",
+		ImplementationAddress: testImplAddress,
+		ImplementationCode:    "0x6080",
+	}
+
+	tests := []struct {
+		name        string
+		modify      func(opts *ProxyContractOptions)
+		expectedErr string
+	}{
+		{
+			name:        "invalid proxy address",
+			modify:      func(opts *ProxyContractOptions) { opts.ProxyAddress = "0x1234" },
+			expectedErr: "invalid proxy address",
+		},
+		{
+			name:        "same addresses",
+			modify:      func(opts *ProxyContractOptions) { opts.ImplementationAddress = opts.ProxyAddress },
+			expectedErr: "must have different addresses",
+		},
+		{
+			name:        "empty implementation bytecode",
+			modify:      func(opts *ProxyContractOptions) { opts.ImplementationCode = "" },
+			expectedErr: "empty bytecode provided",
+		},
+		{
+			name:        "set without layout",
+			modify:      func(opts *ProxyContractOptions) { opts.Sets = []string{"_owner=" + testOwnerAddress} },
+			expectedErr: "storage layout is required",
+		},
+		{
+			name: "invalid assignment",
+			modify: func(opts *ProxyContractOptions) {
+				opts.Layout = testStorageLayout(t)
+				opts.Sets = []string{"_owner"}
+			},
+			expectedErr: "invalid assignment",
+		},
+		{
+			name:        "invalid storage slot",
+			modify:      func(opts *ProxyContractOptions) { opts.Storage = []string{"10=0x1"} },
+			expectedErr: "invalid storage slot",
+		},
+		{
+			name:        "invalid owner",
+			modify:      func(opts *ProxyContractOptions) { opts.Owner = "owner" },
+			expectedErr: "invalid owner address",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			opts := valid
+			tt.modify(&opts)
+
+			err := addProxyContract(&EthereumGenesisSpec{}, opts)
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.expectedErr)
+		})
+	}
+}
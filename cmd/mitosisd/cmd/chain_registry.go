@@ -0,0 +1,110 @@
+package cmd
+
+import (
+	_ "embed"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+const (
+	// derivedEthChainIDOffset is the lower bound of the derived EVM chain IDs.
+	// It keeps them apart from the registered chain IDs, which are small numbers as usual.
+	derivedEthChainIDOffset = uint64(1) << 32
+
+	// derivedEthChainIDRange is the number of the derived EVM chain IDs. The derived chain IDs
+	// stay below 2^53, which is the largest chain ID supported by most Ethereum tools.
+	derivedEthChainIDRange = uint64(1) << 48
+
+	// derivedEthChainIDDomain separates the hash of the derivation from other uses of the chain ID.
+	derivedEthChainIDDomain = "mitosis-eth-chain-id:"
+)
+
+//go:embed chains.json
+var chainRegistryJSON []byte
+
+// ChainRegistryEntry maps a Cosmos chain ID to the EVM chain ID of a known chain
+type ChainRegistryEntry struct {
+	CosmosChainID string `json:"cosmos_chain_id"`
+	EthChainID    uint64 `json:"eth_chain_id"`
+}
+
+// ChainRegistry is the registry of the known chains
+type ChainRegistry struct {
+	Chains []ChainRegistryEntry `json:"chains"`
+}
+
+// knownChains is the registry embedded from chains.json
+var knownChains = mustLoadChainRegistry(chainRegistryJSON)
+
+func mustLoadChainRegistry(bz []byte) ChainRegistry {
+	var registry ChainRegistry
+	if err := json.Unmarshal(bz, &registry); err != nil {
+		panic(fmt.Sprintf("failed to parse chain registry: %v", err))
+	}
+	if err := registry.Validate(); err != nil {
+		panic(fmt.Sprintf("invalid chain registry: %v", err))
+	}
+
+	return registry
+}
+
+// Validate checks that neither the Cosmos chain IDs nor the EVM chain IDs are duplicated.
+func (r ChainRegistry) Validate() error {
+	cosmosChainIDs := make(map[string]bool)
+	ethChainIDs := make(map[uint64]bool)
+	for _, entry := range r.Chains {
+		if entry.CosmosChainID == "" {
+			return fmt.Errorf("empty cosmos chain ID")
+		}
+		if entry.EthChainID == 0 {
+			return fmt.Errorf("zero eth chain ID of %s", entry.CosmosChainID)
+		}
+		if entry.EthChainID >= derivedEthChainIDOffset {
+			return fmt.Errorf("eth chain ID %d of %s overlaps the derived chain IDs", entry.EthChainID, entry.CosmosChainID)
+		}
+		if cosmosChainIDs[entry.CosmosChainID] {
+			return fmt.Errorf("duplicate cosmos chain ID %s", entry.CosmosChainID)
+		}
+		if ethChainIDs[entry.EthChainID] {
+			return fmt.Errorf("duplicate eth chain ID %d", entry.EthChainID)
+		}
+
+		cosmosChainIDs[entry.CosmosChainID] = true
+		ethChainIDs[entry.EthChainID] = true
+	}
+
+	return nil
+}
+
+// Lookup returns the EVM chain ID of the known chain
+func (r ChainRegistry) Lookup(cosmosChainID string) (uint64, bool) {
+	for _, entry := range r.Chains {
+		if entry.CosmosChainID == cosmosChainID {
+			return entry.EthChainID, true
+		}
+	}
+
+	return 0, false
+}
+
+// DeriveEthChainID deterministically derives an EVM chain ID from the Cosmos chain ID.
+// It is taken from keccak256 of the Cosmos chain ID, so different chains get different EVM chain IDs
+// (with a collision probability of about n^2/2^49 for n chains), which prevents replaying signed txs across them.
+func DeriveEthChainID(cosmosChainID string) uint64 {
+	hash := crypto.Keccak256([]byte(derivedEthChainIDDomain + cosmosChainID))
+	return derivedEthChainIDOffset + binary.BigEndian.Uint64(hash[:8])%derivedEthChainIDRange
+}
+
+// GetEthChainIDFromCosmosChainID returns the EVM chain ID of the known chain from the registry,
+// or derives it from the Cosmos chain ID for custom chains.
+func GetEthChainIDFromCosmosChainID(cosmosChainID string) *big.Int {
+	if ethChainID, ok := knownChains.Lookup(cosmosChainID); ok {
+		return new(big.Int).SetUint64(ethChainID)
+	}
+
+	return new(big.Int).SetUint64(DeriveEthChainID(cosmosChainID))
+}
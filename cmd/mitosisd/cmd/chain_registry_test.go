@@ -0,0 +1,81 @@
+package cmd
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestChainRegistry_Embedded(t *testing.T) {
+	require.NoError(t, knownChains.Validate())
+
+	ethChainID, ok := knownChains.Lookup("mitosis-devnet-1")
+	require.True(t, ok)
+	assert.Equal(t, uint64(124864), ethChainID)
+
+	_, ok = knownChains.Lookup("unknown-chain")
+	assert.False(t, ok)
+}
+
+func TestChainRegistry_Validate(t *testing.T) {
+	tests := []struct {
+		name        string
+		chains      []ChainRegistryEntry
+		expectedErr string
+	}{
+		{
+			name:        "empty cosmos chain ID",
+			chains:      []ChainRegistryEntry{{EthChainID: 1}},
+			expectedErr: "empty cosmos chain ID",
+		},
+		{
+			name:        "zero eth chain ID",
+			chains:      []ChainRegistryEntry{{CosmosChainID: "a"}},
+			expectedErr: "zero eth chain ID",
+		},
+		{
+			name:        "duplicate cosmos chain ID",
+			chains:      []ChainRegistryEntry{{CosmosChainID: "a", EthChainID: 1}, {CosmosChainID: "a", EthChainID: 2}},
+			expectedErr: "duplicate cosmos chain ID",
+		},
+		{
+			name:        "duplicate eth chain ID",
+			chains:      []ChainRegistryEntry{{CosmosChainID: "a", EthChainID: 1}, {CosmosChainID: "b", EthChainID: 1}},
+			expectedErr: "duplicate eth chain ID",
+		},
+		{
+			name:        "overlap with derived chain IDs",
+			chains:      []ChainRegistryEntry{{CosmosChainID: "a", EthChainID: derivedEthChainIDOffset}},
+			expectedErr: "overlaps the derived chain IDs",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ChainRegistry{Chains: tt.chains}.Validate()
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.expectedErr)
+		})
+	}
+}
+
+func TestDeriveEthChainID(t *testing.T) {
+	// The derivation must never change, otherwise existing custom chains would get a different EVM chain ID.
+	assert.Equal(t, uint64(234059155238106), DeriveEthChainID("custom-chain"))
+	assert.Equal(t, DeriveEthChainID("custom-chain"), DeriveEthChainID("custom-chain"))
+
+	seen := make(map[uint64]string)
+	for i := 0; i < 10000; i++ {
+		cosmosChainID := fmt.Sprintf("mitosis-devnet-%d", i)
+		ethChainID := DeriveEthChainID(cosmosChainID)
+
+		assert.GreaterOrEqual(t, ethChainID, derivedEthChainIDOffset)
+		assert.Less(t, ethChainID, uint64(1)<<53)
+
+		prev, exists := seen[ethChainID]
+		require.False(t, exists, "%s and %s derive the same chain ID", prev, cosmosChainID)
+		seen[ethChainID] = cosmosChainID
+	}
+}
@@ -3,6 +3,7 @@ package cmd
 import (
 	"errors"
 	"io"
+	"math/big"
 	"time"
 
 	"cosmossdk.io/log"
@@ -45,10 +46,11 @@ func initRootCmd(rootCmd *cobra.Command, txConfig client.TxConfig, basicManager
 
 	rootCmd.AddCommand(
 		server.StatusCommand(),
-		genesisCommand(txConfig, app.DefaultNodeHome, basicManager, evmvalcli.GetGenesisValidatorCmd(app.DefaultNodeHome)),
+		genesisCommand(txConfig, app.DefaultNodeHome, basicManager, evmvalcli.GetGenesisValidatorCmd(app.DefaultNodeHome), evmvalcli.GetGenesisValidatorsCmd(app.DefaultNodeHome)),
 		queryCommand(),
 		txCommand(),
 		keys.Commands(),
+		NewTestnetCmd(basicManager),
 	)
 }
 
@@ -90,6 +92,9 @@ func genesisCommand(txConfig client.TxConfig, defaultNodeHome string, basicManag
 
 	// Add our custom genesis commands
 	cmd.AddCommand(NewAddContractCmd())
+	cmd.AddCommand(NewAddProxyContractCmd())
+	cmd.AddCommand(NewValidateAllCmd(basicManager))
+	cmd.AddCommand(NewGenerateEthGenesisCmd())
 
 	return cmd
 }
@@ -182,6 +187,11 @@ func newApp(
 		panic(err)
 	}
 
+	processProposalCfg, err := getProcessProposalConfig(appConfig.ProcessProposal)
+	if err != nil {
+		panic(err)
+	}
+
 	mitosisApp, err := app.NewMitosisApp(
 		logger,
 		db,
@@ -191,6 +201,7 @@ func newApp(
 		engineBuildDelay,
 		appConfig.Engine.BuildOptimistic,
 		govEntrypointContractAddr,
+		processProposalCfg,
 		true,
 		appOpts,
 		baseappOptions...,
@@ -199,7 +210,29 @@ func newApp(
 		panic(err)
 	}
 
-	return app.NewABCIWrappedApplication(mitosisApp)
+	// The execution client is only required to run the node, not to inspect or modify the local state.
+	if !appConfig.Engine.Mock && runningCmd.Name() == "start" {
+		expectedEthChainID := GetEthChainIDFromCosmosChainID(mitosisApp.ChainID())
+		if appConfig.Engine.EthChainID != 0 {
+			expectedEthChainID = new(big.Int).SetUint64(appConfig.Engine.EthChainID)
+		}
+
+		if err := verifyEngineChainID(runningCmd.Context(), logger, engineCl, expectedEthChainID); err != nil {
+			panic(err)
+		}
+	}
+
+	abciApp := app.NewABCIWrappedApplication(mitosisApp)
+
+	if appConfig.Monitor.Enable && runningCmd.Name() == "start" {
+		validatorMonitor, err := newValidatorMonitor(runningCmd, appConfig.Monitor, mitosisApp, logger.With("module", "monitor"))
+		if err != nil {
+			panic(err)
+		}
+		abciApp.SetBlockMonitor(validatorMonitor)
+	}
+
+	return abciApp
 }
 
 func appExport(
@@ -254,6 +287,11 @@ func appExport(
 		return servertypes.ExportedApp{}, err
 	}
 
+	processProposalCfg, err := getProcessProposalConfig(appConfig.ProcessProposal)
+	if err != nil {
+		return servertypes.ExportedApp{}, err
+	}
+
 	mitosisApp, err = app.NewMitosisApp(
 		logger,
 		db,
@@ -263,6 +301,7 @@ func appExport(
 		engineBuildDelay,
 		appConfig.Engine.BuildOptimistic,
 		govEntrypointContractAddr,
+		processProposalCfg,
 		loadLatest,
 		appOpts,
 	)
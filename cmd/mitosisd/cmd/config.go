@@ -7,27 +7,53 @@ import (
 
 type AppConfig struct {
 	serverconfig.Config `mapstructure:",squash"`
-	Engine              *EngineConfig `mapstructure:"engine"`
-	EVMGov              *EVMGovConfig `mapstructure:"evmgov"`
-	Sentry              *SentryConfig `mapstructure:"sentry"`
+	Engine              *EngineConfig          `mapstructure:"engine"`
+	EVMGov              *EVMGovConfig          `mapstructure:"evmgov"`
+	ProcessProposal     *ProcessProposalConfig `mapstructure:"process-proposal"`
+	Sentry              *SentryConfig          `mapstructure:"sentry"`
+	Monitor             *MonitorConfig         `mapstructure:"monitor"`
 }
 
 type EngineConfig struct {
-	Mock            bool   `mapstructure:"mock"`
-	Endpoint        string `mapstructure:"endpoint"`
-	JWTFile         string `mapstructure:"jwt-file"`
-	BuildDelay      string `mapstructure:"build-delay"`
-	BuildOptimistic bool   `mapstructure:"build-optimistic"`
-	FeeRecipient    string `mapstructure:"fee-recipient"`
+	Mock                bool     `mapstructure:"mock"`
+	Endpoint            string   `mapstructure:"endpoint"`
+	JWTFile             string   `mapstructure:"jwt-file"`
+	FallbackEndpoints   []string `mapstructure:"fallback-endpoints"`
+	FallbackJWTFiles    []string `mapstructure:"fallback-jwt-files"`
+	HealthCheckInterval string   `mapstructure:"health-check-interval"`
+	MaxHeadLag          uint64   `mapstructure:"max-head-lag"`
+	MaxErrorRate        float64  `mapstructure:"max-error-rate"`
+	BuildDelay          string   `mapstructure:"build-delay"`
+	BuildOptimistic     bool     `mapstructure:"build-optimistic"`
+	FeeRecipient        string   `mapstructure:"fee-recipient"`
+	EthChainID          uint64   `mapstructure:"eth-chain-id"`
 }
 
 type EVMGovConfig struct {
+	// Deprecated: the address is stored in x/evmgov state. It is only used as a fallback.
 	Entrypoint string `mapstructure:"entrypoint"`
 }
 
+type ProcessProposalConfig struct {
+	Timeout             string `mapstructure:"timeout"`
+	RejectedHistorySize int    `mapstructure:"rejected-history-size"`
+}
+
 type SentryConfig struct {
-	DSN         string `mapstructure:"dsn"`
-	Environment string `mapstructure:"environment"`
+	DSN          string  `mapstructure:"dsn"`
+	Environment  string  `mapstructure:"environment"`
+	SampleRate   float64 `mapstructure:"sample-rate"`
+	Level        string  `mapstructure:"level"`
+	ModuleLevels string  `mapstructure:"module-levels"`
+}
+
+type MonitorConfig struct {
+	Enable                  bool    `mapstructure:"enable"`
+	WebhookURL              string  `mapstructure:"webhook-url"`
+	ConsecutiveMissedBlocks uint64  `mapstructure:"consecutive-missed-blocks"`
+	MissedBlocksRatio       float64 `mapstructure:"missed-blocks-ratio"`
+	VotingPowerMargin       float64 `mapstructure:"voting-power-margin"`
+	AlertInterval           string  `mapstructure:"alert-interval"`
 }
 
 func DefaultAppConfig() AppConfig {
@@ -38,19 +64,40 @@ func DefaultAppConfig() AppConfig {
 	return AppConfig{
 		Config: *srvCfg,
 		Engine: &EngineConfig{
-			Mock:            false,
-			Endpoint:        "http://127.0.0.1:8551",
-			JWTFile:         "",
-			BuildDelay:      "600ms", // it should be slightly longer than geth's --miner.recommit=500ms.
-			BuildOptimistic: true,
-			FeeRecipient:    "", // empty means using priv_validator_key.json's address.
+			Mock:                false,
+			Endpoint:            "http://127.0.0.1:8551",
+			JWTFile:             "",
+			FallbackEndpoints:   []string{},
+			FallbackJWTFiles:    []string{},
+			HealthCheckInterval: "2s",
+			MaxHeadLag:          8,
+			MaxErrorRate:        0.5,
+			BuildDelay:          "600ms", // it should be slightly longer than geth's --miner.recommit=500ms.
+			BuildOptimistic:     true,
+			FeeRecipient:        "", // empty means using priv_validator_key.json's address.
+			EthChainID:          0,  // 0 means using the chain ID from the chain registry or derived from the cosmos chain ID.
 		},
 		EVMGov: &EVMGovConfig{
 			Entrypoint: "0x0000000000000000000000000000000000000000",
 		},
+		ProcessProposal: &ProcessProposalConfig{
+			Timeout:             "10s",
+			RejectedHistorySize: 0, // 0 means not persisting rejected proposals.
+		},
 		Sentry: &SentryConfig{
-			DSN:         "",
-			Environment: "",
+			DSN:          "",
+			Environment:  "",
+			SampleRate:   1.0,
+			Level:        "warn",
+			ModuleLevels: "", // empty means using level for all the modules.
+		},
+		Monitor: &MonitorConfig{
+			Enable:                  false,
+			WebhookURL:              "", // empty means only logging the alerts.
+			ConsecutiveMissedBlocks: 5,
+			MissedBlocksRatio:       0.5,
+			VotingPowerMargin:       0.1,
+			AlertInterval:           "10m",
 		},
 	}
 }
@@ -75,6 +122,27 @@ endpoint = "{{ .Engine.Endpoint }}"
 # Execution client JWT file used for authentication.
 jwt-file = "{{ .Engine.JWTFile }}"
 
+# Execution client Engine API http endpoints used as hot standbys in the order of priority.
+# NewPayload and ForkchoiceUpdated are mirrored to them to keep them in sync.
+# If the active endpoint becomes unhealthy, the node fails over to the first healthy one
+# and stays on it until it becomes unhealthy.
+# e.g., ["http://10.0.0.2:8551"]
+fallback-endpoints = [{{ range $i, $e := .Engine.FallbackEndpoints }}{{ if $i }}, {{ end }}"{{ $e }}"{{ end }}]
+
+# JWT files of the fallback endpoints in the same order.
+# If it is empty, jwt-file will be used for all the fallback endpoints.
+fallback-jwt-files = [{{ range $i, $e := .Engine.FallbackJWTFiles }}{{ if $i }}, {{ end }}"{{ $e }}"{{ end }}]
+
+# Interval of probing the health (eth_syncing and head) of the endpoints.
+# It is only used if fallback-endpoints is not empty.
+health-check-interval = "{{ .Engine.HealthCheckInterval }}"
+
+# An endpoint is unhealthy if its head lags behind the highest head among the endpoints by more than this number of blocks.
+max-head-lag = {{ .Engine.MaxHeadLag }}
+
+# An endpoint is unhealthy if the rate of its failed calls within a health check interval exceeds this value.
+max-error-rate = {{ .Engine.MaxErrorRate }}
+
 # Build delay is the time to wait before building a block.
 # Slightly longer value is recommended than --miner.recommit in case of geth.
 # e.g., 600ms if --miner.recommit=500ms.
@@ -85,18 +153,45 @@ build-optimistic = {{ .Engine.BuildOptimistic }}
 
 # Fee recipient address for EVM gas fee tips.
 # If it is empty, priv_validator_key.json's address will be used.
+# If the validator has registered a fee recipient in x/evmvalidator,
+# the registered one is used instead, since the proposals with any other are rejected.
 # e.g., 0x0000000000000000000000000000000000000000
 fee-recipient = "{{ .Engine.FeeRecipient }}"
 
+# EVM chain ID that the execution client must run with. The node refuses to start if eth_chainId of any endpoint differs.
+# If it is 0, the chain ID of the chain registry is used for known chains, or it is derived from the cosmos chain ID.
+# Set it if the EVM chain ID was customized at genesis, e.g. with --eth-chain-id of the init command.
+eth-chain-id = {{ .Engine.EthChainID }}
+
 ###############################################################################
 ###                             EVM Gov                                     ###
 ###############################################################################
 
 [evmgov]
 
-# ConsensusGovernanceEntrypoint contract address.
+# DEPRECATED: ConsensusGovernanceEntrypoint contract address.
+# The address is stored in x/evmgov state, which is set from the genesis and updated via
+# MsgUpdateGovEntrypointContractAddr. This value is only used as a fallback while the address
+# is not set in the state, and the node refuses to start if it differs from the state.
+# While the address is not set in the state, this value is node-local and not verified against
+# the other validators, so it must be the same on all the validators.
 entrypoint = "{{ .EVMGov.Entrypoint }}"
 
+###############################################################################
+###                          Process Proposal                               ###
+###############################################################################
+
+[process-proposal]
+
+# Maximum time to process a proposal. A proposal which takes longer is rejected.
+# Too short value could negatively affect liveness as valid proposals might be rejected.
+timeout = "{{ .ProcessProposal.Timeout }}"
+
+# Number of the last rejected proposals to persist to data/rejected_proposals.json for post-mortems.
+# Each record includes the height, proposer, reason and tx hashes of the proposal.
+# If it is 0, rejected proposals are only logged and recorded as metrics.
+rejected-history-size = {{ .ProcessProposal.RejectedHistorySize }}
+
 ###############################################################################
 ###                             Sentry                                      ###
 ###############################################################################
@@ -109,6 +204,46 @@ dsn = "{{ .Sentry.DSN }}"
 
 # Sentry environment.
 environment = "{{ .Sentry.Environment }}"
+
+# Sampling rate of the events in (0, 1]. 1 sends all the events.
+# A lower value reduces the events sent during incidents, while the same errors are still grouped.
+sample-rate = {{ .Sentry.SampleRate }}
+
+# Minimum level of the log messages sent as events: debug, info, warn, error or none.
+level = "{{ .Sentry.Level }}"
+
+# Minimum levels of the modules overriding level, as comma-separated <module>:<level> pairs.
+# The module is the "module" key of the logger, e.g. x/evmengine, x/evmvalidator, x/evmgov, abci-wrapper or engine-failover.
+# e.g., "x/evmvalidator:error,engine-failover:none"
+module-levels = "{{ .Sentry.ModuleLevels }}"
+
+###############################################################################
+###                          Validator Monitor                              ###
+###############################################################################
+
+[monitor]
+
+# Enable monitoring the validator of priv_validator_key.json while running the node.
+# It checks whether the validator signed each block, its missed blocks in the slashing window
+# and its voting power, and exposes them as the "monitor" metrics.
+enable = {{ .Monitor.Enable }}
+
+# URL the alerts are posted to as JSON. If it is empty, the alerts are only logged.
+webhook-url = "{{ .Monitor.WebhookURL }}"
+
+# Number of consecutive missed blocks which triggers an alert.
+consecutive-missed-blocks = {{ .Monitor.ConsecutiveMissedBlocks }}
+
+# Ratio of the missed blocks in the slashing window to the missed blocks allowed before being jailed,
+# which triggers an alert. e.g., 0.5 alerts once half of the allowed missed blocks are missed.
+missed-blocks-ratio = {{ .Monitor.MissedBlocksRatio }}
+
+# An alert is triggered when the voting power is at most (1 + voting-power-margin) * min_voting_power,
+# since the validator is jailed once its voting power falls below min_voting_power.
+voting-power-margin = {{ .Monitor.VotingPowerMargin }}
+
+# Minimum interval of repeating an alert which is still firing.
+alert-interval = "{{ .Monitor.AlertInterval }}"
 `
 
 	return defaultAppTemplate, appConfig
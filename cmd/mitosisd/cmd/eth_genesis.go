@@ -69,21 +69,6 @@ type AllocatedAccount struct {
 	Storage map[string]string `json:"storage,omitempty"`
 }
 
-// GetEthChainIDFromCosmosChainID derives the Ethereum chain ID from Cosmos chain ID
-func GetEthChainIDFromCosmosChainID(cosmosChainID string) *big.Int {
-	// Default mappings based on existing configuration
-	switch cosmosChainID {
-	case "mitosis-localnet-1":
-		return big.NewInt(124899)
-	case "mitosis-devnet-1":
-		return big.NewInt(124864)
-	default:
-		// For custom chains, use a deterministic derivation
-		// This is a simple hash-based approach - you may want to customize this
-		return big.NewInt(100000)
-	}
-}
-
 // EthGenesisOptions contains options for generating Ethereum genesis
 type EthGenesisOptions struct {
 	ChainID        string
@@ -92,6 +77,10 @@ type EthGenesisOptions struct {
 	GasLimit       uint64
 	FundedAddress  string
 	InitialBalance string
+
+	// Manifest describes the genesis to generate. If nil, DefaultEthGenesisManifest is used
+	// with FundedAddress and InitialBalance applied. EthChainID and GasLimit override the manifest.
+	Manifest *EthGenesisManifest
 }
 
 // GenerateEthereumGenesis creates an Ethereum genesis file
@@ -104,90 +93,44 @@ func GenerateEthereumGenesis(chainID string, outputPath string) error {
 
 // GenerateEthereumGenesisWithOptions creates an Ethereum genesis file with custom options
 func GenerateEthereumGenesisWithOptions(opts EthGenesisOptions) error {
-	// Determine Ethereum chain ID
-	var ethChainID *big.Int
-	if opts.EthChainID != nil {
-		ethChainID = opts.EthChainID
+	var manifest EthGenesisManifest
+	if opts.Manifest != nil {
+		if opts.FundedAddress != "" || opts.InitialBalance != "" {
+			return fmt.Errorf("funded address and initial balance cannot be used with a manifest")
+		}
+		manifest = *opts.Manifest
 	} else {
-		ethChainID = GetEthChainIDFromCosmosChainID(opts.ChainID)
-	}
-
-	// Create chain config with all EIPs enabled from genesis
-	zero := big.NewInt(0)
-	zeroTime := uint64(0)
-
-	// Create blob schedule configuration
-	blobSchedule := &BlobSchedule{
-		Cancun: &BlobConfig{
-			Target:                3,
-			Max:                   6,
-			BaseFeeUpdateFraction: 3338477,
-		},
-		Prague: &BlobConfig{
-			Target:                6,
-			Max:                   9,
-			BaseFeeUpdateFraction: 5007716,
-		},
+		manifest = *DefaultEthGenesisManifest()
+		if opts.FundedAddress != "" {
+			manifest.Accounts[0].Address = opts.FundedAddress
+		}
+		if opts.InitialBalance != "" {
+			manifest.Accounts[0].Balance = opts.InitialBalance
+		}
 	}
 
-	chainConfig := &EthereumChainConfig{
-		ChainID:                 ethChainID,
-		HomesteadBlock:          zero,
-		EIP150Block:             zero,
-		EIP155Block:             zero,
-		EIP158Block:             zero,
-		ByzantiumBlock:          zero,
-		ConstantinopleBlock:     zero,
-		PetersburgBlock:         zero,
-		IstanbulBlock:           zero,
-		BerlinBlock:             zero,
-		LondonBlock:             zero,
-		TerminalTotalDifficulty: zero,
-		ShanghaiTime:            &zeroTime,
-		CancunTime:              &zeroTime,
-		PragueTime:              &zeroTime,
-		BlobSchedule:            blobSchedule,
-	}
-
-	// Determine funded address
-	fundedAddress := opts.FundedAddress
-	if fundedAddress == "" {
-		fundedAddress = DefaultFundedAddress
+	if opts.EthChainID != nil {
+		if !opts.EthChainID.IsUint64() || opts.EthChainID.Sign() == 0 {
+			return fmt.Errorf("invalid ethereum chain ID: %s", opts.EthChainID)
+		}
+		manifest.ChainID = opts.EthChainID.Uint64()
 	}
-
-	// Determine initial balance
-	initialBalance := opts.InitialBalance
-	if initialBalance == "" {
-		// Default balance: 999,000,000 ETH
-		initialBalance = "999000000000000000000000000"
+	if opts.GasLimit != 0 {
+		manifest.GasLimit = opts.GasLimit
 	}
 
-	// Determine gas limit
-	gasLimit := opts.GasLimit
-	if gasLimit == 0 {
-		gasLimit = 30000000 // Default: 30,000,000
-	}
-	gasLimitStr := fmt.Sprintf("%d", gasLimit)
-
-	genesis := &EthereumGenesisSpec{
-		Config:     chainConfig,
-		Nonce:      "0",
-		Timestamp:  "0",
-		ExtraData:  "0x",
-		GasLimit:   gasLimitStr,
-		Difficulty: "0",
-		Alloc: map[string]AllocatedAccount{
-			fundedAddress: {
-				Balance: initialBalance,
-			},
-		},
+	genesis, err := manifest.Build(opts.ChainID)
+	if err != nil {
+		return fmt.Errorf("failed to build ethereum genesis: %w", err)
 	}
 
-	// Marshal to JSON with proper formatting
+	// Marshal to JSON with proper formatting.
+	// Map keys are sorted, so the output is deterministic.
 	jsonData, err := json.MarshalIndent(genesis, "", "  ")
 	if err != nil {
 		return fmt.Errorf("failed to marshal ethereum genesis: %w", err)
 	}
+	jsonData = append(jsonData, '\n')
 
 	// Ensure the directory exists
 	dir := filepath.Dir(opts.OutputPath)
@@ -0,0 +1,397 @@
+package cmd
+
+import (
+	"fmt"
+	"math/big"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/common/math"
+	"sigs.k8s.io/yaml"
+)
+
+const (
+	// DefaultEthGasLimit is the default gas limit of the Ethereum genesis block
+	DefaultEthGasLimit = 30000000
+
+	// DefaultFundedBalance is the default balance of the funded address in wei (999,000,000 ETH)
+	DefaultFundedBalance = "999000000000000000000000000"
+)
+
+// EthGenesisManifest describes an Ethereum genesis in a YAML or JSON file.
+// It is meant to be kept in version control, so that the same genesis can be
+// reproduced from it at any time.
+//
+// Example:
+//
+//	chainId: 124864
+//	gasLimit: 30000000
+//	forks:
+//	  shanghai: 0
+//	  cancun: 0
+//	  prague: 1735689600
+//	blobSchedule:
+//	  prague: {target: 6, max: 9, baseFeeUpdateFraction: 5007716}
+//	accounts:
+//	  - address: "0xF530AC32044B7bCF6B6ac9E2B65d8eDb7794d64f"
+//	    balance: "999000000000000000000000000"
+//	contracts:
+//	  - name: ConsensusValidatorEntrypoint
+//	    address: "0x9866D79EF3e9c0c22Db2b55877013e13a60AD478"
+//	    artifact: out/ConsensusValidatorEntrypoint.sol/ConsensusValidatorEntrypoint.json
+//	    storage:
+//	      "0x0": "0x1"
+type EthGenesisManifest struct {
+	// ChainID is the Ethereum chain ID. If zero, it is derived from the Cosmos chain ID.
+	ChainID   uint64 `json:"chainId,omitempty"`
+	GasLimit  uint64 `json:"gasLimit,omitempty"`
+	Timestamp uint64 `json:"timestamp,omitempty"`
+	ExtraData string `json:"extraData,omitempty"`
+
+	// Forks contains the activation timestamps of the time-based forks. A fork that is not listed is not activated.
+	Forks EthForkSchedule `json:"forks"`
+
+	// BlobSchedule overrides the default blob configuration of the activated forks.
+	BlobSchedule BlobSchedule `json:"blobSchedule,omitempty"`
+
+	Accounts  []EthGenesisAccount  `json:"accounts,omitempty"`
+	Contracts []EthGenesisContract `json:"contracts,omitempty"`
+
+	// baseDir is the directory that relative artifact paths are resolved against.
+	baseDir string
+}
+
+// EthForkSchedule contains the activation timestamps of the time-based forks
+type EthForkSchedule struct {
+	Shanghai *uint64 `json:"shanghai,omitempty"`
+	Cancun   *uint64 `json:"cancun,omitempty"`
+	Prague   *uint64 `json:"prague,omitempty"`
+}
+
+// EthGenesisAccount is a funded account in the genesis
+type EthGenesisAccount struct {
+	Address string `json:"address"`
+	// Balance is the balance in wei, either in decimal or 0x-prefixed hex.
+	Balance string `json:"balance"`
+}
+
+// EthGenesisContract is a predeployed contract in the genesis.
+// The code is read from a Foundry artifact unless it is given directly.
+type EthGenesisContract struct {
+	Name            string            `json:"name,omitempty"`
+	Address         string            `json:"address"`
+	Artifact        string            `json:"artifact,omitempty"`
+	UseCreationCode bool              `json:"useCreationCode,omitempty"`
+	Code            string            `json:"code,omitempty"`
+	Balance         string            `json:"balance,omitempty"`
+	Storage         map[string]string `json:"storage,omitempty"`
+}
+
+// DefaultBlobSchedule returns the blob configuration of the forks as specified in the EIPs
+func DefaultBlobSchedule() BlobSchedule {
+	return BlobSchedule{
+		Cancun: &BlobConfig{
+			Target:                3,
+			Max:                   6,
+			BaseFeeUpdateFraction: 3338477,
+		},
+		Prague: &BlobConfig{
+			Target:                6,
+			Max:                   9,
+			BaseFeeUpdateFraction: 5007716,
+		},
+	}
+}
+
+// DefaultEthGenesisManifest returns the manifest of the default genesis, which activates
+// all the forks from genesis and funds DefaultFundedAddress.
+func DefaultEthGenesisManifest() *EthGenesisManifest {
+	zeroTime := uint64(0)
+
+	return &EthGenesisManifest{
+		GasLimit: DefaultEthGasLimit,
+		Forks: EthForkSchedule{
+			Shanghai: &zeroTime,
+			Cancun:   &zeroTime,
+			Prague:   &zeroTime,
+		},
+		BlobSchedule: DefaultBlobSchedule(),
+		Accounts: []EthGenesisAccount{
+			{Address: DefaultFundedAddress, Balance: DefaultFundedBalance},
+		},
+	}
+}
+
+// LoadEthGenesisManifest reads a manifest from a YAML or JSON file.
+// Unknown fields are rejected to catch typos early.
+func LoadEthGenesisManifest(manifestFile string) (*EthGenesisManifest, error) {
+	const maxFileSize = 10 * 1024 * 1024 // 10MB limit
+	allowedExtensions := []string{".yaml", ".yml", ".json"}
+	if err := validateFilePath(manifestFile, allowedExtensions, maxFileSize); err != nil {
+		return nil, fmt.Errorf("invalid manifest file: %w", err)
+	}
+
+	cleanPath := filepath.Clean(manifestFile)
+	data, err := os.ReadFile(cleanPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read manifest file: %w", err)
+	}
+
+	// JSON is a subset of YAML, so both formats are parsed in the same way.
+	var manifest EthGenesisManifest
+	if err := yaml.UnmarshalStrict(data, &manifest); err != nil {
+		return nil, fmt.Errorf("failed to parse manifest file: %w", err)
+	}
+
+	baseDir, err := filepath.Abs(filepath.Dir(cleanPath))
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve manifest directory: %w", err)
+	}
+	manifest.baseDir = baseDir
+
+	return &manifest, nil
+}
+
+// Build creates the Ethereum genesis described by the manifest.
+// The result only depends on the manifest and the artifacts, and all the addresses, balances
+// and storage slots are normalized, so that the generated file is stable across runs.
+func (m *EthGenesisManifest) Build(cosmosChainID string) (*EthereumGenesisSpec, error) {
+	chainConfig, err := m.chainConfig(cosmosChainID)
+	if err != nil {
+		return nil, err
+	}
+
+	alloc := make(map[string]AllocatedAccount)
+	for i, account := range m.Accounts {
+		address, err := normalizeAddress(account.Address)
+		if err != nil {
+			return nil, fmt.Errorf("accounts[%d]: %w", i, err)
+		}
+		if _, exists := alloc[address]; exists {
+			return nil, fmt.Errorf("accounts[%d]: duplicate address %s", i, address)
+		}
+
+		balance, err := normalizeBalance(account.Balance)
+		if err != nil {
+			return nil, fmt.Errorf("accounts[%d]: %w", i, err)
+		}
+
+		alloc[address] = AllocatedAccount{Balance: balance}
+	}
+
+	for i, contract := range m.Contracts {
+		address, account, err := m.buildContract(contract)
+		if err != nil {
+			return nil, fmt.Errorf("contracts[%d] (%s): %w", i, contract.Name, err)
+		}
+		if _, exists := alloc[address]; exists {
+			return nil, fmt.Errorf("contracts[%d] (%s): duplicate address %s", i, contract.Name, address)
+		}
+
+		alloc[address] = account
+	}
+
+	gasLimit := m.GasLimit
+	if gasLimit == 0 {
+		gasLimit = DefaultEthGasLimit
+	}
+
+	extraData := m.ExtraData
+	if extraData == "" {
+		extraData = "0x"
+	}
+	if _, err := hexutil.Decode(extraData); err != nil {
+		return nil, fmt.Errorf("invalid extra data %s: %w", extraData, err)
+	}
+
+	return &EthereumGenesisSpec{
+		Config:     chainConfig,
+		Nonce:      "0",
+		Timestamp:  fmt.Sprintf("%d", m.Timestamp),
+		ExtraData:  extraData,
+		GasLimit:   fmt.Sprintf("%d", gasLimit),
+		Difficulty: "0",
+		Alloc:      alloc,
+	}, nil
+}
+
+func (m *EthGenesisManifest) chainConfig(cosmosChainID string) (*EthereumChainConfig, error) {
+	var ethChainID *big.Int
+	if m.ChainID != 0 {
+		ethChainID = new(big.Int).SetUint64(m.ChainID)
+	} else {
+		ethChainID = GetEthChainIDFromCosmosChainID(cosmosChainID)
+	}
+
+	// Forks must be activated in order, and a fork requires all the previous forks.
+	forks := []struct {
+		name string
+		time *uint64
+	}{
+		{"shanghai", m.Forks.Shanghai},
+		{"cancun", m.Forks.Cancun},
+		{"prague", m.Forks.Prague},
+	}
+	for i := 1; i < len(forks); i++ {
+		prev, cur := forks[i-1], forks[i]
+		if cur.time == nil {
+			continue
+		}
+		if prev.time == nil {
+			return nil, fmt.Errorf("fork %s is scheduled without fork %s", cur.name, prev.name)
+		}
+		if *cur.time < *prev.time {
+			return nil, fmt.Errorf("fork %s (%d) is scheduled before fork %s (%d)", cur.name, *cur.time, prev.name, *prev.time)
+		}
+	}
+
+	// Blob configuration is only included for the activated forks.
+	var blobSchedule *BlobSchedule
+	defaults := DefaultBlobSchedule()
+	if m.Forks.Cancun != nil {
+		blobSchedule = &BlobSchedule{Cancun: m.BlobSchedule.Cancun}
+		if blobSchedule.Cancun == nil {
+			blobSchedule.Cancun = defaults.Cancun
+		}
+	}
+	if m.Forks.Prague != nil {
+		blobSchedule.Prague = m.BlobSchedule.Prague
+		if blobSchedule.Prague == nil {
+			blobSchedule.Prague = defaults.Prague
+		}
+	}
+
+	zero := big.NewInt(0)
+
+	return &EthereumChainConfig{
+		ChainID:                 ethChainID,
+		HomesteadBlock:          zero,
+		EIP150Block:             zero,
+		EIP155Block:             zero,
+		EIP158Block:             zero,
+		ByzantiumBlock:          zero,
+		ConstantinopleBlock:     zero,
+		PetersburgBlock:         zero,
+		IstanbulBlock:           zero,
+		BerlinBlock:             zero,
+		LondonBlock:             zero,
+		TerminalTotalDifficulty: zero,
+		ShanghaiTime:            m.Forks.Shanghai,
+		CancunTime:              m.Forks.Cancun,
+		PragueTime:              m.Forks.Prague,
+		BlobSchedule:            blobSchedule,
+	}, nil
+}
+
+func (m *EthGenesisManifest) buildContract(contract EthGenesisContract) (string, AllocatedAccount, error) {
+	address, err := normalizeAddress(contract.Address)
+	if err != nil {
+		return "", AllocatedAccount{}, err
+	}
+
+	code := contract.Code
+	switch {
+	case contract.Artifact != "" && code != "":
+		return "", AllocatedAccount{}, fmt.Errorf("artifact and code are mutually exclusive")
+	case contract.Artifact != "":
+		artifactFile := contract.Artifact
+		if !filepath.IsAbs(artifactFile) {
+			artifactFile = filepath.Join(m.baseDir, artifactFile)
+		}
+		if err := validateArtifactFile(artifactFile); err != nil {
+			return "", AllocatedAccount{}, fmt.Errorf("invalid artifact file: %w", err)
+		}
+
+		code, err = readBytecodeFromArtifact(artifactFile, contract.UseCreationCode)
+		if err != nil {
+			return "", AllocatedAccount{}, fmt.Errorf("failed to read bytecode from artifact: %w", err)
+		}
+	case code == "":
+		return "", AllocatedAccount{}, fmt.Errorf("either artifact or code must be specified")
+	}
+
+	code, err = normalizeBytecode(code)
+	if err != nil {
+		return "", AllocatedAccount{}, fmt.Errorf("invalid bytecode: %w", err)
+	}
+
+	balance, err := normalizeBalance(contract.Balance)
+	if err != nil {
+		return "", AllocatedAccount{}, err
+	}
+
+	var storage map[string]string
+	if len(contract.Storage) > 0 {
+		storage = make(map[string]string, len(contract.Storage))
+		for key, value := range contract.Storage {
+			slot, err := normalizeStorageWord(key)
+			if err != nil {
+				return "", AllocatedAccount{}, fmt.Errorf("invalid storage slot %s: %w", key, err)
+			}
+			if _, exists := storage[slot]; exists {
+				return "", AllocatedAccount{}, fmt.Errorf("duplicate storage slot %s", slot)
+			}
+
+			word, err := normalizeStorageWord(value)
+			if err != nil {
+				return "", AllocatedAccount{}, fmt.Errorf("invalid storage value of slot %s: %w", key, err)
+			}
+
+			storage[slot] = word
+		}
+	}
+
+	return address, AllocatedAccount{
+		Balance: balance,
+		Code:    code,
+		Storage: storage,
+	}, nil
+}
+
+// normalizeAddress validates a hex address and returns it in the EIP-55 checksum format
+func normalizeAddress(address string) (string, error) {
+	if !common.IsHexAddress(address) || !strings.HasPrefix(address, "0x") {
+		return "", fmt.Errorf("invalid address format: %s (must be 0x followed by 40 hex characters)", address)
+	}
+
+	return common.HexToAddress(address).Hex(), nil
+}
+
+// normalizeBalance validates a decimal or hex balance and returns it in decimal. An empty balance is zero.
+func normalizeBalance(balance string) (string, error) {
+	if balance == "" {
+		return "0", nil
+	}
+
+	value, ok := math.ParseBig256(balance)
+	if !ok {
+		return "", fmt.Errorf("invalid balance: %s", balance)
+	}
+
+	return value.String(), nil
+}
+
+// normalizeStorageWord validates a hex storage slot or value and returns it as a 32-byte hex
+func normalizeStorageWord(word string) (string, error) {
+	if !strings.HasPrefix(word, "0x") {
+		return "", fmt.Errorf("must be 0x-prefixed hex")
+	}
+
+	digits := strings.TrimPrefix(word, "0x")
+	if len(digits) > 2*common.HashLength {
+		return "", fmt.Errorf("longer than %d bytes", common.HashLength)
+	}
+	if len(digits)%2 == 1 {
+		digits = "0" + digits
+	}
+
+	bz, err := hexutil.Decode("0x" + digits)
+	if err != nil && digits != "" {
+		return "", err
+	}
+
+	return common.BytesToHash(bz).Hex(), nil
+}
@@ -0,0 +1,209 @@
+package cmd
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testManifestYAML = `
+chainId: 124864
+gasLimit: 60000000
+timestamp: 1700000000
+forks:
+  shanghai: 0
+  cancun: 0
+  prague: 1735689600
+blobSchedule:
+  prague: {target: 3, max: 6, baseFeeUpdateFraction: 5007716}
+accounts:
+  - address: "0x1111111111111111111111111111111111111111"
+    balance: "1000"
+  - address: "0xf530ac32044b7bcf6b6ac9e2b65d8edb7794d64f"
+    balance: "0x10"
+contracts:
+  - name: Entrypoint
+    address: "0x9866D79EF3e9c0c22Db2b55877013e13a60AD478"
+    artifact: out/Entrypoint.json
+    storage:
+      "0x0": "0x1"
+      "0x360894a13ba1a3210667c828492db98dca3e2076cc3735a920a3ca505d382bbc": "0xabcd"
+  - address: "0x2222222222222222222222222222222222222222"
+    code: "0x6000"
+    balance: "5"
+`
+
+func writeTestManifest(t *testing.T, dir, name, content string) string {
+	t.Helper()
+
+	artifactDir := filepath.Join(dir, "out")
+	require.NoError(t, os.MkdirAll(artifactDir, 0o750))
+	artifact := `{"bytecode":{"object":"0x6080"},"deployedBytecode":{"object":"0x6080604052"}}`
+	require.NoError(t, os.WriteFile(filepath.Join(artifactDir, "Entrypoint.json"), []byte(artifact), 0o600))
+
+	path := filepath.Join(dir, name)
+	require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+
+	return path
+}
+
+func TestEthGenesisManifest_Build(t *testing.T) {
+	path := writeTestManifest(t, t.TempDir(), "manifest.yaml", testManifestYAML)
+
+	manifest, err := LoadEthGenesisManifest(path)
+	require.NoError(t, err)
+
+	genesis, err := manifest.Build("mitosis-localnet-1")
+	require.NoError(t, err)
+
+	// Chain config
+	assert.Equal(t, uint64(124864), genesis.Config.ChainID.Uint64())
+	assert.Equal(t, uint64(0), *genesis.Config.ShanghaiTime)
+	assert.Equal(t, uint64(0), *genesis.Config.CancunTime)
+	assert.Equal(t, uint64(1735689600), *genesis.Config.PragueTime)
+	assert.Equal(t, DefaultBlobSchedule().Cancun, genesis.Config.BlobSchedule.Cancun)
+	assert.Equal(t, &BlobConfig{Target: 3, Max: 6, BaseFeeUpdateFraction: 5007716}, genesis.Config.BlobSchedule.Prague)
+
+	// Genesis parameters
+	assert.Equal(t, "60000000", genesis.GasLimit)
+	assert.Equal(t, "1700000000", genesis.Timestamp)
+
+	// Accounts are normalized
+	require.Len(t, genesis.Alloc, 4)
+	assert.Equal(t, "1000", genesis.Alloc["0x1111111111111111111111111111111111111111"].Balance)
+	assert.Equal(t, "16", genesis.Alloc[DefaultFundedAddress].Balance)
+
+	// Contracts
+	entrypoint := genesis.Alloc["0x9866D79EF3e9c0c22Db2b55877013e13a60AD478"]
+	assert.Equal(t, "0x6080604052", entrypoint.Code)
+	assert.Equal(t, "0", entrypoint.Balance)
+	assert.Equal(t, map[string]string{
+		"0x0000000000000000000000000000000000000000000000000000000000000000": "0x0000000000000000000000000000000000000000000000000000000000000001",
+		"0x360894a13ba1a3210667c828492db98dca3e2076cc3735a920a3ca505d382bbc": "0x000000000000000000000000000000000000000000000000000000000000abcd",
+	}, entrypoint.Storage)
+
+	inline := genesis.Alloc["0x2222222222222222222222222222222222222222"]
+	assert.Equal(t, "0x6000", inline.Code)
+	assert.Equal(t, "5", inline.Balance)
+}
+
+func TestEthGenesisManifest_JSON(t *testing.T) {
+	content := `{
+  "forks": {"shanghai": 0, "cancun": 0},
+  "accounts": [{"address": "0x1111111111111111111111111111111111111111", "balance": "1"}]
+}`
+	path := writeTestManifest(t, t.TempDir(), "manifest.json", content)
+
+	manifest, err := LoadEthGenesisManifest(path)
+	require.NoError(t, err)
+
+	genesis, err := manifest.Build("mitosis-devnet-1")
+	require.NoError(t, err)
+
+	// The chain ID is derived from the cosmos chain ID and prague is not activated.
+	assert.Equal(t, uint64(124864), genesis.Config.ChainID.Uint64())
+	assert.Nil(t, genesis.Config.PragueTime)
+	assert.Nil(t, genesis.Config.BlobSchedule.Prague)
+	assert.Equal(t, "30000000", genesis.GasLimit)
+}
+
+func TestEthGenesisManifest_Deterministic(t *testing.T) {
+	dir := t.TempDir()
+	path := writeTestManifest(t, dir, "manifest.yaml", testManifestYAML)
+
+	manifest, err := LoadEthGenesisManifest(path)
+	require.NoError(t, err)
+
+	var outputs [][]byte
+	for i := 0; i < 3; i++ {
+		outputPath := filepath.Join(dir, "genesis.json")
+		require.NoError(t, GenerateEthereumGenesisWithOptions(EthGenesisOptions{
+			ChainID:    "mitosis-devnet-1",
+			OutputPath: outputPath,
+			Manifest:   manifest,
+		}))
+
+		data, err := os.ReadFile(outputPath)
+		require.NoError(t, err)
+		outputs = append(outputs, data)
+	}
+
+	assert.Equal(t, outputs[0], outputs[1])
+	assert.Equal(t, outputs[0], outputs[2])
+
+	var genesis EthereumGenesisSpec
+	require.NoError(t, json.Unmarshal(outputs[0], &genesis))
+	assert.Len(t, genesis.Alloc, 4)
+}
+
+func TestEthGenesisManifest_Invalid(t *testing.T) {
+	tests := []struct {
+		name        string
+		content     string
+		expectedErr string
+	}{
+		{
+			name:        "unknown field",
+			content:     "gas_limit: 1\n",
+			expectedErr: "failed to parse manifest file",
+		},
+		{
+			name:        "invalid address",
+			content:     "accounts:\n  - address: \"0x1234\"\n    balance: \"1\"\n",
+			expectedErr: "invalid address format",
+		},
+		{
+			name:        "invalid balance",
+			content:     "accounts:\n  - address: \"0x1111111111111111111111111111111111111111\"\n    balance: \"abc\"\n",
+			expectedErr: "invalid balance",
+		},
+		{
+			name: "duplicate address",
+			content: "accounts:\n  - address: \"0x1111111111111111111111111111111111111111\"\n    balance: \"1\"\n" +
+				"contracts:\n  - address: \"0x1111111111111111111111111111111111111111\"\n    code: \"0x00\"\n",
+			expectedErr: "duplicate address",
+		},
+		{
+			name:        "contract without code",
+			content:     "contracts:\n  - address: \"0x1111111111111111111111111111111111111111\"\n",
+			expectedErr: "either artifact or code must be specified",
+		},
+		{
+			name:        "invalid storage slot",
+			content:     "contracts:\n  - address: \"0x1111111111111111111111111111111111111111\"\n    code: \"0x00\"\n    storage:\n      \"1\": \"0x1\"\n",
+			expectedErr: "invalid storage slot",
+		},
+		{
+			name:        "missing artifact",
+			content:     "contracts:\n  - address: \"0x1111111111111111111111111111111111111111\"\n    artifact: out/Missing.json\n",
+			expectedErr: "invalid artifact file",
+		},
+		{
+			name:        "fork without previous fork",
+			content:     "forks:\n  shanghai: 0\n  prague: 0\n",
+			expectedErr: "fork prague is scheduled without fork cancun",
+		},
+		{
+			name:        "forks out of order",
+			content:     "forks:\n  shanghai: 10\n  cancun: 5\n",
+			expectedErr: "fork cancun (5) is scheduled before fork shanghai (10)",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := writeTestManifest(t, t.TempDir(), "manifest.yaml", tt.content)
+
+			manifest, err := LoadEthGenesisManifest(path)
+			if err == nil {
+				_, err = manifest.Build("mitosis-localnet-1")
+			}
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.expectedErr)
+		})
+	}
+}
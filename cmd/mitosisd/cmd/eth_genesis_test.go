@@ -30,12 +30,12 @@ func TestGetEthChainIDFromCosmosChainID(t *testing.T) {
 		{
 			name:            "custom chain ID",
 			cosmosChainID:   "custom-chain-123",
-			expectedChainID: big.NewInt(100000),
+			expectedChainID: big.NewInt(212805698747806),
 		},
 		{
 			name:            "empty chain ID",
 			cosmosChainID:   "",
-			expectedChainID: big.NewInt(100000),
+			expectedChainID: big.NewInt(105233639652383),
 		},
 	}
 
@@ -124,11 +124,11 @@ func TestGenerateEthereumGenesis(t *testing.T) {
 			chainID:         "custom-test-chain",
 			outputPath:      filepath.Join(tempDir, "custom", "genesis.json"),
 			expectedBalance: "10000000000000000000000000",
-			expectedChainID: big.NewInt(100000),
+			expectedChainID: big.NewInt(258094913230321),
 			expectError:     false,
 			validateGenesis: func(t *testing.T, genesis *EthereumGenesisSpec) {
 				// Validate custom chain ID
-				assert.Equal(t, big.NewInt(100000), genesis.Config.ChainID)
+				assert.Equal(t, big.NewInt(258094913230321), genesis.Config.ChainID)
 			},
 		},
 		{
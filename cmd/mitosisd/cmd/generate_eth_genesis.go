@@ -0,0 +1,67 @@
+package cmd
+
+import (
+	"fmt"
+	"path/filepath"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+)
+
+const flagOutput = "output"
+
+func NewGenerateEthGenesisCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "generate-eth-genesis [manifest-file]",
+		Short: "Generate the Ethereum genesis file from a YAML or JSON manifest",
+		Long: `Generate the Ethereum genesis file from a YAML or JSON manifest that lists funded accounts,
+predeployed contracts (from Foundry artifacts), fork activation timestamps and blob schedule.
+
+Relative artifact paths are resolved against the directory of the manifest.
+The generated file is deterministic, so it can be reproduced from the manifest kept in version control.
+
+Usage:
+   mitosisd genesis generate-eth-genesis devnet/eth_genesis.yaml --chain-id mitosis-devnet-1`,
+		Args: cobra.ExactArgs(1),
+		RunE: runGenerateEthGenesis,
+	}
+
+	cmd.Flags().String(flags.FlagChainID, "", "cosmos chain ID used to derive the ethereum chain ID if the manifest doesn't specify it")
+	cmd.Flags().String(flagOutput, "", "output path of the genesis file (default: <home>/config/eth_genesis.json)")
+
+	return cmd
+}
+
+func runGenerateEthGenesis(cmd *cobra.Command, args []string) error {
+	manifest, err := LoadEthGenesisManifest(args[0])
+	if err != nil {
+		return err
+	}
+
+	outputPath, _ := cmd.Flags().GetString(flagOutput)
+	if outputPath == "" {
+		homeDir, _ := cmd.Flags().GetString(flags.FlagHome)
+		if homeDir == "" {
+			homeDir = client.GetClientContextFromCmd(cmd).HomeDir
+		}
+		outputPath = filepath.Join(homeDir, "config", "eth_genesis.json")
+	}
+
+	chainID, _ := cmd.Flags().GetString(flags.FlagChainID)
+	if chainID == "" {
+		chainID = client.GetClientContextFromCmd(cmd).ChainID
+	}
+
+	if err := GenerateEthereumGenesisWithOptions(EthGenesisOptions{
+		ChainID:    chainID,
+		OutputPath: outputPath,
+		Manifest:   manifest,
+	}); err != nil {
+		return err
+	}
+
+	_, _ = cmd.OutOrStdout().Write([]byte(fmt.Sprintf("Successfully generated ethereum genesis file %s\n", outputPath)))
+
+	return nil
+}
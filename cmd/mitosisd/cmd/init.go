@@ -43,6 +43,7 @@ const (
 	FlagEthGasLimit    = "eth-gas-limit"
 	FlagEthFundedAddr  = "eth-funded-address"
 	FlagEthInitBalance = "eth-initial-balance"
+	FlagEthManifest    = "eth-genesis-manifest"
 )
 
 type printInfo struct {
@@ -186,6 +187,7 @@ func InitCmd(mbm module.BasicManager, defaultNodeHome string) *cobra.Command {
 			ethGasLimit, _ := cmd.Flags().GetUint64(FlagEthGasLimit)
 			ethFundedAddr, _ := cmd.Flags().GetString(FlagEthFundedAddr)
 			ethInitBalance, _ := cmd.Flags().GetString(FlagEthInitBalance)
+			ethManifestFile, _ := cmd.Flags().GetString(FlagEthManifest)
 
 			opts := EthGenesisOptions{
 				ChainID:        chainID,
@@ -200,6 +202,14 @@ func InitCmd(mbm module.BasicManager, defaultNodeHome string) *cobra.Command {
 				opts.EthChainID = big.NewInt(ethChainIDFlag)
 			}
 
+			// Load the genesis manifest if provided
+			if ethManifestFile != "" {
+				opts.Manifest, err = LoadEthGenesisManifest(ethManifestFile)
+				if err != nil {
+					return errorsmod.Wrap(err, "Failed to load Ethereum genesis manifest")
+				}
+			}
+
 			if err = GenerateEthereumGenesisWithOptions(opts); err != nil {
 				return errorsmod.Wrap(err, "Failed to generate Ethereum genesis file")
 			}
@@ -208,6 +218,8 @@ func InitCmd(mbm module.BasicManager, defaultNodeHome string) *cobra.Command {
 			var ethChainID *big.Int
 			if opts.EthChainID != nil {
 				ethChainID = opts.EthChainID
+			} else if opts.Manifest != nil && opts.Manifest.ChainID != 0 {
+				ethChainID = new(big.Int).SetUint64(opts.Manifest.ChainID)
 			} else {
 				ethChainID = GetEthChainIDFromCosmosChainID(chainID)
 			}
@@ -230,7 +242,10 @@ func InitCmd(mbm module.BasicManager, defaultNodeHome string) *cobra.Command {
 	cmd.Flags().Int64(FlagEthChainID, 0, "ethereum chain ID (overrides default mapping)")
 	cmd.Flags().Uint64(FlagEthGasLimit, 0, "ethereum genesis gas limit (default: 30000000)")
 	cmd.Flags().String(FlagEthFundedAddr, "", "ethereum funded address (default: "+DefaultFundedAddress+")")
-	cmd.Flags().String(FlagEthInitBalance, "", "ethereum initial balance in wei (default: 999000000000000000000000000)")
+	cmd.Flags().String(FlagEthInitBalance, "", "ethereum initial balance in wei (default: "+DefaultFundedBalance+")")
+	cmd.Flags().String(FlagEthManifest, "", "YAML or JSON manifest of the ethereum genesis (accounts, predeployed contracts, fork schedule)")
+	cmd.MarkFlagsMutuallyExclusive(FlagEthManifest, FlagEthFundedAddr)
+	cmd.MarkFlagsMutuallyExclusive(FlagEthManifest, FlagEthInitBalance)
 
 	return cmd
 }
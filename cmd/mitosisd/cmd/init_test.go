@@ -140,7 +140,7 @@ func TestEthChainIDMapping(t *testing.T) {
 	}{
 		{"mitosis-localnet-1", 124899},
 		{"mitosis-devnet-1", 124864},
-		{"custom-chain", 100000},
+		{"custom-chain", 234059155238106},
 	}
 
 	for _, tt := range tests {
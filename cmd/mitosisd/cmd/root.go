@@ -1,7 +1,11 @@
 package cmd
 
 import (
+	"context"
+	"fmt"
+	"math/big"
 	"os"
+	"time"
 
 	mitotypes "github.com/mitosis-org/chain/types"
 
@@ -13,6 +17,7 @@ import (
 	pvm "github.com/cometbft/cometbft/privval"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
 	"github.com/cosmos/cosmos-sdk/version"
 	"github.com/cosmos/cosmos-sdk/x/auth/types"
@@ -27,6 +32,8 @@ import (
 	txmodule "github.com/cosmos/cosmos-sdk/x/auth/tx/config"
 
 	"github.com/mitosis-org/chain/app"
+	"github.com/mitosis-org/chain/app/failover"
+	"github.com/mitosis-org/chain/app/monitor"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/config"
@@ -37,6 +44,11 @@ import (
 
 const EnvPrefix = "MITO"
 
+const (
+	engineChainIDCheckTimeout       = time.Minute
+	engineChainIDCheckRetryInterval = 2 * time.Second
+)
+
 var runningCmd *cobra.Command
 
 func NewRootCmd() *cobra.Command {
@@ -169,24 +181,25 @@ func ProvideKeyring(clientCtx client.Context, addressCodec address.Codec) (clien
 }
 
 func newAddrProvider(rootCmd *cobra.Command, feeRecipient string) (app.ValidatorAddressProvider, error) {
+	serverCtx := server.GetServerContextFromCmd(rootCmd)
+	cfg := serverCtx.Config
+	privVal := pvm.LoadFilePV(cfg.PrivValidatorKeyFile(), cfg.PrivValidatorStateFile())
+	consAddr := sdk.ConsAddress(privVal.Key.PubKey.Address())
+
 	if feeRecipient != "" {
 		if !common.IsHexAddress(feeRecipient) {
 			return app.ValidatorAddressProvider{}, errors.New("invalid fee recipient address")
 		}
 
 		addr := common.HexToAddress(feeRecipient)
-		return app.ValidatorAddressProvider{Addr: addr}, nil
+		return app.ValidatorAddressProvider{Addr: addr, ConsAddr: consAddr}, nil
 	} else {
-		serverCtx := server.GetServerContextFromCmd(rootCmd)
-		cfg := serverCtx.Config
-		privVal := pvm.LoadFilePV(cfg.PrivValidatorKeyFile(), cfg.PrivValidatorStateFile())
-
 		addr, err := k1util.PubKeyToAddress(privVal.Key.PrivKey.PubKey())
 		if err != nil {
 			return app.ValidatorAddressProvider{}, err
 		}
 
-		return app.ValidatorAddressProvider{Addr: addr}, nil
+		return app.ValidatorAddressProvider{Addr: addr, ConsAddr: consAddr}, nil
 	}
 }
 
@@ -202,20 +215,152 @@ func newEngineClient(rootCmd *cobra.Command) (ethclient.EngineClient, error) {
 		return ethclient.NewEngineMock()
 	}
 
-	jwtSecret, err := ethclient.LoadJWTHexFile(conf.Engine.JWTFile)
+	engineClient, err := newAuthClient(rootCmd, conf.Engine.Endpoint, conf.Engine.JWTFile)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(conf.Engine.FallbackEndpoints) == 0 {
+		return engineClient, nil
+	}
+
+	jwtFiles := conf.Engine.FallbackJWTFiles
+	if len(jwtFiles) == 0 {
+		jwtFiles = make([]string, len(conf.Engine.FallbackEndpoints))
+		for i := range jwtFiles {
+			jwtFiles[i] = conf.Engine.JWTFile
+		}
+	} else if len(jwtFiles) != len(conf.Engine.FallbackEndpoints) {
+		return nil, errors.New("the number of fallback-jwt-files must be the same as fallback-endpoints")
+	}
+
+	engineClients := []ethclient.EngineClient{engineClient}
+	for i, endpoint := range conf.Engine.FallbackEndpoints {
+		fallbackClient, err := newAuthClient(rootCmd, endpoint, jwtFiles[i])
+		if err != nil {
+			return nil, err
+		}
+		engineClients = append(engineClients, fallbackClient)
+	}
+
+	failoverCfg, err := getFailoverConfig(conf.Engine)
 	if err != nil {
 		return nil, err
 	}
 
-	engineClient, err := ethclient.NewAuthClient(rootCmd.Context(), conf.Engine.Endpoint, jwtSecret)
+	failoverClient, err := failover.NewClient(failoverCfg, serverCtx.Logger.With("module", "engine-failover"), engineClients...)
 	if err != nil {
 		return nil, err
 	}
+	failoverClient.Start(rootCmd.Context())
 
-	return engineClient, nil
+	return failoverClient, nil
+}
+
+func newAuthClient(rootCmd *cobra.Command, endpoint string, jwtFile string) (ethclient.EngineClient, error) {
+	jwtSecret, err := ethclient.LoadJWTHexFile(jwtFile)
+	if err != nil {
+		return nil, err
+	}
+
+	return ethclient.NewAuthClient(rootCmd.Context(), endpoint, jwtSecret)
+}
+
+// verifyEngineChainID checks that all the execution client endpoints run the chain of the expected EVM chain ID,
+// not to sign and execute blocks of another chain. It waits for the endpoints up to engineChainIDCheckTimeout.
+func verifyEngineChainID(ctx context.Context, logger log.Logger, engineCl ethclient.EngineClient, expected *big.Int) error {
+	clients := []ethclient.EngineClient{engineCl}
+	if failoverCl, ok := engineCl.(*failover.Client); ok {
+		clients = failoverCl.Endpoints()
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, engineChainIDCheckTimeout)
+	defer cancel()
+
+	for i, cl := range clients {
+		var chainID *big.Int
+		for {
+			var err error
+			chainID, err = cl.ChainID(ctx)
+			if err == nil {
+				break
+			}
+
+			logger.Warn("Failed to fetch chain ID from execution client, retrying", "endpoint", i, "err", err)
+			select {
+			case <-ctx.Done():
+				return errors.Wrap(err, fmt.Sprintf("fetch chain ID from engine endpoint %d", i))
+			case <-time.After(engineChainIDCheckRetryInterval):
+			}
+		}
+
+		if chainID.Cmp(expected) != 0 {
+			return errors.New(fmt.Sprintf(
+				"chain ID mismatch of engine endpoint %d: expected %s, got %s (set [engine] eth-chain-id if the EVM chain ID was customized)",
+				i, expected, chainID,
+			))
+		}
+	}
+
+	return nil
+}
+
+func getFailoverConfig(config *EngineConfig) (failover.Config, error) {
+	interval, err := time.ParseDuration(config.HealthCheckInterval)
+	if err != nil {
+		return failover.Config{}, errors.Wrap(err, "invalid engine health check interval")
+	}
+
+	return failover.Config{
+		ProbeInterval: interval,
+		MaxHeadLag:    config.MaxHeadLag,
+		MaxErrorRate:  config.MaxErrorRate,
+	}, nil
+}
+
+func getMonitorConfig(config *MonitorConfig) (monitor.Config, error) {
+	alertInterval, err := time.ParseDuration(config.AlertInterval)
+	if err != nil {
+		return monitor.Config{}, errors.Wrap(err, "invalid monitor alert interval")
+	}
+
+	return monitor.Config{
+		WebhookURL:        config.WebhookURL,
+		ConsecutiveMissed: config.ConsecutiveMissedBlocks,
+		MissedBlocksRatio: config.MissedBlocksRatio,
+		VotingPowerMargin: config.VotingPowerMargin,
+		AlertInterval:     alertInterval,
+	}, nil
+}
+
+// newValidatorMonitor returns a monitor of the validator of priv_validator_key.json, which is started with the command.
+func newValidatorMonitor(rootCmd *cobra.Command, config *MonitorConfig, mitosisApp *app.MitosisApp, logger log.Logger) (*monitor.Monitor, error) {
+	monitorCfg, err := getMonitorConfig(config)
+	if err != nil {
+		return nil, err
+	}
+
+	cfg := server.GetServerContextFromCmd(rootCmd).Config
+	privVal := pvm.LoadFilePV(cfg.PrivValidatorKeyFile(), cfg.PrivValidatorStateFile())
+	consAddr := sdk.ConsAddress(privVal.Key.PubKey.Address())
+
+	validatorMonitor, err := monitor.New(monitorCfg, logger, consAddr, mitosisApp.EVMValKeeper, mitosisApp.SlashingKeeper)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid monitor config")
+	}
+	validatorMonitor.Start(rootCmd.Context())
+
+	logger.Info("Validator monitor enabled", "validator", consAddr.String(), "webhook", config.WebhookURL != "")
+
+	return validatorMonitor, nil
 }
 
 func getGovEntrypointContractAddr(config *EVMGovConfig) (mitotypes.EthAddress, error) {
+	// The deprecated option can be removed from app.toml once the address is set in the state.
+	if config.Entrypoint == "" {
+		return mitotypes.EthAddress{}, nil
+	}
+
 	if !common.IsHexAddress(config.Entrypoint) {
 		return mitotypes.EthAddress{}, errors.New("invalid governance entrypoint address")
 	}
@@ -224,6 +369,24 @@ func getGovEntrypointContractAddr(config *EVMGovConfig) (mitotypes.EthAddress, e
 	return mitotypes.EthAddress(addr), nil
 }
 
+func getProcessProposalConfig(config *ProcessProposalConfig) (app.ProcessProposalConfig, error) {
+	timeout, err := time.ParseDuration(config.Timeout)
+	if err != nil {
+		return app.ProcessProposalConfig{}, errors.Wrap(err, "invalid process proposal timeout")
+	}
+	if timeout <= 0 {
+		return app.ProcessProposalConfig{}, errors.New("process proposal timeout must be positive")
+	}
+	if config.RejectedHistorySize < 0 {
+		return app.ProcessProposalConfig{}, errors.New("rejected history size must not be negative")
+	}
+
+	return app.ProcessProposalConfig{
+		Timeout:             timeout,
+		RejectedHistorySize: config.RejectedHistorySize,
+	}, nil
+}
+
 func getAppConfig(rootCmd *cobra.Command) (AppConfig, error) {
 	serverCtx := server.GetServerContextFromCmd(rootCmd)
 
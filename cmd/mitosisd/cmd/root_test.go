@@ -0,0 +1,59 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+	"time"
+
+	"cosmossdk.io/log"
+	"github.com/omni-network/omni/lib/ethclient"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mitosis-org/chain/app/failover"
+)
+
+type chainIDClient struct {
+	ethclient.EngineClient
+	chainID  *big.Int
+	failures int
+}
+
+func (c *chainIDClient) ChainID(context.Context) (*big.Int, error) {
+	if c.failures > 0 {
+		c.failures--
+		return nil, errors.New("connection refused")
+	}
+
+	return c.chainID, nil
+}
+
+func (c *chainIDClient) Address() string {
+	return "http://127.0.0.1:8551"
+}
+
+func TestVerifyEngineChainID(t *testing.T) {
+	ctx := context.Background()
+	logger := log.NewNopLogger()
+	expected := big.NewInt(124864)
+
+	// Matching chain ID
+	require.NoError(t, verifyEngineChainID(ctx, logger, &chainIDClient{chainID: big.NewInt(124864)}, expected))
+
+	// Mismatching chain ID
+	err := verifyEngineChainID(ctx, logger, &chainIDClient{chainID: big.NewInt(124899)}, expected)
+	require.ErrorContains(t, err, "chain ID mismatch")
+
+	// An unreachable endpoint is retried
+	require.NoError(t, verifyEngineChainID(ctx, logger, &chainIDClient{chainID: big.NewInt(124864), failures: 1}, expected))
+
+	// All the failover endpoints are checked
+	failoverCl, err := failover.NewClient(failover.Config{ProbeInterval: time.Second, MaxHeadLag: 1, MaxErrorRate: 0.5}, logger,
+		&chainIDClient{chainID: big.NewInt(124864)},
+		&chainIDClient{chainID: big.NewInt(124899)},
+	)
+	require.NoError(t, err)
+	err = verifyEngineChainID(ctx, logger, failoverCl, expected)
+	require.ErrorContains(t, err, "chain ID mismatch of engine endpoint 1")
+}
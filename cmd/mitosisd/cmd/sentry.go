@@ -2,6 +2,7 @@ package cmd
 
 import (
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/omni-network/omni/lib/errors"
@@ -12,12 +13,118 @@ import (
 
 var _ log.Logger = (*SentryLogger)(nil)
 
+// Keys of the log key-values which are sent as the tags of Sentry events
+const (
+	sentryKeyModule = "module"
+	sentryKeyHeight = "height"
+	sentryKeyName   = "name"
+)
+
+// sentryLevelNone disables the events of a module
+const sentryLevelNone sentry.Level = "none"
+
+// sentryLevelRanks is the order of the levels to compare them with the thresholds
+var sentryLevelRanks = map[sentry.Level]int{
+	sentry.LevelDebug:   0,
+	sentry.LevelInfo:    1,
+	sentry.LevelWarning: 2,
+	sentry.LevelError:   3,
+	sentryLevelNone:     4,
+}
+
+// SentryThresholds are the minimum levels of the log messages sent to Sentry
+type SentryThresholds struct {
+	Default sentry.Level
+	// Modules overrides Default for the modules, which are the "module" key-value of the logger
+	Modules map[string]sentry.Level
+}
+
+// ParseSentryThresholds parses the default level and the comma-separated <module>:<level> pairs.
+// The levels are debug, info, warn, error or none.
+func ParseSentryThresholds(level string, moduleLevels string) (SentryThresholds, error) {
+	defaultLevel, err := parseSentryLevel(level)
+	if err != nil {
+		return SentryThresholds{}, err
+	}
+
+	thresholds := SentryThresholds{Default: defaultLevel, Modules: map[string]sentry.Level{}}
+	for _, pair := range strings.Split(moduleLevels, ",") {
+		pair = strings.TrimSpace(pair)
+		if pair == "" {
+			continue
+		}
+
+		module, level, ok := strings.Cut(pair, ":")
+		module = strings.TrimSpace(module)
+		if !ok || module == "" {
+			return SentryThresholds{}, errors.New(fmt.Sprintf("invalid module level %q: must be <module>:<level>", pair))
+		}
+		if thresholds.Modules[module], err = parseSentryLevel(level); err != nil {
+			return SentryThresholds{}, errors.Wrap(err, fmt.Sprintf("invalid module level of %s", module))
+		}
+	}
+
+	return thresholds, nil
+}
+
+func parseSentryLevel(level string) (sentry.Level, error) {
+	switch strings.ToLower(strings.TrimSpace(level)) {
+	case "debug":
+		return sentry.LevelDebug, nil
+	case "info":
+		return sentry.LevelInfo, nil
+	case "warn", "warning":
+		return sentry.LevelWarning, nil
+	case "error":
+		return sentry.LevelError, nil
+	case "none":
+		return sentryLevelNone, nil
+	default:
+		return "", errors.New(fmt.Sprintf("invalid sentry level %q: must be debug, info, warn, error or none", level))
+	}
+}
+
+// enabled returns whether a log message of the level of the module is sent to Sentry
+func (t SentryThresholds) enabled(module string, level sentry.Level) bool {
+	threshold, ok := t.Modules[module]
+	if !ok {
+		threshold = t.Default
+	}
+
+	return threshold != sentryLevelNone && sentryLevelRanks[level] >= sentryLevelRanks[threshold]
+}
+
+// lowest returns the lowest threshold among the modules, below which nothing is sent to Sentry
+func (t SentryThresholds) lowest() sentry.Level {
+	lowest := t.Default
+	for _, level := range t.Modules {
+		if sentryLevelRanks[level] < sentryLevelRanks[lowest] {
+			lowest = level
+		}
+	}
+
+	return lowest
+}
+
+// SentryLogger is a logger which also sends the log messages to Sentry as structured events.
+// The events are tagged with the module, height and event name of the key-values, and fingerprinted
+// by the module, message and event name so that the same errors are grouped regardless of the other key-values.
 type SentryLogger struct {
-	logger log.Logger
+	logger     log.Logger
+	hub        *sentry.Hub
+	thresholds SentryThresholds
+	lowest     int
+	// keyVals are the key-values of With to be included in the events
+	keyVals []any
 }
 
-func NewSentryLogger(logger log.Logger) log.Logger {
-	return SentryLogger{logger}
+func NewSentryLogger(logger log.Logger, hub *sentry.Hub, thresholds SentryThresholds) log.Logger {
+	return SentryLogger{
+		logger:     logger,
+		hub:        hub,
+		thresholds: thresholds,
+		lowest:     sentryLevelRanks[thresholds.lowest()],
+	}
 }
 
 func InitSentry(config *SentryConfig, logger log.Logger) (log.Logger, error) {
@@ -25,63 +132,103 @@ func InitSentry(config *SentryConfig, logger log.Logger) (log.Logger, error) {
 		return logger, nil
 	}
 
+	return initSentryWithTransport(config, logger, nil)
+}
+
+// initSentryWithTransport initializes Sentry with the transport. If it is nil, the default HTTP transport is used.
+func initSentryWithTransport(config *SentryConfig, logger log.Logger, transport sentry.Transport) (log.Logger, error) {
+	if config.SampleRate <= 0 || config.SampleRate > 1 {
+		return nil, errors.New(fmt.Sprintf("invalid sentry sample-rate %v: must be in (0, 1]", config.SampleRate))
+	}
+
+	thresholds, err := ParseSentryThresholds(config.Level, config.ModuleLevels)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid sentry level")
+	}
+
 	environment := config.Environment
 	if environment == "" {
 		environment = "localnet"
 	}
-	err := sentry.Init(sentry.ClientOptions{
+	client, err := sentry.NewClient(sentry.ClientOptions{
 		Dsn:         config.DSN,
 		Environment: environment,
+		SampleRate:  config.SampleRate,
+		Transport:   transport,
 	})
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to initialize sentry")
 	}
 
-	return NewSentryLogger(logger), nil
+	return NewSentryLogger(logger, sentry.NewHub(client, sentry.NewScope()), thresholds), nil
 }
 
 func (l SentryLogger) HandleSentryMessage(level sentry.Level, msg string, keyVals ...any) {
-	hub := sentry.CurrentHub().Clone()
+	if sentryLevelRanks[level] < l.lowest {
+		return
+	}
+
+	keyVals = append(append([]any{}, l.keyVals...), keyVals...)
+
 	evt := sentry.NewEvent()
+	evt.Message = msg
+	evt.Timestamp = time.Now()
+	evt.Level = level
 
-	tags := map[string]string{}
+	var module, name string
 	for i := 1; i < len(keyVals); i += 2 {
 		key := fmt.Sprintf("%v", keyVals[i-1])
 		val := fmt.Sprintf("%v", keyVals[i])
-		tags[key] = val
+
+		switch key {
+		case sentryKeyModule:
+			module = val
+			evt.Tags["module"] = val
+		case sentryKeyHeight:
+			evt.Tags["height"] = val
+		case sentryKeyName:
+			name = val
+			evt.Tags["event"] = val
+		}
+		evt.Extra[key] = val
 	}
 
-	evt.Message = msg
-	evt.Timestamp = time.Now()
-	evt.Tags = tags
-	evt.Level = level
+	if !l.thresholds.enabled(module, level) {
+		return
+	}
+
+	evt.Fingerprint = []string{module, msg}
+	if name != "" {
+		evt.Fingerprint = append(evt.Fingerprint, name)
+	}
 
-	hub.CaptureEvent(evt)
+	l.hub.CaptureEvent(evt)
 }
 
 func (l SentryLogger) Info(msg string, keyVals ...any) {
 	l.logger.Info(msg, keyVals...)
-	// l.HandleSentryMessage(sentry.LevelInfo, msg, keyVals)
+	l.HandleSentryMessage(sentry.LevelInfo, msg, keyVals...)
 }
 
 func (l SentryLogger) Warn(msg string, keyVals ...any) {
 	l.logger.Warn(msg, keyVals...)
-	l.HandleSentryMessage(sentry.LevelWarning, msg, keyVals)
+	l.HandleSentryMessage(sentry.LevelWarning, msg, keyVals...)
 }
 
 func (l SentryLogger) Error(msg string, keyVals ...any) {
 	l.logger.Error(msg, keyVals...)
-	l.HandleSentryMessage(sentry.LevelError, msg, keyVals)
+	l.HandleSentryMessage(sentry.LevelError, msg, keyVals...)
 }
 
 func (l SentryLogger) Debug(msg string, keyVals ...any) {
 	l.logger.Debug(msg, keyVals...)
-	// l.HandleSentryMessage(sentry.LevelDebug, msg, keyVals)
+	l.HandleSentryMessage(sentry.LevelDebug, msg, keyVals...)
 }
 
 func (l SentryLogger) With(keyVals ...any) log.Logger {
-	logger := l.logger.With(keyVals...)
-	return SentryLogger{logger}
+	l.logger = l.logger.With(keyVals...)
+	l.keyVals = append(append([]any{}, l.keyVals...), keyVals...)
+	return l
 }
 
 func (l SentryLogger) Impl() any {
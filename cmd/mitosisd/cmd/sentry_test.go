@@ -0,0 +1,162 @@
+package cmd
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"cosmossdk.io/log"
+	"github.com/getsentry/sentry-go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// stubTransport records the events instead of sending them to Sentry
+type stubTransport struct {
+	mu     sync.Mutex
+	events []*sentry.Event
+}
+
+func (t *stubTransport) Flush(time.Duration) bool { return true }
+
+func (t *stubTransport) Configure(sentry.ClientOptions) {}
+
+func (t *stubTransport) SendEvent(event *sentry.Event) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.events = append(t.events, event)
+}
+
+func (t *stubTransport) Events() []*sentry.Event {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	return append([]*sentry.Event{}, t.events...)
+}
+
+func newTestSentryLogger(t *testing.T, config SentryConfig) (log.Logger, *stubTransport) {
+	t.Helper()
+
+	config.DSN = "https://public@sentry.example.com/1"
+	transport := &stubTransport{}
+	logger, err := initSentryWithTransport(&config, log.NewNopLogger(), transport)
+	require.NoError(t, err)
+
+	return logger, transport
+}
+
+func TestSentryLogger_StructuredEvent(t *testing.T) {
+	logger, transport := newTestSentryLogger(t, SentryConfig{SampleRate: 1, Level: "warn"})
+
+	moduleLogger := logger.With("module", "x/evmvalidator")
+	for height := int64(10); height < 13; height++ {
+		moduleLogger.Error("Processing event failed but ignored",
+			"name", "RegisterValidator",
+			"height", height,
+			"evmBlockHash", "0xabc",
+			"err", "insufficient collateral",
+		)
+	}
+
+	events := transport.Events()
+	require.Len(t, events, 3)
+	for i, evt := range events {
+		assert.Equal(t, sentry.LevelError, evt.Level)
+		assert.Equal(t, "Processing event failed but ignored", evt.Message)
+		assert.Equal(t, map[string]string{
+			"module": "x/evmvalidator",
+			"height": []string{"10", "11", "12"}[i],
+			"event":  "RegisterValidator",
+		}, evt.Tags)
+		assert.Equal(t, "insufficient collateral", evt.Extra["err"])
+		assert.Equal(t, "0xabc", evt.Extra["evmBlockHash"])
+
+		// The events are grouped regardless of the height
+		assert.Equal(t, []string{"x/evmvalidator", "Processing event failed but ignored", "RegisterValidator"}, evt.Fingerprint)
+	}
+
+	// The key-values of With are not shared with the parent logger
+	logger.Error("failed", "err", "boom")
+	events = transport.Events()
+	require.Len(t, events, 4)
+	assert.Empty(t, events[3].Tags)
+	assert.Equal(t, []string{"", "failed"}, events[3].Fingerprint)
+}
+
+func TestSentryLogger_Thresholds(t *testing.T) {
+	logger, transport := newTestSentryLogger(t, SentryConfig{
+		SampleRate:   1,
+		Level:        "warn",
+		ModuleLevels: "x/evmgov:error, engine-failover:none, abci-wrapper:debug",
+	})
+
+	logger.Info("info of no module")
+	logger.Warn("warn of no module")
+	logger.With("module", "x/evmgov").Warn("warn of evmgov")
+	logger.With("module", "x/evmgov").Error("error of evmgov")
+	logger.With("module", "engine-failover").Error("error of failover")
+	logger.With("module", "abci-wrapper").Debug("debug of abci-wrapper")
+
+	var messages []string
+	for _, evt := range transport.Events() {
+		messages = append(messages, evt.Message)
+	}
+	assert.Equal(t, []string{"warn of no module", "error of evmgov", "debug of abci-wrapper"}, messages)
+}
+
+func TestSentryLogger_Sampling(t *testing.T) {
+	logger, transport := newTestSentryLogger(t, SentryConfig{SampleRate: 0.2, Level: "error"})
+
+	for i := 0; i < 1000; i++ {
+		logger.Error("failed")
+	}
+
+	// 200 events are expected to be sent
+	numEvents := len(transport.Events())
+	assert.Greater(t, numEvents, 100)
+	assert.Less(t, numEvents, 300)
+}
+
+func TestInitSentry_Invalid(t *testing.T) {
+	tests := []struct {
+		name        string
+		config      SentryConfig
+		expectedErr string
+	}{
+		{
+			name:        "zero sample rate",
+			config:      SentryConfig{SampleRate: 0, Level: "error"},
+			expectedErr: "invalid sentry sample-rate",
+		},
+		{
+			name:        "too high sample rate",
+			config:      SentryConfig{SampleRate: 1.5, Level: "error"},
+			expectedErr: "invalid sentry sample-rate",
+		},
+		{
+			name:        "invalid level",
+			config:      SentryConfig{SampleRate: 1, Level: "fatal"},
+			expectedErr: "invalid sentry level",
+		},
+		{
+			name:        "invalid module level",
+			config:      SentryConfig{SampleRate: 1, Level: "error", ModuleLevels: "x/evmgov"},
+			expectedErr: "must be <module>:<level>",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.config.DSN = "https://public@sentry.example.com/1"
+			_, err := InitSentry(&tt.config, log.NewNopLogger())
+			require.ErrorContains(t, err, tt.expectedErr)
+		})
+	}
+
+	// Sentry is disabled without a DSN
+	logger := log.NewNopLogger()
+	sentryLogger, err := InitSentry(&SentryConfig{}, logger)
+	require.NoError(t, err)
+	assert.Equal(t, logger, sentryLogger)
+}
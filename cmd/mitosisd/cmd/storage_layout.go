@@ -0,0 +1,309 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/common/math"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// StorageLayout is the storage layout of a contract emitted by solc, e.g. by `forge inspect <contract> storageLayout --json`
+type StorageLayout struct {
+	Storage []StorageVariable      `json:"storage"`
+	Types   map[string]StorageType `json:"types"`
+}
+
+// StorageVariable is a state variable or a struct member in the storage layout
+type StorageVariable struct {
+	Label  string `json:"label"`
+	Offset int    `json:"offset"`
+	Slot   string `json:"slot"`
+	Type   string `json:"type"`
+}
+
+// StorageType is a type in the storage layout
+type StorageType struct {
+	Encoding      string            `json:"encoding"`
+	Label         string            `json:"label"`
+	NumberOfBytes string            `json:"numberOfBytes"`
+	Key           string            `json:"key,omitempty"`
+	Value         string            `json:"value,omitempty"`
+	Members       []StorageVariable `json:"members,omitempty"`
+}
+
+// StorageLocation is the location of a value type variable in the storage
+type StorageLocation struct {
+	Slot   common.Hash
+	Offset int
+	Type   StorageType
+}
+
+// readStorageLayout reads a storage layout file. It also accepts a Foundry artifact
+// compiled with `extra_output = ["storageLayout"]`, which contains the layout in `storageLayout`.
+func readStorageLayout(layoutFile string) (*StorageLayout, error) {
+	if err := validateArtifactFile(layoutFile); err != nil {
+		return nil, fmt.Errorf("invalid storage layout file: %w", err)
+	}
+
+	data, err := os.ReadFile(filepath.Clean(layoutFile))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read storage layout file: %w", err)
+	}
+
+	var artifact struct {
+		StorageLayout *StorageLayout `json:"storageLayout"`
+	}
+	if err := json.Unmarshal(data, &artifact); err != nil {
+		return nil, fmt.Errorf("failed to parse storage layout JSON: %w", err)
+	}
+	if artifact.StorageLayout != nil {
+		return artifact.StorageLayout, nil
+	}
+
+	var layout StorageLayout
+	if err := json.Unmarshal(data, &layout); err != nil {
+		return nil, fmt.Errorf("failed to parse storage layout JSON: %w", err)
+	}
+	if layout.Types == nil {
+		return nil, fmt.Errorf("no storage layout found in %s", layoutFile)
+	}
+
+	return &layout, nil
+}
+
+// Variable returns the state variable of the label, if any
+func (l *StorageLayout) Variable(label string) (StorageVariable, bool) {
+	for _, v := range l.Storage {
+		if v.Label == label {
+			return v, true
+		}
+	}
+
+	return StorageVariable{}, false
+}
+
+// Locate returns the storage location of a value type variable referred by the path.
+// The path is a state variable label followed by mapping keys and struct members,
+// e.g. `_owner`, `_permittedCallers[0x12...]` or `_config.epochInterval`.
+func (l *StorageLayout) Locate(path string) (StorageLocation, error) {
+	label, rest := splitStoragePath(path)
+	variable, ok := l.Variable(label)
+	if !ok {
+		return StorageLocation{}, fmt.Errorf("variable %s not found in the storage layout", label)
+	}
+
+	slot, err := parseStorageSlot(variable.Slot)
+	if err != nil {
+		return StorageLocation{}, fmt.Errorf("invalid slot of %s: %w", label, err)
+	}
+	offset := variable.Offset
+	typeID := variable.Type
+
+	for rest != "" {
+		typ, ok := l.Types[typeID]
+		if !ok {
+			return StorageLocation{}, fmt.Errorf("type %s not found in the storage layout", typeID)
+		}
+
+		switch {
+		case strings.HasPrefix(rest, "["):
+			end := strings.Index(rest, "]")
+			if end < 0 {
+				return StorageLocation{}, fmt.Errorf("unclosed bracket in %s", path)
+			}
+			if typ.Encoding != "mapping" {
+				return StorageLocation{}, fmt.Errorf("%s is not a mapping", typ.Label)
+			}
+
+			keyType, ok := l.Types[typ.Key]
+			if !ok {
+				return StorageLocation{}, fmt.Errorf("type %s not found in the storage layout", typ.Key)
+			}
+			key, err := encodeMappingKey(keyType, rest[1:end])
+			if err != nil {
+				return StorageLocation{}, fmt.Errorf("invalid key of %s: %w", typ.Label, err)
+			}
+
+			slot = crypto.Keccak256Hash(key, slot.Bytes())
+			offset = 0
+			typeID = typ.Value
+			rest = rest[end+1:]
+		case strings.HasPrefix(rest, "."):
+			if typ.Encoding != "inplace" || typ.Members == nil {
+				return StorageLocation{}, fmt.Errorf("%s is not a struct", typ.Label)
+			}
+
+			var name string
+			name, rest = splitStoragePath(rest[1:])
+			member, found := StorageVariable{}, false
+			for _, m := range typ.Members {
+				if m.Label == name {
+					member, found = m, true
+					break
+				}
+			}
+			if !found {
+				return StorageLocation{}, fmt.Errorf("member %s not found in %s", name, typ.Label)
+			}
+
+			memberSlot, err := parseStorageSlot(member.Slot)
+			if err != nil {
+				return StorageLocation{}, fmt.Errorf("invalid slot of %s: %w", name, err)
+			}
+
+			slot = common.BigToHash(new(big.Int).Add(slot.Big(), memberSlot.Big()))
+			offset = member.Offset
+			typeID = member.Type
+		default:
+			return StorageLocation{}, fmt.Errorf("invalid storage path %s", path)
+		}
+	}
+
+	typ, ok := l.Types[typeID]
+	if !ok {
+		return StorageLocation{}, fmt.Errorf("type %s not found in the storage layout", typeID)
+	}
+	if typ.Encoding != "inplace" || typ.Members != nil {
+		return StorageLocation{}, fmt.Errorf("%s of %s is not a value type", typ.Label, path)
+	}
+
+	return StorageLocation{Slot: slot, Offset: offset, Type: typ}, nil
+}
+
+// Set encodes the value of the variable referred by the path into the storage.
+// Variables packed in the same slot are preserved.
+func (l *StorageLayout) Set(storage map[string]string, path string, value string) error {
+	loc, err := l.Locate(path)
+	if err != nil {
+		return err
+	}
+
+	encoded, err := encodeStorageValue(loc.Type, value)
+	if err != nil {
+		return fmt.Errorf("invalid value of %s: %w", path, err)
+	}
+
+	setStorageBytes(storage, loc.Slot, loc.Offset, encoded)
+
+	return nil
+}
+
+// setStorageBytes writes the value at the offset (from the lowest-order byte) of the slot, as solc packs variables.
+func setStorageBytes(storage map[string]string, slot common.Hash, offset int, value []byte) {
+	word := common.HexToHash(storage[slot.Hex()])
+	copy(word[common.HashLength-offset-len(value):common.HashLength-offset], value)
+	storage[slot.Hex()] = word.Hex()
+}
+
+// splitStoragePath splits the leading identifier from the rest of the path
+func splitStoragePath(path string) (string, string) {
+	if i := strings.IndexAny(path, "[."); i >= 0 {
+		return path[:i], path[i:]
+	}
+
+	return path, ""
+}
+
+func parseStorageSlot(slot string) (common.Hash, error) {
+	value, ok := math.ParseBig256(slot)
+	if !ok {
+		return common.Hash{}, fmt.Errorf("invalid slot %s", slot)
+	}
+
+	return common.BigToHash(value), nil
+}
+
+// encodeMappingKey encodes the key as solc does to compute the slot of the mapping value
+func encodeMappingKey(typ StorageType, key string) ([]byte, error) {
+	switch {
+	case typ.Label == "string":
+		return []byte(key), nil
+	case typ.Label == "bytes":
+		return hexutil.Decode(key)
+	case strings.HasPrefix(typ.Label, "bytes"):
+		// Fixed-size bytes are left-aligned
+		value, err := encodeStorageValue(typ, key)
+		if err != nil {
+			return nil, err
+		}
+		return common.RightPadBytes(value, common.HashLength), nil
+	default:
+		value, err := encodeStorageValue(typ, key)
+		if err != nil {
+			return nil, err
+		}
+		return common.LeftPadBytes(value, common.HashLength), nil
+	}
+}
+
+// encodeStorageValue encodes the value of the value type in its size
+func encodeStorageValue(typ StorageType, value string) ([]byte, error) {
+	size, err := strconv.Atoi(typ.NumberOfBytes)
+	if err != nil || size <= 0 || size > common.HashLength {
+		return nil, fmt.Errorf("invalid size of %s: %s", typ.Label, typ.NumberOfBytes)
+	}
+
+	switch {
+	case typ.Label == "address" || typ.Label == "address payable" || strings.HasPrefix(typ.Label, "contract "):
+		if !common.IsHexAddress(value) {
+			return nil, fmt.Errorf("invalid address: %s", value)
+		}
+		return common.HexToAddress(value).Bytes(), nil
+	case typ.Label == "bool":
+		b, err := strconv.ParseBool(value)
+		if err != nil {
+			return nil, fmt.Errorf("invalid bool: %s", value)
+		}
+		if b {
+			return []byte{1}, nil
+		}
+		return []byte{0}, nil
+	case strings.HasPrefix(typ.Label, "uint") || strings.HasPrefix(typ.Label, "enum "):
+		n, ok := math.ParseBig256(value)
+		if !ok || n.BitLen() > 8*size {
+			return nil, fmt.Errorf("invalid %s: %s", typ.Label, value)
+		}
+		return math.PaddedBigBytes(n, size), nil
+	case strings.HasPrefix(typ.Label, "int"):
+		n, ok := new(big.Int).SetString(value, 0)
+		limit := new(big.Int).Lsh(big.NewInt(1), uint(8*size-1))
+		if !ok || n.Cmp(limit) >= 0 || n.Cmp(new(big.Int).Neg(limit)) < 0 {
+			return nil, fmt.Errorf("invalid %s: %s", typ.Label, value)
+		}
+		if n.Sign() < 0 {
+			n.Add(n, new(big.Int).Lsh(big.NewInt(1), uint(8*size)))
+		}
+		return math.PaddedBigBytes(n, size), nil
+	case strings.HasPrefix(typ.Label, "bytes"):
+		bz, err := hexutil.Decode(value)
+		if err != nil || len(bz) > size {
+			return nil, fmt.Errorf("invalid %s: %s", typ.Label, value)
+		}
+		return common.RightPadBytes(bz, size), nil
+	default:
+		return nil, fmt.Errorf("unsupported type %s", typ.Label)
+	}
+}
+
+// erc7201Slot returns the base slot of the namespaced storage defined by ERC-7201:
+// keccak256(abi.encode(uint256(keccak256(namespace)) - 1)) & ~bytes32(uint256(0xff))
+func erc7201Slot(namespace string) common.Hash {
+	id := new(big.Int).Sub(crypto.Keccak256Hash([]byte(namespace)).Big(), big.NewInt(1))
+	slot := crypto.Keccak256Hash(common.BigToHash(id).Bytes())
+	slot[common.HashLength-1] = 0
+
+	return slot
+}
+
+// erc1967Slot returns the slot defined by ERC-1967: bytes32(uint256(keccak256(name)) - 1)
+func erc1967Slot(name string) common.Hash {
+	return common.BigToHash(new(big.Int).Sub(crypto.Keccak256Hash([]byte(name)).Big(), big.NewInt(1)))
+}
@@ -0,0 +1,106 @@
+package cmd
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testStorageLayoutJSON = `{
+  "storage": [
+    {"label": "_owner", "offset": 0, "slot": "0", "type": "t_address"},
+    {"label": "_paused", "offset": 20, "slot": "0", "type": "t_bool"},
+    {"label": "_permittedCallers", "offset": 0, "slot": "1", "type": "t_mapping(t_address,t_bool)"},
+    {"label": "_config", "offset": 0, "slot": "2", "type": "t_struct(Config)1_storage"}
+  ],
+  "types": {
+    "t_address": {"encoding": "inplace", "label": "address", "numberOfBytes": "20"},
+    "t_bool": {"encoding": "inplace", "label": "bool", "numberOfBytes": "1"},
+    "t_uint64": {"encoding": "inplace", "label": "uint64", "numberOfBytes": "8"},
+    "t_int128": {"encoding": "inplace", "label": "int128", "numberOfBytes": "16"},
+    "t_mapping(t_address,t_bool)": {"encoding": "mapping", "key": "t_address", "label": "mapping(address => bool)", "numberOfBytes": "32", "value": "t_bool"},
+    "t_struct(Config)1_storage": {
+      "encoding": "inplace", "label": "struct Config", "numberOfBytes": "64",
+      "members": [
+        {"label": "epoch", "offset": 0, "slot": "0", "type": "t_uint64"},
+        {"label": "minimum", "offset": 8, "slot": "0", "type": "t_int128"},
+        {"label": "admin", "offset": 0, "slot": "1", "type": "t_address"}
+      ]
+    }
+  }
+}`
+
+func testStorageLayout(t *testing.T) *StorageLayout {
+	t.Helper()
+
+	var layout StorageLayout
+	require.NoError(t, json.Unmarshal([]byte(testStorageLayoutJSON), &layout))
+
+	return &layout
+}
+
+func TestStorageLayout_Set(t *testing.T) {
+	layout := testStorageLayout(t)
+	storage := make(map[string]string)
+
+	caller := common.HexToAddress("0x1111111111111111111111111111111111111111")
+
+	require.NoError(t, layout.Set(storage, "_owner", "0x2222222222222222222222222222222222222222"))
+	require.NoError(t, layout.Set(storage, "_paused", "true"))
+	require.NoError(t, layout.Set(storage, "_permittedCallers["+caller.Hex()+"]", "true"))
+	require.NoError(t, layout.Set(storage, "_config.epoch", "100"))
+	require.NoError(t, layout.Set(storage, "_config.minimum", "-1"))
+	require.NoError(t, layout.Set(storage, "_config.admin", "0x3333333333333333333333333333333333333333"))
+
+	// Variables packed in the same slot
+	assert.Equal(t, "0x0000000000000000000000012222222222222222222222222222222222222222", storage[common.BigToHash(common.Big0).Hex()])
+
+	// Mapping value at keccak256(key . slot)
+	mappingSlot := crypto.Keccak256Hash(common.LeftPadBytes(caller.Bytes(), 32), common.BigToHash(common.Big1).Bytes())
+	assert.Equal(t, "0x0000000000000000000000000000000000000000000000000000000000000001", storage[mappingSlot.Hex()])
+
+	// Struct members
+	assert.Equal(t, "0x"+"0000000000000000"+"ffffffffffffffffffffffffffffffff"+"0000000000000064", storage[common.BigToHash(common.Big2).Hex()])
+	assert.Equal(t, "0x0000000000000000000000003333333333333333333333333333333333333333", storage[common.BigToHash(common.Big3).Hex()])
+	assert.Len(t, storage, 4)
+}
+
+func TestStorageLayout_SetInvalid(t *testing.T) {
+	layout := testStorageLayout(t)
+
+	tests := []struct {
+		name        string
+		path        string
+		value       string
+		expectedErr string
+	}{
+		{"unknown variable", "_unknown", "1", "variable _unknown not found"},
+		{"invalid address", "_owner", "0x1234", "invalid address"},
+		{"invalid bool", "_paused", "yes", "invalid bool"},
+		{"overflow", "_config.epoch", "18446744073709551616", "invalid uint64"},
+		{"unknown member", "_config.unknown", "1", "member unknown not found"},
+		{"not a mapping", "_owner[0x1111111111111111111111111111111111111111]", "1", "is not a mapping"},
+		{"not a value type", "_config", "1", "is not a value type"},
+		{"invalid mapping key", "_permittedCallers[0x12]", "true", "invalid key"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := layout.Set(make(map[string]string), tt.path, tt.value)
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.expectedErr)
+		})
+	}
+}
+
+func TestStorageSlots(t *testing.T) {
+	// Well-known slots
+	assert.Equal(t, "0x360894a13ba1a3210667c828492db98dca3e2076cc3735a920a3ca505d382bbc", erc1967ImplementationSlot.Hex())
+	assert.Equal(t, "0xb53127684a568b3173ae13b9f8a6016e243e63b6e8ee1178d6a717850b5d6103", erc1967AdminSlot.Hex())
+	assert.Equal(t, "0xf0c57e16840df040f15088dc2f81fe391c3923bec73e23a9662efc9c229c6a00", ozInitializableSlot.Hex())
+	assert.Equal(t, "0x9016d09d72d40fdae2fd8ceac6b6234c7706214fd39c1cd1e609a0528c199300", ozOwnableSlot.Hex())
+}
@@ -0,0 +1,368 @@
+package cmd
+
+import (
+	"bytes"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	cfg "github.com/cometbft/cometbft/config"
+	cmttypes "github.com/cometbft/cometbft/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/codec"
+	srvconfig "github.com/cosmos/cosmos-sdk/server/config"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/cosmos/cosmos-sdk/x/genutil"
+	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
+	evmengtypes "github.com/omni-network/omni/octane/evmengine/types"
+	"github.com/spf13/cobra"
+
+	evmvalcli "github.com/mitosis-org/chain/x/evmvalidator/client/cli"
+	evmvaltypes "github.com/mitosis-org/chain/x/evmvalidator/types"
+)
+
+const (
+	flagNumValidators     = "validators"
+	flagOutputDir         = "output-dir"
+	flagNodeDirPrefix     = "node-dir-prefix"
+	flagNodeDaemonHome    = "node-daemon-home"
+	flagStartingIPAddress = "starting-ip-address"
+	flagEngineEndpoint    = "engine-endpoint"
+	flagCollateral        = "collateral"
+	flagMinGasPrices      = "minimum-gas-prices"
+
+	// defaultTestnetCollateral is the collateral of each validator in gwei (1M MITO)
+	defaultTestnetCollateral = "1000000000000000"
+	// testnetP2PPort is the CometBFT P2P port of the nodes used in the persistent peers
+	testnetP2PPort = 26656
+)
+
+// TestnetOptions are the options of InitTestnetFiles
+type TestnetOptions struct {
+	ChainID           string
+	NumValidators     int
+	OutputDir         string
+	NodeDirPrefix     string
+	NodeDaemonHome    string
+	StartingIPAddress string
+	// EngineEndpoint is the Engine API endpoint of each node. {name}, {index} and {ip} are replaced with
+	// the node directory name, the node index and the IP address of the node.
+	EngineEndpoint string
+	// Collateral is the collateral of each genesis validator in gwei
+	Collateral         sdkmath.Uint
+	MinGasPrices       string
+	EthChainID         uint64
+	EthGenesisManifest *EthGenesisManifest
+}
+
+// TestnetNode is a node initialized by InitTestnetFiles
+type TestnetNode struct {
+	Name      string
+	Home      string
+	NodeID    string
+	IP        string
+	Validator evmvaltypes.Validator
+}
+
+// NewTestnetCmd returns a command with the subcommands to set up a multi-validator network
+func NewTestnetCmd(basicManager module.BasicManager) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        "testnet",
+		Short:                      "Subcommands for setting up a multi-validator testnet",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(NewTestnetInitFilesCmd(basicManager))
+
+	return cmd
+}
+
+// NewTestnetInitFilesCmd returns a command to initialize the home directories of a multi-validator testnet
+func NewTestnetInitFilesCmd(basicManager module.BasicManager) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "init-files",
+		Short: "Initialize the home directories of the nodes of a multi-validator testnet",
+		Long: `Initialize the home directories of the nodes of a multi-validator testnet.
+
+For each validator, it creates <output-dir>/<node-dir-prefix><index> with:
+  - the validator key (secp256k1) and the node key
+  - the shared genesis.json with the validator added to x/evmvalidator and x/evmengine execution_block_hash set
+  - the shared eth_genesis.json for the execution clients
+  - config.toml with the other nodes as persistent peers
+  - app.toml with the Engine API endpoint and a JWT secret (config/jwt.hex) for its execution client
+
+Each node is expected to run on its own IP address, which is incremented from --starting-ip-address.
+
+Example:
+$ mitosisd testnet init-files --validators 4 --chain-id mitosis-localnet-1 --output-dir ./testnet \
+    --starting-ip-address 172.50.1.1 --engine-endpoint "http://{name}-geth:8551"
+`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			var opts TestnetOptions
+			opts.ChainID, _ = cmd.Flags().GetString(flags.FlagChainID)
+			opts.NumValidators, _ = cmd.Flags().GetInt(flagNumValidators)
+			opts.OutputDir, _ = cmd.Flags().GetString(flagOutputDir)
+			opts.NodeDirPrefix, _ = cmd.Flags().GetString(flagNodeDirPrefix)
+			opts.NodeDaemonHome, _ = cmd.Flags().GetString(flagNodeDaemonHome)
+			opts.StartingIPAddress, _ = cmd.Flags().GetString(flagStartingIPAddress)
+			opts.EngineEndpoint, _ = cmd.Flags().GetString(flagEngineEndpoint)
+			opts.MinGasPrices, _ = cmd.Flags().GetString(flagMinGasPrices)
+			opts.EthChainID, _ = cmd.Flags().GetUint64(FlagEthChainID)
+
+			collateral, _ := cmd.Flags().GetString(flagCollateral)
+			var err error
+			if opts.Collateral, err = sdkmath.ParseUint(collateral); err != nil {
+				return fmt.Errorf("invalid collateral %q: %w", collateral, err)
+			}
+
+			if manifestFile, _ := cmd.Flags().GetString(FlagEthManifest); manifestFile != "" {
+				if opts.EthGenesisManifest, err = LoadEthGenesisManifest(manifestFile); err != nil {
+					return fmt.Errorf("failed to load ethereum genesis manifest: %w", err)
+				}
+			}
+
+			nodes, err := InitTestnetFiles(clientCtx.Codec, basicManager, opts)
+			if err != nil {
+				return err
+			}
+
+			for _, node := range nodes {
+				_, _ = fmt.Fprintf(cmd.OutOrStdout(), "%s: home=%s node-id=%s ip=%s validator=%s\n",
+					node.Name, node.Home, node.NodeID, node.IP, node.Validator.Addr)
+			}
+			_, _ = fmt.Fprintf(cmd.OutOrStdout(), "Successfully initialized %d node directories\n", len(nodes))
+
+			return nil
+		},
+	}
+
+	cmd.Flags().Int(flagNumValidators, 4, "number of validators to initialize the testnet with")
+	cmd.Flags().StringP(flagOutputDir, "o", "./testnet", "directory to store the initialization data of the testnet")
+	cmd.Flags().String(flags.FlagChainID, "mitosis-localnet-1", "genesis file chain-id")
+	cmd.Flags().String(flagNodeDirPrefix, "node", "prefix of the directory name of each node (node results in node0, node1, ...)")
+	cmd.Flags().String(flagNodeDaemonHome, "", "home directory of the nodes at runtime, e.g. in the containers (default: the initialized directory of each node)")
+	cmd.Flags().String(flagStartingIPAddress, "192.168.0.1", "IP address of the first node, incremented for the others to build the persistent peers")
+	cmd.Flags().String(flagEngineEndpoint, "http://{name}-geth:8551", "Engine API endpoint of the execution client of each node; {name}, {index} and {ip} are replaced")
+	cmd.Flags().String(flagCollateral, defaultTestnetCollateral, "collateral of each genesis validator in gwei")
+	cmd.Flags().String(flagMinGasPrices, fmt.Sprintf("0.000006%s", sdk.DefaultBondDenom), "minimum gas prices of the nodes")
+	cmd.Flags().Uint64(FlagEthChainID, 0, "ethereum chain ID (overrides default mapping)")
+	cmd.Flags().String(FlagEthManifest, "", "YAML or JSON manifest of the ethereum genesis (accounts, predeployed contracts, fork schedule)")
+
+	return cmd
+}
+
+// InitTestnetFiles initializes the home directories of the nodes of a testnet sharing genesis.json and eth_genesis.json
+func InitTestnetFiles(cdc codec.JSONCodec, basicManager module.BasicManager, opts TestnetOptions) ([]TestnetNode, error) {
+	if opts.NumValidators < 1 {
+		return nil, fmt.Errorf("number of validators must be positive: %d", opts.NumValidators)
+	}
+	if opts.ChainID == "" {
+		return nil, fmt.Errorf("chain ID is required")
+	}
+
+	outputDir, err := filepath.Abs(opts.OutputDir)
+	if err != nil {
+		return nil, fmt.Errorf("invalid output directory: %w", err)
+	}
+
+	// Generate the keys and the configs of the nodes
+	nodes := make([]TestnetNode, opts.NumValidators)
+	configs := make([]*cfg.Config, opts.NumValidators)
+	pubkeys := make([][]byte, opts.NumValidators)
+	for i := range nodes {
+		name := fmt.Sprintf("%s%d", opts.NodeDirPrefix, i)
+		home := filepath.Join(outputDir, name)
+		if _, err := os.Stat(home); err == nil {
+			return nil, fmt.Errorf("node directory already exists: %s", home)
+		}
+
+		ip, err := nthIPAddress(opts.StartingIPAddress, i)
+		if err != nil {
+			return nil, err
+		}
+
+		config := initTendermintConfig()
+		config.SetRoot(home)
+		config.Moniker = name
+		if err := os.MkdirAll(filepath.Join(home, "config"), 0o750); err != nil {
+			return nil, fmt.Errorf("failed to create node directory: %w", err)
+		}
+		if err := os.MkdirAll(filepath.Join(home, "data"), 0o750); err != nil {
+			return nil, fmt.Errorf("failed to create node directory: %w", err)
+		}
+
+		nodeID, pubkey, err := InitializeNodeValidatorFiles(config)
+		if err != nil {
+			return nil, fmt.Errorf("failed to initialize the keys of %s: %w", name, err)
+		}
+
+		nodes[i] = TestnetNode{Name: name, Home: home, NodeID: nodeID, IP: ip}
+		configs[i] = config
+		pubkeys[i] = pubkey.Bytes()
+	}
+
+	// Generate the shared Ethereum genesis
+	ethGenesisFile := filepath.Join(outputDir, "eth_genesis.json")
+	ethOpts := EthGenesisOptions{
+		ChainID:    opts.ChainID,
+		OutputPath: ethGenesisFile,
+		Manifest:   opts.EthGenesisManifest,
+	}
+	if opts.EthChainID != 0 {
+		ethOpts.EthChainID = new(big.Int).SetUint64(opts.EthChainID)
+	}
+	if err := GenerateEthereumGenesisWithOptions(ethOpts); err != nil {
+		return nil, fmt.Errorf("failed to generate ethereum genesis: %w", err)
+	}
+	ethGenesis, err := readGenesisFile(ethGenesisFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read ethereum genesis: %w", err)
+	}
+	ethBlockHash, err := ethGenesisBlockHash(ethGenesis)
+	if err != nil {
+		return nil, fmt.Errorf("failed to compute ethereum genesis block hash: %w", err)
+	}
+
+	// Generate the shared genesis with all the validators
+	appState := basicManager.DefaultGenesis(cdc)
+	for i := range nodes {
+		valAddr, err := evmvaltypes.PubkeyToEthAddress(pubkeys[i])
+		if err != nil {
+			return nil, fmt.Errorf("failed to convert pubkey to eth address: %w", err)
+		}
+
+		// The validator owns its collateral as in add-validator of infra/devnet.
+		nodes[i].Validator, err = evmvalcli.AddGenesisValidator(cdc, appState, pubkeys[i], valAddr, opts.Collateral, sdkmath.ZeroUint(), false)
+		if err != nil {
+			return nil, fmt.Errorf("failed to add validator of %s: %w", nodes[i].Name, err)
+		}
+	}
+	appState[evmengtypes.ModuleName] = cdc.MustMarshalJSON(evmengtypes.NewGenesisState(ethBlockHash))
+
+	appStateJSON, err := json.MarshalIndent(appState, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal application genesis state: %w", err)
+	}
+
+	consensusParams := cmttypes.DefaultConsensusParams()
+	consensusParams.Block.MaxBytes = -1 // Required by PrepareProposal of x/evmengine.
+	consensusParams.Validator.PubKeyTypes = []string{cmttypes.ABCIPubKeyTypeSecp256k1}
+
+	appGenesis := genutiltypes.NewAppGenesisWithVersion(opts.ChainID, appStateJSON)
+	appGenesis.AppName = version.AppName
+	appGenesis.GenesisTime = time.Now().UTC().Truncate(time.Second)
+	appGenesis.Consensus = &genutiltypes.ConsensusGenesis{Params: consensusParams}
+	if err := appGenesis.ValidateAndComplete(); err != nil {
+		return nil, fmt.Errorf("invalid genesis: %w", err)
+	}
+
+	// Write the files of each node
+	appTemplate, appConfig := initAppConfig()
+	srvconfig.SetConfigTemplate(appTemplate)
+
+	for i, node := range nodes {
+		config := configs[i]
+
+		if err := genutil.ExportGenesisFile(appGenesis, config.GenesisFile()); err != nil {
+			return nil, fmt.Errorf("failed to export genesis of %s: %w", node.Name, err)
+		}
+		if err := safeCopyFile(ethGenesisFile, filepath.Join(node.Home, "config", "eth_genesis.json")); err != nil {
+			return nil, fmt.Errorf("failed to copy ethereum genesis to %s: %w", node.Name, err)
+		}
+
+		jwtFile := filepath.Join(node.Home, "config", "jwt.hex")
+		if err := writeJWTSecret(jwtFile); err != nil {
+			return nil, fmt.Errorf("failed to write JWT secret of %s: %w", node.Name, err)
+		}
+
+		// config.toml
+		var peers []string
+		for j, peer := range nodes {
+			if j != i {
+				peers = append(peers, fmt.Sprintf("%s@%s:%d", peer.NodeID, peer.IP, testnetP2PPort))
+			}
+		}
+		config.P2P.PersistentPeers = strings.Join(peers, ",")
+		config.P2P.AddrBookStrict = false
+		config.P2P.AllowDuplicateIP = true
+		config.Mempool.Type = cfg.MempoolTypeNop // we don't use mempool in consensus layer
+		config.Mempool.Broadcast = false
+		config.Consensus.TimeoutCommit = time.Second
+		if err := writeCometConfigFile(filepath.Join(node.Home, "config", "config.toml"), config); err != nil {
+			return nil, fmt.Errorf("failed to write config.toml of %s: %w", node.Name, err)
+		}
+
+		// app.toml
+		runtimeHome := node.Home
+		if opts.NodeDaemonHome != "" {
+			runtimeHome = opts.NodeDaemonHome
+		}
+		appConfig.MinGasPrices = opts.MinGasPrices
+		appConfig.Engine.Endpoint = strings.NewReplacer(
+			"{name}", node.Name,
+			"{index}", strconv.Itoa(i),
+			"{ip}", node.IP,
+		).Replace(opts.EngineEndpoint)
+		appConfig.Engine.JWTFile = filepath.Join(runtimeHome, "config", "jwt.hex")
+		appConfig.Engine.EthChainID = opts.EthChainID
+		srvconfig.WriteConfigFile(filepath.Join(node.Home, "config", "app.toml"), appConfig)
+	}
+
+	return nodes, nil
+}
+
+// writeCometConfigFile writes config.toml. The mempool type is set separately since it is fixed to flood in the template.
+func writeCometConfigFile(file string, config *cfg.Config) error {
+	cfg.WriteConfigFile(file, config)
+
+	bz, err := os.ReadFile(file)
+	if err != nil {
+		return err
+	}
+	bz = bytes.Replace(bz, []byte(`type = "flood"`), []byte(fmt.Sprintf("type = %q", config.Mempool.Type)), 1)
+
+	return os.WriteFile(file, bz, 0o600)
+}
+
+// nthIPAddress returns the IPv4 address incremented by n from the given one
+func nthIPAddress(ip string, n int) (string, error) {
+	ipv4 := net.ParseIP(ip).To4()
+	if ipv4 == nil {
+		return "", fmt.Errorf("invalid IPv4 address: %s", ip)
+	}
+
+	addr := new(big.Int).SetBytes(ipv4)
+	addr.Add(addr, big.NewInt(int64(n)))
+	if addr.BitLen() > 32 {
+		return "", fmt.Errorf("IPv4 address overflows: %s + %d", ip, n)
+	}
+
+	return net.IP(addr.FillBytes(make([]byte, 4))).String(), nil
+}
+
+// writeJWTSecret writes a random 32-byte hex JWT secret shared with the execution client
+func writeJWTSecret(file string) error {
+	secret := make([]byte, 32)
+	if _, err := rand.Read(secret); err != nil {
+		return err
+	}
+
+	return os.WriteFile(file, []byte(hex.EncodeToString(secret)), 0o600)
+}
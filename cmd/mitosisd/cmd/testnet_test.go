@@ -0,0 +1,239 @@
+package cmd
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"cosmossdk.io/depinject"
+	"cosmossdk.io/log"
+	sdkmath "cosmossdk.io/math"
+	cfg "github.com/cometbft/cometbft/config"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/omni-network/omni/lib/ethclient"
+	evmengtypes "github.com/omni-network/omni/octane/evmengine/types"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mitosis-org/chain/app"
+	evmvaltypes "github.com/mitosis-org/chain/x/evmvalidator/types"
+)
+
+func TestNthIPAddress(t *testing.T) {
+	tests := []struct {
+		ip          string
+		n           int
+		expected    string
+		expectedErr string
+	}{
+		{ip: "192.168.0.1", n: 0, expected: "192.168.0.1"},
+		{ip: "192.168.0.1", n: 3, expected: "192.168.0.4"},
+		{ip: "172.50.1.255", n: 1, expected: "172.50.2.0"},
+		{ip: "255.255.255.255", n: 1, expectedErr: "overflows"},
+		{ip: "::1", n: 1, expectedErr: "invalid IPv4 address"},
+		{ip: "node0", n: 1, expectedErr: "invalid IPv4 address"},
+	}
+
+	for _, tt := range tests {
+		ip, err := nthIPAddress(tt.ip, tt.n)
+		if tt.expectedErr != "" {
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.expectedErr)
+			continue
+		}
+		require.NoError(t, err)
+		assert.Equal(t, tt.expected, ip)
+	}
+}
+
+func TestInitTestnetFiles(t *testing.T) {
+	cdc, basicManager, txConfig := testCodecAndBasicManager(t)
+	outputDir := t.TempDir()
+
+	opts := TestnetOptions{
+		ChainID:           "mitosis-localnet-1",
+		NumValidators:     3,
+		OutputDir:         outputDir,
+		NodeDirPrefix:     "node",
+		NodeDaemonHome:    "/root/.mitosisd",
+		StartingIPAddress: "172.50.1.1",
+		EngineEndpoint:    "http://{name}-geth:8551",
+		Collateral:        sdkmath.NewUint(1_000_000_000),
+		MinGasPrices:      "0.001ustake",
+	}
+	nodes, err := InitTestnetFiles(cdc, basicManager, opts)
+	require.NoError(t, err)
+	require.Len(t, nodes, 3)
+
+	ethGenesis, err := readGenesisFile(filepath.Join(outputDir, "eth_genesis.json"))
+	require.NoError(t, err)
+	ethBlockHash, err := ethGenesisBlockHash(ethGenesis)
+	require.NoError(t, err)
+
+	var genesisJSON []byte
+	for i, node := range nodes {
+		assert.Equal(t, filepath.Join(outputDir, node.Name), node.Home)
+		for _, file := range []string{"priv_validator_key.json", "node_key.json", "eth_genesis.json"} {
+			assert.FileExists(t, filepath.Join(node.Home, "config", file))
+		}
+
+		// genesis.json is shared
+		bz, err := os.ReadFile(filepath.Join(node.Home, "config", "genesis.json"))
+		require.NoError(t, err)
+		if genesisJSON == nil {
+			genesisJSON = bz
+		}
+		assert.Equal(t, genesisJSON, bz)
+
+		// config.toml has the other nodes as persistent peers
+		config := readCometConfig(t, node.Home)
+		peers := strings.Split(config.P2P.PersistentPeers, ",")
+		assert.Len(t, peers, 2)
+		for j, peer := range nodes {
+			if i != j {
+				assert.Contains(t, peers, peer.NodeID+"@"+peer.IP+":26656")
+			}
+		}
+		assert.Equal(t, cfg.MempoolTypeNop, config.Mempool.Type)
+
+		// app.toml has the engine endpoint and the JWT file at runtime
+		appConfig := readAppConfig(t, node.Home)
+		assert.Equal(t, "http://"+node.Name+"-geth:8551", appConfig.Engine.Endpoint)
+		assert.Equal(t, "/root/.mitosisd/config/jwt.hex", appConfig.Engine.JWTFile)
+		assert.Equal(t, "0.001ustake", appConfig.MinGasPrices)
+
+		jwtSecret, err := ethclient.LoadJWTHexFile(filepath.Join(node.Home, "config", "jwt.hex"))
+		require.NoError(t, err)
+		assert.Len(t, jwtSecret, 32)
+	}
+
+	appGenesis, err := genutiltypes.AppGenesisFromFile(filepath.Join(nodes[0].Home, "config", "genesis.json"))
+	require.NoError(t, err)
+	assert.Equal(t, "mitosis-localnet-1", appGenesis.ChainID)
+	assert.Equal(t, int64(-1), appGenesis.Consensus.Params.Block.MaxBytes)
+
+	appState, err := genutiltypes.GenesisStateFromAppGenesis(appGenesis)
+	require.NoError(t, err)
+	require.NoError(t, basicManager.ValidateGenesis(cdc, txConfig, appState))
+
+	var evmengGenesis evmengtypes.GenesisState
+	cdc.MustUnmarshalJSON(appState[evmengtypes.ModuleName], &evmengGenesis)
+	assert.Equal(t, ethBlockHash.Bytes(), evmengGenesis.ExecutionBlockHash)
+
+	var evmvalGenesis evmvaltypes.GenesisState
+	cdc.MustUnmarshalJSON(appState[evmvaltypes.ModuleName], &evmvalGenesis)
+	require.Len(t, evmvalGenesis.Validators, 3)
+	require.Len(t, evmvalGenesis.CollateralOwnerships, 3)
+	for i, node := range nodes {
+		assert.Equal(t, node.Validator.Addr, evmvalGenesis.Validators[i].Addr)
+		assert.Equal(t, opts.Collateral, evmvalGenesis.Validators[i].Collateral)
+		assert.Equal(t, node.Validator.Addr, evmvalGenesis.CollateralOwnerships[i].Owner)
+	}
+
+	// The node directories are not overwritten
+	_, err = InitTestnetFiles(cdc, basicManager, opts)
+	require.ErrorContains(t, err, "node directory already exists")
+}
+
+func TestInitTestnetFiles_CustomEthChainID(t *testing.T) {
+	cdc, basicManager, _ := testCodecAndBasicManager(t)
+
+	nodes, err := InitTestnetFiles(cdc, basicManager, TestnetOptions{
+		ChainID:           "custom-chain",
+		NumValidators:     1,
+		OutputDir:         t.TempDir(),
+		StartingIPAddress: "127.0.0.1",
+		EngineEndpoint:    "http://{ip}:8551",
+		Collateral:        sdkmath.NewUint(1),
+		EthChainID:        777,
+	})
+	require.NoError(t, err)
+
+	ethGenesis, err := readGenesisFile(filepath.Join(nodes[0].Home, "config", "eth_genesis.json"))
+	require.NoError(t, err)
+	assert.Equal(t, int64(777), ethGenesis.Config.ChainID.Int64())
+
+	// The node verifies the execution client against the customized chain ID
+	appConfig := readAppConfig(t, nodes[0].Home)
+	assert.Equal(t, uint64(777), appConfig.Engine.EthChainID)
+	assert.Equal(t, "http://127.0.0.1:8551", appConfig.Engine.Endpoint)
+	assert.Equal(t, filepath.Join(nodes[0].Home, "config", "jwt.hex"), appConfig.Engine.JWTFile)
+}
+
+func TestInitTestnetFiles_Invalid(t *testing.T) {
+	cdc, basicManager, _ := testCodecAndBasicManager(t)
+
+	_, err := InitTestnetFiles(cdc, basicManager, TestnetOptions{ChainID: "mitosis-localnet-1", NumValidators: 0})
+	require.ErrorContains(t, err, "number of validators must be positive")
+
+	_, err = InitTestnetFiles(cdc, basicManager, TestnetOptions{
+		ChainID:           "mitosis-localnet-1",
+		NumValidators:     1,
+		OutputDir:         t.TempDir(),
+		StartingIPAddress: "localhost",
+	})
+	require.ErrorContains(t, err, "invalid IPv4 address")
+}
+
+func testCodecAndBasicManager(t *testing.T) (codec.Codec, module.BasicManager, client.TxConfig) {
+	t.Helper()
+
+	app.SetupConfig()
+
+	mockEngineClient, err := ethclient.NewEngineMock()
+	require.NoError(t, err)
+
+	var (
+		cdc          codec.Codec
+		basicManager module.BasicManager
+		txConfig     client.TxConfig
+	)
+	err = depinject.Inject(
+		depinject.Configs(
+			app.AppConfig(),
+			depinject.Supply(
+				log.NewNopLogger(),
+				mockEngineClient,
+				&app.ValidatorAddressProvider{Addr: common.Address{}},
+			),
+		),
+		&cdc,
+		&basicManager,
+		&txConfig,
+	)
+	require.NoError(t, err)
+
+	return cdc, basicManager, txConfig
+}
+
+func readCometConfig(t *testing.T, home string) *cfg.Config {
+	t.Helper()
+
+	v := viper.New()
+	v.SetConfigFile(filepath.Join(home, "config", "config.toml"))
+	require.NoError(t, v.ReadInConfig())
+
+	config := cfg.DefaultConfig()
+	require.NoError(t, v.Unmarshal(config))
+
+	return config
+}
+
+func readAppConfig(t *testing.T, home string) AppConfig {
+	t.Helper()
+
+	v := viper.New()
+	v.SetConfigFile(filepath.Join(home, "config", "app.toml"))
+	require.NoError(t, v.ReadInConfig())
+
+	appConfig := DefaultAppConfig()
+	require.NoError(t, v.Unmarshal(&appConfig))
+
+	return appConfig
+}
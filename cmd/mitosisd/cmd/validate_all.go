@@ -0,0 +1,328 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"path/filepath"
+	"slices"
+
+	cmttypes "github.com/cometbft/cometbft/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/server"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
+	"github.com/cosmos/gogoproto/proto"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/math"
+	"github.com/ethereum/go-ethereum/core"
+	evmengtypes "github.com/omni-network/omni/octane/evmengine/types"
+	"github.com/spf13/cobra"
+
+	mitotypes "github.com/mitosis-org/chain/types"
+	evmgovtypes "github.com/mitosis-org/chain/x/evmgov/types"
+	evmvaltypes "github.com/mitosis-org/chain/x/evmvalidator/types"
+)
+
+const (
+	flagExpectedEthChainID         = "eth-chain-id"
+	flagAllowUndeployedEntrypoints = "allow-undeployed-entrypoints"
+)
+
+// gweiToWei is the number of wei in a gwei, which is the unit of the collateral in x/evmvalidator
+var gweiToWei = big.NewInt(1_000_000_000)
+
+// GenesisIssue is an inconsistency found by cross-checking the genesis files
+type GenesisIssue struct {
+	// Warning is true if the issue doesn't prevent the network from launching
+	Warning bool
+	Message string
+	// Hint is how to resolve the issue
+	Hint string
+}
+
+func (i GenesisIssue) String() string {
+	level := "ERROR"
+	if i.Warning {
+		level = "WARN"
+	}
+
+	if i.Hint == "" {
+		return fmt.Sprintf("%s: %s", level, i.Message)
+	}
+
+	return fmt.Sprintf("%s: %s\n  -> %s", level, i.Message, i.Hint)
+}
+
+// GenesisFiles are the genesis states cross-checked by validate-all
+type GenesisFiles struct {
+	ChainID         string
+	ConsensusParams *cmttypes.ConsensusParams
+	EVMValidator    evmvaltypes.GenesisState
+	EVMGov          evmgovtypes.GenesisState
+	EVMEngine       evmengtypes.GenesisState
+	Eth             *EthereumGenesisSpec
+}
+
+// CrossCheckOptions are the options of CrossCheckGenesis
+type CrossCheckOptions struct {
+	// EthChainID is the expected EVM chain ID. If zero, it is taken from the chain registry or derived from the chain ID.
+	EthChainID uint64
+	// AllowUndeployedEntrypoints allows the entrypoint contracts to be deployed after the launch.
+	AllowUndeployedEntrypoints bool
+}
+
+func NewValidateAllCmd(basicManager module.BasicManager) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "validate-all [genesis-file] [eth-genesis-file]",
+		Short: "Validate genesis.json and eth_genesis.json and cross-check their consistency",
+		Long: `Validate genesis.json and eth_genesis.json and cross-check their consistency before launching a network.
+
+In addition to the validation of the modules, it checks that:
+  - the EVM chain ID of eth_genesis.json is the expected one for the chain ID
+  - x/evmengine execution_block_hash is the hash of the genesis block of eth_genesis.json
+  - the entrypoint contracts of x/evmvalidator and x/evmgov have code in eth_genesis.json
+  - the collateral of the genesis validators is backed by the balance of the validator entrypoint
+  - the consensus params are compatible with x/evmengine and x/evmvalidator
+
+The files default to config/genesis.json and config/eth_genesis.json in the home directory.`,
+		Args: cobra.RangeArgs(0, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			serverCtx := server.GetServerContextFromCmd(cmd)
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			genesisFile := serverCtx.Config.GenesisFile()
+			if len(args) > 0 {
+				genesisFile = args[0]
+			}
+			ethGenesisFile := filepath.Join(filepath.Dir(serverCtx.Config.GenesisFile()), "eth_genesis.json")
+			if len(args) > 1 {
+				ethGenesisFile = args[1]
+			}
+
+			var opts CrossCheckOptions
+			opts.EthChainID, _ = cmd.Flags().GetUint64(flagExpectedEthChainID)
+			opts.AllowUndeployedEntrypoints, _ = cmd.Flags().GetBool(flagAllowUndeployedEntrypoints)
+
+			appGenesis, err := genutiltypes.AppGenesisFromFile(genesisFile)
+			if err != nil {
+				return fmt.Errorf("failed to read genesis file: %w", err)
+			}
+			if err := appGenesis.ValidateAndComplete(); err != nil {
+				return fmt.Errorf("invalid genesis file %s: %w", genesisFile, err)
+			}
+
+			var genState map[string]json.RawMessage
+			if err := json.Unmarshal(appGenesis.AppState, &genState); err != nil {
+				return fmt.Errorf("failed to parse app state of %s: %w", genesisFile, err)
+			}
+
+			ethGenesis, err := readGenesisFile(ethGenesisFile)
+			if err != nil {
+				return fmt.Errorf("failed to read eth genesis file: %w", err)
+			}
+
+			var issues []GenesisIssue
+			if err := basicManager.ValidateGenesis(clientCtx.Codec, clientCtx.TxConfig, genState); err != nil {
+				issues = append(issues, GenesisIssue{
+					Message: fmt.Sprintf("invalid app state: %v", err),
+					Hint:    "run `mitosisd genesis validate` for details",
+				})
+			}
+
+			files := GenesisFiles{
+				ChainID:         appGenesis.ChainID,
+				ConsensusParams: appGenesis.Consensus.Params,
+				Eth:             ethGenesis,
+			}
+			for _, module := range []struct {
+				name  string
+				state proto.Message
+			}{
+				{evmvaltypes.ModuleName, &files.EVMValidator},
+				{evmgovtypes.ModuleName, &files.EVMGov},
+				{evmengtypes.ModuleName, &files.EVMEngine},
+			} {
+				bz, ok := genState[module.name]
+				if !ok {
+					return fmt.Errorf("%s is missing in the app state of %s", module.name, genesisFile)
+				}
+				if err := clientCtx.Codec.UnmarshalJSON(bz, module.state); err != nil {
+					return fmt.Errorf("failed to parse %s genesis: %w", module.name, err)
+				}
+			}
+
+			issues = append(issues, CrossCheckGenesis(files, opts)...)
+
+			numErrors := 0
+			for _, issue := range issues {
+				if !issue.Warning {
+					numErrors++
+				}
+				_, _ = fmt.Fprintln(cmd.OutOrStdout(), issue.String())
+			}
+			if numErrors > 0 {
+				return fmt.Errorf("found %d error(s) in %s and %s", numErrors, genesisFile, ethGenesisFile)
+			}
+
+			_, _ = fmt.Fprintf(cmd.OutOrStdout(), "%s and %s are consistent\n", genesisFile, ethGenesisFile)
+
+			return nil
+		},
+	}
+
+	cmd.Flags().Uint64(flagExpectedEthChainID, 0, "expected EVM chain ID if it was customized (default: from the chain registry or derived from the chain ID)")
+	cmd.Flags().Bool(flagAllowUndeployedEntrypoints, false, "allow the entrypoint contracts to be missing in eth_genesis.json if they are deployed after the launch")
+
+	return cmd
+}
+
+// CrossCheckGenesis cross-checks the CometBFT genesis and the Ethereum genesis and returns all the issues found
+func CrossCheckGenesis(files GenesisFiles, opts CrossCheckOptions) []GenesisIssue {
+	var issues []GenesisIssue
+	addError := func(hint string, format string, args ...any) {
+		issues = append(issues, GenesisIssue{Message: fmt.Sprintf(format, args...), Hint: hint})
+	}
+	addWarning := func(hint string, format string, args ...any) {
+		issues = append(issues, GenesisIssue{Warning: true, Message: fmt.Sprintf(format, args...), Hint: hint})
+	}
+
+	// Consensus params
+	if params := files.ConsensusParams; params != nil {
+		if params.Block.MaxBytes != -1 {
+			addError("set consensus.params.block.max_bytes to \"-1\"",
+				"consensus.params.block.max_bytes is %d, but x/evmengine requires -1", params.Block.MaxBytes)
+		}
+		if !slices.Contains(params.Validator.PubKeyTypes, cmttypes.ABCIPubKeyTypeSecp256k1) {
+			addError("set consensus.params.validator.pub_key_types to [\"secp256k1\"]",
+				"consensus.params.validator.pub_key_types is %v, but x/evmvalidator requires secp256k1", params.Validator.PubKeyTypes)
+		}
+	}
+
+	eth := files.Eth
+	if eth.Config == nil || eth.Config.ChainID == nil {
+		addError("regenerate eth_genesis.json with `mitosisd genesis generate-eth-genesis`", "config.chainId is missing in eth_genesis.json")
+	} else {
+		expected := GetEthChainIDFromCosmosChainID(files.ChainID)
+		if opts.EthChainID != 0 {
+			expected = new(big.Int).SetUint64(opts.EthChainID)
+		}
+		if eth.Config.ChainID.Cmp(expected) != 0 {
+			addError("regenerate eth_genesis.json for the chain ID, or pass --eth-chain-id (and set [engine] eth-chain-id in app.toml) if it is customized",
+				"EVM chain ID of eth_genesis.json is %s, but %s is expected for %s", eth.Config.ChainID, expected, files.ChainID)
+		}
+	}
+
+	// Genesis block hash
+	blockHash, err := ethGenesisBlockHash(eth)
+	switch {
+	case err != nil:
+		addError("fix eth_genesis.json so that the execution client accepts it", "invalid eth_genesis.json: %v", err)
+	case len(files.EVMEngine.ExecutionBlockHash) == 0:
+		addError(fmt.Sprintf("set app_state.evmengine.execution_block_hash to the genesis block hash %s", blockHash),
+			"app_state.evmengine.execution_block_hash is not set")
+	case common.BytesToHash(files.EVMEngine.ExecutionBlockHash) != blockHash:
+		addError(fmt.Sprintf("set app_state.evmengine.execution_block_hash to the genesis block hash %s, or make sure eth_genesis.json is the one the execution client is initialized with", blockHash),
+			"app_state.evmengine.execution_block_hash is %s, but the genesis block hash of eth_genesis.json is %s",
+			common.BytesToHash(files.EVMEngine.ExecutionBlockHash), blockHash)
+	}
+
+	// Entrypoint contracts
+	entrypoints := []struct {
+		name    string
+		field   string
+		addr    mitotypes.EthAddress
+		account *AllocatedAccount
+	}{
+		{"ConsensusValidatorEntrypoint", "app_state.evmvalidator.validator_entrypoint_contract_addr", files.EVMValidator.ValidatorEntrypointContractAddr, nil},
+		{"ConsensusGovernanceEntrypoint", "app_state.evmgov.gov_entrypoint_contract_addr", files.EVMGov.GovEntrypointContractAddr, nil},
+	}
+	for i := range entrypoints {
+		entrypoint := &entrypoints[i]
+		if entrypoint.addr.Address() == (common.Address{}) {
+			addWarning(fmt.Sprintf("set %s to the address of the deployed %s", entrypoint.field, entrypoint.name),
+				"%s is not set, so no events of %s are processed", entrypoint.field, entrypoint.name)
+			continue
+		}
+
+		account, ok := lookupAllocAccount(eth.Alloc, entrypoint.addr.Address())
+		if !ok || account.Code == "" || account.Code == "0x" {
+			hint := fmt.Sprintf("add %s to eth_genesis.json with `mitosisd genesis add-proxy-contract` or `add-contract`, "+
+				"or pass --%s if it is deployed after the launch", entrypoint.name, flagAllowUndeployedEntrypoints)
+			if opts.AllowUndeployedEntrypoints {
+				addWarning(hint, "%s (%s) has no code in eth_genesis.json", entrypoint.name, entrypoint.addr)
+			} else {
+				addError(hint, "%s (%s) has no code in eth_genesis.json", entrypoint.name, entrypoint.addr)
+			}
+			continue
+		}
+		entrypoint.account = &account
+	}
+
+	// Collateral backing
+	totalCollateral := new(big.Int)
+	for _, validator := range files.EVMValidator.Validators {
+		totalCollateral.Add(totalCollateral, validator.Collateral.BigInt())
+	}
+	for _, withdrawal := range files.EVMValidator.Withdrawals {
+		totalCollateral.Add(totalCollateral, new(big.Int).SetUint64(withdrawal.Amount))
+	}
+	totalCollateral.Mul(totalCollateral, gweiToWei)
+
+	if validatorEntrypoint := entrypoints[0].account; validatorEntrypoint != nil && totalCollateral.Sign() > 0 {
+		balance, ok := math.ParseBig256(validatorEntrypoint.Balance)
+		if !ok {
+			balance = new(big.Int)
+		}
+		if balance.Cmp(totalCollateral) < 0 {
+			addError(fmt.Sprintf("set the balance of %s in eth_genesis.json to at least %s wei", entrypoints[0].addr, totalCollateral),
+				"collateral of the genesis validators and withdrawals (%s wei) is not backed by the balance of ConsensusValidatorEntrypoint (%s wei)",
+				totalCollateral, balance)
+		}
+	}
+
+	return issues
+}
+
+// ethGenesisBlockHash returns the hash of the genesis block that the execution client creates from the genesis
+func ethGenesisBlockHash(ethGenesis *EthereumGenesisSpec) (common.Hash, error) {
+	bz, err := json.Marshal(ethGenesis)
+	if err != nil {
+		return common.Hash{}, err
+	}
+
+	// Empty fields such as mixHash and coinbase are omitted, as they are not accepted by go-ethereum.
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(bz, &fields); err != nil {
+		return common.Hash{}, err
+	}
+	for key, value := range fields {
+		if string(value) == `""` {
+			delete(fields, key)
+		}
+	}
+	if bz, err = json.Marshal(fields); err != nil {
+		return common.Hash{}, err
+	}
+
+	var genesis core.Genesis
+	if err := json.Unmarshal(bz, &genesis); err != nil {
+		return common.Hash{}, err
+	}
+	if genesis.Config == nil {
+		return common.Hash{}, fmt.Errorf("config is missing")
+	}
+
+	return genesis.ToBlock().Hash(), nil
+}
+
+// lookupAllocAccount returns the account of the address in the alloc, whose keys may be in any case
+func lookupAllocAccount(alloc map[string]AllocatedAccount, addr common.Address) (AllocatedAccount, bool) {
+	for key, account := range alloc {
+		if common.IsHexAddress(key) && common.HexToAddress(key) == addr {
+			return account, true
+		}
+	}
+
+	return AllocatedAccount{}, false
+}
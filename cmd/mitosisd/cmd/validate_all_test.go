@@ -0,0 +1,185 @@
+package cmd
+
+import (
+	"strings"
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	cmttypes "github.com/cometbft/cometbft/types"
+	"github.com/ethereum/go-ethereum/common"
+	evmengtypes "github.com/omni-network/omni/octane/evmengine/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	mitotypes "github.com/mitosis-org/chain/types"
+	evmvaltypes "github.com/mitosis-org/chain/x/evmvalidator/types"
+)
+
+var (
+	testValidatorEntrypoint = common.HexToAddress("0x9866D79EF3e9c0c22Db2b55877013e13a60AD478")
+	testGovEntrypoint       = common.HexToAddress("0x06c9918ff483fd88C65dD02E788427cfF04545b9")
+)
+
+// consistentGenesisFiles returns genesis files which pass all the cross-checks
+func consistentGenesisFiles(t *testing.T) GenesisFiles {
+	t.Helper()
+
+	manifest := DefaultEthGenesisManifest()
+	manifest.Contracts = []EthGenesisContract{
+		// 1000 MITO to back the collateral of the genesis validator
+		{Address: testValidatorEntrypoint.Hex(), Code: "0x6080", Balance: "1000000000000000000000"},
+		{Address: testGovEntrypoint.Hex(), Code: "0x6080"},
+	}
+	eth, err := manifest.Build("mitosis-devnet-1")
+	require.NoError(t, err)
+
+	blockHash, err := ethGenesisBlockHash(eth)
+	require.NoError(t, err)
+
+	consensusParams := cmttypes.DefaultConsensusParams()
+	consensusParams.Block.MaxBytes = -1
+	consensusParams.Validator.PubKeyTypes = []string{cmttypes.ABCIPubKeyTypeSecp256k1}
+
+	files := GenesisFiles{
+		ChainID:         "mitosis-devnet-1",
+		ConsensusParams: consensusParams,
+		EVMEngine:       *evmengtypes.NewGenesisState(blockHash),
+		Eth:             eth,
+	}
+	files.EVMValidator.ValidatorEntrypointContractAddr = mitotypes.EthAddress(testValidatorEntrypoint)
+	files.EVMValidator.Validators = []evmvaltypes.Validator{
+		{Addr: mitotypes.EthAddress(common.HexToAddress("0x1111111111111111111111111111111111111111")), Collateral: sdkmath.NewUint(1000_000_000_000)},
+	}
+	files.EVMGov.GovEntrypointContractAddr = mitotypes.EthAddress(testGovEntrypoint)
+
+	return files
+}
+
+func TestCrossCheckGenesis_Consistent(t *testing.T) {
+	issues := CrossCheckGenesis(consistentGenesisFiles(t), CrossCheckOptions{})
+	assert.Empty(t, issues)
+}
+
+func TestCrossCheckGenesis_Inconsistent(t *testing.T) {
+	tests := []struct {
+		name     string
+		modify   func(files *GenesisFiles, opts *CrossCheckOptions)
+		warning  bool
+		expected string
+	}{
+		{
+			name:     "max bytes",
+			modify:   func(files *GenesisFiles, _ *CrossCheckOptions) { files.ConsensusParams.Block.MaxBytes = 1024 },
+			expected: "consensus.params.block.max_bytes is 1024",
+		},
+		{
+			name: "pub key types",
+			modify: func(files *GenesisFiles, _ *CrossCheckOptions) {
+				files.ConsensusParams.Validator.PubKeyTypes = []string{cmttypes.ABCIPubKeyTypeEd25519}
+			},
+			expected: "requires secp256k1",
+		},
+		{
+			name:     "chain ID mismatch",
+			modify:   func(files *GenesisFiles, _ *CrossCheckOptions) { files.ChainID = "mitosis-localnet-1" },
+			expected: "EVM chain ID of eth_genesis.json is 124864, but 124899 is expected for mitosis-localnet-1",
+		},
+		{
+			name:     "customized chain ID mismatch",
+			modify:   func(_ *GenesisFiles, opts *CrossCheckOptions) { opts.EthChainID = 1 },
+			expected: "but 1 is expected",
+		},
+		{
+			name:     "execution block hash not set",
+			modify:   func(files *GenesisFiles, _ *CrossCheckOptions) { files.EVMEngine.ExecutionBlockHash = nil },
+			expected: "execution_block_hash is not set",
+		},
+		{
+			name: "execution block hash mismatch",
+			modify: func(files *GenesisFiles, _ *CrossCheckOptions) {
+				files.Eth.Alloc[DefaultFundedAddress] = AllocatedAccount{Balance: "1"}
+			},
+			expected: "but the genesis block hash of eth_genesis.json is",
+		},
+		{
+			name: "validator entrypoint without code",
+			modify: func(files *GenesisFiles, _ *CrossCheckOptions) {
+				delete(files.Eth.Alloc, testValidatorEntrypoint.Hex())
+				files.EVMEngine.ExecutionBlockHash = mustEthGenesisBlockHash(t, files.Eth)
+			},
+			expected: "ConsensusValidatorEntrypoint (0x9866D79EF3e9c0c22Db2b55877013e13a60AD478) has no code",
+		},
+		{
+			name: "undeployed gov entrypoint allowed",
+			modify: func(files *GenesisFiles, opts *CrossCheckOptions) {
+				delete(files.Eth.Alloc, testGovEntrypoint.Hex())
+				files.EVMEngine.ExecutionBlockHash = mustEthGenesisBlockHash(t, files.Eth)
+				opts.AllowUndeployedEntrypoints = true
+			},
+			warning:  true,
+			expected: "ConsensusGovernanceEntrypoint (0x06c9918ff483fd88C65dD02E788427cfF04545b9) has no code",
+		},
+		{
+			name: "entrypoint not set",
+			modify: func(files *GenesisFiles, _ *CrossCheckOptions) {
+				files.EVMGov.GovEntrypointContractAddr = mitotypes.EthAddress{}
+			},
+			warning:  true,
+			expected: "app_state.evmgov.gov_entrypoint_contract_addr is not set",
+		},
+		{
+			name: "collateral not backed",
+			modify: func(files *GenesisFiles, _ *CrossCheckOptions) {
+				files.EVMValidator.Withdrawals = []evmvaltypes.Withdrawal{{Amount: 1}}
+			},
+			expected: "collateral of the genesis validators and withdrawals (1000000000001000000000 wei) is not backed",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			files := consistentGenesisFiles(t)
+			var opts CrossCheckOptions
+			tt.modify(&files, &opts)
+
+			issues := CrossCheckGenesis(files, opts)
+			require.Len(t, issues, 1)
+			assert.Equal(t, tt.warning, issues[0].Warning)
+			assert.Contains(t, issues[0].Message, tt.expected)
+			assert.NotEmpty(t, issues[0].Hint)
+		})
+	}
+}
+
+func TestEthGenesisBlockHash(t *testing.T) {
+	eth, err := DefaultEthGenesisManifest().Build("mitosis-localnet-1")
+	require.NoError(t, err)
+
+	// The hash doesn't depend on the order of the alloc and changes with the alloc.
+	hash := mustEthGenesisBlockHash(t, eth)
+	assert.Equal(t, hash, mustEthGenesisBlockHash(t, eth))
+
+	eth.Alloc[testValidatorEntrypoint.Hex()] = AllocatedAccount{Balance: "0", Code: "0x6080"}
+	assert.NotEqual(t, hash, mustEthGenesisBlockHash(t, eth))
+
+	eth.Config = nil
+	_, err = ethGenesisBlockHash(eth)
+	require.Error(t, err)
+}
+
+func TestGenesisIssue_String(t *testing.T) {
+	issue := GenesisIssue{Message: "something is wrong", Hint: "fix it"}
+	assert.Equal(t, "ERROR: something is wrong\n  -> fix it", issue.String())
+
+	issue = GenesisIssue{Warning: true, Message: "something may be wrong"}
+	assert.True(t, strings.HasPrefix(issue.String(), "WARN: "))
+}
+
+func mustEthGenesisBlockHash(t *testing.T, eth *EthereumGenesisSpec) []byte {
+	t.Helper()
+
+	hash, err := ethGenesisBlockHash(eth)
+	require.NoError(t, err)
+
+	return hash.Bytes()
+}
@@ -0,0 +1,59 @@
+#!/usr/bin/env bash
+
+# Scaffolds a new software upgrade under app/upgrades/<name>.
+#
+# Usage: ./scripts/new-upgrade.sh <name>   (e.g. ./scripts/new-upgrade.sh v2)
+
+set -e
+
+name=$1
+if [ -z "$name" ]; then
+  echo "Usage: $0 <name>" >&2
+  exit 1
+fi
+
+if ! [[ "$name" =~ ^[a-z][a-z0-9_]*$ ]]; then
+  echo "Invalid upgrade name '$name': must match ^[a-z][a-z0-9_]*$ since it is used as a Go package name" >&2
+  exit 1
+fi
+
+root=$(cd "$(dirname "$0")/.." && pwd)
+dir="$root/app/upgrades/$name"
+
+if [ -e "$dir" ]; then
+  echo "Upgrade '$name' already exists at $dir" >&2
+  exit 1
+fi
+
+mkdir -p "$dir"
+cat > "$dir/upgrade.go" <<GO
+package $name
+
+import (
+	store "cosmossdk.io/store/types"
+	"github.com/mitosis-org/chain/app/upgrades"
+)
+
+// UpgradeName defines the on-chain upgrade name for the $name upgrade.
+const UpgradeName = "$name"
+
+var Upgrade = upgrades.Upgrade{
+	UpgradeName:          UpgradeName,
+	CreateUpgradeHandler: upgrades.CreateDefaultUpgradeHandler,
+	// Add the store keys of the modules which are introduced by this upgrade (e.g. \`evmgov\`)
+	// to \`Added\`, and the ones which are removed by this upgrade to \`Deleted\`.
+	StoreUpgrades: store.StoreUpgrades{
+		Added:   []string{},
+		Deleted: []string{},
+	},
+}
+GO
+
+gofmt -w "$dir/upgrade.go"
+
+echo "Created $dir/upgrade.go"
+echo
+echo "Next steps:"
+echo "  1. Register the upgrade by appending '$name.Upgrade' to 'Upgrades' in app/upgrades.go."
+echo "  2. Fill in the store upgrades, and replace the upgrade handler if custom logic is required."
+echo "  3. Bump 'ConsensusVersion' and register a migration in 'RegisterServices' of each module whose state changes."
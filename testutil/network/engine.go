@@ -0,0 +1,394 @@
+package network
+
+import (
+	"context"
+	"encoding/binary"
+	"math/big"
+	"slices"
+	"sync"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/beacon/engine"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/ethereum/go-ethereum/trie"
+	"github.com/omni-network/omni/lib/errors"
+	"github.com/omni-network/omni/lib/ethclient"
+)
+
+const (
+	// mockEngineAddress is the address reported by the mock execution engine.
+	mockEngineAddress = "mock://engine"
+
+	// genesisGasLimit is the gas limit of the execution blocks.
+	genesisGasLimit = 30_000_000
+)
+
+var _ ethclient.EngineClient = (*MockEngine)(nil)
+
+// MockEngine is an in-memory execution engine shared by all the nodes of a test network.
+//
+// It doesn't execute transactions. A payload built by ForkchoiceUpdatedV3 is an empty block on top of the head,
+// which contains the requested withdrawals and the EVM logs injected by InjectLogs since the last built block.
+// The logs are returned by FilterLogs once the block is known, just like the logs emitted by the entrypoint
+// contracts of a real execution client.
+//
+// Only the Engine API and the methods used by the app are implemented. The other methods panic.
+type MockEngine struct {
+	ethclient.EngineClient
+
+	genesis common.Hash
+
+	mu       sync.Mutex
+	blocks   map[common.Hash]*types.Block
+	logs     map[common.Hash][]types.Log
+	head     *types.Block
+	payloads map[engine.PayloadID]*types.Block
+	pending  []types.Log
+}
+
+// NewMockEngine returns a mock execution engine with a genesis block.
+func NewMockEngine() *MockEngine {
+	genesis := types.NewBlockWithHeader(&types.Header{
+		UncleHash:       types.EmptyUncleHash,
+		TxHash:          types.EmptyTxsHash,
+		ReceiptHash:     types.EmptyReceiptsHash,
+		Difficulty:      common.Big0,
+		Number:          common.Big0,
+		GasLimit:        genesisGasLimit,
+		BaseFee:         big.NewInt(params.InitialBaseFee),
+		WithdrawalsHash: &types.EmptyWithdrawalsHash,
+		BlobGasUsed:     new(uint64),
+		ExcessBlobGas:   new(uint64),
+	}).WithBody(types.Body{Withdrawals: types.Withdrawals{}})
+
+	return &MockEngine{
+		genesis:  genesis.Hash(),
+		blocks:   map[common.Hash]*types.Block{genesis.Hash(): genesis},
+		logs:     make(map[common.Hash][]types.Log),
+		head:     genesis,
+		payloads: make(map[engine.PayloadID]*types.Block),
+	}
+}
+
+// GenesisHash returns the hash of the genesis block, which is the execution block hash of the x/evmengine genesis.
+func (m *MockEngine) GenesisHash() common.Hash {
+	return m.genesis
+}
+
+// InjectLogs queues the EVM logs to be included in the next built block.
+// Only the address, topics and data of the logs are used.
+func (m *MockEngine) InjectLogs(logs ...types.Log) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.pending = append(m.pending, logs...)
+}
+
+// Head returns the head block, which is the last block finalized by the network.
+func (m *MockEngine) Head() *types.Block {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return m.head
+}
+
+// Withdrawals returns all the withdrawals executed in the canonical chain in order.
+func (m *MockEngine) Withdrawals() []*types.Withdrawal {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	var withdrawals []*types.Withdrawal
+	for b := m.head; b != nil; b = m.blocks[b.ParentHash()] {
+		withdrawals = append(slices.Clone(b.Withdrawals()), withdrawals...)
+		if b.NumberU64() == 0 {
+			break
+		}
+	}
+
+	return withdrawals
+}
+
+// ForkchoiceUpdatedV3 sets the head and builds a payload on top of it if the payload attributes are given.
+func (m *MockEngine) ForkchoiceUpdatedV3(_ context.Context, update engine.ForkchoiceStateV1,
+	payloadAttributes *engine.PayloadAttributes,
+) (engine.ForkChoiceResponse, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	head, ok := m.blocks[update.HeadBlockHash]
+	if !ok {
+		return engine.ForkChoiceResponse{PayloadStatus: invalidStatus("unknown head")}, nil
+	}
+
+	// The head only moves forward, since the nodes replaying the chain update the forkchoice to old blocks.
+	if head.NumberU64() > m.head.NumberU64() {
+		m.head = head
+		// The injected logs are consumed once a block including them is finalized.
+		if len(m.logs[head.Hash()]) > 0 {
+			m.pending = m.pending[len(m.logs[head.Hash()]):]
+		}
+	}
+
+	headHash := head.Hash()
+	resp := engine.ForkChoiceResponse{
+		PayloadStatus: engine.PayloadStatusV1{Status: engine.VALID, LatestValidHash: &headHash},
+	}
+	if payloadAttributes == nil {
+		return resp, nil
+	}
+
+	block, logs, err := m.build(head, payloadAttributes)
+	if err != nil {
+		return engine.ForkChoiceResponse{}, err
+	}
+
+	id := payloadID(block.Hash())
+	m.payloads[id] = block
+	m.blocks[block.Hash()] = block
+	m.logs[block.Hash()] = logs
+	resp.PayloadID = &id
+
+	return resp, nil
+}
+
+// build builds an empty block on top of the parent with the pending logs.
+func (m *MockEngine) build(parent *types.Block, attrs *engine.PayloadAttributes) (*types.Block, []types.Log, error) {
+	if attrs.BeaconRoot == nil {
+		return nil, nil, errors.New("missing beacon root")
+	}
+
+	withdrawals := types.Withdrawals(attrs.Withdrawals)
+	if withdrawals == nil {
+		withdrawals = types.Withdrawals{}
+	}
+	withdrawalsHash := types.DeriveSha(withdrawals, trie.NewStackTrie(nil))
+	requestsHash := types.CalcRequestsHash(nil)
+
+	number := parent.NumberU64() + 1
+	logs := make([]types.Log, 0, len(m.pending))
+	for i, l := range m.pending {
+		logs = append(logs, types.Log{
+			Address:     l.Address,
+			Topics:      l.Topics,
+			Data:        l.Data,
+			BlockNumber: number,
+			TxHash:      txHash(number, uint(i)),
+			TxIndex:     uint(i),
+			Index:       uint(i),
+		})
+	}
+
+	// The receipts root commits to the logs, so that blocks with different logs have different hashes.
+	receiptHash := types.EmptyReceiptsHash
+	if len(logs) > 0 {
+		enc, err := rlp.EncodeToBytes(logs)
+		if err != nil {
+			return nil, nil, errors.Wrap(err, "encode logs")
+		}
+		receiptHash = crypto.Keccak256Hash(enc)
+	}
+
+	block := types.NewBlockWithHeader(&types.Header{
+		ParentHash:       parent.Hash(),
+		UncleHash:        types.EmptyUncleHash,
+		Coinbase:         attrs.SuggestedFeeRecipient,
+		Root:             parent.Root(),
+		TxHash:           types.EmptyTxsHash,
+		ReceiptHash:      receiptHash,
+		Difficulty:       common.Big0,
+		Number:           new(big.Int).SetUint64(number),
+		GasLimit:         parent.GasLimit(),
+		Time:             attrs.Timestamp,
+		BaseFee:          parent.BaseFee(),
+		MixDigest:        attrs.Random,
+		WithdrawalsHash:  &withdrawalsHash,
+		BlobGasUsed:      new(uint64),
+		ExcessBlobGas:    new(uint64),
+		ParentBeaconRoot: attrs.BeaconRoot,
+		RequestsHash:     &requestsHash,
+	}).WithBody(types.Body{Withdrawals: withdrawals})
+
+	for i := range logs {
+		logs[i].BlockHash = block.Hash()
+	}
+
+	return block, logs, nil
+}
+
+// GetPayloadV4 returns a payload built by ForkchoiceUpdatedV3.
+func (m *MockEngine) GetPayloadV4(_ context.Context, payloadID engine.PayloadID) (*engine.ExecutionPayloadEnvelope, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	block, ok := m.payloads[payloadID]
+	if !ok {
+		return nil, engine.UnknownPayload
+	}
+
+	return engine.BlockToExecutableData(block, common.Big0, nil, nil), nil
+}
+
+// NewPayloadV4 verifies the payload against its parent and stores it.
+func (m *MockEngine) NewPayloadV4(_ context.Context, params engine.ExecutableData, versionedHashes []common.Hash,
+	beaconRoot *common.Hash, executionRequests []hexutil.Bytes,
+) (engine.PayloadStatusV1, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	requests := make([][]byte, 0, len(executionRequests))
+	for _, r := range executionRequests {
+		requests = append(requests, r)
+	}
+
+	block, err := engine.ExecutableDataToBlock(params, versionedHashes, beaconRoot, requests)
+	if err != nil {
+		return invalidStatus(err.Error()), nil
+	}
+
+	parent, ok := m.blocks[block.ParentHash()]
+	if !ok {
+		return invalidStatus("unknown parent"), nil
+	} else if block.NumberU64() != parent.NumberU64()+1 {
+		return invalidStatus("invalid number"), nil
+	} else if block.Time() <= parent.Time() {
+		return invalidStatus("invalid timestamp"), nil
+	}
+
+	// A payload not built by this engine has no logs.
+	if _, ok := m.blocks[block.Hash()]; !ok {
+		m.blocks[block.Hash()] = block
+	}
+
+	hash := block.Hash()
+
+	return engine.PayloadStatusV1{Status: engine.VALID, LatestValidHash: &hash}, nil
+}
+
+// FilterLogs returns the logs of the block matching the query. Only queries by block hash are supported.
+func (m *MockEngine) FilterLogs(_ context.Context, q ethereum.FilterQuery) ([]types.Log, error) {
+	if q.BlockHash == nil {
+		return nil, errors.New("only queries by block hash are supported")
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if _, ok := m.blocks[*q.BlockHash]; !ok {
+		return nil, errors.New("unknown block")
+	}
+
+	var logs []types.Log
+	for _, l := range m.logs[*q.BlockHash] {
+		if matchLog(l, q) {
+			logs = append(logs, l)
+		}
+	}
+
+	return logs, nil
+}
+
+func matchLog(l types.Log, q ethereum.FilterQuery) bool {
+	if len(q.Addresses) > 0 && !slices.Contains(q.Addresses, l.Address) {
+		return false
+	}
+
+	for i, topics := range q.Topics {
+		if len(topics) == 0 {
+			continue
+		}
+		if i >= len(l.Topics) || !slices.Contains(topics, l.Topics[i]) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// BlockNumber returns the number of the head block.
+func (m *MockEngine) BlockNumber(context.Context) (uint64, error) {
+	return m.Head().NumberU64(), nil
+}
+
+// BlockByNumber returns the canonical block by number, or the head block if the number is nil.
+func (m *MockEngine) BlockByNumber(_ context.Context, number *big.Int) (*types.Block, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	for b := m.head; b != nil; b = m.blocks[b.ParentHash()] {
+		if number == nil || b.NumberU64() == number.Uint64() {
+			return b, nil
+		}
+		if b.NumberU64() == 0 {
+			break
+		}
+	}
+
+	return nil, ethereum.NotFound
+}
+
+// HeaderByNumber returns the canonical header by number, or the head header if the number is nil.
+func (m *MockEngine) HeaderByNumber(ctx context.Context, number *big.Int) (*types.Header, error) {
+	block, err := m.BlockByNumber(ctx, number)
+	if err != nil {
+		return nil, err
+	}
+
+	return block.Header(), nil
+}
+
+// HeaderByHash returns the header of a known block.
+func (m *MockEngine) HeaderByHash(_ context.Context, hash common.Hash) (*types.Header, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	block, ok := m.blocks[hash]
+	if !ok {
+		return nil, ethereum.NotFound
+	}
+
+	return block.Header(), nil
+}
+
+// HeaderByType returns the head header for all the types, since the head is always final.
+func (m *MockEngine) HeaderByType(ctx context.Context, _ ethclient.HeadType) (*types.Header, error) {
+	return m.HeaderByNumber(ctx, nil)
+}
+
+// ProgressIfSyncing returns that the engine is never syncing.
+func (*MockEngine) ProgressIfSyncing(context.Context) (*ethereum.SyncProgress, bool, error) {
+	return nil, false, nil
+}
+
+// Address returns the address of the mock engine.
+func (*MockEngine) Address() string {
+	return mockEngineAddress
+}
+
+// Close does nothing.
+func (*MockEngine) Close() {}
+
+func invalidStatus(reason string) engine.PayloadStatusV1 {
+	return engine.PayloadStatusV1{Status: engine.INVALID, ValidationError: &reason}
+}
+
+// payloadID derives a deterministic payload ID from the block hash.
+func payloadID(hash common.Hash) engine.PayloadID {
+	var id engine.PayloadID
+	copy(id[:], hash[:])
+
+	return id
+}
+
+// txHash derives a deterministic transaction hash for a log, since the mock engine doesn't execute transactions.
+func txHash(number uint64, index uint) common.Hash {
+	var b [16]byte
+	binary.BigEndian.PutUint64(b[:8], number)
+	binary.BigEndian.PutUint64(b[8:], uint64(index))
+
+	return crypto.Keccak256Hash(b[:])
+}
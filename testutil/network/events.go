@@ -0,0 +1,64 @@
+package network
+
+import (
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/omni-network/omni/lib/errors"
+
+	evmvalkeeper "github.com/mitosis-org/chain/x/evmvalidator/keeper"
+)
+
+// The following methods inject the events of the validator entrypoint contract,
+// which are delivered to x/evmvalidator in the next block.
+
+// InjectRegisterValidator injects a MsgRegisterValidator event.
+func (n *Network) InjectRegisterValidator(valAddr common.Address, pubkey []byte, owner common.Address, collateralGwei uint64) error {
+	return n.injectEvent(evmvalkeeper.EventMsgRegisterValidator, valAddr, pubkey, owner, new(big.Int).SetUint64(collateralGwei))
+}
+
+// InjectDepositCollateral injects a MsgDepositCollateral event.
+func (n *Network) InjectDepositCollateral(valAddr, owner common.Address, amountGwei uint64) error {
+	return n.injectEvent(evmvalkeeper.EventMsgDepositCollateral, valAddr, owner, new(big.Int).SetUint64(amountGwei))
+}
+
+// InjectWithdrawCollateral injects a MsgWithdrawCollateral event.
+func (n *Network) InjectWithdrawCollateral(valAddr, owner, receiver common.Address, amountGwei uint64, maturesAt time.Time) error {
+	return n.injectEvent(evmvalkeeper.EventMsgWithdrawCollateral,
+		valAddr, owner, receiver, new(big.Int).SetUint64(amountGwei), big.NewInt(maturesAt.Unix()))
+}
+
+// InjectTransferCollateralOwnership injects a MsgTransferCollateralOwnership event.
+func (n *Network) InjectTransferCollateralOwnership(valAddr, prevOwner, newOwner common.Address) error {
+	return n.injectEvent(evmvalkeeper.EventMsgTransferCollateralOwnership, valAddr, prevOwner, newOwner)
+}
+
+// InjectUnjail injects a MsgUnjail event.
+func (n *Network) InjectUnjail(valAddr common.Address) error {
+	return n.injectEvent(evmvalkeeper.EventMsgUnjail, valAddr)
+}
+
+// InjectUpdateExtraVotingPower injects a MsgUpdateExtraVotingPower event.
+func (n *Network) InjectUpdateExtraVotingPower(valAddr common.Address, extraVotingPowerWei *big.Int) error {
+	return n.injectEvent(evmvalkeeper.EventMsgUpdateExtraVotingPower, valAddr, extraVotingPowerWei)
+}
+
+// injectEvent injects a log of the event emitted by the validator entrypoint contract.
+// All the arguments of the entrypoint events are non-indexed.
+func (n *Network) injectEvent(event abi.Event, args ...any) error {
+	data, err := event.Inputs.Pack(args...)
+	if err != nil {
+		return errors.Wrap(err, "pack "+event.Name)
+	}
+
+	n.Engine.InjectLogs(types.Log{
+		Address: n.cfg.ValidatorEntrypointContractAddr,
+		Topics:  []common.Hash{event.ID},
+		Data:    data,
+	})
+
+	return nil
+}
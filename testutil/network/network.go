@@ -0,0 +1,527 @@
+// Package network provides an in-process test network of MitosisApp validators on a mock execution engine.
+//
+// The network drives the ABCI methods of all the nodes the way CometBFT does, without networking and
+// with a single round per height: the proposer prepares a proposal, all the nodes process it, and then
+// all the nodes finalize and commit it. The validator set is tracked from the validator updates, so
+// validator set transitions, withdrawals and slashing can be asserted end-to-end in `go test`.
+package network
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"time"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/math"
+	abci "github.com/cometbft/cometbft/abci/types"
+	cryptoenc "github.com/cometbft/cometbft/crypto/encoding"
+	"github.com/cometbft/cometbft/crypto/secp256k1"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	cmttypes "github.com/cometbft/cometbft/types"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/omni-network/omni/lib/errors"
+
+	"github.com/mitosis-org/chain/app"
+	mitotypes "github.com/mitosis-org/chain/types"
+	evmvaltypes "github.com/mitosis-org/chain/x/evmvalidator/types"
+	evmengtypes "github.com/omni-network/omni/octane/evmengine/types"
+)
+
+// Config is the configuration of a test network.
+type Config struct {
+	ChainID string
+	// NumValidators is the number of the genesis validators, each of which runs a node.
+	NumValidators int
+	// CollateralGwei is the collateral of each genesis validator.
+	CollateralGwei uint64
+	GenesisTime    time.Time
+	// BlockInterval is the time between the blocks.
+	BlockInterval time.Duration
+	// ValidatorEntrypointContractAddr is the address of the validator entrypoint contract emitting the injected events.
+	ValidatorEntrypointContractAddr common.Address
+	// ModifyGenesis modifies the app genesis state before the chain is initialized, e.g. to change module params.
+	ModifyGenesis func(cdc codec.Codec, genesis map[string]json.RawMessage) error
+	Logger        log.Logger
+}
+
+// DefaultConfig returns the default configuration of a test network with four validators.
+func DefaultConfig() Config {
+	return Config{
+		ChainID:                         "mitosis-testnet",
+		NumValidators:                   4,
+		CollateralGwei:                  1_000 * evmvaltypes.VotingPowerReduction.Uint64(),
+		GenesisTime:                     time.Unix(1_700_000_000, 0).UTC(),
+		BlockInterval:                   time.Second,
+		ValidatorEntrypointContractAddr: common.HexToAddress("0x00000000000000000000000000000000000000ee"),
+		Logger:                          log.NewNopLogger(),
+	}
+}
+
+// Node is a node of a test network.
+type Node struct {
+	App     *app.MitosisApp
+	PrivKey secp256k1.PrivKey
+	// ConsAddr is the consensus address of the node.
+	ConsAddr sdk.ConsAddress
+	// EthAddr is the validator address of the node, which is also used as the fee recipient.
+	EthAddr common.Address
+
+	signing bool
+}
+
+// PubKey returns the compressed secp256k1 public key of the node.
+func (n *Node) PubKey() []byte {
+	return n.PrivKey.PubKey().Bytes()
+}
+
+// Context returns a context to query the committed state of the node.
+func (n *Node) Context() sdk.Context {
+	return n.App.NewUncachedContext(false, cmtproto.Header{
+		ChainID: n.App.ChainID(),
+		Height:  n.App.LastBlockHeight(),
+	})
+}
+
+// Network is an in-process test network.
+type Network struct {
+	cfg    Config
+	Engine *MockEngine
+	Nodes  []*Node
+
+	initReq   *abci.RequestInitChain
+	finalized []*abci.RequestFinalizeBlock
+	appHashes [][]byte
+
+	height      int64
+	time        time.Time
+	valsets     map[int64][]abci.Validator
+	lastCommit  abci.CommitInfo
+	misbehavior []abci.Misbehavior
+}
+
+// New creates a test network and initializes the chain with the genesis validators.
+func New(cfg Config) (*Network, error) {
+	if cfg.NumValidators < 1 {
+		return nil, errors.New("at least one validator is required")
+	}
+
+	app.SetupConfig()
+
+	n := &Network{
+		cfg:     cfg,
+		Engine:  NewMockEngine(),
+		time:    cfg.GenesisTime,
+		valsets: make(map[int64][]abci.Validator),
+	}
+
+	for i := 0; i < cfg.NumValidators; i++ {
+		node, err := n.newNode()
+		if err != nil {
+			return nil, err
+		}
+		n.Nodes = append(n.Nodes, node)
+	}
+
+	appState, err := n.genesisState()
+	if err != nil {
+		return nil, err
+	}
+
+	consParams := cmttypes.DefaultConsensusParams()
+	consParams.Block.MaxBytes = -1 // Required by PrepareProposal of x/evmengine.
+	consParams.Validator.PubKeyTypes = []string{cmttypes.ABCIPubKeyTypeSecp256k1}
+	consParamsProto := consParams.ToProto()
+
+	n.initReq = &abci.RequestInitChain{
+		Time:            cfg.GenesisTime,
+		ChainId:         cfg.ChainID,
+		ConsensusParams: &consParamsProto,
+		AppStateBytes:   appState,
+		InitialHeight:   1,
+	}
+
+	var updates []abci.ValidatorUpdate
+	for _, node := range n.Nodes {
+		res, err := node.App.InitChain(n.initReq)
+		if err != nil {
+			return nil, errors.Wrap(err, "init chain")
+		}
+		updates = res.Validators
+	}
+
+	valset, err := applyValidatorUpdates(nil, updates)
+	if err != nil {
+		return nil, err
+	}
+	n.valsets[1] = valset
+	n.valsets[2] = valset
+
+	return n, nil
+}
+
+// newNode creates a node with a deterministic key and an empty state.
+func (n *Network) newNode() (*Node, error) {
+	privKey := secp256k1.GenPrivKeySecp256k1([]byte(fmt.Sprintf("%s-node-%d", n.cfg.ChainID, len(n.Nodes))))
+
+	ethAddr, err := evmvaltypes.PubkeyToEthAddress(privKey.PubKey().Bytes())
+	if err != nil {
+		return nil, errors.Wrap(err, "pubkey to eth address")
+	}
+	consAddr := sdk.ConsAddress(privKey.PubKey().Address())
+
+	mitosisApp, err := app.NewMitosisApp(
+		n.cfg.Logger.With("node", len(n.Nodes)),
+		dbm.NewMemDB(),
+		nil,
+		n.Engine,
+		app.ValidatorAddressProvider{Addr: ethAddr.Address(), ConsAddr: consAddr},
+		0,
+		false,
+		mitotypes.EthAddress{},
+		app.DefaultProcessProposalConfig(),
+		true,
+		app.EmptyAppOptions{},
+		baseapp.SetChainID(n.cfg.ChainID),
+	)
+	if err != nil {
+		return nil, errors.Wrap(err, "new app")
+	}
+
+	return &Node{
+		App:      mitosisApp,
+		PrivKey:  privKey,
+		ConsAddr: consAddr,
+		EthAddr:  ethAddr.Address(),
+		signing:  true,
+	}, nil
+}
+
+// genesisState returns the default app genesis state with the genesis validators and the execution genesis block.
+func (n *Network) genesisState() ([]byte, error) {
+	mitosisApp := n.Nodes[0].App
+	cdc := mitosisApp.AppCodec()
+	genesis := mitosisApp.DefaultGenesis()
+
+	evmvalGenesis := evmvaltypes.DefaultGenesisState()
+	evmvalGenesis.ValidatorEntrypointContractAddr = mitotypes.EthAddress(n.cfg.ValidatorEntrypointContractAddr)
+	for _, node := range n.Nodes {
+		valAddr := mitotypes.EthAddress(node.EthAddr)
+		evmvalGenesis.Validators = append(evmvalGenesis.Validators, evmvaltypes.Validator{
+			Addr:             valAddr,
+			Pubkey:           node.PubKey(),
+			Collateral:       math.NewUint(n.cfg.CollateralGwei),
+			CollateralShares: math.NewUint(0),
+			ExtraVotingPower: math.NewUint(0),
+		})
+		evmvalGenesis.CollateralOwnerships = append(evmvalGenesis.CollateralOwnerships, evmvaltypes.CollateralOwnership{
+			ValAddr: valAddr,
+			Owner:   valAddr,
+			Shares:  math.NewUint(0),
+		})
+	}
+	if err := evmvalGenesis.Validate(); err != nil {
+		return nil, errors.Wrap(err, "validate evmvalidator genesis")
+	}
+	genesis[evmvaltypes.ModuleName] = cdc.MustMarshalJSON(evmvalGenesis)
+	genesis[evmengtypes.ModuleName] = cdc.MustMarshalJSON(evmengtypes.NewGenesisState(n.Engine.GenesisHash()))
+
+	if n.cfg.ModifyGenesis != nil {
+		if err := n.cfg.ModifyGenesis(cdc, genesis); err != nil {
+			return nil, errors.Wrap(err, "modify genesis")
+		}
+	}
+
+	appState, err := json.Marshal(genesis)
+	if err != nil {
+		return nil, errors.Wrap(err, "marshal genesis")
+	}
+
+	return appState, nil
+}
+
+// AddNode adds a node which is not a validator, and syncs it by replaying all the finalized blocks.
+// It becomes a validator once it's registered by InjectRegisterValidator.
+func (n *Network) AddNode() (*Node, error) {
+	node, err := n.newNode()
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := node.App.InitChain(n.initReq); err != nil {
+		return nil, errors.Wrap(err, "init chain")
+	}
+
+	for i, req := range n.finalized {
+		res, err := node.App.FinalizeBlock(req)
+		if err != nil {
+			return nil, errors.Wrap(err, fmt.Sprintf("finalize block %d", req.Height))
+		} else if !bytes.Equal(res.AppHash, n.appHashes[i]) {
+			return nil, errors.New(fmt.Sprintf("app hash mismatch at height %d", req.Height))
+		} else if _, err := node.App.Commit(); err != nil {
+			return nil, errors.Wrap(err, fmt.Sprintf("commit block %d", req.Height))
+		}
+	}
+
+	n.Nodes = append(n.Nodes, node)
+
+	return node, nil
+}
+
+// Height returns the height of the last committed block.
+func (n *Network) Height() int64 {
+	return n.height
+}
+
+// Time returns the time of the next block.
+func (n *Network) Time() time.Time {
+	return n.time
+}
+
+// AdvanceTime delays the next block by the given duration.
+func (n *Network) AdvanceTime(d time.Duration) {
+	n.time = n.time.Add(d)
+}
+
+// Validators returns the validator set of the next block.
+func (n *Network) Validators() []abci.Validator {
+	return slices.Clone(n.valsets[n.height+1])
+}
+
+// IsValidator returns true if the node is in the validator set of the next block.
+func (n *Network) IsValidator(node *Node) bool {
+	return slices.ContainsFunc(n.valsets[n.height+1], func(v abci.Validator) bool {
+		return bytes.Equal(v.Address, node.ConsAddr)
+	})
+}
+
+// StopSigning makes the node miss its votes, e.g. to get it jailed for downtime.
+// The node still follows the chain.
+func (n *Network) StopSigning(node *Node) {
+	node.signing = false
+}
+
+// StartSigning makes the node sign its votes again.
+func (n *Network) StartSigning(node *Node) {
+	node.signing = true
+}
+
+// ReportDoubleSign reports the duplicate vote evidence of the node at the last height in the next block.
+func (n *Network) ReportDoubleSign(node *Node) error {
+	valset := n.valsets[n.height]
+	idx := slices.IndexFunc(valset, func(v abci.Validator) bool {
+		return bytes.Equal(v.Address, node.ConsAddr)
+	})
+	if idx < 0 {
+		return errors.New(fmt.Sprintf("node %X is not a validator at height %d", node.ConsAddr, n.height))
+	}
+
+	var totalPower int64
+	for _, v := range valset {
+		totalPower += v.Power
+	}
+
+	n.misbehavior = append(n.misbehavior, abci.Misbehavior{
+		Type:             abci.MisbehaviorType_DUPLICATE_VOTE,
+		Validator:        valset[idx],
+		Height:           n.height,
+		Time:             n.finalized[len(n.finalized)-1].Time,
+		TotalVotingPower: totalPower,
+	})
+
+	return nil
+}
+
+// ProduceBlocks produces the given number of blocks.
+func (n *Network) ProduceBlocks(count int) error {
+	for i := 0; i < count; i++ {
+		if err := n.ProduceBlock(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ProduceBlock produces a block proposed by the next proposer and commits it on all the nodes.
+func (n *Network) ProduceBlock() error {
+	height := n.height + 1
+	blockTime := n.time
+
+	proposer := n.proposer(height)
+	if proposer == nil {
+		return errors.New(fmt.Sprintf("no signing validator to propose block %d", height))
+	}
+
+	prepRes, err := proposer.App.PrepareProposal(&abci.RequestPrepareProposal{
+		MaxTxBytes:      cmttypes.MaxBlockSizeBytes,
+		LocalLastCommit: extendedCommitInfo(n.lastCommit),
+		Misbehavior:     n.misbehavior,
+		Height:          height,
+		Time:            blockTime,
+		ProposerAddress: proposer.ConsAddr,
+	})
+	if err != nil {
+		return errors.Wrap(err, fmt.Sprintf("prepare proposal %d", height))
+	}
+	hash := blockHash(height, prepRes.Txs)
+
+	for i, node := range n.Nodes {
+		res, err := node.App.ProcessProposal(&abci.RequestProcessProposal{
+			Txs:                prepRes.Txs,
+			ProposedLastCommit: n.lastCommit,
+			Misbehavior:        n.misbehavior,
+			Hash:               hash,
+			Height:             height,
+			Time:               blockTime,
+			ProposerAddress:    proposer.ConsAddr,
+		})
+		if err != nil {
+			return errors.Wrap(err, fmt.Sprintf("process proposal %d on node %d", height, i))
+		} else if res.Status != abci.ResponseProcessProposal_ACCEPT {
+			return errors.New(fmt.Sprintf("proposal %d rejected by node %d", height, i))
+		}
+	}
+
+	req := &abci.RequestFinalizeBlock{
+		Txs:               prepRes.Txs,
+		DecidedLastCommit: n.lastCommit,
+		Misbehavior:       n.misbehavior,
+		Hash:              hash,
+		Height:            height,
+		Time:              blockTime,
+		ProposerAddress:   proposer.ConsAddr,
+	}
+
+	var res *abci.ResponseFinalizeBlock
+	for i, node := range n.Nodes {
+		nodeRes, err := node.App.FinalizeBlock(req)
+		if err != nil {
+			return errors.Wrap(err, fmt.Sprintf("finalize block %d on node %d", height, i))
+		}
+		for _, txRes := range nodeRes.TxResults {
+			if txRes.Code != abci.CodeTypeOK {
+				return errors.New(fmt.Sprintf("tx failed in block %d on node %d: %s", height, i, txRes.Log))
+			}
+		}
+		if res != nil && !bytes.Equal(res.AppHash, nodeRes.AppHash) {
+			return errors.New(fmt.Sprintf("app hash mismatch at height %d on node %d", height, i))
+		}
+		res = nodeRes
+
+		if _, err := node.App.Commit(); err != nil {
+			return errors.Wrap(err, fmt.Sprintf("commit block %d on node %d", height, i))
+		}
+	}
+
+	// The validator updates of a block take effect two blocks later.
+	next, err := applyValidatorUpdates(n.valsets[height+1], res.ValidatorUpdates)
+	if err != nil {
+		return err
+	}
+	n.valsets[height+2] = next
+
+	n.lastCommit = n.commitInfo(height)
+	n.finalized = append(n.finalized, req)
+	n.appHashes = append(n.appHashes, res.AppHash)
+	n.misbehavior = nil
+	n.height = height
+	n.time = blockTime.Add(n.cfg.BlockInterval)
+
+	return nil
+}
+
+// proposer returns the proposer of the height, rotating over the signing validators in the validator set.
+func (n *Network) proposer(height int64) *Node {
+	var candidates []*Node
+	for _, v := range n.valsets[height] {
+		if node := n.nodeByConsAddr(v.Address); node != nil && node.signing {
+			candidates = append(candidates, node)
+		}
+	}
+	if len(candidates) == 0 {
+		return nil
+	}
+
+	return candidates[height%int64(len(candidates))]
+}
+
+// commitInfo returns the votes of the validators at the height.
+func (n *Network) commitInfo(height int64) abci.CommitInfo {
+	var votes []abci.VoteInfo
+	for _, v := range n.valsets[height] {
+		flag := cmtproto.BlockIDFlagAbsent
+		if node := n.nodeByConsAddr(v.Address); node != nil && node.signing {
+			flag = cmtproto.BlockIDFlagCommit
+		}
+		votes = append(votes, abci.VoteInfo{Validator: v, BlockIdFlag: flag})
+	}
+
+	return abci.CommitInfo{Votes: votes}
+}
+
+func (n *Network) nodeByConsAddr(addr []byte) *Node {
+	for _, node := range n.Nodes {
+		if bytes.Equal(node.ConsAddr, addr) {
+			return node
+		}
+	}
+
+	return nil
+}
+
+func extendedCommitInfo(info abci.CommitInfo) abci.ExtendedCommitInfo {
+	votes := make([]abci.ExtendedVoteInfo, 0, len(info.Votes))
+	for _, v := range info.Votes {
+		votes = append(votes, abci.ExtendedVoteInfo{Validator: v.Validator, BlockIdFlag: v.BlockIdFlag})
+	}
+
+	return abci.ExtendedCommitInfo{Round: info.Round, Votes: votes}
+}
+
+// applyValidatorUpdates returns the validator set with the updates applied, ordered by power and address.
+func applyValidatorUpdates(valset []abci.Validator, updates []abci.ValidatorUpdate) ([]abci.Validator, error) {
+	next := slices.Clone(valset)
+	for _, u := range updates {
+		pubkey, err := cryptoenc.PubKeyFromProto(u.PubKey)
+		if err != nil {
+			return nil, errors.Wrap(err, "validator update pubkey")
+		}
+		addr := pubkey.Address()
+
+		next = slices.DeleteFunc(next, func(v abci.Validator) bool {
+			return bytes.Equal(v.Address, addr)
+		})
+		if u.Power > 0 {
+			next = append(next, abci.Validator{Address: addr, Power: u.Power})
+		}
+	}
+
+	slices.SortFunc(next, func(a, b abci.Validator) int {
+		if a.Power != b.Power {
+			return int(b.Power - a.Power)
+		}
+
+		return bytes.Compare(a.Address, b.Address)
+	})
+
+	return next, nil
+}
+
+// blockHash returns a deterministic hash of the block, since there are no real block headers.
+func blockHash(height int64, txs [][]byte) []byte {
+	h := sha256.New()
+	_ = binary.Write(h, binary.BigEndian, height)
+	for _, tx := range txs {
+		h.Write(tx)
+	}
+
+	return h.Sum(nil)
+}
@@ -0,0 +1,161 @@
+package network_test
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/ethereum/go-ethereum"
+	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mitosis-org/chain/testutil/network"
+	mitotypes "github.com/mitosis-org/chain/types"
+	evmvaltypes "github.com/mitosis-org/chain/x/evmvalidator/types"
+)
+
+func newNetwork(t *testing.T, cfg network.Config) *network.Network {
+	t.Helper()
+
+	net, err := network.New(cfg)
+	require.NoError(t, err)
+
+	// The first block is always empty, so the injected events are delivered from the second block.
+	require.NoError(t, net.ProduceBlock())
+
+	return net
+}
+
+func getValidator(t *testing.T, node *network.Node, valAddr common.Address) evmvaltypes.Validator {
+	t.Helper()
+
+	validator, found := node.App.EVMValKeeper.GetValidator(node.Context(), mitotypes.EthAddress(valAddr))
+	require.True(t, found)
+
+	return validator
+}
+
+func TestNetwork_RegisterValidator(t *testing.T) {
+	cfg := network.DefaultConfig()
+	net := newNetwork(t, cfg)
+	require.Len(t, net.Validators(), cfg.NumValidators)
+
+	node, err := net.AddNode()
+	require.NoError(t, err)
+	require.False(t, net.IsValidator(node))
+
+	require.NoError(t, net.InjectRegisterValidator(node.EthAddr, node.PubKey(), node.EthAddr, cfg.CollateralGwei))
+	require.NoError(t, net.ProduceBlock())
+	require.Equal(t, node.EthAddr, getValidator(t, net.Nodes[0], node.EthAddr).Addr.Address())
+
+	// The receipt of the event is recorded by the tx hash and the log index of the event log.
+	head := net.Engine.Head().Hash()
+	logs, err := net.Engine.FilterLogs(context.Background(), ethereum.FilterQuery{BlockHash: &head})
+	require.NoError(t, err)
+	require.Len(t, logs, 1)
+	receipts := net.Nodes[0].App.EVMValKeeper.GetEventReceiptsByTxHash(net.Nodes[0].Context(), logs[0].TxHash)
+	require.Len(t, receipts, 1)
+	require.Equal(t, head.Hex(), receipts[0].EvmBlockHash)
+	require.Equal(t, uint64(logs[0].Index), receipts[0].LogIndex)
+	require.Equal(t, evmvaltypes.EventReceiptStatusApplied, receipts[0].Status)
+
+	// The validator update takes effect two blocks later.
+	require.False(t, net.IsValidator(node))
+	require.NoError(t, net.ProduceBlock())
+	require.True(t, net.IsValidator(node))
+	require.Len(t, net.Validators(), cfg.NumValidators+1)
+
+	// The new validator takes part in the consensus.
+	require.NoError(t, net.ProduceBlocks(2*len(net.Nodes)))
+}
+
+func TestNetwork_Withdrawal(t *testing.T) {
+	cfg := network.DefaultConfig()
+	net := newNetwork(t, cfg)
+
+	val := net.Nodes[0]
+	receiver := common.HexToAddress("0x1111111111111111111111111111111111111111")
+	amountGwei := 10 * evmvaltypes.VotingPowerReduction.Uint64()
+	maturesAt := net.Time().Add(5 * time.Second)
+
+	require.NoError(t, net.InjectWithdrawCollateral(val.EthAddr, val.EthAddr, receiver, amountGwei, maturesAt))
+	require.NoError(t, net.ProduceBlock())
+	require.Equal(t, math.NewUint(cfg.CollateralGwei-amountGwei), getValidator(t, val, val.EthAddr).Collateral)
+	require.Empty(t, net.Engine.Withdrawals())
+
+	// The matured withdrawal is included in the execution payload of the next block.
+	for net.Time().Before(maturesAt.Add(2 * cfg.BlockInterval)) {
+		require.NoError(t, net.ProduceBlock())
+	}
+
+	withdrawals := net.Engine.Withdrawals()
+	require.Len(t, withdrawals, 1)
+	require.Equal(t, receiver, withdrawals[0].Address)
+	require.Equal(t, amountGwei, withdrawals[0].Amount)
+}
+
+func TestNetwork_DowntimeSlashing(t *testing.T) {
+	const jailDuration = time.Minute
+
+	cfg := network.DefaultConfig()
+	cfg.ModifyGenesis = func(cdc codec.Codec, genesis map[string]json.RawMessage) error {
+		var slashingGenesis slashingtypes.GenesisState
+		if err := cdc.UnmarshalJSON(genesis[slashingtypes.ModuleName], &slashingGenesis); err != nil {
+			return err
+		}
+
+		slashingGenesis.Params.SignedBlocksWindow = 10
+		slashingGenesis.Params.MinSignedPerWindow = math.LegacyNewDecWithPrec(5, 1)
+		slashingGenesis.Params.DowntimeJailDuration = jailDuration
+		slashingGenesis.Params.SlashFractionDowntime = math.LegacyNewDecWithPrec(1, 2)
+
+		bz, err := cdc.MarshalJSON(&slashingGenesis)
+		genesis[slashingtypes.ModuleName] = bz
+
+		return err
+	}
+	net := newNetwork(t, cfg)
+
+	val := net.Nodes[len(net.Nodes)-1]
+	net.StopSigning(val)
+	for net.IsValidator(val) {
+		require.Less(t, net.Height(), int64(30), "validator not jailed")
+		require.NoError(t, net.ProduceBlock())
+	}
+	require.Len(t, net.Validators(), cfg.NumValidators-1)
+
+	validator := getValidator(t, val, val.EthAddr)
+	require.True(t, validator.Jailed)
+	require.True(t, validator.Collateral.LT(math.NewUint(cfg.CollateralGwei)))
+
+	// The validator is unjailed after the jail duration.
+	net.StartSigning(val)
+	net.AdvanceTime(jailDuration)
+	require.NoError(t, net.InjectUnjail(val.EthAddr))
+	require.NoError(t, net.ProduceBlocks(2))
+	require.False(t, getValidator(t, val, val.EthAddr).Jailed)
+	require.True(t, net.IsValidator(val))
+}
+
+func TestNetwork_DoubleSignSlashing(t *testing.T) {
+	cfg := network.DefaultConfig()
+	net := newNetwork(t, cfg)
+	require.NoError(t, net.ProduceBlock())
+
+	val := net.Nodes[1]
+	require.NoError(t, net.ReportDoubleSign(val))
+	require.NoError(t, net.ProduceBlocks(2))
+	require.False(t, net.IsValidator(val))
+
+	validator := getValidator(t, val, val.EthAddr)
+	require.True(t, validator.Jailed)
+	require.True(t, validator.Collateral.LT(math.NewUint(cfg.CollateralGwei)))
+
+	info, err := val.App.SlashingKeeper.GetValidatorSigningInfo(val.Context(), val.ConsAddr)
+	require.NoError(t, err)
+	require.True(t, info.Tombstoned)
+}
@@ -0,0 +1,283 @@
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/mitosis-org/chain/x/evmgov/types"
+	"github.com/spf13/cobra"
+)
+
+// GetQueryCmd returns the query commands for x/evmgov
+func GetQueryCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        "evmgov",
+		Short:                      "Querying commands for the evmgov module",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(
+		GetCmdQueryParams(),
+		GetCmdQueryGovEntrypointContractAddr(),
+		GetCmdQueryExecutionRecord(),
+		GetCmdQueryExecutionRecords(),
+		GetCmdQueryScheduledExecution(),
+		GetCmdQueryScheduledExecutions(),
+		GetCmdQuerySimulateExecute(),
+	)
+
+	return cmd
+}
+
+// GetCmdQueryParams implements the query params command.
+func GetCmdQueryParams() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "params",
+		Short: "Query the current evmgov parameters",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Params(cmd.Context(), &types.QueryParamsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryGovEntrypointContractAddr implements the query ConsensusGovernanceEntrypoint contract address command.
+func GetCmdQueryGovEntrypointContractAddr() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "gov-entrypoint-contract-addr",
+		Short: "Query the ConsensusGovernanceEntrypoint contract address",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.GovEntrypointContractAddr(cmd.Context(), &types.QueryGovEntrypointContractAddrRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryExecutionRecord implements the query execution record command.
+func GetCmdQueryExecutionRecord() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "execution-record [id]",
+		Short: "Query an execution record of the governance entrypoint by ID",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid execution record id: %s", args[0])
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.ExecutionRecord(cmd.Context(), &types.QueryExecutionRecordRequest{Id: id})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryExecutionRecords implements the query execution records command.
+func GetCmdQueryExecutionRecords() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "execution-records",
+		Short: "Query all execution records of the governance entrypoint",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.ExecutionRecords(cmd.Context(), &types.QueryExecutionRecordsRequest{Pagination: pageReq})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "execution-records")
+
+	return cmd
+}
+
+// GetCmdQueryScheduledExecution implements the query scheduled execution command.
+func GetCmdQueryScheduledExecution() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "scheduled-execution [id]",
+		Short: "Query a pending scheduled execution by ID",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid scheduled execution id: %s", args[0])
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.ScheduledExecution(cmd.Context(), &types.QueryScheduledExecutionRequest{Id: id})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryScheduledExecutions implements the query scheduled executions command.
+func GetCmdQueryScheduledExecutions() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "scheduled-executions",
+		Short: "Query all pending scheduled executions",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.ScheduledExecutions(cmd.Context(), &types.QueryScheduledExecutionsRequest{Pagination: pageReq})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "scheduled-executions")
+
+	return cmd
+}
+
+// GetCmdQuerySimulateExecute implements the simulate execute command.
+func GetCmdQuerySimulateExecute() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "simulate-execute [messages-json-or-file]",
+		Short: "Simulate the execution of governance messages on the current state",
+		Long: `Simulate the execution of governance messages on the current state without committing any state changes.
+The messages are given as a JSON array in the same format as ConsensusGovernanceEntrypoint.execute, either inline or as a file path.
+
+Example:
+$ mitosisd query evmgov simulate-execute '[{"@type":"/mitosis.evmgov.v1.MsgUpdateParams","authority":"mito1...","params":{...}}]'
+$ mitosisd query evmgov simulate-execute messages.json`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			messages, err := parseMessages(args[0])
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.SimulateExecute(cmd.Context(), &types.QuerySimulateExecuteRequest{Messages: messages})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// parseMessages parses the JSON array of messages given inline or as a file path.
+func parseMessages(arg string) ([]string, error) {
+	content := strings.TrimSpace(arg)
+	if !strings.HasPrefix(content, "[") {
+		bz, err := os.ReadFile(arg)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read messages file: %w", err)
+		}
+		content = string(bz)
+	}
+
+	var rawMsgs []json.RawMessage
+	if err := json.Unmarshal([]byte(content), &rawMsgs); err != nil {
+		return nil, fmt.Errorf("messages must be a JSON array: %w", err)
+	}
+
+	messages := make([]string, len(rawMsgs))
+	for i, rawMsg := range rawMsgs {
+		messages[i] = string(rawMsg)
+	}
+
+	return messages, nil
+}
@@ -0,0 +1,21 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/mitosis-org/chain/x/evmgov/types"
+)
+
+// EndBlocker is called at the end of every block
+func (k *Keeper) EndBlocker(ctx sdk.Context) error {
+	defer telemetry.ModuleMeasureSince(types.ModuleName, telemetry.Now(), telemetry.MetricKeyEndBlocker)
+
+	// Execute scheduled messages which are due
+	k.ProcessScheduledExecutions(ctx)
+
+	// Prune execution records which are out of the retention window
+	k.PruneExecutionRecords(ctx)
+
+	return nil
+}
@@ -2,7 +2,9 @@ package keeper
 
 import (
 	"context"
+	"encoding/json"
 
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
@@ -29,8 +31,10 @@ func (*Keeper) Name() string {
 }
 
 // FilterParams defines the matching EVM log events.
-func (k *Keeper) FilterParams(_ context.Context) ([]common.Address, [][]common.Hash) {
-	return []common.Address{k.govEntrypointContractAddr.Address()},
+func (k *Keeper) FilterParams(ctx context.Context) ([]common.Address, [][]common.Hash) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	contractAddr := k.GetEffectiveGovEntrypointContractAddr(sdkCtx).Address()
+	return []common.Address{contractAddr},
 		[][]common.Hash{{EventMsgExecute.ID}}
 }
 
@@ -39,7 +43,7 @@ func (k *Keeper) Deliver(ctx context.Context, blockHash common.Hash, elog evmeng
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
 	cacheCtx, writeCache := sdkCtx.CacheContext()
 
-	err, ignore := k.processEvent(cacheCtx, blockHash, elog)
+	record, err, ignore := k.processEvent(cacheCtx, blockHash, elog)
 	if err != nil {
 		if ignore {
 			// If the processing fails but needs to be ignored, the error will be logged and
@@ -51,6 +55,12 @@ func (k *Keeper) Deliver(ctx context.Context, blockHash common.Hash, elog evmeng
 				"evmLog", elog.String(),
 				"err", err,
 			)
+
+			// The execution record is persisted even though the state changes are discarded
+			if record != nil {
+				k.AddNewExecutionRecordWithNextID(sdkCtx, record)
+			}
+
 			return nil
 		} else {
 			return errors.Wrap(err, "failed to process event",
@@ -63,15 +73,21 @@ func (k *Keeper) Deliver(ctx context.Context, blockHash common.Hash, elog evmeng
 	}
 
 	writeCache()
+
+	if record != nil {
+		k.AddNewExecutionRecordWithNextID(sdkCtx, record)
+	}
+
 	return nil
 }
 
 // processEvent parses the provided event and processes it.
-// If the second return value is true, the error will be ignored.
-func (k *Keeper) processEvent(ctx sdk.Context, blockHash common.Hash, elog evmengtypes.EVMEvent) (error, bool) {
+// The first return value is the execution record if the event is MsgExecute.
+// If the third return value is true, the error will be ignored.
+func (k *Keeper) processEvent(ctx sdk.Context, blockHash common.Hash, elog evmengtypes.EVMEvent) (*types.ExecutionRecord, error, bool) {
 	ethlog, err := elog.ToEthLog()
 	if err != nil {
-		return err, false
+		return nil, err, false
 	}
 
 	switch ethlog.Topics[0] {
@@ -83,41 +99,198 @@ func (k *Keeper) processEvent(ctx sdk.Context, blockHash common.Hash, elog evmen
 	case EventMsgExecute.ID:
 		event, err := k.govEntrypointContract.ParseMsgExecute(ethlog)
 		if err != nil {
-			return errors.Wrap(err, "parse MsgExecute"), false
+			return nil, errors.Wrap(err, "parse MsgExecute"), false
 		}
 
-		if err := k.processMsgExecute(ctx, blockHash, event); err != nil {
-			return errors.Wrap(err, "process MsgExecute"), true
+		record, err := k.processMsgExecute(ctx, blockHash, event)
+		if err != nil {
+			return record, errors.Wrap(err, "process MsgExecute"), true
 		}
+
+		return record, nil, false
 	default:
-		return errors.New("unknown event"), false
+		return nil, errors.New("unknown event"), false
 	}
-
-	return nil, false
 }
 
 // processMsgExecute processes the MsgExecute event.
-func (k *Keeper) processMsgExecute(ctx sdk.Context, evmBlockHash common.Hash, event *bindings.ConsensusGovernanceEntrypointMsgExecute) error {
-	for _, rawMsg := range event.Messages {
+// It returns the execution record containing the result of each message.
+func (k *Keeper) processMsgExecute(ctx sdk.Context, evmBlockHash common.Hash, event *bindings.ConsensusGovernanceEntrypointMsgExecute) (*types.ExecutionRecord, error) {
+	record := &types.ExecutionRecord{
+		EvmBlockHash: evmBlockHash.Hex(),
+		Height:       ctx.BlockHeight(),
+	}
+
+	rawMsgs := event.Messages
+	if len(rawMsgs) > 0 && isExecutionOptions(rawMsgs[0]) {
+		opts, err := k.ParseExecutionOptions(rawMsgs[0])
+		if err != nil {
+			record.Results = newMessageResults(rawMsgs)
+			record.Results[0].Status = types.MessageStatusFailed
+			record.Results[0].Error = err.Error()
+			return record, err
+		}
+
+		record.Mode = opts.Mode
+		rawMsgs = rawMsgs[1:]
+
+		if opts.IsScheduled(ctx.BlockHeight(), ctx.BlockTime()) {
+			// Reject invalid messages now rather than when they are due. The messages are checked
+			// again at execution since the allowed message types might change in the meantime.
+			for i, rawMsg := range rawMsgs {
+				if _, err := k.parseAllowedMessage(ctx, rawMsg); err != nil {
+					record.Results = newMessageResults(rawMsgs)
+					record.Results[i].Status = types.MessageStatusFailed
+					record.Results[i].Error = err.Error()
+					return record, errors.Wrap(err, "validate scheduled message")
+				}
+			}
+
+			se := &types.ScheduledExecution{
+				EvmBlockHash: evmBlockHash.Hex(),
+				Height:       ctx.BlockHeight(),
+				Options:      opts,
+				RawMsgs:      rawMsgs,
+			}
+			k.AddNewScheduledExecutionWithNextID(ctx, se)
+
+			k.Logger(ctx).Info("⏰ Schedule the messages",
+				"id", se.ID,
+				"height", ctx.BlockHeight(),
+				"evmBlockHash", evmBlockHash.Hex(),
+				"executeAtHeight", opts.ExecuteAtHeight,
+				"executeAtTime", opts.ExecuteAtTime,
+			)
+
+			// The execution record will be persisted when the messages are executed
+			return nil, nil
+		}
+	}
+	record.Results = newMessageResults(rawMsgs)
+
+	return record, k.executeMessages(ctx, evmBlockHash, record)
+}
+
+// executeMessages executes the messages of the record according to its execution mode.
+// If an error is returned, all state changes must be discarded by the caller.
+func (k *Keeper) executeMessages(ctx sdk.Context, evmBlockHash common.Hash, record *types.ExecutionRecord) error {
+	switch record.Mode {
+	case types.ExecutionModeBestEffort:
+		k.executeBestEffort(ctx, evmBlockHash, record)
+		return nil
+	default:
+		return k.executeAtomic(ctx, evmBlockHash, record)
+	}
+}
+
+// executeAtomic executes the messages of the record sequentially.
+// If any of them fails, the error is returned and all state changes must be discarded by the caller.
+func (k *Keeper) executeAtomic(ctx sdk.Context, evmBlockHash common.Hash, record *types.ExecutionRecord) error {
+	for i := range record.Results {
+		result := &record.Results[i]
 		k.Logger(ctx).Info("⚡️ Execute the message",
 			"height", ctx.BlockHeight(),
 			"evmBlockHash", evmBlockHash.Hex(),
-			"rawMsg", rawMsg,
+			"rawMsg", result.RawMsg,
 		)
 
-		msg, err := k.ParseMessage(rawMsg)
+		response, err := k.executeRawMessage(ctx, result.RawMsg)
 		if err != nil {
-			return err
-		}
+			// All messages are reverted if any of them fails
+			for j := 0; j < i; j++ {
+				record.Results[j].Status = types.MessageStatusReverted
+			}
+			result.Status = types.MessageStatusFailed
+			result.Error = err.Error()
 
-		if err = k.ExecuteMessage(ctx, msg); err != nil {
 			return err
 		}
+
+		result.Status = types.MessageStatusExecuted
+		result.Response = response
 	}
 
+	record.Applied = true
 	return nil
 }
 
+// executeBestEffort executes each message of the record in its own cache context.
+// A failed message is recorded and does not affect the others.
+// The record is marked as applied only if at least one message was executed.
+func (k *Keeper) executeBestEffort(ctx sdk.Context, evmBlockHash common.Hash, record *types.ExecutionRecord) {
+	for i := range record.Results {
+		result := &record.Results[i]
+		k.Logger(ctx).Info("⚡️ Execute the message",
+			"height", ctx.BlockHeight(),
+			"evmBlockHash", evmBlockHash.Hex(),
+			"rawMsg", result.RawMsg,
+			"mode", record.Mode.String(),
+		)
+
+		cacheCtx, writeCache := ctx.CacheContext()
+		response, err := k.executeRawMessage(cacheCtx, result.RawMsg)
+		if err != nil {
+			k.Logger(ctx).Error("Executing the message failed but ignored",
+				"height", ctx.BlockHeight(),
+				"evmBlockHash", evmBlockHash.Hex(),
+				"rawMsg", result.RawMsg,
+				"err", err,
+			)
+			result.Status = types.MessageStatusFailed
+			result.Error = err.Error()
+			continue
+		}
+
+		writeCache()
+		result.Status = types.MessageStatusExecuted
+		result.Response = response
+		record.Applied = true
+	}
+}
+
+// newMessageResults returns the initial results of the raw messages
+func newMessageResults(rawMsgs []string) []types.MessageResult {
+	results := make([]types.MessageResult, len(rawMsgs))
+	for i, rawMsg := range rawMsgs {
+		results[i] = types.MessageResult{RawMsg: rawMsg, Status: types.MessageStatusNotExecuted}
+	}
+	return results
+}
+
+// parseAllowedMessage parses the raw message and checks whether its type is allowed.
+func (k *Keeper) parseAllowedMessage(ctx sdk.Context, rawMsg string) (sdk.Msg, error) {
+	msg, err := k.ParseMessage(rawMsg)
+	if err != nil {
+		return nil, err
+	}
+
+	if typeURL := sdk.MsgTypeURL(msg); !k.GetParams(ctx).IsMsgTypeAllowed(typeURL) {
+		return nil, errors.Wrap(types.ErrMsgTypeNotAllowed, "check message type", "typeURL", typeURL)
+	}
+
+	return msg, nil
+}
+
+// executeRawMessage parses the raw message, checks whether it is allowed, and executes it.
+// It returns the response of the message handler.
+func (k *Keeper) executeRawMessage(ctx sdk.Context, rawMsg string) (*codectypes.Any, error) {
+	msg, err := k.parseAllowedMessage(ctx, rawMsg)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := k.ExecuteMessage(ctx, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(res.MsgResponses) > 0 {
+		return res.MsgResponses[0], nil
+	}
+
+	return nil, nil
+}
+
 // mustGetABI returns the metadata's ABI as an abi.ABI type.
 // It panics on error.
 func mustGetABI(metadata *bind.MetaData) *abi.ABI {
@@ -154,3 +327,15 @@ func eventName(elog evmengtypes.EVMEvent) string {
 
 	return event.Name
 }
+
+// isExecutionOptions returns whether the raw message is the execution options.
+func isExecutionOptions(rawMsg string) bool {
+	var typed struct {
+		Type string `json:"@type"`
+	}
+	if err := json.Unmarshal([]byte(rawMsg), &typed); err != nil {
+		return false
+	}
+
+	return typed.Type == sdk.MsgTypeURL(&types.ExecutionOptions{})
+}
@@ -0,0 +1,95 @@
+package keeper
+
+import (
+	"encoding/binary"
+
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/mitosis-org/chain/x/evmgov/types"
+)
+
+// GetExecutionRecordLastID gets the last ID of execution records
+func (k *Keeper) GetExecutionRecordLastID(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.ExecutionRecordLastIDKey)
+
+	if bz == nil {
+		return 0
+	}
+
+	return binary.BigEndian.Uint64(bz)
+}
+
+// SetExecutionRecordLastID sets the last ID of execution records
+func (k *Keeper) SetExecutionRecordLastID(ctx sdk.Context, lastID uint64) {
+	store := ctx.KVStore(k.storeKey)
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, lastID)
+	store.Set(types.ExecutionRecordLastIDKey, bz)
+}
+
+// GetExecutionRecord gets the execution record by ID
+func (k *Keeper) GetExecutionRecord(ctx sdk.Context, id uint64) (record types.ExecutionRecord, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetExecutionRecordKey(id))
+	if bz == nil {
+		return record, false
+	}
+
+	k.cdc.MustUnmarshal(bz, &record)
+	return record, true
+}
+
+// SetExecutionRecord sets the execution record with its index by height
+func (k *Keeper) SetExecutionRecord(ctx sdk.Context, record types.ExecutionRecord) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&record)
+	store.Set(types.GetExecutionRecordKey(record.ID), bz)
+	store.Set(types.GetExecutionRecordByHeightKey(record.Height, record.ID), []byte{})
+}
+
+// GetAllExecutionRecords gets all execution records
+func (k *Keeper) GetAllExecutionRecords(ctx sdk.Context) []types.ExecutionRecord {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, types.ExecutionRecordKeyPrefix)
+	defer iterator.Close()
+
+	var records []types.ExecutionRecord
+	for ; iterator.Valid(); iterator.Next() {
+		var record types.ExecutionRecord
+		k.cdc.MustUnmarshal(iterator.Value(), &record)
+		records = append(records, record)
+	}
+
+	return records
+}
+
+// AddNewExecutionRecordWithNextID adds a new execution record with the next ID
+func (k *Keeper) AddNewExecutionRecordWithNextID(ctx sdk.Context, record *types.ExecutionRecord) {
+	record.ID = k.GetExecutionRecordLastID(ctx) + 1
+	k.SetExecutionRecordLastID(ctx, record.ID)
+	k.SetExecutionRecord(ctx, *record)
+}
+
+// PruneExecutionRecords deletes the execution records older than the retention blocks in the params
+func (k *Keeper) PruneExecutionRecords(ctx sdk.Context) {
+	pruneHeight := ctx.BlockHeight() - k.GetParams(ctx).ExecutionRecordRetentionBlocks
+	if pruneHeight <= 0 {
+		return
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	iterator := store.Iterator(types.ExecutionRecordByHeightKeyPrefix, types.GetExecutionRecordByHeightEndKey(pruneHeight))
+	defer iterator.Close()
+
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+
+	for _, key := range keys {
+		store.Delete(types.GetExecutionRecordKey(types.ParseExecutionRecordByHeightKey(key)))
+		store.Delete(key)
+	}
+}
@@ -0,0 +1,43 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/mitosis-org/chain/x/evmgov/types"
+)
+
+// InitGenesis initializes the evmgov module's state from a provided genesis state
+func (k *Keeper) InitGenesis(ctx sdk.Context, data *types.GenesisState) error {
+	// Set module parameters
+	if err := k.SetParams(ctx, data.Params); err != nil {
+		return err
+	}
+
+	// Set ConsensusGovernanceEntrypoint contract address
+	k.SetGovEntrypointContractAddr(ctx, data.GovEntrypointContractAddr)
+
+	// Set execution records
+	for _, record := range data.ExecutionRecords {
+		k.SetExecutionRecord(ctx, record)
+	}
+	k.SetExecutionRecordLastID(ctx, data.ExecutionRecordLastID)
+
+	// Set scheduled executions with their indexes
+	for _, se := range data.ScheduledExecutions {
+		k.SetScheduledExecution(ctx, se)
+	}
+	k.SetScheduledExecutionLastID(ctx, data.ScheduledExecutionLastID)
+
+	return nil
+}
+
+// ExportGenesis returns the evmgov module's exported genesis state
+func (k *Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
+	return types.NewGenesisState(
+		k.GetParams(ctx),
+		k.GetGovEntrypointContractAddr(ctx),
+		k.GetAllExecutionRecords(ctx),
+		k.GetExecutionRecordLastID(ctx),
+		k.GetAllScheduledExecutions(ctx),
+		k.GetScheduledExecutionLastID(ctx),
+	)
+}
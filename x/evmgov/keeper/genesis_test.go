@@ -0,0 +1,119 @@
+package keeper_test
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	mitotypes "github.com/mitosis-org/chain/types"
+	"github.com/mitosis-org/chain/x/evmgov/keeper"
+	"github.com/mitosis-org/chain/x/evmgov/types"
+)
+
+func (s *KeeperTestSuite) Test_InitExportGenesis() {
+	rawMsg := s.updateParamsMsg(s.authority, types.MsgTypeFilterModeDenylist, "/cosmos.bank.v1beta1.MsgSend")
+	genState := types.NewGenesisState(
+		types.Params{
+			MsgTypeFilterMode:              types.MsgTypeFilterModeAllowlist,
+			MsgTypeUrls:                    []string{"/cosmos.bank.v1beta1.MsgSend"},
+			ExecutionRecordRetentionBlocks: 1_000,
+		},
+		mitotypes.EthAddress(common.HexToAddress("0x00000000000000000000000000000000000000e3")),
+		[]types.ExecutionRecord{
+			{ID: 1, EvmBlockHash: common.HexToHash("0xb1").Hex(), Height: 3, Applied: true},
+			{ID: 3, EvmBlockHash: common.HexToHash("0xb3").Hex(), Height: 5},
+		},
+		3,
+		[]types.ScheduledExecution{
+			{
+				ID:           2,
+				EvmBlockHash: common.HexToHash("0xb2").Hex(),
+				Height:       4,
+				Options:      types.ExecutionOptions{ExecuteAtHeight: 20},
+				RawMsgs:      []string{rawMsg},
+			},
+		},
+		2,
+	)
+	s.Require().NoError(genState.Validate())
+	s.Require().NoError(s.keeper.InitGenesis(s.ctx, genState))
+
+	s.Require().Equal(genState.GovEntrypointContractAddr, s.keeper.GetGovEntrypointContractAddr(s.ctx))
+	s.Require().Equal(genState, s.keeper.ExportGenesis(s.ctx))
+
+	// The scheduled execution is indexed, and new IDs continue from the last IDs
+	s.Require().Len(s.keeper.GetDueScheduledExecutions(s.ctx.WithBlockHeight(20)), 1)
+	s.Require().NoError(s.keeper.EndBlocker(s.ctx.WithBlockHeight(20)))
+	s.Require().Empty(s.keeper.GetAllScheduledExecutions(s.ctx))
+
+	record, found := s.keeper.GetExecutionRecord(s.ctx, 4)
+	s.Require().True(found)
+	s.Require().Equal(uint64(2), record.ScheduledExecutionId)
+}
+
+func (s *KeeperTestSuite) Test_Migrate1to2() {
+	// Clear the state as in version 1
+	s.keeper.SetGovEntrypointContractAddr(s.ctx, mitotypes.EthAddress{})
+	fallback := mitotypes.EthAddress(common.HexToAddress("0x00000000000000000000000000000000000000f1"))
+	s.keeper.SetFallbackGovEntrypointContractAddr(fallback)
+
+	m := keeper.NewMigrator(s.keeper)
+	s.Require().NoError(m.Migrate1to2(s.ctx))
+
+	s.Require().Equal(types.DefaultParams(), s.keeper.GetParams(s.ctx))
+
+	// The node-local fallback address is not written into the state
+	s.Require().Equal(mitotypes.EthAddress{}, s.keeper.GetGovEntrypointContractAddr(s.ctx))
+	s.Require().Equal(fallback, s.keeper.GetEffectiveGovEntrypointContractAddr(s.ctx))
+}
+
+func (s *KeeperTestSuite) Test_UpdateGovEntrypointContractAddr() {
+	msgServer := keeper.NewMsgServerImpl(s.keeper)
+	newAddr := mitotypes.EthAddress(common.HexToAddress("0x00000000000000000000000000000000000000e4"))
+
+	_, err := msgServer.UpdateGovEntrypointContractAddr(s.ctx, &types.MsgUpdateGovEntrypointContractAddr{
+		Authority: "invalid-authority",
+		Addr:      newAddr,
+	})
+	s.Require().ErrorContains(err, "invalid authority")
+	s.Require().Equal(s.entrypoint, s.keeper.GetGovEntrypointContractAddr(s.ctx))
+
+	_, err = msgServer.UpdateGovEntrypointContractAddr(s.ctx, &types.MsgUpdateGovEntrypointContractAddr{
+		Authority: s.authority,
+		Addr:      mitotypes.EthAddress{},
+	})
+	s.Require().ErrorIs(err, types.ErrInvalidMessage)
+	s.Require().Equal(s.entrypoint, s.keeper.GetGovEntrypointContractAddr(s.ctx))
+
+	_, err = msgServer.UpdateGovEntrypointContractAddr(s.ctx, &types.MsgUpdateGovEntrypointContractAddr{
+		Authority: s.authority,
+		Addr:      newAddr,
+	})
+	s.Require().NoError(err)
+	s.Require().Equal(newAddr, s.keeper.GetGovEntrypointContractAddr(s.ctx))
+
+	addrs, _ := s.keeper.FilterParams(s.ctx)
+	s.Require().Equal([]common.Address{newAddr.Address()}, addrs)
+}
+
+func (s *KeeperTestSuite) Test_FallbackGovEntrypointContractAddr() {
+	fallback := mitotypes.EthAddress(common.HexToAddress("0x00000000000000000000000000000000000000f1"))
+	s.keeper.SetFallbackGovEntrypointContractAddr(fallback)
+
+	// The address in the state takes precedence
+	addrs, _ := s.keeper.FilterParams(s.ctx)
+	s.Require().Equal([]common.Address{s.entrypoint.Address()}, addrs)
+	s.Require().ErrorContains(s.keeper.CheckGovEntrypointContractAddrConsistency(s.ctx), "differs from the address in the state")
+
+	// The fallback is used if the address is not set in the state
+	s.keeper.SetGovEntrypointContractAddr(s.ctx, mitotypes.EthAddress{})
+	addrs, _ = s.keeper.FilterParams(s.ctx)
+	s.Require().Equal([]common.Address{fallback.Address()}, addrs)
+	s.Require().NoError(s.keeper.CheckGovEntrypointContractAddrConsistency(s.ctx))
+
+	// No conflict if both are the same
+	s.keeper.SetGovEntrypointContractAddr(s.ctx, fallback)
+	s.Require().NoError(s.keeper.CheckGovEntrypointContractAddrConsistency(s.ctx))
+
+	// No conflict if the fallback is not configured
+	s.keeper.SetFallbackGovEntrypointContractAddr(mitotypes.EthAddress{})
+	s.keeper.SetGovEntrypointContractAddr(s.ctx, s.entrypoint)
+	s.Require().NoError(s.keeper.CheckGovEntrypointContractAddrConsistency(s.ctx))
+}
@@ -0,0 +1,160 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/mitosis-org/chain/x/evmgov/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var _ types.QueryServer = QueryServer{}
+
+// QueryServer implements the QueryServer interface for the evmgov module
+type QueryServer struct {
+	k *Keeper
+}
+
+// NewQueryServer creates a new QueryServer instance
+func NewQueryServer(keeper *Keeper) types.QueryServer {
+	return &QueryServer{k: keeper}
+}
+
+// Params returns the module parameters
+func (q QueryServer) Params(ctx context.Context, req *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	params := q.k.GetParams(sdkCtx)
+
+	return &types.QueryParamsResponse{Params: params}, nil
+}
+
+// GovEntrypointContractAddr returns the address of the ConsensusGovernanceEntrypoint contract
+func (q QueryServer) GovEntrypointContractAddr(ctx context.Context, req *types.QueryGovEntrypointContractAddrRequest) (*types.QueryGovEntrypointContractAddrResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	addr := q.k.GetGovEntrypointContractAddr(sdkCtx)
+	return &types.QueryGovEntrypointContractAddrResponse{Addr: addr}, nil
+}
+
+// ExecutionRecord returns the execution record by ID
+func (q QueryServer) ExecutionRecord(ctx context.Context, req *types.QueryExecutionRecordRequest) (*types.QueryExecutionRecordResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	record, found := q.k.GetExecutionRecord(sdkCtx, req.Id)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "execution record %d not found", req.Id)
+	}
+
+	return &types.QueryExecutionRecordResponse{Record: record}, nil
+}
+
+// ExecutionRecords returns all execution records
+func (q QueryServer) ExecutionRecords(ctx context.Context, req *types.QueryExecutionRecordsRequest) (*types.QueryExecutionRecordsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	store := sdkCtx.KVStore(q.k.storeKey)
+	recordStore := prefix.NewStore(store, types.ExecutionRecordKeyPrefix)
+
+	var records []types.ExecutionRecord
+	pageRes, err := query.Paginate(recordStore, req.Pagination, func(key []byte, value []byte) error {
+		var record types.ExecutionRecord
+		q.k.cdc.MustUnmarshal(value, &record)
+		records = append(records, record)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryExecutionRecordsResponse{
+		Records:    records,
+		Pagination: pageRes,
+	}, nil
+}
+
+// ScheduledExecution returns the pending scheduled execution by ID
+func (q QueryServer) ScheduledExecution(ctx context.Context, req *types.QueryScheduledExecutionRequest) (*types.QueryScheduledExecutionResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	se, found := q.k.GetScheduledExecution(sdkCtx, req.Id)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "scheduled execution %d not found", req.Id)
+	}
+
+	return &types.QueryScheduledExecutionResponse{ScheduledExecution: se}, nil
+}
+
+// ScheduledExecutions returns all pending scheduled executions
+func (q QueryServer) ScheduledExecutions(ctx context.Context, req *types.QueryScheduledExecutionsRequest) (*types.QueryScheduledExecutionsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	store := sdkCtx.KVStore(q.k.storeKey)
+	seStore := prefix.NewStore(store, types.ScheduledExecutionKeyPrefix)
+
+	var scheduledExecutions []types.ScheduledExecution
+	pageRes, err := query.Paginate(seStore, req.Pagination, func(key []byte, value []byte) error {
+		var se types.ScheduledExecution
+		q.k.cdc.MustUnmarshal(value, &se)
+		scheduledExecutions = append(scheduledExecutions, se)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryScheduledExecutionsResponse{
+		ScheduledExecutions: scheduledExecutions,
+		Pagination:          pageRes,
+	}, nil
+}
+
+// SimulateExecute simulates the execution of the raw messages without committing any state changes
+func (q QueryServer) SimulateExecute(ctx context.Context, req *types.QuerySimulateExecuteRequest) (*types.QuerySimulateExecuteResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if len(req.Messages) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "empty messages")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	record, events, gasUsed, err := q.k.SimulateExecute(sdkCtx, req.Messages)
+	if err != nil {
+		return nil, status.Error(codes.ResourceExhausted, err.Error())
+	}
+
+	return &types.QuerySimulateExecuteResponse{
+		Mode:    record.Mode,
+		Applied: record.Applied,
+		Results: record.Results,
+		Events:  events,
+		GasUsed: gasUsed,
+	}, nil
+}
@@ -1,63 +1,178 @@
 package keeper
 
 import (
+	"bytes"
+	"encoding/json"
 	"fmt"
+	"strings"
 
 	mitotypes "github.com/mitosis-org/chain/types"
 
+	msgv1 "cosmossdk.io/api/cosmos/msg/v1"
 	"cosmossdk.io/log"
+	storetypes "cosmossdk.io/store/types"
 
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/codec"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	gogoproto "github.com/cosmos/gogoproto/proto"
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/mitosis-org/chain/bindings"
 	"github.com/mitosis-org/chain/x/evmgov/types"
 	"github.com/omni-network/omni/lib/errors"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
 )
 
 type Keeper struct {
-	cdc                       codec.Codec
-	router                    baseapp.MessageRouter
-	govEntrypointContractAddr mitotypes.EthAddress
-	govEntrypointContract     *bindings.ConsensusGovernanceEntrypoint
+	cdc                   codec.Codec
+	storeKey              storetypes.StoreKey
+	router                baseapp.MessageRouter
+	authority             string
+	govEntrypointContract *bindings.ConsensusGovernanceEntrypoint
+	simulateGasLimit      uint64
+
+	// Deprecated: the address is stored in the module state. It is only used when the state is not set yet.
+	fallbackGovEntrypointContractAddr mitotypes.EthAddress
 }
 
-func NewKeeper(cdc codec.Codec, router baseapp.MessageRouter) (*Keeper, error) {
-	keeper := &Keeper{
-		cdc,
-		router,
-		mitotypes.EthAddress{},
-		nil,
+func NewKeeper(cdc codec.Codec, storeKey storetypes.StoreKey, router baseapp.MessageRouter, authority string) (*Keeper, error) {
+	// The contract binding is only used to parse logs, so the address doesn't matter.
+	contract, err := bindings.NewConsensusGovernanceEntrypoint(common.Address{}, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create governance entrypoint contract")
 	}
 
-	if err := keeper.SetGovEntrypointContractAddr(mitotypes.EthAddress{}); err != nil {
-		return nil, err
-	}
+	return &Keeper{
+		cdc:                               cdc,
+		storeKey:                          storeKey,
+		router:                            router,
+		authority:                         authority,
+		govEntrypointContract:             contract,
+		simulateGasLimit:                  types.DefaultSimulateExecuteGasLimit,
+		fallbackGovEntrypointContractAddr: mitotypes.EthAddress{},
+	}, nil
+}
 
-	return keeper, nil
+// SetSimulateGasLimit sets the maximum gas which can be consumed by SimulateExecute.
+func (k *Keeper) SetSimulateGasLimit(limit uint64) {
+	k.simulateGasLimit = limit
 }
 
 func (k *Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
 }
 
-func (k *Keeper) SetGovEntrypointContractAddr(addr mitotypes.EthAddress) error {
-	contract, err := bindings.NewConsensusGovernanceEntrypoint(addr.Address(), nil)
-	if err != nil {
-		return errors.Wrap(err, "failed to create governance entrypoint contract")
+// GetParams gets the parameters for the x/evmgov module
+func (k *Keeper) GetParams(ctx sdk.Context) (params types.Params) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.ParamsKey)
+	if bz == nil {
+		return types.DefaultParams()
 	}
 
-	k.govEntrypointContractAddr = addr
-	k.govEntrypointContract = contract
+	k.cdc.MustUnmarshal(bz, &params)
+	return params
+}
+
+// SetParams sets the parameters for the x/evmgov module
+func (k *Keeper) SetParams(ctx sdk.Context, params types.Params) error {
+	if err := params.Validate(); err != nil {
+		return err
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&params)
+	store.Set(types.ParamsKey, bz)
 
 	return nil
 }
 
+// GetGovEntrypointContractAddr gets the ConsensusGovernanceEntrypoint contract address stored in the module state
+func (k *Keeper) GetGovEntrypointContractAddr(ctx sdk.Context) mitotypes.EthAddress {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GovEntrypointContractAddrKey)
+	if bz == nil {
+		return mitotypes.EthAddress{}
+	}
+
+	return mitotypes.BytesToEthAddress(bz)
+}
+
+// SetGovEntrypointContractAddr sets the ConsensusGovernanceEntrypoint contract address
+func (k *Keeper) SetGovEntrypointContractAddr(ctx sdk.Context, addr mitotypes.EthAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GovEntrypointContractAddrKey, addr.Bytes())
+}
+
+// GetEffectiveGovEntrypointContractAddr returns the ConsensusGovernanceEntrypoint contract address to filter logs of.
+// The address in the module state takes precedence, and the deprecated fallback address is used only if it is not set.
+func (k *Keeper) GetEffectiveGovEntrypointContractAddr(ctx sdk.Context) mitotypes.EthAddress {
+	addr := k.GetGovEntrypointContractAddr(ctx)
+	if addr.Equal(mitotypes.EthAddress{}) {
+		return k.fallbackGovEntrypointContractAddr
+	}
+
+	return addr
+}
+
+// GetFallbackGovEntrypointContractAddr returns the deprecated fallback address configured in app.toml.
+func (k *Keeper) GetFallbackGovEntrypointContractAddr() mitotypes.EthAddress {
+	return k.fallbackGovEntrypointContractAddr
+}
+
+// SetFallbackGovEntrypointContractAddr sets the deprecated fallback address configured in app.toml.
+//
+// Deprecated: set the address in the genesis or update it via MsgUpdateGovEntrypointContractAddr instead.
+func (k *Keeper) SetFallbackGovEntrypointContractAddr(addr mitotypes.EthAddress) {
+	k.fallbackGovEntrypointContractAddr = addr
+}
+
+// CheckGovEntrypointContractAddrConsistency checks that the deprecated fallback address doesn't conflict with
+// the address stored in the module state.
+//
+// NOTE: the fallback address is node-local. While the address is not set in the state, each node filters the logs
+// of its own fallback address, so it can't be verified that the validators are configured with the same address.
+// A misconfigured node only diverges from the others once a log of the governance entrypoint is delivered.
+func (k *Keeper) CheckGovEntrypointContractAddrConsistency(ctx sdk.Context) error {
+	fallback := k.fallbackGovEntrypointContractAddr
+	if fallback.Equal(mitotypes.EthAddress{}) {
+		return nil
+	}
+
+	addr := k.GetGovEntrypointContractAddr(ctx)
+	if addr.Equal(mitotypes.EthAddress{}) {
+		k.Logger(ctx).Warn("Using deprecated [evmgov] entrypoint in app.toml since the address is not set in the state. "+
+			"It must be the same on all the validators since it is not verified against the others. "+
+			"Please set it via MsgUpdateGovEntrypointContractAddr.",
+			"entrypoint", fallback.String(),
+		)
+		return nil
+	}
+
+	if !addr.Equal(fallback) {
+		return errors.New("[evmgov] entrypoint in app.toml differs from the address in the state; remove it from app.toml",
+			"app_toml", fallback.String(),
+			"state", addr.String(),
+		)
+	}
+
+	k.Logger(ctx).Warn("[evmgov] entrypoint in app.toml is deprecated and can be removed since the address is set in the state",
+		"entrypoint", addr.String(),
+	)
+
+	return nil
+}
+
+// ParseMessage strictly parses the raw message and validates it before execution.
+// It rejects unknown fields and missing top-level fields, runs ValidateBasic if available,
+// and checks that all signers of the message are the x/evmgov module account.
 func (k *Keeper) ParseMessage(rawMsg string) (sdk.Msg, error) {
 	// Example of rawMsg: {"@type": "/cosmos.upgrade.v1beta1.MsgSoftwareUpgrade", "authority": "...", "plan": {...}}
 
-	// TODO(thai): There is no error even though there is missing field. How can we make sure there is no missing field?
+	// Unknown fields are rejected by the JSON unmarshaler.
 	var protoMsg codectypes.Any
 	err := k.cdc.UnmarshalJSON([]byte(rawMsg), &protoMsg)
 	if err != nil {
@@ -70,20 +185,133 @@ func (k *Keeper) ParseMessage(rawMsg string) (sdk.Msg, error) {
 		return nil, errors.Wrap(err, fmt.Sprintf("failed to unpack message of %s", protoMsg.TypeUrl))
 	}
 
+	desc, err := k.msgDescriptor(msg)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := checkMissingFields(rawMsg, desc); err != nil {
+		return nil, errors.Wrap(err, fmt.Sprintf("invalid message of %s", protoMsg.TypeUrl))
+	}
+
+	if err := k.checkSigners(msg, desc); err != nil {
+		return nil, errors.Wrap(err, fmt.Sprintf("invalid message of %s", protoMsg.TypeUrl))
+	}
+
+	if m, ok := msg.(sdk.HasValidateBasic); ok {
+		if err := m.ValidateBasic(); err != nil {
+			return nil, errors.Wrap(err, fmt.Sprintf("invalid message of %s", protoMsg.TypeUrl))
+		}
+	}
+
 	return msg, nil
 }
 
-func (k *Keeper) ExecuteMessage(ctx sdk.Context, msg sdk.Msg) error {
-	handler := k.router.Handler(msg)
+// msgDescriptor returns the protobuf message descriptor of the message.
+func (k *Keeper) msgDescriptor(msg sdk.Msg) (protoreflect.MessageDescriptor, error) {
+	name := gogoproto.MessageName(msg)
+	desc, err := k.cdc.InterfaceRegistry().FindDescriptorByName(protoreflect.FullName(name))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to find message descriptor", "name", name)
+	}
+
+	md, ok := desc.(protoreflect.MessageDescriptor)
+	if !ok {
+		return nil, errors.New("not a message descriptor", "name", name)
+	}
+
+	return md, nil
+}
+
+// checkMissingFields checks that all top-level fields of the message are explicitly given in the raw message.
+// Fields of a oneof are not checked since only one of them can be given.
+func checkMissingFields(rawMsg string, desc protoreflect.MessageDescriptor) error {
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal([]byte(rawMsg), &fields); err != nil {
+		return errors.Wrap(err, "failed to parse message fields")
+	}
 
-	_, err := safeExecuteHandler(ctx, msg, handler)
+	for i := 0; i < desc.Fields().Len(); i++ {
+		field := desc.Fields().Get(i)
+		if field.ContainingOneof() != nil {
+			continue
+		}
+
+		_, byName := fields[string(field.Name())]
+		_, byJSONName := fields[field.JSONName()]
+		if !byName && !byJSONName {
+			return errors.Wrap(types.ErrInvalidMessage, fmt.Sprintf("missing field %q", field.Name()))
+		}
+	}
+
+	return nil
+}
+
+// checkSigners checks that all signers of the message are the x/evmgov module account.
+func (k *Keeper) checkSigners(msg sdk.Msg, desc protoreflect.MessageDescriptor) error {
+	signerField := "signer"
+	if fields, ok := proto.GetExtension(desc.Options(), msgv1.E_Signer).([]string); ok && len(fields) > 0 {
+		signerField = strings.Join(fields, ",")
+	}
+
+	signers, _, err := k.cdc.GetMsgV1Signers(msg)
 	if err != nil {
-		return errors.Wrap(err, "failed to execute message")
+		return errors.Wrap(types.ErrInvalidMessage, fmt.Sprintf("invalid field %q: %s", signerField, err))
+	}
+
+	moduleAddr := authtypes.NewModuleAddress(types.ModuleName)
+	for _, signer := range signers {
+		if !bytes.Equal(signer, moduleAddr) {
+			return errors.Wrap(types.ErrInvalidMessage, fmt.Sprintf(
+				"invalid field %q: expected %s module address %s, got %s",
+				signerField, types.ModuleName, moduleAddr, sdk.AccAddress(signer),
+			))
+		}
 	}
 
 	return nil
 }
 
+// ParseExecutionOptions parses and validates the raw execution options which is put as the first message of MsgExecute.
+func (k *Keeper) ParseExecutionOptions(rawMsg string) (types.ExecutionOptions, error) {
+	// Example of rawMsg: {"@type": "/mitosis.evmgov.v1.ExecutionOptions", "mode": "EXECUTION_MODE_BEST_EFFORT"}
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal([]byte(rawMsg), &fields); err != nil {
+		return types.ExecutionOptions{}, errors.Wrap(err, "failed to parse execution options")
+	}
+	delete(fields, "@type")
+
+	bz, err := json.Marshal(fields)
+	if err != nil {
+		return types.ExecutionOptions{}, errors.Wrap(err, "failed to parse execution options")
+	}
+
+	var opts types.ExecutionOptions
+	if err := k.cdc.UnmarshalJSON(bz, &opts); err != nil {
+		return types.ExecutionOptions{}, errors.Wrap(err, "failed to parse execution options")
+	}
+
+	if err := opts.Validate(); err != nil {
+		return types.ExecutionOptions{}, errors.Wrap(types.ErrInvalidExecutionOptions, err.Error())
+	}
+
+	return opts, nil
+}
+
+func (k *Keeper) ExecuteMessage(ctx sdk.Context, msg sdk.Msg) (*sdk.Result, error) {
+	handler := k.router.Handler(msg)
+	if handler == nil {
+		return nil, errors.New("no message handler found", "typeURL", sdk.MsgTypeURL(msg))
+	}
+
+	res, err := safeExecuteHandler(ctx, msg, handler)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to execute message")
+	}
+
+	return res, nil
+}
+
 // safeExecuteHandler executes handle(msg) and recovers from panic.
 func safeExecuteHandler(ctx sdk.Context, msg sdk.Msg, handler baseapp.MsgServiceHandler,
 ) (res *sdk.Result, err error) {
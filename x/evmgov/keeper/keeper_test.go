@@ -0,0 +1,541 @@
+package keeper_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	storetypes "cosmossdk.io/store/types"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	tmtime "github.com/cometbft/cometbft/types/time"
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+	"github.com/ethereum/go-ethereum/common"
+	mitotypes "github.com/mitosis-org/chain/types"
+	"github.com/mitosis-org/chain/x/evmgov"
+	"github.com/mitosis-org/chain/x/evmgov/keeper"
+	"github.com/mitosis-org/chain/x/evmgov/types"
+	evmengtypes "github.com/omni-network/omni/octane/evmengine/types"
+	"github.com/stretchr/testify/suite"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// KeeperTestSuite is a test suite for the evmgov keeper
+type KeeperTestSuite struct {
+	suite.Suite
+
+	ctx        sdk.Context
+	keeper     *keeper.Keeper
+	authority  string
+	entrypoint mitotypes.EthAddress
+}
+
+// SetupTest initializes the test suite
+func (s *KeeperTestSuite) SetupTest() {
+	storeKey := storetypes.NewKVStoreKey(types.StoreKey)
+	testCtx := testutil.DefaultContextWithDB(s.T(), storeKey, storetypes.NewTransientStoreKey("transient_test"))
+	s.ctx = testCtx.Ctx.WithBlockHeader(tmproto.Header{Time: tmtime.Now(), Height: 10})
+	encCfg := moduletestutil.MakeTestEncodingConfig(evmgov.AppModuleBasic{}, bank.AppModuleBasic{})
+
+	router := baseapp.NewMsgServiceRouter()
+	router.SetInterfaceRegistry(encCfg.InterfaceRegistry)
+
+	s.authority = authtypes.NewModuleAddress(types.ModuleName).String()
+	k, err := keeper.NewKeeper(encCfg.Codec, storeKey, router, s.authority)
+	s.Require().NoError(err)
+	types.RegisterMsgServer(router, keeper.NewMsgServerImpl(k))
+
+	s.entrypoint = mitotypes.EthAddress(common.HexToAddress("0x00000000000000000000000000000000000000e2"))
+	k.SetGovEntrypointContractAddr(s.ctx, s.entrypoint)
+
+	s.keeper = k
+}
+
+// TestKeeperTestSuite runs the keeper test suite
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
+
+// msgExecuteEvent returns a MsgExecute event of the governance entrypoint
+func (s *KeeperTestSuite) msgExecuteEvent(messages ...string) evmengtypes.EVMEvent {
+	data, err := keeper.EventMsgExecute.Inputs.Pack(messages)
+	s.Require().NoError(err)
+
+	return evmengtypes.EVMEvent{
+		Address: s.entrypoint.Bytes(),
+		Topics:  [][]byte{keeper.EventMsgExecute.ID.Bytes()},
+		Data:    data,
+	}
+}
+
+// updateParamsMsg returns a raw MsgUpdateParams of x/evmgov
+func (s *KeeperTestSuite) updateParamsMsg(authority string, mode types.MsgTypeFilterMode, typeURLs ...string) string {
+	urls := "[]"
+	if len(typeURLs) > 0 {
+		urls = fmt.Sprintf(`["%s"]`, typeURLs[0])
+	}
+
+	return fmt.Sprintf(
+		`{"@type":"/mitosis.evmgov.v1.MsgUpdateParams","authority":"%s","params":{"msg_type_filter_mode":"%s","msg_type_urls":%s,"execution_record_retention_blocks":"%d"}}`,
+		authority, mode.String(), urls, types.DefaultExecutionRecordRetentionBlocks,
+	)
+}
+
+func (s *KeeperTestSuite) Test_Deliver_ExecutionRecord_Applied() {
+	blockHash := common.HexToHash("0xb1")
+	rawMsg := s.updateParamsMsg(s.authority, types.MsgTypeFilterModeDenylist, "/cosmos.bank.v1beta1.MsgSend")
+
+	s.Require().NoError(s.keeper.Deliver(s.ctx, blockHash, s.msgExecuteEvent(rawMsg)))
+
+	// The message was applied
+	params := s.keeper.GetParams(s.ctx)
+	s.Require().Equal(types.MsgTypeFilterModeDenylist, params.MsgTypeFilterMode)
+
+	record, found := s.keeper.GetExecutionRecord(s.ctx, 1)
+	s.Require().True(found)
+	s.Require().Equal(uint64(1), record.ID)
+	s.Require().Equal(blockHash.Hex(), record.EvmBlockHash)
+	s.Require().Equal(int64(10), record.Height)
+	s.Require().True(record.Applied)
+	s.Require().Len(record.Results, 1)
+	s.Require().Equal(rawMsg, record.Results[0].RawMsg)
+	s.Require().Equal(types.MessageStatusExecuted, record.Results[0].Status)
+	s.Require().Empty(record.Results[0].Error)
+	s.Require().NotNil(record.Results[0].Response)
+	s.Require().Equal(sdk.MsgTypeURL(&types.MsgUpdateParamsResponse{}), record.Results[0].Response.TypeUrl)
+}
+
+func (s *KeeperTestSuite) Test_Deliver_ExecutionRecord_Failed() {
+	rawMsgs := []string{
+		s.updateParamsMsg(s.authority, types.MsgTypeFilterModeDenylist, "/cosmos.bank.v1beta1.MsgSend"),
+		s.updateParamsMsg("invalid-authority", types.MsgTypeFilterModeDisabled),
+		s.updateParamsMsg(s.authority, types.MsgTypeFilterModeAllowlist),
+	}
+
+	s.Require().NoError(s.keeper.Deliver(s.ctx, common.HexToHash("0xb2"), s.msgExecuteEvent(rawMsgs...)))
+
+	// Nothing was applied
+	s.Require().Equal(types.DefaultParams(), s.keeper.GetParams(s.ctx))
+
+	record, found := s.keeper.GetExecutionRecord(s.ctx, 1)
+	s.Require().True(found)
+	s.Require().False(record.Applied)
+	s.Require().Len(record.Results, 3)
+	s.Require().Equal(types.MessageStatusReverted, record.Results[0].Status)
+	s.Require().Equal(types.MessageStatusFailed, record.Results[1].Status)
+	s.Require().Contains(record.Results[1].Error, `invalid field "authority"`)
+	s.Require().Equal(types.MessageStatusNotExecuted, record.Results[2].Status)
+
+	// Invalid messages are recorded as well
+	s.Require().NoError(s.keeper.Deliver(s.ctx, common.HexToHash("0xb3"), s.msgExecuteEvent(`{"@type":"/unknown.Msg"}`)))
+
+	record, found = s.keeper.GetExecutionRecord(s.ctx, 2)
+	s.Require().True(found)
+	s.Require().False(record.Applied)
+	s.Require().Equal(types.MessageStatusFailed, record.Results[0].Status)
+	s.Require().Equal(uint64(2), s.keeper.GetExecutionRecordLastID(s.ctx))
+}
+
+func (s *KeeperTestSuite) Test_Deliver_MsgTypeFilter() {
+	s.Require().NoError(s.keeper.SetParams(s.ctx, types.Params{
+		MsgTypeFilterMode:              types.MsgTypeFilterModeAllowlist,
+		MsgTypeUrls:                    []string{"/cosmos.upgrade.v1beta1.MsgSoftwareUpgrade"},
+		ExecutionRecordRetentionBlocks: types.DefaultExecutionRecordRetentionBlocks,
+	}))
+
+	// MsgUpdateParams of x/evmgov is always allowed
+	rawMsg := s.updateParamsMsg(s.authority, types.MsgTypeFilterModeDenylist, "/cosmos.bank.v1beta1.MsgSend")
+	s.Require().NoError(s.keeper.Deliver(s.ctx, common.HexToHash("0xb4"), s.msgExecuteEvent(rawMsg)))
+	s.Require().Equal(types.MsgTypeFilterModeDenylist, s.keeper.GetParams(s.ctx).MsgTypeFilterMode)
+
+	// Denied message types are not executed
+	s.Require().NoError(s.keeper.SetParams(s.ctx, types.Params{
+		MsgTypeFilterMode:              types.MsgTypeFilterModeAllowlist,
+		MsgTypeUrls:                    []string{"/cosmos.upgrade.v1beta1.MsgSoftwareUpgrade"},
+		ExecutionRecordRetentionBlocks: types.DefaultExecutionRecordRetentionBlocks,
+	}))
+	rawMsg = fmt.Sprintf(`{"@type":"/cosmos.bank.v1beta1.MsgSend","from_address":"%s","to_address":"%s","amount":[]}`, s.authority, s.authority)
+	s.Require().NoError(s.keeper.Deliver(s.ctx, common.HexToHash("0xb5"), s.msgExecuteEvent(rawMsg)))
+
+	record, found := s.keeper.GetExecutionRecord(s.ctx, 2)
+	s.Require().True(found)
+	s.Require().False(record.Applied)
+	s.Require().Equal(types.MessageStatusFailed, record.Results[0].Status)
+	s.Require().Contains(record.Results[0].Error, types.ErrMsgTypeNotAllowed.Error())
+}
+
+func (s *KeeperTestSuite) Test_Deliver_BestEffortMode() {
+	options := `{"@type":"/mitosis.evmgov.v1.ExecutionOptions","mode":"EXECUTION_MODE_BEST_EFFORT"}`
+	rawMsgs := []string{
+		s.updateParamsMsg(s.authority, types.MsgTypeFilterModeAllowlist, "/cosmos.bank.v1beta1.MsgSend"),
+		s.updateParamsMsg("invalid-authority", types.MsgTypeFilterModeDisabled),
+		s.updateParamsMsg(s.authority, types.MsgTypeFilterModeDenylist, "/cosmos.upgrade.v1beta1.MsgSoftwareUpgrade"),
+	}
+
+	s.Require().NoError(s.keeper.Deliver(s.ctx, common.HexToHash("0xb6"), s.msgExecuteEvent(append([]string{options}, rawMsgs...)...)))
+
+	// The successful messages were applied in order
+	s.Require().Equal(types.Params{
+		MsgTypeFilterMode:              types.MsgTypeFilterModeDenylist,
+		MsgTypeUrls:                    []string{"/cosmos.upgrade.v1beta1.MsgSoftwareUpgrade"},
+		ExecutionRecordRetentionBlocks: types.DefaultExecutionRecordRetentionBlocks,
+	}, s.keeper.GetParams(s.ctx))
+
+	record, found := s.keeper.GetExecutionRecord(s.ctx, 1)
+	s.Require().True(found)
+	s.Require().Equal(types.ExecutionModeBestEffort, record.Mode)
+	s.Require().True(record.Applied)
+	s.Require().Len(record.Results, 3)
+	s.Require().Equal(rawMsgs[0], record.Results[0].RawMsg)
+	s.Require().Equal(types.MessageStatusExecuted, record.Results[0].Status)
+	s.Require().Equal(types.MessageStatusFailed, record.Results[1].Status)
+	s.Require().Contains(record.Results[1].Error, `invalid field "authority"`)
+	s.Require().Equal(types.MessageStatusExecuted, record.Results[2].Status)
+}
+
+func (s *KeeperTestSuite) Test_Deliver_BestEffortMode_AllFailed() {
+	options := `{"@type":"/mitosis.evmgov.v1.ExecutionOptions","mode":"EXECUTION_MODE_BEST_EFFORT"}`
+	rawMsgs := []string{
+		options,
+		s.updateParamsMsg("invalid-authority", types.MsgTypeFilterModeDisabled),
+		fmt.Sprintf(`{"@type":"/mitosis.evmgov.v1.MsgCancelScheduledExecution","authority":"%s","id":"100"}`, s.authority),
+	}
+	s.Require().NoError(s.keeper.Deliver(s.ctx, common.HexToHash("0xba"), s.msgExecuteEvent(rawMsgs...)))
+	s.Require().Equal(types.DefaultParams(), s.keeper.GetParams(s.ctx))
+
+	// Nothing was applied since every message failed
+	record, found := s.keeper.GetExecutionRecord(s.ctx, 1)
+	s.Require().True(found)
+	s.Require().Equal(types.ExecutionModeBestEffort, record.Mode)
+	s.Require().False(record.Applied)
+	s.Require().Len(record.Results, 2)
+	s.Require().Equal(types.MessageStatusFailed, record.Results[0].Status)
+	s.Require().Equal(types.MessageStatusFailed, record.Results[1].Status)
+}
+
+func (s *KeeperTestSuite) Test_Deliver_ExecutionOptions() {
+	// Explicit atomic mode behaves as the default mode
+	options := `{"@type":"/mitosis.evmgov.v1.ExecutionOptions","mode":"EXECUTION_MODE_ATOMIC"}`
+	rawMsgs := []string{
+		options,
+		s.updateParamsMsg(s.authority, types.MsgTypeFilterModeAllowlist, "/cosmos.bank.v1beta1.MsgSend"),
+		s.updateParamsMsg("invalid-authority", types.MsgTypeFilterModeDisabled),
+	}
+	s.Require().NoError(s.keeper.Deliver(s.ctx, common.HexToHash("0xb7"), s.msgExecuteEvent(rawMsgs...)))
+	s.Require().Equal(types.DefaultParams(), s.keeper.GetParams(s.ctx))
+
+	record, found := s.keeper.GetExecutionRecord(s.ctx, 1)
+	s.Require().True(found)
+	s.Require().Equal(types.ExecutionModeAtomic, record.Mode)
+	s.Require().False(record.Applied)
+	s.Require().Len(record.Results, 2)
+	s.Require().Equal(types.MessageStatusReverted, record.Results[0].Status)
+	s.Require().Equal(types.MessageStatusFailed, record.Results[1].Status)
+
+	// Invalid options fail the whole execution
+	invalidOptions := `{"@type":"/mitosis.evmgov.v1.ExecutionOptions","mode":"EXECUTION_MODE_BEST_EFFORT","unknown":1}`
+	rawMsgs = []string{
+		invalidOptions,
+		s.updateParamsMsg(s.authority, types.MsgTypeFilterModeAllowlist, "/cosmos.bank.v1beta1.MsgSend"),
+	}
+	s.Require().NoError(s.keeper.Deliver(s.ctx, common.HexToHash("0xb8"), s.msgExecuteEvent(rawMsgs...)))
+	s.Require().Equal(types.DefaultParams(), s.keeper.GetParams(s.ctx))
+
+	record, found = s.keeper.GetExecutionRecord(s.ctx, 2)
+	s.Require().True(found)
+	s.Require().False(record.Applied)
+	s.Require().Len(record.Results, 2)
+	s.Require().Equal(types.MessageStatusFailed, record.Results[0].Status)
+	s.Require().Equal(types.MessageStatusNotExecuted, record.Results[1].Status)
+}
+
+func (s *KeeperTestSuite) Test_ScheduledExecution() {
+	options := `{"@type":"/mitosis.evmgov.v1.ExecutionOptions","execute_at_height":"12"}`
+	rawMsg := s.updateParamsMsg(s.authority, types.MsgTypeFilterModeDenylist, "/cosmos.bank.v1beta1.MsgSend")
+
+	s.Require().NoError(s.keeper.Deliver(s.ctx, common.HexToHash("0xc1"), s.msgExecuteEvent(options, rawMsg)))
+
+	// Nothing is executed until the scheduled height
+	s.Require().Equal(types.DefaultParams(), s.keeper.GetParams(s.ctx))
+	s.Require().Equal(uint64(0), s.keeper.GetExecutionRecordLastID(s.ctx))
+
+	se, found := s.keeper.GetScheduledExecution(s.ctx, 1)
+	s.Require().True(found)
+	s.Require().Equal(common.HexToHash("0xc1").Hex(), se.EvmBlockHash)
+	s.Require().Equal(int64(10), se.Height)
+	s.Require().Equal(int64(12), se.Options.ExecuteAtHeight)
+	s.Require().Equal([]string{rawMsg}, se.RawMsgs)
+
+	s.Require().NoError(s.keeper.EndBlocker(s.ctx.WithBlockHeight(11)))
+	_, found = s.keeper.GetScheduledExecution(s.ctx, 1)
+	s.Require().True(found)
+
+	s.Require().NoError(s.keeper.EndBlocker(s.ctx.WithBlockHeight(12)))
+	_, found = s.keeper.GetScheduledExecution(s.ctx, 1)
+	s.Require().False(found)
+	s.Require().Equal(types.MsgTypeFilterModeDenylist, s.keeper.GetParams(s.ctx).MsgTypeFilterMode)
+
+	record, found := s.keeper.GetExecutionRecord(s.ctx, 1)
+	s.Require().True(found)
+	s.Require().True(record.Applied)
+	s.Require().Equal(uint64(1), record.ScheduledExecutionId)
+	s.Require().Equal(int64(12), record.Height)
+	s.Require().Equal(types.MessageStatusExecuted, record.Results[0].Status)
+}
+
+func (s *KeeperTestSuite) Test_ScheduledExecution_ByTime() {
+	executeAt := s.ctx.BlockTime().Add(time.Hour).Unix()
+	options := fmt.Sprintf(`{"@type":"/mitosis.evmgov.v1.ExecutionOptions","execute_at_time":"%d"}`, executeAt)
+	// The message is valid, but fails at execution since there is no such scheduled execution
+	rawMsg := fmt.Sprintf(`{"@type":"/mitosis.evmgov.v1.MsgCancelScheduledExecution","authority":"%s","id":"100"}`, s.authority)
+
+	s.Require().NoError(s.keeper.Deliver(s.ctx, common.HexToHash("0xc2"), s.msgExecuteEvent(options, rawMsg)))
+
+	s.Require().NoError(s.keeper.EndBlocker(s.ctx.WithBlockTime(s.ctx.BlockTime().Add(time.Minute))))
+	s.Require().Len(s.keeper.GetAllScheduledExecutions(s.ctx), 1)
+
+	// A failed scheduled execution is recorded as well
+	s.Require().NoError(s.keeper.EndBlocker(s.ctx.WithBlockTime(time.Unix(executeAt, 0))))
+	s.Require().Empty(s.keeper.GetAllScheduledExecutions(s.ctx))
+
+	record, found := s.keeper.GetExecutionRecord(s.ctx, 1)
+	s.Require().True(found)
+	s.Require().False(record.Applied)
+	s.Require().Equal(types.MessageStatusFailed, record.Results[0].Status)
+	s.Require().Contains(record.Results[0].Error, types.ErrScheduledExecutionNotFound.Error())
+}
+
+func (s *KeeperTestSuite) Test_ScheduledExecution_InvalidMessage() {
+	options := `{"@type":"/mitosis.evmgov.v1.ExecutionOptions","execute_at_height":"20"}`
+	validMsg := s.updateParamsMsg(s.authority, types.MsgTypeFilterModeDenylist)
+	invalidMsg := s.updateParamsMsg("invalid-authority", types.MsgTypeFilterModeDenylist)
+
+	// The messages are not scheduled if any of them is invalid
+	s.Require().NoError(s.keeper.Deliver(s.ctx, common.HexToHash("0xc6"), s.msgExecuteEvent(options, validMsg, invalidMsg)))
+	s.Require().Empty(s.keeper.GetAllScheduledExecutions(s.ctx))
+
+	record, found := s.keeper.GetExecutionRecord(s.ctx, 1)
+	s.Require().True(found)
+	s.Require().False(record.Applied)
+	s.Require().Equal(uint64(0), record.ScheduledExecutionId)
+	s.Require().Equal(types.MessageStatusNotExecuted, record.Results[0].Status)
+	s.Require().Equal(types.MessageStatusFailed, record.Results[1].Status)
+	s.Require().NotEmpty(record.Results[1].Error)
+
+	// A message type which is not allowed is rejected as well
+	s.Require().NoError(s.keeper.SetParams(s.ctx, types.Params{
+		MsgTypeFilterMode:              types.MsgTypeFilterModeAllowlist,
+		ExecutionRecordRetentionBlocks: types.DefaultExecutionRecordRetentionBlocks,
+	}))
+	cancelMsg := fmt.Sprintf(`{"@type":"/mitosis.evmgov.v1.MsgCancelScheduledExecution","authority":"%s","id":"1"}`, s.authority)
+	s.Require().NoError(s.keeper.Deliver(s.ctx, common.HexToHash("0xc7"), s.msgExecuteEvent(options, cancelMsg)))
+	s.Require().Empty(s.keeper.GetAllScheduledExecutions(s.ctx))
+
+	record, found = s.keeper.GetExecutionRecord(s.ctx, 2)
+	s.Require().True(found)
+	s.Require().Contains(record.Results[0].Error, types.ErrMsgTypeNotAllowed.Error())
+}
+
+func (s *KeeperTestSuite) Test_CancelScheduledExecution() {
+	options := `{"@type":"/mitosis.evmgov.v1.ExecutionOptions","execute_at_height":"20"}`
+	rawMsg := s.updateParamsMsg(s.authority, types.MsgTypeFilterModeDenylist, "/cosmos.bank.v1beta1.MsgSend")
+	s.Require().NoError(s.keeper.Deliver(s.ctx, common.HexToHash("0xc3"), s.msgExecuteEvent(options, rawMsg)))
+
+	msgServer := keeper.NewMsgServerImpl(s.keeper)
+
+	_, err := msgServer.CancelScheduledExecution(s.ctx, &types.MsgCancelScheduledExecution{Authority: "invalid", Id: 1})
+	s.Require().Error(err)
+
+	_, err = msgServer.CancelScheduledExecution(s.ctx, &types.MsgCancelScheduledExecution{Authority: s.authority, Id: 2})
+	s.Require().ErrorIs(err, types.ErrScheduledExecutionNotFound)
+
+	// Cancel through the governance entrypoint
+	cancelMsg := fmt.Sprintf(`{"@type":"/mitosis.evmgov.v1.MsgCancelScheduledExecution","authority":"%s","id":"1"}`, s.authority)
+	s.Require().NoError(s.keeper.Deliver(s.ctx, common.HexToHash("0xc4"), s.msgExecuteEvent(cancelMsg)))
+	_, found := s.keeper.GetScheduledExecution(s.ctx, 1)
+	s.Require().False(found)
+
+	s.Require().NoError(s.keeper.EndBlocker(s.ctx.WithBlockHeight(20)))
+	s.Require().Equal(types.DefaultParams(), s.keeper.GetParams(s.ctx))
+}
+
+func (s *KeeperTestSuite) Test_CancelScheduledExecution_SameBlock() {
+	options := `{"@type":"/mitosis.evmgov.v1.ExecutionOptions","execute_at_height":"20"}`
+
+	// The first scheduled execution cancels the second one due at the same height
+	cancelMsg := fmt.Sprintf(`{"@type":"/mitosis.evmgov.v1.MsgCancelScheduledExecution","authority":"%s","id":"2"}`, s.authority)
+	s.Require().NoError(s.keeper.Deliver(s.ctx, common.HexToHash("0xc8"), s.msgExecuteEvent(options, cancelMsg)))
+	rawMsg := s.updateParamsMsg(s.authority, types.MsgTypeFilterModeDenylist, "/cosmos.bank.v1beta1.MsgSend")
+	s.Require().NoError(s.keeper.Deliver(s.ctx, common.HexToHash("0xc9"), s.msgExecuteEvent(options, rawMsg)))
+	s.Require().Len(s.keeper.GetAllScheduledExecutions(s.ctx), 2)
+
+	s.Require().NoError(s.keeper.EndBlocker(s.ctx.WithBlockHeight(20)))
+	s.Require().Empty(s.keeper.GetAllScheduledExecutions(s.ctx))
+	s.Require().Equal(types.DefaultParams(), s.keeper.GetParams(s.ctx))
+
+	// Only the cancelling execution is recorded
+	s.Require().Equal(uint64(1), s.keeper.GetExecutionRecordLastID(s.ctx))
+	record, found := s.keeper.GetExecutionRecord(s.ctx, 1)
+	s.Require().True(found)
+	s.Require().True(record.Applied)
+	s.Require().Equal(uint64(1), record.ScheduledExecutionId)
+}
+
+func (s *KeeperTestSuite) Test_ScheduledExecution_InvalidOptions() {
+	options := `{"@type":"/mitosis.evmgov.v1.ExecutionOptions","execute_at_height":"20","execute_at_time":"2000000000"}`
+	rawMsg := s.updateParamsMsg(s.authority, types.MsgTypeFilterModeDenylist, "/cosmos.bank.v1beta1.MsgSend")
+	s.Require().NoError(s.keeper.Deliver(s.ctx, common.HexToHash("0xc5"), s.msgExecuteEvent(options, rawMsg)))
+
+	s.Require().Empty(s.keeper.GetAllScheduledExecutions(s.ctx))
+
+	record, found := s.keeper.GetExecutionRecord(s.ctx, 1)
+	s.Require().True(found)
+	s.Require().False(record.Applied)
+	s.Require().Contains(record.Results[0].Error, types.ErrInvalidExecutionOptions.Error())
+}
+
+func (s *KeeperTestSuite) Test_PruneExecutionRecords() {
+	s.keeper.SetExecutionRecord(s.ctx, types.ExecutionRecord{ID: 1, Height: 10})
+	s.keeper.SetExecutionRecord(s.ctx, types.ExecutionRecord{ID: 2, Height: 11})
+
+	// Nothing is pruned within the retention window
+	s.Require().NoError(s.keeper.EndBlocker(s.ctx.WithBlockHeight(10 + types.DefaultExecutionRecordRetentionBlocks - 1)))
+	s.Require().Len(s.keeper.GetAllExecutionRecords(s.ctx), 2)
+
+	s.Require().NoError(s.keeper.EndBlocker(s.ctx.WithBlockHeight(10 + types.DefaultExecutionRecordRetentionBlocks)))
+	_, found := s.keeper.GetExecutionRecord(s.ctx, 1)
+	s.Require().False(found)
+	_, found = s.keeper.GetExecutionRecord(s.ctx, 2)
+	s.Require().True(found)
+}
+
+func (s *KeeperTestSuite) Test_PruneExecutionRecords_RetentionParam() {
+	params := types.DefaultParams()
+	params.ExecutionRecordRetentionBlocks = 100
+	s.Require().NoError(s.keeper.SetParams(s.ctx, params))
+
+	s.keeper.SetExecutionRecord(s.ctx, types.ExecutionRecord{ID: 1, Height: 10})
+	s.keeper.SetExecutionRecord(s.ctx, types.ExecutionRecord{ID: 2, Height: 11})
+
+	s.Require().NoError(s.keeper.EndBlocker(s.ctx.WithBlockHeight(10 + 99)))
+	s.Require().Len(s.keeper.GetAllExecutionRecords(s.ctx), 2)
+
+	s.Require().NoError(s.keeper.EndBlocker(s.ctx.WithBlockHeight(10 + 100)))
+	_, found := s.keeper.GetExecutionRecord(s.ctx, 1)
+	s.Require().False(found)
+	_, found = s.keeper.GetExecutionRecord(s.ctx, 2)
+	s.Require().True(found)
+}
+
+func (s *KeeperTestSuite) Test_ParseMessage() {
+	moduleAddr := s.authority
+	otherAddr := authtypes.NewModuleAddress("other").String()
+
+	testCases := []struct {
+		name   string
+		rawMsg string
+		errMsg string
+	}{
+		{
+			name:   "valid",
+			rawMsg: s.updateParamsMsg(moduleAddr, types.MsgTypeFilterModeDenylist, "/cosmos.bank.v1beta1.MsgSend"),
+		},
+		{
+			name:   "unknown field",
+			rawMsg: fmt.Sprintf(`{"@type":"/mitosis.evmgov.v1.MsgCancelScheduledExecution","authority":"%s","id":"1","foo":"bar"}`, moduleAddr),
+			errMsg: `unknown field "foo"`,
+		},
+		{
+			name:   "unknown nested field",
+			rawMsg: fmt.Sprintf(`{"@type":"/mitosis.evmgov.v1.MsgUpdateParams","authority":"%s","params":{"msg_type_filter_mode":"MSG_TYPE_FILTER_MODE_DISABLED","foo":"bar"}}`, moduleAddr),
+			errMsg: `unknown field "foo"`,
+		},
+		{
+			name:   "missing field",
+			rawMsg: fmt.Sprintf(`{"@type":"/mitosis.evmgov.v1.MsgCancelScheduledExecution","authority":"%s"}`, moduleAddr),
+			errMsg: `missing field "id"`,
+		},
+		{
+			name:   "missing field by json name",
+			rawMsg: fmt.Sprintf(`{"@type":"/cosmos.bank.v1beta1.MsgSend","fromAddress":"%s","to_address":"%s"}`, moduleAddr, otherAddr),
+			errMsg: `missing field "amount"`,
+		},
+		{
+			name:   "invalid signer",
+			rawMsg: s.updateParamsMsg(otherAddr, types.MsgTypeFilterModeDisabled),
+			errMsg: `invalid field "authority": expected evmgov module address`,
+		},
+		{
+			name:   "invalid signer of other message",
+			rawMsg: fmt.Sprintf(`{"@type":"/cosmos.bank.v1beta1.MsgSend","from_address":"%s","to_address":"%s","amount":[{"denom":"stake","amount":"1"}]}`, otherAddr, moduleAddr),
+			errMsg: `invalid field "from_address"`,
+		},
+		{
+			name:   "validate basic",
+			rawMsg: s.updateParamsMsg(moduleAddr, types.MsgTypeFilterModeDenylist, "cosmos.bank.v1beta1.MsgSend"),
+			errMsg: "invalid msg type url",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			msg, err := s.keeper.ParseMessage(tc.rawMsg)
+			if tc.errMsg == "" {
+				s.Require().NoError(err)
+				s.Require().NotNil(msg)
+			} else {
+				s.Require().ErrorContains(err, tc.errMsg)
+			}
+		})
+	}
+}
+
+func (s *KeeperTestSuite) Test_SimulateExecute() {
+	rawMsgs := []string{
+		s.updateParamsMsg(s.authority, types.MsgTypeFilterModeDenylist, "/cosmos.bank.v1beta1.MsgSend"),
+	}
+
+	record, _, gasUsed, err := s.keeper.SimulateExecute(s.ctx, rawMsgs)
+	s.Require().NoError(err)
+	s.Require().True(record.Applied)
+	s.Require().Len(record.Results, 1)
+	s.Require().Equal(types.MessageStatusExecuted, record.Results[0].Status)
+	s.Require().Positive(gasUsed)
+
+	// Nothing was committed
+	s.Require().Equal(types.DefaultParams(), s.keeper.GetParams(s.ctx))
+	_, found := s.keeper.GetExecutionRecord(s.ctx, 1)
+	s.Require().False(found)
+
+	// A failed message is reported per message
+	options := `{"@type":"/mitosis.evmgov.v1.ExecutionOptions","mode":"EXECUTION_MODE_BEST_EFFORT","execute_at_height":"20"}`
+	rawMsgs = []string{options, rawMsgs[0], s.updateParamsMsg("invalid-authority", types.MsgTypeFilterModeDisabled)}
+
+	record, _, _, err = s.keeper.SimulateExecute(s.ctx, rawMsgs)
+	s.Require().NoError(err)
+	s.Require().Equal(types.ExecutionModeBestEffort, record.Mode)
+	s.Require().True(record.Applied)
+	s.Require().Len(record.Results, 2)
+	s.Require().Equal(types.MessageStatusExecuted, record.Results[0].Status)
+	s.Require().Equal(types.MessageStatusFailed, record.Results[1].Status)
+	s.Require().Contains(record.Results[1].Error, `invalid field "authority"`)
+
+	// Nothing was scheduled nor committed
+	s.Require().Empty(s.keeper.GetAllScheduledExecutions(s.ctx))
+	s.Require().Equal(types.DefaultParams(), s.keeper.GetParams(s.ctx))
+
+	// The simulation fails if it runs out of gas
+	s.keeper.SetSimulateGasLimit(gasUsed - 1)
+	_, _, _, err = s.keeper.SimulateExecute(s.ctx, rawMsgs[1:2])
+	s.Require().ErrorIs(err, sdkerrors.ErrOutOfGas)
+
+	_, err = keeper.NewQueryServer(s.keeper).SimulateExecute(s.ctx, &types.QuerySimulateExecuteRequest{Messages: rawMsgs[1:2]})
+	s.Require().Equal(codes.ResourceExhausted, status.Code(err))
+	s.Require().Equal(types.DefaultParams(), s.keeper.GetParams(s.ctx))
+}
@@ -0,0 +1,26 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Migrator is a struct for handling in-place store migrations of the evmgov module.
+type Migrator struct {
+	keeper *Keeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(keeper *Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// Migrate1to2 migrates the evmgov module state from consensus version 1 to 2.
+//
+// The module had no store in version 1, so the store is added by the store upgrades of the v2 upgrade
+// (see app/upgrades/v2), which runs this migration.
+// It only initializes the parameters. The governance entrypoint contract address is left unset, since the address
+// configured in app.toml is node-local and writing it would make the app hashes of misconfigured nodes diverge.
+// Until it is set via MsgUpdateGovEntrypointContractAddr, the address in app.toml keeps being used as before.
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	return m.keeper.SetParams(ctx, m.keeper.GetParams(ctx))
+}
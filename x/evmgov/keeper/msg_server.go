@@ -0,0 +1,72 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/mitosis-org/chain/x/evmgov/types"
+)
+
+var _ types.MsgServer = msgServer{}
+
+type msgServer struct {
+	k *Keeper
+}
+
+// NewMsgServerImpl returns an implementation of the evmgov MsgServer interface
+// for the provided Keeper.
+func NewMsgServerImpl(keeper *Keeper) types.MsgServer {
+	return &msgServer{k: keeper}
+}
+
+// UpdateParams updates the module parameters
+func (m msgServer) UpdateParams(ctx context.Context, msg *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	if m.k.authority != msg.Authority {
+		return nil, errors.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", m.k.authority, msg.Authority)
+	}
+
+	if err := m.k.SetParams(sdkCtx, msg.Params); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUpdateParamsResponse{}, nil
+}
+
+// CancelScheduledExecution cancels a scheduled execution
+func (m msgServer) CancelScheduledExecution(ctx context.Context, msg *types.MsgCancelScheduledExecution) (*types.MsgCancelScheduledExecutionResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	if m.k.authority != msg.Authority {
+		return nil, errors.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", m.k.authority, msg.Authority)
+	}
+
+	se, found := m.k.GetScheduledExecution(sdkCtx, msg.Id)
+	if !found {
+		return nil, errors.Wrapf(types.ErrScheduledExecutionNotFound, "id %d", msg.Id)
+	}
+
+	m.k.DeleteScheduledExecution(sdkCtx, se)
+
+	return &types.MsgCancelScheduledExecutionResponse{}, nil
+}
+
+// UpdateGovEntrypointContractAddr updates the address of the ConsensusGovernanceEntrypoint contract
+func (m msgServer) UpdateGovEntrypointContractAddr(ctx context.Context, msg *types.MsgUpdateGovEntrypointContractAddr) (*types.MsgUpdateGovEntrypointContractAddrResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	if m.k.authority != msg.Authority {
+		return nil, errors.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", m.k.authority, msg.Authority)
+	}
+
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	m.k.SetGovEntrypointContractAddr(sdkCtx, msg.Addr)
+
+	return &types.MsgUpdateGovEntrypointContractAddrResponse{}, nil
+}
@@ -0,0 +1,156 @@
+package keeper
+
+import (
+	"encoding/binary"
+	"sort"
+
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/mitosis-org/chain/x/evmgov/types"
+)
+
+// GetScheduledExecutionLastID gets the last ID of scheduled executions
+func (k *Keeper) GetScheduledExecutionLastID(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.ScheduledExecutionLastIDKey)
+
+	if bz == nil {
+		return 0
+	}
+
+	return binary.BigEndian.Uint64(bz)
+}
+
+// SetScheduledExecutionLastID sets the last ID of scheduled executions
+func (k *Keeper) SetScheduledExecutionLastID(ctx sdk.Context, lastID uint64) {
+	store := ctx.KVStore(k.storeKey)
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, lastID)
+	store.Set(types.ScheduledExecutionLastIDKey, bz)
+}
+
+// GetScheduledExecution gets the scheduled execution by ID
+func (k *Keeper) GetScheduledExecution(ctx sdk.Context, id uint64) (se types.ScheduledExecution, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetScheduledExecutionKey(id))
+	if bz == nil {
+		return se, false
+	}
+
+	k.cdc.MustUnmarshal(bz, &se)
+	return se, true
+}
+
+// SetScheduledExecution sets the scheduled execution and its index
+func (k *Keeper) SetScheduledExecution(ctx sdk.Context, se types.ScheduledExecution) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&se)
+	store.Set(types.GetScheduledExecutionKey(se.ID), bz)
+	store.Set(scheduledExecutionIndexKey(se), []byte{})
+}
+
+// DeleteScheduledExecution deletes the scheduled execution and its index
+func (k *Keeper) DeleteScheduledExecution(ctx sdk.Context, se types.ScheduledExecution) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetScheduledExecutionKey(se.ID))
+	store.Delete(scheduledExecutionIndexKey(se))
+}
+
+// AddNewScheduledExecutionWithNextID adds a new scheduled execution with the next ID
+func (k *Keeper) AddNewScheduledExecutionWithNextID(ctx sdk.Context, se *types.ScheduledExecution) {
+	se.ID = k.GetScheduledExecutionLastID(ctx) + 1
+	k.SetScheduledExecutionLastID(ctx, se.ID)
+	k.SetScheduledExecution(ctx, *se)
+}
+
+// GetAllScheduledExecutions gets all pending scheduled executions
+func (k *Keeper) GetAllScheduledExecutions(ctx sdk.Context) []types.ScheduledExecution {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, types.ScheduledExecutionKeyPrefix)
+	defer iterator.Close()
+
+	var scheduledExecutions []types.ScheduledExecution
+	for ; iterator.Valid(); iterator.Next() {
+		var se types.ScheduledExecution
+		k.cdc.MustUnmarshal(iterator.Value(), &se)
+		scheduledExecutions = append(scheduledExecutions, se)
+	}
+
+	return scheduledExecutions
+}
+
+// GetDueScheduledExecutions gets the scheduled executions which are due at the current block (sorted by ID)
+func (k *Keeper) GetDueScheduledExecutions(ctx sdk.Context) []types.ScheduledExecution {
+	store := ctx.KVStore(k.storeKey)
+
+	var ids []uint64
+	collect := func(prefix []byte, value int64) {
+		iterator := store.Iterator(prefix, types.GetScheduledExecutionIndexEndKey(prefix, value))
+		defer iterator.Close()
+
+		for ; iterator.Valid(); iterator.Next() {
+			ids = append(ids, types.ParseScheduledExecutionIndexKey(iterator.Key()))
+		}
+	}
+	collect(types.ScheduledExecutionByHeightKeyPrefix, ctx.BlockHeight())
+	collect(types.ScheduledExecutionByTimeKeyPrefix, ctx.BlockTime().Unix())
+
+	// Execute in the order they were scheduled
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+
+	scheduledExecutions := make([]types.ScheduledExecution, 0, len(ids))
+	for _, id := range ids {
+		se, found := k.GetScheduledExecution(ctx, id)
+		if !found {
+			panic("scheduled execution not found for index [BUG]")
+		}
+		scheduledExecutions = append(scheduledExecutions, se)
+	}
+
+	return scheduledExecutions
+}
+
+// ProcessScheduledExecutions executes the scheduled executions which are due at the current block.
+// The execution record is persisted regardless of whether the execution succeeded.
+// The executions cancelled by an earlier execution in the same block are skipped.
+func (k *Keeper) ProcessScheduledExecutions(ctx sdk.Context) {
+	for _, due := range k.GetDueScheduledExecutions(ctx) {
+		// A scheduled execution executed earlier in this block may have cancelled this one.
+		se, found := k.GetScheduledExecution(ctx, due.ID)
+		if !found {
+			continue
+		}
+		k.DeleteScheduledExecution(ctx, se)
+
+		evmBlockHash := common.HexToHash(se.EvmBlockHash)
+		record := &types.ExecutionRecord{
+			EvmBlockHash:         se.EvmBlockHash,
+			Height:               ctx.BlockHeight(),
+			Mode:                 se.Options.Mode,
+			ScheduledExecutionId: se.ID,
+			Results:              newMessageResults(se.RawMsgs),
+		}
+
+		cacheCtx, writeCache := ctx.CacheContext()
+		if err := k.executeMessages(cacheCtx, evmBlockHash, record); err != nil {
+			k.Logger(ctx).Error("Executing scheduled messages failed but ignored",
+				"id", se.ID,
+				"height", ctx.BlockHeight(),
+				"evmBlockHash", se.EvmBlockHash,
+				"err", err,
+			)
+		} else {
+			writeCache()
+		}
+
+		k.AddNewExecutionRecordWithNextID(ctx, record)
+	}
+}
+
+func scheduledExecutionIndexKey(se types.ScheduledExecution) []byte {
+	if se.Options.ExecuteAtTime != 0 {
+		return types.GetScheduledExecutionByTimeKey(se.Options.ExecuteAtTime, se.ID)
+	}
+	return types.GetScheduledExecutionByHeightKey(se.Options.ExecuteAtHeight, se.ID)
+}
@@ -0,0 +1,77 @@
+package keeper
+
+import (
+	"fmt"
+
+	"cosmossdk.io/log"
+	storetypes "cosmossdk.io/store/types"
+	abci "github.com/cometbft/cometbft/abci/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/mitosis-org/chain/x/evmgov/types"
+	"github.com/omni-network/omni/lib/errors"
+)
+
+// SimulateExecute executes the raw messages as MsgExecute does, but on a cache context that is always discarded.
+// The schedule of ExecutionOptions is ignored and the messages are simulated as if they are executed right now.
+// It returns the execution record, the events emitted during the execution and the gas used.
+// It returns an error if the execution consumes more gas than the simulation gas limit.
+func (k *Keeper) SimulateExecute(ctx sdk.Context, rawMsgs []string) (*types.ExecutionRecord, []abci.Event, uint64, error) {
+	gasMeter := storetypes.NewGasMeter(k.simulateGasLimit)
+	simCtx, _ := ctx.
+		WithGasMeter(gasMeter).
+		WithLogger(log.NewNopLogger()).
+		CacheContext()
+
+	record := &types.ExecutionRecord{
+		Height: ctx.BlockHeight(),
+	}
+
+	if len(rawMsgs) > 0 && isExecutionOptions(rawMsgs[0]) {
+		opts, err := k.ParseExecutionOptions(rawMsgs[0])
+		if err != nil {
+			record.Results = newMessageResults(rawMsgs)
+			record.Results[0].Status = types.MessageStatusFailed
+			record.Results[0].Error = err.Error()
+			return record, nil, gasMeter.GasConsumed(), nil
+		}
+
+		record.Mode = opts.Mode
+		rawMsgs = rawMsgs[1:]
+	}
+	record.Results = newMessageResults(rawMsgs)
+
+	if err := k.simulateMessages(simCtx, record); err != nil {
+		return nil, nil, gasMeter.GasConsumed(), err
+	}
+
+	// The panics of the message handlers are recovered and recorded as failed messages,
+	// so the gas meter is checked as well.
+	if gasMeter.IsPastLimit() {
+		return nil, nil, gasMeter.GasConsumed(), outOfGasError(gasMeter.Limit())
+	}
+
+	return record, simCtx.EventManager().ABCIEvents(), gasMeter.GasConsumed(), nil
+}
+
+// simulateMessages executes the messages of the record, and returns an error only if it runs out of gas.
+func (k *Keeper) simulateMessages(ctx sdk.Context, record *types.ExecutionRecord) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			if _, ok := r.(storetypes.ErrorOutOfGas); !ok {
+				panic(r)
+			}
+			err = outOfGasError(ctx.GasMeter().Limit())
+		}
+	}()
+
+	// The error is already recorded in the results, and the state changes are discarded anyway.
+	_ = k.executeMessages(ctx, common.Hash{}, record)
+
+	return nil
+}
+
+func outOfGasError(limit uint64) error {
+	return errors.Wrap(sdkerrors.ErrOutOfGas, fmt.Sprintf("simulation exceeded the gas limit %d", limit))
+}
@@ -1,10 +1,19 @@
 package evmgov
 
 import (
+	"context"
+	"encoding/json"
+	"fmt"
+
 	"cosmossdk.io/depinject/appconfig"
+	storetypes "cosmossdk.io/store/types"
 	"github.com/cosmos/cosmos-sdk/baseapp"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/mitosis-org/chain/x/evmgov/client/cli"
 	"github.com/mitosis-org/chain/x/evmgov/keeper"
-	"github.com/omni-network/omni/halo/evmstaking/types"
+	"github.com/mitosis-org/chain/x/evmgov/types"
+	"github.com/omni-network/omni/lib/errors"
+	"github.com/spf13/cobra"
 
 	evmengtypes "github.com/omni-network/omni/octane/evmengine/types"
 
@@ -13,6 +22,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/codec"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
 	"github.com/grpc-ecosystem/grpc-gateway/runtime"
 
@@ -20,12 +30,16 @@ import (
 )
 
 const (
-	ConsensusVersion = 1
+	// ConsensusVersion is bumped to 2 by the v2 upgrade, which adds the store of the module.
+	ConsensusVersion = 2
 )
 
 var (
-	_ module.AppModuleBasic = (*AppModule)(nil)
-	_ appmodule.AppModule   = (*AppModule)(nil)
+	_ module.AppModuleBasic   = (*AppModule)(nil)
+	_ appmodule.AppModule     = (*AppModule)(nil)
+	_ module.HasServices      = (*AppModule)(nil)
+	_ module.HasGenesis       = (*AppModule)(nil)
+	_ appmodule.HasEndBlocker = (*AppModule)(nil)
 )
 
 // ----------------------------------------------------------------------------
@@ -42,6 +56,11 @@ func NewAppModuleBasic(cdc codec.BinaryCodec) AppModuleBasic {
 	return AppModuleBasic{cdc: cdc}
 }
 
+// GetQueryCmd returns the evmgov module's root query command.
+func (AppModuleBasic) GetQueryCmd() *cobra.Command {
+	return cli.GetQueryCmd()
+}
+
 // Name returns the name of the module as a string.
 func (AppModuleBasic) Name() string {
 	return types.ModuleName
@@ -56,10 +75,16 @@ func (AppModuleBasic) ConsensusVersion() uint64 {
 func (AppModuleBasic) RegisterLegacyAminoCodec(*codec.LegacyAmino) {}
 
 // RegisterInterfaces registers a module's interface types and their concrete implementations as proto.Message.
-func (AppModuleBasic) RegisterInterfaces(codectypes.InterfaceRegistry) {}
+func (AppModuleBasic) RegisterInterfaces(reg codectypes.InterfaceRegistry) {
+	types.RegisterInterfaces(reg)
+}
 
 // RegisterGRPCGatewayRoutes registers the gRPC Gateway routes for the module.
-func (AppModuleBasic) RegisterGRPCGatewayRoutes(client.Context, *runtime.ServeMux) {}
+func (AppModuleBasic) RegisterGRPCGatewayRoutes(clientCtx client.Context, mux *runtime.ServeMux) {
+	if err := types.RegisterQueryHandlerClient(context.Background(), mux, types.NewQueryClient(clientCtx)); err != nil {
+		panic(err)
+	}
+}
 
 // ----------------------------------------------------------------------------
 // AppModule
@@ -82,8 +107,57 @@ func NewAppModule(
 	}
 }
 
+// EndBlock executes all ABCI EndBlock logic for the evmgov module.
+func (am AppModule) EndBlock(ctx context.Context) error {
+	return am.keeper.EndBlocker(sdk.UnwrapSDKContext(ctx))
+}
+
+// InitGenesis performs the evmgov module's genesis initialization
+func (am AppModule) InitGenesis(ctx sdk.Context, cdc codec.JSONCodec, raw json.RawMessage) {
+	var data types.GenesisState
+	cdc.MustUnmarshalJSON(raw, &data)
+
+	if err := am.keeper.InitGenesis(ctx, &data); err != nil {
+		panic(errors.Wrap(err, "init genesis"))
+	}
+}
+
+// ExportGenesis returns the evmgov module's exported genesis state as raw JSON bytes.
+func (am AppModule) ExportGenesis(ctx sdk.Context, cdc codec.JSONCodec) json.RawMessage {
+	genState := am.keeper.ExportGenesis(ctx)
+	return cdc.MustMarshalJSON(genState)
+}
+
+// DefaultGenesis returns the evmgov module's default genesis state.
+func (am AppModule) DefaultGenesis(cdc codec.JSONCodec) json.RawMessage {
+	return cdc.MustMarshalJSON(types.DefaultGenesisState())
+}
+
+// ValidateGenesis performs validation of the evmgov module's genesis state.
+func (am AppModule) ValidateGenesis(cdc codec.JSONCodec, _ client.TxEncodingConfig, bz json.RawMessage) error {
+	var data types.GenesisState
+	if err := cdc.UnmarshalJSON(bz, &data); err != nil {
+		return errors.Wrap(err, "unmarshal genesis state")
+	}
+
+	err := data.Validate()
+	if err != nil {
+		return errors.Wrap(err, "validate genesis state")
+	}
+
+	return nil
+}
+
 // RegisterServices registers a gRPC query service to respond to the module-specific gRPC queries.
-func (AppModule) RegisterServices() {}
+func (am AppModule) RegisterServices(cfg module.Configurator) {
+	types.RegisterMsgServer(cfg.MsgServer(), keeper.NewMsgServerImpl(am.keeper))
+	types.RegisterQueryServer(cfg.QueryServer(), keeper.NewQueryServer(am.keeper))
+
+	m := keeper.NewMigrator(am.keeper)
+	if err := cfg.RegisterMigration(types.ModuleName, 1, m.Migrate1to2); err != nil {
+		panic(fmt.Sprintf("failed to register migration of x/%s from version 1 to 2: %v", types.ModuleName, err))
+	}
+}
 
 // IsOnePerModuleType implements the depinject.OnePerModuleType interface.
 func (AppModule) IsOnePerModuleType() {}
@@ -108,6 +182,7 @@ type ModuleInputs struct {
 
 	Config           *modulev1.Module
 	Cdc              codec.Codec
+	StoreKey         *storetypes.KVStoreKey
 	MsgServiceRouter baseapp.MessageRouter
 }
 
@@ -120,9 +195,17 @@ type ModuleOutputs struct {
 }
 
 func ProvideModule(in ModuleInputs) (ModuleOutputs, error) {
+	// Parse authority address
+	if in.Config.Authority == "" {
+		return ModuleOutputs{}, errors.New("authority address is empty")
+	}
+	authority := authtypes.NewModuleAddressOrBech32Address(in.Config.Authority)
+
 	k, err := keeper.NewKeeper(
 		in.Cdc,
+		in.StoreKey,
 		in.MsgServiceRouter,
+		authority.String(),
 	)
 	if err != nil {
 		return ModuleOutputs{}, err
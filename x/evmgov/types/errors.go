@@ -0,0 +1,13 @@
+package types
+
+import (
+	"cosmossdk.io/errors"
+)
+
+// x/evmgov module sentinel errors
+var (
+	ErrMsgTypeNotAllowed          = errors.Register(ModuleName, 1, "message type not allowed")
+	ErrInvalidExecutionOptions    = errors.Register(ModuleName, 2, "invalid execution options")
+	ErrScheduledExecutionNotFound = errors.Register(ModuleName, 3, "scheduled execution not found")
+	ErrInvalidMessage             = errors.Register(ModuleName, 4, "invalid message")
+)
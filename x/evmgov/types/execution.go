@@ -0,0 +1,32 @@
+package types
+
+import (
+	"fmt"
+	"time"
+)
+
+// DefaultSimulateExecuteGasLimit is the default maximum gas which can be consumed by simulating an execution,
+// so that a query can't exhaust the resources of the node.
+const DefaultSimulateExecuteGasLimit uint64 = 100_000_000
+
+// Validate validates the execution options.
+func (o ExecutionOptions) Validate() error {
+	if _, ok := ExecutionMode_name[int32(o.Mode)]; !ok {
+		return fmt.Errorf("invalid execution mode: %d", o.Mode)
+	}
+	if o.ExecuteAtHeight < 0 {
+		return fmt.Errorf("execute_at_height must not be negative: %d", o.ExecuteAtHeight)
+	}
+	if o.ExecuteAtTime < 0 {
+		return fmt.Errorf("execute_at_time must not be negative: %d", o.ExecuteAtTime)
+	}
+	if o.ExecuteAtHeight != 0 && o.ExecuteAtTime != 0 {
+		return fmt.Errorf("execute_at_height and execute_at_time cannot be set together")
+	}
+	return nil
+}
+
+// IsScheduled returns whether the execution should be deferred to a later block.
+func (o ExecutionOptions) IsScheduled(height int64, blockTime time.Time) bool {
+	return o.ExecuteAtHeight > height || o.ExecuteAtTime > blockTime.Unix()
+}
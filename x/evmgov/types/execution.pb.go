@@ -0,0 +1,1591 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: mitosis/evmgov/v1/execution.proto
+
+package types
+
+import (
+	fmt "fmt"
+	types "github.com/cosmos/cosmos-sdk/codec/types"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// ExecutionMode defines how the messages of a MsgExecute event are executed
+type ExecutionMode int32
+
+const (
+	// EXECUTION_MODE_ATOMIC means no message is applied if any of them fails
+	ExecutionModeAtomic ExecutionMode = 0
+	// EXECUTION_MODE_BEST_EFFORT means each message is applied independently
+	// of the others
+	ExecutionModeBestEffort ExecutionMode = 1
+)
+
+var ExecutionMode_name = map[int32]string{
+	0: "EXECUTION_MODE_ATOMIC",
+	1: "EXECUTION_MODE_BEST_EFFORT",
+}
+
+var ExecutionMode_value = map[string]int32{
+	"EXECUTION_MODE_ATOMIC":      0,
+	"EXECUTION_MODE_BEST_EFFORT": 1,
+}
+
+func (x ExecutionMode) String() string {
+	return proto.EnumName(ExecutionMode_name, int32(x))
+}
+
+func (ExecutionMode) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_6ea6860481c25cf3, []int{0}
+}
+
+// MessageStatus defines the execution status of a governance message
+type MessageStatus int32
+
+const (
+	// MESSAGE_STATUS_UNSPECIFIED defines an unspecified status
+	MessageStatusUnspecified MessageStatus = 0
+	// MESSAGE_STATUS_EXECUTED means the message was executed and applied
+	MessageStatusExecuted MessageStatus = 1
+	// MESSAGE_STATUS_FAILED means the message failed to be parsed or executed
+	MessageStatusFailed MessageStatus = 2
+	// MESSAGE_STATUS_REVERTED means the message was executed but its state
+	// changes were reverted because another message in the atomic batch failed
+	MessageStatusReverted MessageStatus = 3
+	// MESSAGE_STATUS_NOT_EXECUTED means the message was not executed because
+	// another message in the atomic batch failed
+	MessageStatusNotExecuted MessageStatus = 4
+)
+
+var MessageStatus_name = map[int32]string{
+	0: "MESSAGE_STATUS_UNSPECIFIED",
+	1: "MESSAGE_STATUS_EXECUTED",
+	2: "MESSAGE_STATUS_FAILED",
+	3: "MESSAGE_STATUS_REVERTED",
+	4: "MESSAGE_STATUS_NOT_EXECUTED",
+}
+
+var MessageStatus_value = map[string]int32{
+	"MESSAGE_STATUS_UNSPECIFIED":  0,
+	"MESSAGE_STATUS_EXECUTED":     1,
+	"MESSAGE_STATUS_FAILED":       2,
+	"MESSAGE_STATUS_REVERTED":     3,
+	"MESSAGE_STATUS_NOT_EXECUTED": 4,
+}
+
+func (x MessageStatus) String() string {
+	return proto.EnumName(MessageStatus_name, int32(x))
+}
+
+func (MessageStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_6ea6860481c25cf3, []int{1}
+}
+
+// ExecutionOptions defines the options of a MsgExecute event. It can be put
+// as the first message of the event with its type URL, e.g.
+// {"@type": "/mitosis.evmgov.v1.ExecutionOptions", "mode":
+// "EXECUTION_MODE_BEST_EFFORT"}. If omitted, the default options are used.
+type ExecutionOptions struct {
+	// mode is the execution mode of the messages
+	Mode ExecutionMode `protobuf:"varint,1,opt,name=mode,proto3,enum=mitosis.evmgov.v1.ExecutionMode" json:"mode,omitempty"`
+	// execute_at_height is the block height at which the messages are executed.
+	// If zero or not in the future, the messages are not scheduled by height.
+	ExecuteAtHeight int64 `protobuf:"varint,2,opt,name=execute_at_height,json=executeAtHeight,proto3" json:"execute_at_height,omitempty"`
+	// execute_at_time is the unix timestamp (seconds) of the block time after
+	// which the messages are executed. If zero or not in the future, the messages
+	// are not scheduled by time. It cannot be used with execute_at_height.
+	ExecuteAtTime int64 `protobuf:"varint,3,opt,name=execute_at_time,json=executeAtTime,proto3" json:"execute_at_time,omitempty"`
+}
+
+func (m *ExecutionOptions) Reset()         { *m = ExecutionOptions{} }
+func (m *ExecutionOptions) String() string { return proto.CompactTextString(m) }
+func (*ExecutionOptions) ProtoMessage()    {}
+func (*ExecutionOptions) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6ea6860481c25cf3, []int{0}
+}
+func (m *ExecutionOptions) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ExecutionOptions) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ExecutionOptions.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ExecutionOptions) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExecutionOptions.Merge(m, src)
+}
+func (m *ExecutionOptions) XXX_Size() int {
+	return m.Size()
+}
+func (m *ExecutionOptions) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExecutionOptions.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExecutionOptions proto.InternalMessageInfo
+
+func (m *ExecutionOptions) GetMode() ExecutionMode {
+	if m != nil {
+		return m.Mode
+	}
+	return ExecutionModeAtomic
+}
+
+func (m *ExecutionOptions) GetExecuteAtHeight() int64 {
+	if m != nil {
+		return m.ExecuteAtHeight
+	}
+	return 0
+}
+
+func (m *ExecutionOptions) GetExecuteAtTime() int64 {
+	if m != nil {
+		return m.ExecuteAtTime
+	}
+	return 0
+}
+
+// MessageResult defines the execution result of a governance message
+type MessageResult struct {
+	// raw_msg is the raw JSON message emitted by the governance entrypoint
+	RawMsg string `protobuf:"bytes,1,opt,name=raw_msg,json=rawMsg,proto3" json:"raw_msg,omitempty"`
+	// status is the execution status of the message
+	Status MessageStatus `protobuf:"varint,2,opt,name=status,proto3,enum=mitosis.evmgov.v1.MessageStatus" json:"status,omitempty"`
+	// error is the error message if the message failed
+	Error string `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+	// response is the response of the message handler if the message was
+	// executed
+	Response *types.Any `protobuf:"bytes,4,opt,name=response,proto3" json:"response,omitempty"`
+}
+
+func (m *MessageResult) Reset()         { *m = MessageResult{} }
+func (m *MessageResult) String() string { return proto.CompactTextString(m) }
+func (*MessageResult) ProtoMessage()    {}
+func (*MessageResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6ea6860481c25cf3, []int{1}
+}
+func (m *MessageResult) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MessageResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MessageResult.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MessageResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MessageResult.Merge(m, src)
+}
+func (m *MessageResult) XXX_Size() int {
+	return m.Size()
+}
+func (m *MessageResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_MessageResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MessageResult proto.InternalMessageInfo
+
+func (m *MessageResult) GetRawMsg() string {
+	if m != nil {
+		return m.RawMsg
+	}
+	return ""
+}
+
+func (m *MessageResult) GetStatus() MessageStatus {
+	if m != nil {
+		return m.Status
+	}
+	return MessageStatusUnspecified
+}
+
+func (m *MessageResult) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+func (m *MessageResult) GetResponse() *types.Any {
+	if m != nil {
+		return m.Response
+	}
+	return nil
+}
+
+// ExecutionRecord defines the record of a MsgExecute event of the governance
+// entrypoint
+type ExecutionRecord struct {
+	ID uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// evm_block_hash is the hash of the EVM block containing the event
+	EvmBlockHash string `protobuf:"bytes,2,opt,name=evm_block_hash,json=evmBlockHash,proto3" json:"evm_block_hash,omitempty"`
+	// height is the height at which the event was processed
+	Height int64 `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
+	// applied indicates whether the state changes of the execution were applied.
+	// In best-effort mode, it is true if at least one message was executed.
+	Applied bool `protobuf:"varint,4,opt,name=applied,proto3" json:"applied,omitempty"`
+	// results is the list of the execution results of each message
+	Results []MessageResult `protobuf:"bytes,5,rep,name=results,proto3" json:"results"`
+	// mode is the execution mode of the messages
+	Mode ExecutionMode `protobuf:"varint,6,opt,name=mode,proto3,enum=mitosis.evmgov.v1.ExecutionMode" json:"mode,omitempty"`
+	// scheduled_execution_id is the ID of the scheduled execution if the messages
+	// were scheduled, otherwise zero
+	ScheduledExecutionId uint64 `protobuf:"varint,7,opt,name=scheduled_execution_id,json=scheduledExecutionId,proto3" json:"scheduled_execution_id,omitempty"`
+}
+
+func (m *ExecutionRecord) Reset()         { *m = ExecutionRecord{} }
+func (m *ExecutionRecord) String() string { return proto.CompactTextString(m) }
+func (*ExecutionRecord) ProtoMessage()    {}
+func (*ExecutionRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6ea6860481c25cf3, []int{2}
+}
+func (m *ExecutionRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ExecutionRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ExecutionRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ExecutionRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExecutionRecord.Merge(m, src)
+}
+func (m *ExecutionRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *ExecutionRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExecutionRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExecutionRecord proto.InternalMessageInfo
+
+func (m *ExecutionRecord) GetID() uint64 {
+	if m != nil {
+		return m.ID
+	}
+	return 0
+}
+
+func (m *ExecutionRecord) GetEvmBlockHash() string {
+	if m != nil {
+		return m.EvmBlockHash
+	}
+	return ""
+}
+
+func (m *ExecutionRecord) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *ExecutionRecord) GetApplied() bool {
+	if m != nil {
+		return m.Applied
+	}
+	return false
+}
+
+func (m *ExecutionRecord) GetResults() []MessageResult {
+	if m != nil {
+		return m.Results
+	}
+	return nil
+}
+
+func (m *ExecutionRecord) GetMode() ExecutionMode {
+	if m != nil {
+		return m.Mode
+	}
+	return ExecutionModeAtomic
+}
+
+func (m *ExecutionRecord) GetScheduledExecutionId() uint64 {
+	if m != nil {
+		return m.ScheduledExecutionId
+	}
+	return 0
+}
+
+// ScheduledExecution defines the messages of a MsgExecute event which are
+// scheduled to be executed later
+type ScheduledExecution struct {
+	ID uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// evm_block_hash is the hash of the EVM block containing the event
+	EvmBlockHash string `protobuf:"bytes,2,opt,name=evm_block_hash,json=evmBlockHash,proto3" json:"evm_block_hash,omitempty"`
+	// height is the height at which the messages were scheduled
+	Height int64 `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
+	// options is the execution options of the messages
+	Options ExecutionOptions `protobuf:"bytes,4,opt,name=options,proto3" json:"options"`
+	// raw_msgs is the list of the raw JSON messages to be executed
+	RawMsgs []string `protobuf:"bytes,5,rep,name=raw_msgs,json=rawMsgs,proto3" json:"raw_msgs,omitempty"`
+}
+
+func (m *ScheduledExecution) Reset()         { *m = ScheduledExecution{} }
+func (m *ScheduledExecution) String() string { return proto.CompactTextString(m) }
+func (*ScheduledExecution) ProtoMessage()    {}
+func (*ScheduledExecution) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6ea6860481c25cf3, []int{3}
+}
+func (m *ScheduledExecution) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ScheduledExecution) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ScheduledExecution.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ScheduledExecution) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScheduledExecution.Merge(m, src)
+}
+func (m *ScheduledExecution) XXX_Size() int {
+	return m.Size()
+}
+func (m *ScheduledExecution) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScheduledExecution.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ScheduledExecution proto.InternalMessageInfo
+
+func (m *ScheduledExecution) GetID() uint64 {
+	if m != nil {
+		return m.ID
+	}
+	return 0
+}
+
+func (m *ScheduledExecution) GetEvmBlockHash() string {
+	if m != nil {
+		return m.EvmBlockHash
+	}
+	return ""
+}
+
+func (m *ScheduledExecution) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *ScheduledExecution) GetOptions() ExecutionOptions {
+	if m != nil {
+		return m.Options
+	}
+	return ExecutionOptions{}
+}
+
+func (m *ScheduledExecution) GetRawMsgs() []string {
+	if m != nil {
+		return m.RawMsgs
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterEnum("mitosis.evmgov.v1.ExecutionMode", ExecutionMode_name, ExecutionMode_value)
+	proto.RegisterEnum("mitosis.evmgov.v1.MessageStatus", MessageStatus_name, MessageStatus_value)
+	proto.RegisterType((*ExecutionOptions)(nil), "mitosis.evmgov.v1.ExecutionOptions")
+	proto.RegisterType((*MessageResult)(nil), "mitosis.evmgov.v1.MessageResult")
+	proto.RegisterType((*ExecutionRecord)(nil), "mitosis.evmgov.v1.ExecutionRecord")
+	proto.RegisterType((*ScheduledExecution)(nil), "mitosis.evmgov.v1.ScheduledExecution")
+}
+
+func init() { proto.RegisterFile("mitosis/evmgov/v1/execution.proto", fileDescriptor_6ea6860481c25cf3) }
+
+var fileDescriptor_6ea6860481c25cf3 = []byte{
+	// 770 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x54, 0x4d, 0x8f, 0xdb, 0x44,
+	0x18, 0x8e, 0x9d, 0x34, 0xd9, 0x4c, 0x49, 0x37, 0x1d, 0xd2, 0x8d, 0xd7, 0x45, 0xa9, 0x09, 0x08,
+	0x45, 0x8b, 0xb0, 0xe9, 0x52, 0x21, 0x24, 0x40, 0x22, 0x1f, 0x13, 0x1a, 0x89, 0x24, 0x68, 0xec,
+	0x20, 0xc4, 0xc5, 0x72, 0xec, 0x89, 0x6d, 0x11, 0x67, 0x22, 0xcf, 0x24, 0xed, 0xfe, 0x01, 0x84,
+	0x72, 0xe2, 0x07, 0x90, 0x13, 0x37, 0x2e, 0xdc, 0xf8, 0x0d, 0x15, 0xa7, 0x1e, 0x39, 0x55, 0x28,
+	0xfb, 0x47, 0x90, 0x3f, 0x62, 0x48, 0xba, 0x54, 0xe2, 0xd0, 0x4b, 0x94, 0xd7, 0xef, 0xf3, 0xf8,
+	0x7d, 0xde, 0x67, 0x1e, 0x0f, 0x78, 0x3b, 0xf0, 0x39, 0x65, 0x3e, 0xd3, 0xc8, 0x3a, 0x70, 0xe9,
+	0x5a, 0x5b, 0x3f, 0xd4, 0xc8, 0x53, 0x62, 0xaf, 0xb8, 0x4f, 0x17, 0xea, 0x32, 0xa4, 0x9c, 0xc2,
+	0xbb, 0x29, 0x44, 0x4d, 0x20, 0xea, 0xfa, 0xa1, 0x5c, 0x73, 0xa9, 0x4b, 0xe3, 0xae, 0x16, 0xfd,
+	0x4b, 0x80, 0xf2, 0xb9, 0x4b, 0xa9, 0x3b, 0x27, 0x5a, 0x5c, 0x4d, 0x57, 0x33, 0xcd, 0x5a, 0x5c,
+	0x25, 0xad, 0xe6, 0xcf, 0x02, 0xa8, 0xa2, 0xfd, 0x7b, 0xc7, 0xcb, 0xe8, 0x97, 0xc1, 0x47, 0xa0,
+	0x10, 0x50, 0x87, 0x48, 0x82, 0x22, 0xb4, 0xee, 0x5c, 0x2a, 0xea, 0x4b, 0x73, 0xd4, 0x8c, 0x32,
+	0xa4, 0x0e, 0xc1, 0x31, 0x1a, 0x5e, 0x80, 0xbb, 0x89, 0x42, 0x62, 0x5a, 0xdc, 0xf4, 0x88, 0xef,
+	0x7a, 0x5c, 0x12, 0x15, 0xa1, 0x95, 0xc7, 0xa7, 0x69, 0xa3, 0xcd, 0x1f, 0xc7, 0x8f, 0xe1, 0x7b,
+	0xe0, 0xf4, 0x5f, 0x58, 0xee, 0x07, 0x44, 0xca, 0xc7, 0xc8, 0x4a, 0x86, 0x34, 0xfc, 0x80, 0x34,
+	0x7f, 0x15, 0x40, 0x65, 0x48, 0x18, 0xb3, 0x5c, 0x82, 0x09, 0x5b, 0xcd, 0x39, 0xac, 0x83, 0x52,
+	0x68, 0x3d, 0x31, 0x03, 0xe6, 0xc6, 0xf2, 0xca, 0xb8, 0x18, 0x5a, 0x4f, 0x86, 0xcc, 0x85, 0x9f,
+	0x80, 0x22, 0xe3, 0x16, 0x5f, 0x31, 0x49, 0xfc, 0x4f, 0xd9, 0xe9, 0xab, 0xf4, 0x18, 0x87, 0x53,
+	0x3c, 0xac, 0x81, 0x5b, 0x24, 0x0c, 0x69, 0x18, 0x4b, 0x28, 0xe3, 0xa4, 0x80, 0x1f, 0x82, 0x93,
+	0x90, 0xb0, 0x25, 0x5d, 0x30, 0x22, 0x15, 0x14, 0xa1, 0x75, 0xfb, 0xb2, 0xa6, 0x26, 0x3e, 0xaa,
+	0x7b, 0x1f, 0xd5, 0xf6, 0xe2, 0x0a, 0x67, 0xa8, 0xe6, 0x6f, 0x22, 0x38, 0xcd, 0x8c, 0xc1, 0xc4,
+	0xa6, 0xa1, 0x03, 0xcf, 0x80, 0xe8, 0x3b, 0xb1, 0xd2, 0x42, 0xa7, 0xb8, 0x7b, 0xf1, 0x40, 0x1c,
+	0xf4, 0xb0, 0xe8, 0x3b, 0xf0, 0x5d, 0x70, 0x87, 0xac, 0x03, 0x73, 0x3a, 0xa7, 0xf6, 0xf7, 0xa6,
+	0x67, 0x31, 0x2f, 0x56, 0x5d, 0xc6, 0x6f, 0x90, 0x75, 0xd0, 0x89, 0x1e, 0x3e, 0xb6, 0x98, 0x07,
+	0xcf, 0x40, 0x31, 0xf5, 0x31, 0x71, 0x27, 0xad, 0xa0, 0x04, 0x4a, 0xd6, 0x72, 0x39, 0xf7, 0x89,
+	0x13, 0x4b, 0x3b, 0xc1, 0xfb, 0x12, 0x7e, 0x01, 0x4a, 0x61, 0x6c, 0x14, 0x93, 0x6e, 0x29, 0xf9,
+	0xd6, 0xed, 0x57, 0xd9, 0x90, 0x38, 0xda, 0x29, 0x3c, 0x7b, 0xf1, 0x20, 0x87, 0xf7, 0xb4, 0xec,
+	0xf0, 0x8b, 0xff, 0xeb, 0xf0, 0x1f, 0x81, 0x33, 0x66, 0x7b, 0xc4, 0x59, 0xcd, 0x89, 0x63, 0x66,
+	0x41, 0x35, 0x7d, 0x47, 0x2a, 0x45, 0xbb, 0xe3, 0x5a, 0xd6, 0xcd, 0xd8, 0x03, 0xa7, 0xf9, 0x87,
+	0x00, 0xa0, 0xfe, 0x52, 0xe3, 0x35, 0x99, 0xd6, 0x05, 0x25, 0x9a, 0x04, 0x3c, 0x3d, 0xcf, 0x77,
+	0x5e, 0xb5, 0x5b, 0xfa, 0x2d, 0xec, 0xdd, 0x49, 0x99, 0xf0, 0x1c, 0x9c, 0xa4, 0xf1, 0x4b, 0x0c,
+	0x2e, 0xe3, 0x52, 0x92, 0x3f, 0x76, 0xf1, 0x83, 0x00, 0x2a, 0x07, 0xd6, 0xc0, 0x4b, 0x70, 0x0f,
+	0x7d, 0x8b, 0xba, 0x13, 0x63, 0x30, 0x1e, 0x99, 0xc3, 0x71, 0x0f, 0x99, 0x6d, 0x63, 0x3c, 0x1c,
+	0x74, 0xab, 0x39, 0xb9, 0xbe, 0xd9, 0x2a, 0x6f, 0x1e, 0xa0, 0xdb, 0x9c, 0x06, 0xbe, 0x0d, 0x3f,
+	0x05, 0xf2, 0x11, 0xa7, 0x83, 0x74, 0xc3, 0x44, 0xfd, 0xfe, 0x18, 0x1b, 0x55, 0x41, 0xbe, 0xbf,
+	0xd9, 0x2a, 0xf5, 0x03, 0x62, 0x87, 0x30, 0x8e, 0x66, 0x33, 0x1a, 0x72, 0xb9, 0xf0, 0xe3, 0x2f,
+	0x8d, 0xdc, 0xc5, 0xef, 0x22, 0xa8, 0x1c, 0x24, 0x1d, 0x7e, 0x06, 0xe4, 0x21, 0xd2, 0xf5, 0xf6,
+	0x97, 0xc8, 0xd4, 0x8d, 0xb6, 0x31, 0xd1, 0xcd, 0xc9, 0x48, 0xff, 0x1a, 0x75, 0x07, 0xfd, 0x01,
+	0xea, 0x55, 0x73, 0xf2, 0x5b, 0x9b, 0xad, 0x22, 0x1d, 0x50, 0x26, 0x0b, 0xb6, 0x24, 0xb6, 0x3f,
+	0x8b, 0x32, 0xf5, 0x31, 0xa8, 0x1f, 0xb1, 0x13, 0x85, 0xa8, 0x57, 0x15, 0xe4, 0xf3, 0xcd, 0x56,
+	0xb9, 0x77, 0x40, 0x4d, 0xc4, 0x11, 0x27, 0x5a, 0xff, 0x88, 0xd7, 0x6f, 0x0f, 0xbe, 0x42, 0xbd,
+	0xaa, 0x98, 0xac, 0x7f, 0xc0, 0xea, 0x5b, 0xfe, 0xfc, 0xc6, 0x59, 0x18, 0x7d, 0x83, 0x70, 0x34,
+	0x2b, 0x7f, 0xc3, 0x2c, 0x4c, 0xd6, 0x24, 0x8c, 0x66, 0x7d, 0x0e, 0xee, 0x1f, 0xf1, 0x46, 0x63,
+	0xe3, 0x1f, 0x9d, 0x85, 0x1b, 0x56, 0x1c, 0x51, 0xbe, 0x97, 0x9a, 0x18, 0xd7, 0x41, 0xcf, 0x76,
+	0x0d, 0xe1, 0xf9, 0xae, 0x21, 0xfc, 0xb5, 0x6b, 0x08, 0x3f, 0x5d, 0x37, 0x72, 0xcf, 0xaf, 0x1b,
+	0xb9, 0x3f, 0xaf, 0x1b, 0xb9, 0xef, 0xde, 0x77, 0x7d, 0xee, 0xad, 0xa6, 0xaa, 0x4d, 0x03, 0x2d,
+	0x0d, 0xcd, 0x07, 0x34, 0x74, 0x35, 0xdb, 0xb3, 0xfc, 0x85, 0xf6, 0x74, 0x7f, 0x49, 0xf3, 0xab,
+	0x25, 0x61, 0xd3, 0x62, 0x7c, 0x3f, 0x7c, 0xf4, 0xf7, 0x00, 0xfe, 0x25, 0xc1, 0xab, 0xc3, 0x05,
+	0x00, 0x00,
+}
+
+func (m *ExecutionOptions) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExecutionOptions) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ExecutionOptions) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ExecuteAtTime != 0 {
+		i = encodeVarintExecution(dAtA, i, uint64(m.ExecuteAtTime))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.ExecuteAtHeight != 0 {
+		i = encodeVarintExecution(dAtA, i, uint64(m.ExecuteAtHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Mode != 0 {
+		i = encodeVarintExecution(dAtA, i, uint64(m.Mode))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MessageResult) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MessageResult) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MessageResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Response != nil {
+		{
+			size, err := m.Response.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintExecution(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintExecution(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Status != 0 {
+		i = encodeVarintExecution(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.RawMsg) > 0 {
+		i -= len(m.RawMsg)
+		copy(dAtA[i:], m.RawMsg)
+		i = encodeVarintExecution(dAtA, i, uint64(len(m.RawMsg)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ExecutionRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExecutionRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ExecutionRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ScheduledExecutionId != 0 {
+		i = encodeVarintExecution(dAtA, i, uint64(m.ScheduledExecutionId))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.Mode != 0 {
+		i = encodeVarintExecution(dAtA, i, uint64(m.Mode))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Results) > 0 {
+		for iNdEx := len(m.Results) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Results[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintExecution(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.Applied {
+		i--
+		if m.Applied {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Height != 0 {
+		i = encodeVarintExecution(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.EvmBlockHash) > 0 {
+		i -= len(m.EvmBlockHash)
+		copy(dAtA[i:], m.EvmBlockHash)
+		i = encodeVarintExecution(dAtA, i, uint64(len(m.EvmBlockHash)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ID != 0 {
+		i = encodeVarintExecution(dAtA, i, uint64(m.ID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ScheduledExecution) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ScheduledExecution) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ScheduledExecution) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RawMsgs) > 0 {
+		for iNdEx := len(m.RawMsgs) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.RawMsgs[iNdEx])
+			copy(dAtA[i:], m.RawMsgs[iNdEx])
+			i = encodeVarintExecution(dAtA, i, uint64(len(m.RawMsgs[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	{
+		size, err := m.Options.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintExecution(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.Height != 0 {
+		i = encodeVarintExecution(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.EvmBlockHash) > 0 {
+		i -= len(m.EvmBlockHash)
+		copy(dAtA[i:], m.EvmBlockHash)
+		i = encodeVarintExecution(dAtA, i, uint64(len(m.EvmBlockHash)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ID != 0 {
+		i = encodeVarintExecution(dAtA, i, uint64(m.ID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintExecution(dAtA []byte, offset int, v uint64) int {
+	offset -= sovExecution(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ExecutionOptions) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Mode != 0 {
+		n += 1 + sovExecution(uint64(m.Mode))
+	}
+	if m.ExecuteAtHeight != 0 {
+		n += 1 + sovExecution(uint64(m.ExecuteAtHeight))
+	}
+	if m.ExecuteAtTime != 0 {
+		n += 1 + sovExecution(uint64(m.ExecuteAtTime))
+	}
+	return n
+}
+
+func (m *MessageResult) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RawMsg)
+	if l > 0 {
+		n += 1 + l + sovExecution(uint64(l))
+	}
+	if m.Status != 0 {
+		n += 1 + sovExecution(uint64(m.Status))
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovExecution(uint64(l))
+	}
+	if m.Response != nil {
+		l = m.Response.Size()
+		n += 1 + l + sovExecution(uint64(l))
+	}
+	return n
+}
+
+func (m *ExecutionRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ID != 0 {
+		n += 1 + sovExecution(uint64(m.ID))
+	}
+	l = len(m.EvmBlockHash)
+	if l > 0 {
+		n += 1 + l + sovExecution(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovExecution(uint64(m.Height))
+	}
+	if m.Applied {
+		n += 2
+	}
+	if len(m.Results) > 0 {
+		for _, e := range m.Results {
+			l = e.Size()
+			n += 1 + l + sovExecution(uint64(l))
+		}
+	}
+	if m.Mode != 0 {
+		n += 1 + sovExecution(uint64(m.Mode))
+	}
+	if m.ScheduledExecutionId != 0 {
+		n += 1 + sovExecution(uint64(m.ScheduledExecutionId))
+	}
+	return n
+}
+
+func (m *ScheduledExecution) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ID != 0 {
+		n += 1 + sovExecution(uint64(m.ID))
+	}
+	l = len(m.EvmBlockHash)
+	if l > 0 {
+		n += 1 + l + sovExecution(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovExecution(uint64(m.Height))
+	}
+	l = m.Options.Size()
+	n += 1 + l + sovExecution(uint64(l))
+	if len(m.RawMsgs) > 0 {
+		for _, s := range m.RawMsgs {
+			l = len(s)
+			n += 1 + l + sovExecution(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovExecution(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozExecution(x uint64) (n int) {
+	return sovExecution(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ExecutionOptions) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowExecution
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ExecutionOptions: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ExecutionOptions: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mode", wireType)
+			}
+			m.Mode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Mode |= ExecutionMode(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecuteAtHeight", wireType)
+			}
+			m.ExecuteAtHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExecuteAtHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecuteAtTime", wireType)
+			}
+			m.ExecuteAtTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExecuteAtTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipExecution(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthExecution
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MessageResult) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowExecution
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MessageResult: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MessageResult: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RawMsg", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExecution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExecution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RawMsg = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= MessageStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExecution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExecution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Response", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthExecution
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthExecution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Response == nil {
+				m.Response = &types.Any{}
+			}
+			if err := m.Response.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipExecution(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthExecution
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ExecutionRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowExecution
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ExecutionRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ExecutionRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			m.ID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EvmBlockHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExecution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExecution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EvmBlockHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Applied", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Applied = bool(v != 0)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Results", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthExecution
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthExecution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Results = append(m.Results, MessageResult{})
+			if err := m.Results[len(m.Results)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mode", wireType)
+			}
+			m.Mode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Mode |= ExecutionMode(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduledExecutionId", wireType)
+			}
+			m.ScheduledExecutionId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ScheduledExecutionId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipExecution(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthExecution
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ScheduledExecution) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowExecution
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ScheduledExecution: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ScheduledExecution: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			m.ID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EvmBlockHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExecution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExecution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EvmBlockHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Options", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthExecution
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthExecution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Options.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RawMsgs", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExecution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExecution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RawMsgs = append(m.RawMsgs, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipExecution(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthExecution
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipExecution(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowExecution
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowExecution
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowExecution
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthExecution
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupExecution
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthExecution
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthExecution        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowExecution          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupExecution = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,77 @@
+package types
+
+import (
+	"fmt"
+
+	mitotypes "github.com/mitosis-org/chain/types"
+
+	"github.com/omni-network/omni/lib/errors"
+)
+
+// NewGenesisState creates a new GenesisState object
+func NewGenesisState(
+	params Params,
+	govEntrypointContractAddr mitotypes.EthAddress,
+	executionRecords []ExecutionRecord,
+	executionRecordLastID uint64,
+	scheduledExecutions []ScheduledExecution,
+	scheduledExecutionLastID uint64,
+) *GenesisState {
+	return &GenesisState{
+		Params:                    params,
+		GovEntrypointContractAddr: govEntrypointContractAddr,
+		ExecutionRecords:          executionRecords,
+		ExecutionRecordLastID:     executionRecordLastID,
+		ScheduledExecutions:       scheduledExecutions,
+		ScheduledExecutionLastID:  scheduledExecutionLastID,
+	}
+}
+
+// DefaultGenesisState creates a default GenesisState object
+func DefaultGenesisState() *GenesisState {
+	return &GenesisState{
+		Params:                    DefaultParams(),
+		GovEntrypointContractAddr: mitotypes.EthAddress{},
+		ExecutionRecords:          []ExecutionRecord{},
+		ExecutionRecordLastID:     0,
+		ScheduledExecutions:       []ScheduledExecution{},
+		ScheduledExecutionLastID:  0,
+	}
+}
+
+// Validate performs basic genesis state validation
+func (gs GenesisState) Validate() error {
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+
+	// Validate execution records
+	recordIDs := make(map[uint64]struct{}, len(gs.ExecutionRecords))
+	for i, record := range gs.ExecutionRecords {
+		if record.ID == 0 || record.ID > gs.ExecutionRecordLastID {
+			return fmt.Errorf("execution record %d has invalid id: %d (last id: %d)", i, record.ID, gs.ExecutionRecordLastID)
+		}
+		if _, ok := recordIDs[record.ID]; ok {
+			return fmt.Errorf("duplicate execution record id: %d", record.ID)
+		}
+		recordIDs[record.ID] = struct{}{}
+	}
+
+	// Validate scheduled executions
+	scheduledIDs := make(map[uint64]struct{}, len(gs.ScheduledExecutions))
+	for i, se := range gs.ScheduledExecutions {
+		if se.ID == 0 || se.ID > gs.ScheduledExecutionLastID {
+			return fmt.Errorf("scheduled execution %d has invalid id: %d (last id: %d)", i, se.ID, gs.ScheduledExecutionLastID)
+		}
+		if _, ok := scheduledIDs[se.ID]; ok {
+			return fmt.Errorf("duplicate scheduled execution id: %d", se.ID)
+		}
+		scheduledIDs[se.ID] = struct{}{}
+
+		if err := se.Options.Validate(); err != nil {
+			return errors.Wrap(err, fmt.Sprintf("scheduled execution %d has invalid options", se.ID))
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,582 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: mitosis/evmgov/v1/genesis.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	github_com_mitosis_org_chain_types "github.com/mitosis-org/chain/types"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// GenesisState defines the evmgov module's genesis state
+type GenesisState struct {
+	// params defines all the parameters of the module
+	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	// gov_entrypoint_contract_addr is the address of the
+	// ConsensusGovernanceEntrypoint contract
+	GovEntrypointContractAddr github_com_mitosis_org_chain_types.EthAddress `protobuf:"bytes,2,opt,name=gov_entrypoint_contract_addr,json=govEntrypointContractAddr,proto3,customtype=github.com/mitosis-org/chain/types.EthAddress" json:"gov_entrypoint_contract_addr"`
+	// execution_records is the list of execution records
+	ExecutionRecords []ExecutionRecord `protobuf:"bytes,3,rep,name=execution_records,json=executionRecords,proto3" json:"execution_records"`
+	// execution_record_last_id is the last ID of execution records
+	ExecutionRecordLastID uint64 `protobuf:"varint,4,opt,name=execution_record_last_id,json=executionRecordLastId,proto3" json:"execution_record_last_id,omitempty"`
+	// scheduled_executions is the list of pending scheduled executions
+	ScheduledExecutions []ScheduledExecution `protobuf:"bytes,5,rep,name=scheduled_executions,json=scheduledExecutions,proto3" json:"scheduled_executions"`
+	// scheduled_execution_last_id is the last ID of scheduled executions
+	ScheduledExecutionLastID uint64 `protobuf:"varint,6,opt,name=scheduled_execution_last_id,json=scheduledExecutionLastId,proto3" json:"scheduled_execution_last_id,omitempty"`
+}
+
+func (m *GenesisState) Reset()         { *m = GenesisState{} }
+func (m *GenesisState) String() string { return proto.CompactTextString(m) }
+func (*GenesisState) ProtoMessage()    {}
+func (*GenesisState) Descriptor() ([]byte, []int) {
+	return fileDescriptor_880d97ecf5a1f85f, []int{0}
+}
+func (m *GenesisState) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GenesisState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GenesisState.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GenesisState) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GenesisState.Merge(m, src)
+}
+func (m *GenesisState) XXX_Size() int {
+	return m.Size()
+}
+func (m *GenesisState) XXX_DiscardUnknown() {
+	xxx_messageInfo_GenesisState.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GenesisState proto.InternalMessageInfo
+
+func (m *GenesisState) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
+func (m *GenesisState) GetExecutionRecords() []ExecutionRecord {
+	if m != nil {
+		return m.ExecutionRecords
+	}
+	return nil
+}
+
+func (m *GenesisState) GetExecutionRecordLastID() uint64 {
+	if m != nil {
+		return m.ExecutionRecordLastID
+	}
+	return 0
+}
+
+func (m *GenesisState) GetScheduledExecutions() []ScheduledExecution {
+	if m != nil {
+		return m.ScheduledExecutions
+	}
+	return nil
+}
+
+func (m *GenesisState) GetScheduledExecutionLastID() uint64 {
+	if m != nil {
+		return m.ScheduledExecutionLastID
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*GenesisState)(nil), "mitosis.evmgov.v1.GenesisState")
+}
+
+func init() { proto.RegisterFile("mitosis/evmgov/v1/genesis.proto", fileDescriptor_880d97ecf5a1f85f) }
+
+var fileDescriptor_880d97ecf5a1f85f = []byte{
+	// 415 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x92, 0xc1, 0x6a, 0xd4, 0x40,
+	0x18, 0xc7, 0x37, 0x76, 0xdd, 0xc3, 0xb4, 0x07, 0x1b, 0x5b, 0x98, 0x5d, 0x4b, 0x12, 0x0b, 0x42,
+	0x40, 0x9a, 0xd0, 0x8a, 0x78, 0x36, 0x1a, 0x44, 0xf0, 0x20, 0x29, 0x5e, 0x14, 0x0c, 0xd3, 0xcc,
+	0x30, 0x19, 0x68, 0x32, 0x61, 0xbe, 0xd9, 0xd0, 0xbe, 0x85, 0x6f, 0xe1, 0xab, 0xf4, 0xd8, 0xa3,
+	0x78, 0x08, 0x92, 0x7d, 0x11, 0xd9, 0x64, 0x36, 0x60, 0x13, 0xbc, 0x85, 0xf9, 0xff, 0xf2, 0xfb,
+	0xfe, 0xc3, 0x7c, 0xc8, 0x2d, 0x84, 0x96, 0x20, 0x20, 0x64, 0x75, 0xc1, 0x65, 0x1d, 0xd6, 0xe7,
+	0x21, 0x67, 0x25, 0x03, 0x01, 0x41, 0xa5, 0xa4, 0x96, 0xf6, 0xa1, 0x01, 0x82, 0x1e, 0x08, 0xea,
+	0xf3, 0xd5, 0x11, 0x97, 0x5c, 0x76, 0x69, 0xb8, 0xfd, 0xea, 0xc1, 0x95, 0x33, 0x36, 0x55, 0x44,
+	0x91, 0xc2, 0x88, 0x56, 0xcf, 0xc7, 0x39, 0xbb, 0x61, 0xd9, 0x5a, 0x0b, 0x59, 0xf6, 0xc8, 0xe9,
+	0xcf, 0x39, 0x3a, 0xf8, 0xd0, 0x4f, 0xbf, 0xd4, 0x44, 0x33, 0xfb, 0x0d, 0x5a, 0xf4, 0x0e, 0x6c,
+	0x79, 0x96, 0xbf, 0x7f, 0xb1, 0x0c, 0x46, 0x6d, 0x82, 0xcf, 0x1d, 0x10, 0xcd, 0xef, 0x1a, 0x77,
+	0x96, 0x18, 0xdc, 0xae, 0xd1, 0x09, 0x97, 0x75, 0xca, 0x4a, 0xad, 0x6e, 0x2b, 0x29, 0x4a, 0x9d,
+	0x66, 0xb2, 0xd4, 0x8a, 0x64, 0x3a, 0x25, 0x94, 0x2a, 0xfc, 0xc8, 0xb3, 0xfc, 0x83, 0xe8, 0xf5,
+	0xf6, 0x9f, 0xdf, 0x8d, 0x7b, 0xc6, 0x85, 0xce, 0xd7, 0x57, 0x41, 0x26, 0x8b, 0xd0, 0x0c, 0x38,
+	0x93, 0x8a, 0x87, 0x59, 0x4e, 0x44, 0x19, 0xea, 0xdb, 0x8a, 0x41, 0x10, 0xeb, 0xfc, 0x2d, 0xa5,
+	0x8a, 0x01, 0x24, 0x4b, 0x2e, 0xeb, 0x78, 0x30, 0xbf, 0x33, 0xe2, 0x6d, 0x6a, 0x7f, 0x41, 0x87,
+	0xc3, 0xa5, 0x52, 0xc5, 0x32, 0xa9, 0x28, 0xe0, 0x3d, 0x6f, 0xcf, 0xdf, 0xbf, 0x38, 0x9d, 0xe8,
+	0x1e, 0xef, 0xd8, 0xa4, 0x43, 0xcd, 0x25, 0x9e, 0xb0, 0x7f, 0x8f, 0xc1, 0x4e, 0x10, 0x7e, 0xa8,
+	0x4d, 0xaf, 0x09, 0xe8, 0x54, 0x50, 0x3c, 0xf7, 0x2c, 0x7f, 0x1e, 0x2d, 0xdb, 0xc6, 0x3d, 0x7e,
+	0xa0, 0xfb, 0x44, 0x40, 0x7f, 0x7c, 0x9f, 0x1c, 0xb3, 0x89, 0x63, 0x6a, 0x7f, 0x47, 0x47, 0x90,
+	0xe5, 0x8c, 0xae, 0xaf, 0x19, 0x4d, 0x07, 0x04, 0xf0, 0xe3, 0xae, 0xed, 0x8b, 0x89, 0xb6, 0x97,
+	0x3b, 0x7c, 0x98, 0x63, 0x0a, 0x3f, 0x85, 0x51, 0x02, 0xf6, 0x37, 0xf4, 0x6c, 0xc2, 0x3f, 0xd4,
+	0x5e, 0x74, 0xb5, 0x4f, 0xda, 0xc6, 0xc5, 0x63, 0xaf, 0x69, 0x8e, 0x61, 0x3a, 0xa1, 0x51, 0x7c,
+	0xd7, 0x3a, 0xd6, 0x7d, 0xeb, 0x58, 0x7f, 0x5a, 0xc7, 0xfa, 0xb1, 0x71, 0x66, 0xf7, 0x1b, 0x67,
+	0xf6, 0x6b, 0xe3, 0xcc, 0xbe, 0xbe, 0xfc, 0xef, 0x5b, 0xde, 0xec, 0xb6, 0xaf, 0x7b, 0xd4, 0xab,
+	0x45, 0xb7, 0x77, 0xaf, 0xfe, 0x0e, 0x00, 0x49, 0x75, 0xf2, 0x03, 0x06, 0x03, 0x00, 0x00,
+}
+
+func (m *GenesisState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GenesisState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ScheduledExecutionLastID != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.ScheduledExecutionLastID))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.ScheduledExecutions) > 0 {
+		for iNdEx := len(m.ScheduledExecutions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ScheduledExecutions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.ExecutionRecordLastID != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.ExecutionRecordLastID))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.ExecutionRecords) > 0 {
+		for iNdEx := len(m.ExecutionRecords) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ExecutionRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	{
+		size := m.GovEntrypointContractAddr.Size()
+		i -= size
+		if _, err := m.GovEntrypointContractAddr.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGenesis(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *GenesisState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.GovEntrypointContractAddr.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.ExecutionRecords) > 0 {
+		for _, e := range m.ExecutionRecords {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.ExecutionRecordLastID != 0 {
+		n += 1 + sovGenesis(uint64(m.ExecutionRecordLastID))
+	}
+	if len(m.ScheduledExecutions) > 0 {
+		for _, e := range m.ScheduledExecutions {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.ScheduledExecutionLastID != 0 {
+		n += 1 + sovGenesis(uint64(m.ScheduledExecutionLastID))
+	}
+	return n
+}
+
+func sovGenesis(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozGenesis(x uint64) (n int) {
+	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *GenesisState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GovEntrypointContractAddr", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.GovEntrypointContractAddr.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutionRecords", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExecutionRecords = append(m.ExecutionRecords, ExecutionRecord{})
+			if err := m.ExecutionRecords[len(m.ExecutionRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutionRecordLastID", wireType)
+			}
+			m.ExecutionRecordLastID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExecutionRecordLastID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduledExecutions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ScheduledExecutions = append(m.ScheduledExecutions, ScheduledExecution{})
+			if err := m.ScheduledExecutions[len(m.ScheduledExecutions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduledExecutionLastID", wireType)
+			}
+			m.ScheduledExecutionLastID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ScheduledExecutionLastID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipGenesis(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthGenesis
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupGenesis
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthGenesis
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthGenesis        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowGenesis          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupGenesis = fmt.Errorf("proto: unexpected end of group")
+)
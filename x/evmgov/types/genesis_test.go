@@ -0,0 +1,51 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/mitosis-org/chain/x/evmgov/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenesisState_Validate(t *testing.T) {
+	withState := func(f func(gs *types.GenesisState)) types.GenesisState {
+		gs := types.DefaultGenesisState()
+		gs.ExecutionRecords = []types.ExecutionRecord{{ID: 1}, {ID: 2}}
+		gs.ExecutionRecordLastID = 2
+		gs.ScheduledExecutions = []types.ScheduledExecution{{ID: 1, Options: types.ExecutionOptions{ExecuteAtHeight: 10}}}
+		gs.ScheduledExecutionLastID = 1
+		f(gs)
+		return *gs
+	}
+
+	testCases := []struct {
+		name     string
+		genState types.GenesisState
+		wantErr  bool
+	}{
+		{"default", *types.DefaultGenesisState(), false},
+		{"valid", withState(func(*types.GenesisState) {}), false},
+		{"invalid params", withState(func(gs *types.GenesisState) { gs.Params.MsgTypeFilterMode = 3 }), true},
+		{"zero record id", withState(func(gs *types.GenesisState) { gs.ExecutionRecords[0].ID = 0 }), true},
+		{"record id above last id", withState(func(gs *types.GenesisState) { gs.ExecutionRecordLastID = 1 }), true},
+		{"duplicate record id", withState(func(gs *types.GenesisState) { gs.ExecutionRecords[1].ID = 1 }), true},
+		{"scheduled id above last id", withState(func(gs *types.GenesisState) { gs.ScheduledExecutionLastID = 0 }), true},
+		{"duplicate scheduled id", withState(func(gs *types.GenesisState) {
+			gs.ScheduledExecutions = append(gs.ScheduledExecutions, gs.ScheduledExecutions[0])
+		}), true},
+		{"invalid scheduled options", withState(func(gs *types.GenesisState) {
+			gs.ScheduledExecutions[0].Options.ExecuteAtTime = 100
+		}), true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.genState.Validate()
+			if tc.wantErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
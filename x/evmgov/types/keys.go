@@ -1,6 +1,103 @@
 package types
 
+import (
+	"encoding/binary"
+)
+
 const (
 	// ModuleName defines the module name.
 	ModuleName = "evmgov"
+
+	// StoreKey defines the primary module store key.
+	StoreKey = ModuleName
 )
+
+var (
+	// ParamsKey is the key for module parameters
+	ParamsKey = []byte{0x01}
+
+	// ExecutionRecordKeyPrefix is the prefix for execution record store
+	ExecutionRecordKeyPrefix = []byte{0x02}
+
+	// ExecutionRecordLastIDKey is the key for the last execution record ID
+	ExecutionRecordLastIDKey = []byte{0x03}
+
+	// ScheduledExecutionKeyPrefix is the prefix for scheduled execution store
+	ScheduledExecutionKeyPrefix = []byte{0x04}
+
+	// ScheduledExecutionLastIDKey is the key for the last scheduled execution ID
+	ScheduledExecutionLastIDKey = []byte{0x05}
+
+	// ScheduledExecutionByHeightKeyPrefix is the prefix for scheduled executions indexed by execute_at_height
+	ScheduledExecutionByHeightKeyPrefix = []byte{0x06}
+
+	// ScheduledExecutionByTimeKeyPrefix is the prefix for scheduled executions indexed by execute_at_time
+	ScheduledExecutionByTimeKeyPrefix = []byte{0x07}
+
+	// GovEntrypointContractAddrKey is the key for the ConsensusGovernanceEntrypoint contract address
+	GovEntrypointContractAddrKey = []byte{0x08}
+
+	// ExecutionRecordByHeightKeyPrefix is the prefix for execution records indexed by height
+	ExecutionRecordByHeightKeyPrefix = []byte{0x09}
+)
+
+// GetExecutionRecordKey creates key for an execution record from ID
+func GetExecutionRecordKey(id uint64) []byte {
+	idBytes := make([]byte, 8)
+	binary.BigEndian.PutUint64(idBytes, id)
+	return append(ExecutionRecordKeyPrefix, idBytes...)
+}
+
+// GetExecutionRecordByHeightKey creates key for an execution record by height and ID
+func GetExecutionRecordByHeightKey(height int64, id uint64) []byte {
+	return getIndexKey(ExecutionRecordByHeightKeyPrefix, height, id)
+}
+
+// GetExecutionRecordByHeightEndKey creates the exclusive end key to iterate execution records up to the height (inclusive)
+func GetExecutionRecordByHeightEndKey(height int64) []byte {
+	heightBytes := make([]byte, 8)
+	binary.BigEndian.PutUint64(heightBytes, uint64(height+1)) //nolint:gosec
+	return append(append([]byte{}, ExecutionRecordByHeightKeyPrefix...), heightBytes...)
+}
+
+// ParseExecutionRecordByHeightKey parses the ID from the index key of an execution record by height
+func ParseExecutionRecordByHeightKey(key []byte) uint64 {
+	return binary.BigEndian.Uint64(key[len(key)-8:])
+}
+
+// GetScheduledExecutionKey creates key for a scheduled execution from ID
+func GetScheduledExecutionKey(id uint64) []byte {
+	idBytes := make([]byte, 8)
+	binary.BigEndian.PutUint64(idBytes, id)
+	return append(ScheduledExecutionKeyPrefix, idBytes...)
+}
+
+// GetScheduledExecutionByHeightKey creates key for a scheduled execution by execute_at_height and ID
+func GetScheduledExecutionByHeightKey(height int64, id uint64) []byte {
+	return getIndexKey(ScheduledExecutionByHeightKeyPrefix, height, id)
+}
+
+// GetScheduledExecutionByTimeKey creates key for a scheduled execution by execute_at_time and ID
+func GetScheduledExecutionByTimeKey(time int64, id uint64) []byte {
+	return getIndexKey(ScheduledExecutionByTimeKeyPrefix, time, id)
+}
+
+// GetScheduledExecutionIndexEndKey creates the exclusive end key to iterate the index up to the given value (inclusive)
+func GetScheduledExecutionIndexEndKey(prefix []byte, value int64) []byte {
+	valueBytes := make([]byte, 8)
+	binary.BigEndian.PutUint64(valueBytes, uint64(value+1)) //nolint:gosec
+	return append(append([]byte{}, prefix...), valueBytes...)
+}
+
+// ParseScheduledExecutionIndexKey parses the ID from the index key of a scheduled execution
+func ParseScheduledExecutionIndexKey(key []byte) uint64 {
+	return binary.BigEndian.Uint64(key[len(key)-8:])
+}
+
+func getIndexKey(prefix []byte, value int64, id uint64) []byte {
+	valueBytes := make([]byte, 8)
+	binary.BigEndian.PutUint64(valueBytes, uint64(value)) //nolint:gosec
+	idBytes := make([]byte, 8)
+	binary.BigEndian.PutUint64(idBytes, id)
+	return append(append(append([]byte{}, prefix...), valueBytes...), idBytes...)
+}
@@ -0,0 +1,27 @@
+package types
+
+import (
+	"cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	mitotypes "github.com/mitosis-org/chain/types"
+)
+
+var (
+	_ sdk.HasValidateBasic = (*MsgUpdateParams)(nil)
+	_ sdk.HasValidateBasic = (*MsgUpdateGovEntrypointContractAddr)(nil)
+)
+
+// ValidateBasic performs stateless validation of MsgUpdateParams
+func (m *MsgUpdateParams) ValidateBasic() error {
+	return m.Params.Validate()
+}
+
+// ValidateBasic performs stateless validation of MsgUpdateGovEntrypointContractAddr
+func (m *MsgUpdateGovEntrypointContractAddr) ValidateBasic() error {
+	// The zero address would silently disable the governance entrypoint, or make the nodes fall back to app.toml.
+	if m.Addr.Equal(mitotypes.EthAddress{}) {
+		return errors.Wrap(ErrInvalidMessage, "addr must not be the zero address")
+	}
+
+	return nil
+}
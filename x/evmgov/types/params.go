@@ -0,0 +1,71 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// DefaultExecutionRecordRetentionBlocks is the default number of blocks for which an execution record is kept
+// before being pruned.
+const DefaultExecutionRecordRetentionBlocks int64 = 100_000
+
+// DefaultParams returns a default set of parameters.
+func DefaultParams() Params {
+	return Params{
+		MsgTypeFilterMode:              MsgTypeFilterModeDisabled,
+		MsgTypeUrls:                    nil,
+		ExecutionRecordRetentionBlocks: DefaultExecutionRecordRetentionBlocks,
+	}
+}
+
+// Validate validates the set of params.
+func (p Params) Validate() error {
+	if _, ok := MsgTypeFilterMode_name[int32(p.MsgTypeFilterMode)]; !ok {
+		return fmt.Errorf("invalid msg type filter mode: %d", p.MsgTypeFilterMode)
+	}
+
+	seen := make(map[string]struct{}, len(p.MsgTypeUrls))
+	for _, typeURL := range p.MsgTypeUrls {
+		if !strings.HasPrefix(typeURL, "/") {
+			return fmt.Errorf("invalid msg type url: %q", typeURL)
+		}
+		if _, ok := seen[typeURL]; ok {
+			return fmt.Errorf("duplicate msg type url: %s", typeURL)
+		}
+		seen[typeURL] = struct{}{}
+	}
+
+	if p.ExecutionRecordRetentionBlocks <= 0 {
+		return fmt.Errorf("execution record retention blocks must be positive: %d", p.ExecutionRecordRetentionBlocks)
+	}
+
+	return nil
+}
+
+// IsMsgTypeAllowed returns whether the message type can be executed through the governance entrypoint.
+// MsgUpdateParams of x/evmgov is always allowed so that governance cannot lock itself out.
+func (p Params) IsMsgTypeAllowed(typeURL string) bool {
+	if typeURL == sdk.MsgTypeURL(&MsgUpdateParams{}) {
+		return true
+	}
+
+	switch p.MsgTypeFilterMode {
+	case MsgTypeFilterModeAllowlist:
+		return p.containsMsgTypeURL(typeURL)
+	case MsgTypeFilterModeDenylist:
+		return !p.containsMsgTypeURL(typeURL)
+	default:
+		return true
+	}
+}
+
+func (p Params) containsMsgTypeURL(typeURL string) bool {
+	for _, u := range p.MsgTypeUrls {
+		if u == typeURL {
+			return true
+		}
+	}
+	return false
+}
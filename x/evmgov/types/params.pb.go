@@ -0,0 +1,482 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: mitosis/evmgov/v1/params.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// MsgTypeFilterMode defines how the message type filter is applied to the
+// messages executed through the governance entrypoint
+type MsgTypeFilterMode int32
+
+const (
+	// MSG_TYPE_FILTER_MODE_DISABLED means any message type can be executed
+	MsgTypeFilterModeDisabled MsgTypeFilterMode = 0
+	// MSG_TYPE_FILTER_MODE_ALLOWLIST means only the listed message types can be
+	// executed
+	MsgTypeFilterModeAllowlist MsgTypeFilterMode = 1
+	// MSG_TYPE_FILTER_MODE_DENYLIST means the listed message types cannot be
+	// executed
+	MsgTypeFilterModeDenylist MsgTypeFilterMode = 2
+)
+
+var MsgTypeFilterMode_name = map[int32]string{
+	0: "MSG_TYPE_FILTER_MODE_DISABLED",
+	1: "MSG_TYPE_FILTER_MODE_ALLOWLIST",
+	2: "MSG_TYPE_FILTER_MODE_DENYLIST",
+}
+
+var MsgTypeFilterMode_value = map[string]int32{
+	"MSG_TYPE_FILTER_MODE_DISABLED":  0,
+	"MSG_TYPE_FILTER_MODE_ALLOWLIST": 1,
+	"MSG_TYPE_FILTER_MODE_DENYLIST":  2,
+}
+
+func (x MsgTypeFilterMode) String() string {
+	return proto.EnumName(MsgTypeFilterMode_name, int32(x))
+}
+
+func (MsgTypeFilterMode) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_9634c5c35538a785, []int{0}
+}
+
+// Params defines the parameters for the x/evmgov module
+type Params struct {
+	// msg_type_filter_mode is the mode of the message type filter
+	MsgTypeFilterMode MsgTypeFilterMode `protobuf:"varint,1,opt,name=msg_type_filter_mode,json=msgTypeFilterMode,proto3,enum=mitosis.evmgov.v1.MsgTypeFilterMode" json:"msg_type_filter_mode,omitempty"`
+	// msg_type_urls is the list of message type URLs which the filter applies to
+	// (e.g. /cosmos.upgrade.v1beta1.MsgSoftwareUpgrade)
+	MsgTypeUrls []string `protobuf:"bytes,2,rep,name=msg_type_urls,json=msgTypeUrls,proto3" json:"msg_type_urls,omitempty"`
+	// execution_record_retention_blocks is the number of blocks for which an
+	// execution record is kept before being pruned
+	ExecutionRecordRetentionBlocks int64 `protobuf:"varint,3,opt,name=execution_record_retention_blocks,json=executionRecordRetentionBlocks,proto3" json:"execution_record_retention_blocks,omitempty"`
+}
+
+func (m *Params) Reset()         { *m = Params{} }
+func (m *Params) String() string { return proto.CompactTextString(m) }
+func (*Params) ProtoMessage()    {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9634c5c35538a785, []int{0}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetMsgTypeFilterMode() MsgTypeFilterMode {
+	if m != nil {
+		return m.MsgTypeFilterMode
+	}
+	return MsgTypeFilterModeDisabled
+}
+
+func (m *Params) GetMsgTypeUrls() []string {
+	if m != nil {
+		return m.MsgTypeUrls
+	}
+	return nil
+}
+
+func (m *Params) GetExecutionRecordRetentionBlocks() int64 {
+	if m != nil {
+		return m.ExecutionRecordRetentionBlocks
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterEnum("mitosis.evmgov.v1.MsgTypeFilterMode", MsgTypeFilterMode_name, MsgTypeFilterMode_value)
+	proto.RegisterType((*Params)(nil), "mitosis.evmgov.v1.Params")
+}
+
+func init() { proto.RegisterFile("mitosis/evmgov/v1/params.proto", fileDescriptor_9634c5c35538a785) }
+
+var fileDescriptor_9634c5c35538a785 = []byte{
+	// 406 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x92, 0x4f, 0x6b, 0xd4, 0x40,
+	0x18, 0xc6, 0x33, 0x5d, 0x29, 0x3a, 0xa2, 0x74, 0x43, 0x0f, 0x6b, 0xa0, 0xd3, 0x58, 0x3c, 0x2c,
+	0x8a, 0x09, 0xd5, 0x9b, 0x27, 0x77, 0x49, 0x2a, 0x81, 0xc4, 0x96, 0x6c, 0x8a, 0xd4, 0xcb, 0x90,
+	0x3f, 0x63, 0x3a, 0x38, 0xc9, 0x84, 0x99, 0xd9, 0xd8, 0xfd, 0x06, 0xd2, 0x93, 0x47, 0x2f, 0x85,
+	0x82, 0x5f, 0xc6, 0x63, 0x8f, 0x3d, 0xea, 0xee, 0xc5, 0x8f, 0x21, 0x3b, 0xdd, 0xf6, 0x60, 0xdc,
+	0xdb, 0x30, 0xcf, 0xef, 0xfd, 0xf1, 0xc0, 0xfb, 0x42, 0x54, 0x51, 0xc5, 0x25, 0x95, 0x2e, 0x69,
+	0xab, 0x92, 0xb7, 0x6e, 0xbb, 0xef, 0x36, 0xa9, 0x48, 0x2b, 0xe9, 0x34, 0x82, 0x2b, 0x6e, 0xf6,
+	0x57, 0xb9, 0x73, 0x93, 0x3b, 0xed, 0xbe, 0xb5, 0x5d, 0xf2, 0x92, 0xeb, 0xd4, 0x5d, 0xbe, 0x6e,
+	0xc0, 0xbd, 0x6b, 0x00, 0x37, 0x8f, 0xf4, 0xa4, 0x79, 0x0c, 0xb7, 0x2b, 0x59, 0x62, 0x35, 0x6b,
+	0x08, 0xfe, 0x44, 0x99, 0x22, 0x02, 0x57, 0xbc, 0x20, 0x03, 0x60, 0x83, 0xe1, 0xe3, 0x57, 0xcf,
+	0x9c, 0x8e, 0xd2, 0x89, 0x64, 0x99, 0xcc, 0x1a, 0x72, 0xa0, 0xe1, 0x88, 0x17, 0x24, 0xee, 0x57,
+	0xff, 0x7e, 0x99, 0x7b, 0xf0, 0xd1, 0x9d, 0x76, 0x2a, 0x98, 0x1c, 0x6c, 0xd8, 0xbd, 0xe1, 0x83,
+	0xf8, 0xe1, 0x8a, 0x3c, 0x16, 0x4c, 0x9a, 0x01, 0x7c, 0x4a, 0xce, 0x48, 0x3e, 0x55, 0x94, 0xd7,
+	0x58, 0x90, 0x9c, 0x8b, 0x02, 0x0b, 0xa2, 0x48, 0xad, 0x3f, 0x32, 0xc6, 0xf3, 0xcf, 0x72, 0xd0,
+	0xb3, 0xc1, 0xb0, 0x17, 0xa3, 0x3b, 0x30, 0xd6, 0x5c, 0x7c, 0x8b, 0x8d, 0x35, 0xf5, 0xe6, 0xfe,
+	0xf7, 0xcb, 0x5d, 0xf0, 0xe7, 0x72, 0x17, 0x3c, 0xff, 0x0d, 0x60, 0xbf, 0xd3, 0xd0, 0x7c, 0x0b,
+	0x77, 0xa2, 0xc9, 0x3b, 0x9c, 0x9c, 0x1c, 0xf9, 0xf8, 0x20, 0x08, 0x13, 0x3f, 0xc6, 0xd1, 0xa1,
+	0xe7, 0x63, 0x2f, 0x98, 0x8c, 0xc6, 0xa1, 0xef, 0x6d, 0x19, 0xd6, 0xce, 0xf9, 0x85, 0xfd, 0xa4,
+	0x33, 0xe9, 0x51, 0x99, 0x66, 0x8c, 0x14, 0xe6, 0x18, 0xa2, 0xff, 0x1a, 0x46, 0x61, 0x78, 0xf8,
+	0x21, 0x0c, 0x26, 0xc9, 0x16, 0xb0, 0xd0, 0xf9, 0x85, 0x6d, 0x75, 0x14, 0x23, 0xc6, 0xf8, 0x17,
+	0x46, 0xa5, 0x5a, 0xdf, 0xc2, 0x7f, 0x7f, 0xa2, 0x15, 0x1b, 0xeb, 0x5a, 0x90, 0x7a, 0xb6, 0x34,
+	0x58, 0xf7, 0xbe, 0xfe, 0x40, 0xc6, 0xd8, 0xff, 0x39, 0x47, 0xe0, 0x6a, 0x8e, 0xc0, 0xaf, 0x39,
+	0x02, 0xdf, 0x16, 0xc8, 0xb8, 0x5a, 0x20, 0xe3, 0x7a, 0x81, 0x8c, 0x8f, 0x2f, 0x4a, 0xaa, 0x4e,
+	0xa7, 0x99, 0x93, 0xf3, 0xca, 0x5d, 0x6d, 0xee, 0x25, 0x17, 0xa5, 0x9b, 0x9f, 0xa6, 0xb4, 0x76,
+	0xcf, 0x6e, 0x0f, 0x67, 0xb9, 0x16, 0x99, 0x6d, 0xea, 0x63, 0x78, 0xfd, 0x77, 0x00, 0x4e, 0x91,
+	0xfa, 0x52, 0x57, 0x02, 0x00, 0x00,
+}
+
+func (this *Params) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Params)
+	if !ok {
+		that2, ok := that.(Params)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.MsgTypeFilterMode != that1.MsgTypeFilterMode {
+		return false
+	}
+	if len(this.MsgTypeUrls) != len(that1.MsgTypeUrls) {
+		return false
+	}
+	for i := range this.MsgTypeUrls {
+		if this.MsgTypeUrls[i] != that1.MsgTypeUrls[i] {
+			return false
+		}
+	}
+	if this.ExecutionRecordRetentionBlocks != that1.ExecutionRecordRetentionBlocks {
+		return false
+	}
+	return true
+}
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ExecutionRecordRetentionBlocks != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.ExecutionRecordRetentionBlocks))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.MsgTypeUrls) > 0 {
+		for iNdEx := len(m.MsgTypeUrls) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.MsgTypeUrls[iNdEx])
+			copy(dAtA[i:], m.MsgTypeUrls[iNdEx])
+			i = encodeVarintParams(dAtA, i, uint64(len(m.MsgTypeUrls[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.MsgTypeFilterMode != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MsgTypeFilterMode))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintParams(dAtA []byte, offset int, v uint64) int {
+	offset -= sovParams(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MsgTypeFilterMode != 0 {
+		n += 1 + sovParams(uint64(m.MsgTypeFilterMode))
+	}
+	if len(m.MsgTypeUrls) > 0 {
+		for _, s := range m.MsgTypeUrls {
+			l = len(s)
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	if m.ExecutionRecordRetentionBlocks != 0 {
+		n += 1 + sovParams(uint64(m.ExecutionRecordRetentionBlocks))
+	}
+	return n
+}
+
+func sovParams(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozParams(x uint64) (n int) {
+	return sovParams(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeFilterMode", wireType)
+			}
+			m.MsgTypeFilterMode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MsgTypeFilterMode |= MsgTypeFilterMode(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrls", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgTypeUrls = append(m.MsgTypeUrls, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutionRecordRetentionBlocks", wireType)
+			}
+			m.ExecutionRecordRetentionBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExecutionRecordRetentionBlocks |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipParams(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthParams
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupParams
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthParams
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthParams        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowParams          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupParams = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,73 @@
+package types_test
+
+import (
+	"testing"
+
+	upgradetypes "cosmossdk.io/x/upgrade/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/mitosis-org/chain/x/evmgov/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParams_Validate(t *testing.T) {
+	withParams := func(f func(p *types.Params)) types.Params {
+		p := types.DefaultParams()
+		f(&p)
+		return p
+	}
+
+	testCases := []struct {
+		name    string
+		params  types.Params
+		wantErr bool
+	}{
+		{"default", types.DefaultParams(), false},
+		{"allowlist", withParams(func(p *types.Params) {
+			p.MsgTypeFilterMode = types.MsgTypeFilterModeAllowlist
+			p.MsgTypeUrls = []string{"/cosmos.bank.v1beta1.MsgSend"}
+		}), false},
+		{"invalid mode", withParams(func(p *types.Params) { p.MsgTypeFilterMode = 3 }), true},
+		{"invalid type url", withParams(func(p *types.Params) {
+			p.MsgTypeFilterMode = types.MsgTypeFilterModeDenylist
+			p.MsgTypeUrls = []string{"cosmos.bank.v1beta1.MsgSend"}
+		}), true},
+		{"duplicate type url", withParams(func(p *types.Params) {
+			p.MsgTypeFilterMode = types.MsgTypeFilterModeDenylist
+			p.MsgTypeUrls = []string{"/a.MsgA", "/a.MsgA"}
+		}), true},
+		{"zero retention blocks", withParams(func(p *types.Params) { p.ExecutionRecordRetentionBlocks = 0 }), true},
+		{"negative retention blocks", withParams(func(p *types.Params) { p.ExecutionRecordRetentionBlocks = -1 }), true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.params.Validate()
+			if tc.wantErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestParams_IsMsgTypeAllowed(t *testing.T) {
+	msgSend := sdk.MsgTypeURL(&banktypes.MsgSend{})
+	msgUpgrade := sdk.MsgTypeURL(&upgradetypes.MsgSoftwareUpgrade{})
+	msgUpdateParams := sdk.MsgTypeURL(&types.MsgUpdateParams{})
+
+	disabled := types.DefaultParams()
+	require.True(t, disabled.IsMsgTypeAllowed(msgSend))
+	require.True(t, disabled.IsMsgTypeAllowed(msgUpgrade))
+
+	allowlist := types.Params{MsgTypeFilterMode: types.MsgTypeFilterModeAllowlist, MsgTypeUrls: []string{msgUpgrade}}
+	require.False(t, allowlist.IsMsgTypeAllowed(msgSend))
+	require.True(t, allowlist.IsMsgTypeAllowed(msgUpgrade))
+	require.True(t, allowlist.IsMsgTypeAllowed(msgUpdateParams))
+
+	denylist := types.Params{MsgTypeFilterMode: types.MsgTypeFilterModeDenylist, MsgTypeUrls: []string{msgSend, msgUpdateParams}}
+	require.False(t, denylist.IsMsgTypeAllowed(msgSend))
+	require.True(t, denylist.IsMsgTypeAllowed(msgUpgrade))
+	require.True(t, denylist.IsMsgTypeAllowed(msgUpdateParams))
+}
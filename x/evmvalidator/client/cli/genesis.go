@@ -11,6 +11,7 @@ import (
 	"cosmossdk.io/math"
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/server"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/genutil"
@@ -90,11 +91,6 @@ $ mitosisd genesis add-validator AxB91wLslhi5+SijCPT7Zxmsb04h1me96N3iKRstM3XX 0x
 				return errors.Wrap(err, "failed to parse pubkey")
 			}
 
-			valAddr, err := types.PubkeyToEthAddress(pubkey)
-			if err != nil {
-				return errors.Wrap(err, "failed to convert pubkey to eth address")
-			}
-
 			// Parse collateral owner address
 			collateralOwner := mitotypes.EthAddress(common.HexToAddress(args[1]))
 
@@ -110,31 +106,6 @@ $ mitosisd genesis add-validator AxB91wLslhi5+SijCPT7Zxmsb04h1me96N3iKRstM3XX 0x
 				return errors.Wrap(err, "failed to parse jailed status")
 			}
 
-			// Create validator
-			validator := types.Validator{
-				Addr:             valAddr,
-				Pubkey:           pubkey,
-				Collateral:       collateral,
-				CollateralShares: math.NewUint(0), // not used in InitGenesis
-				ExtraVotingPower: extraVotingPower,
-				VotingPower:      0, // Will be computed during InitGenesis
-				Jailed:           jailed,
-				Bonded:           false,
-			}
-
-			//nolint:forbidigo
-			{
-				println("------------ Validator Info ------------")
-				println("evm address :", valAddr.String())
-				println("acc address :", sdk.AccAddress(valAddr.Bytes()).String())
-				println("val address :", sdk.ValAddress(valAddr.Bytes()).String())
-				println("cons address:", validator.MustConsAddr().String())
-				println()
-				println("pubkey (hex)   :", fmt.Sprintf("%X", pubkey))
-				println("pubkey (base64):", base64.StdEncoding.EncodeToString(pubkey))
-				println("----------------------------------------")
-			}
-
 			// Get the server context
 			serverCtx := server.GetServerContextFromCmd(cmd)
 			config := serverCtx.Config
@@ -146,34 +117,24 @@ $ mitosisd genesis add-validator AxB91wLslhi5+SijCPT7Zxmsb04h1me96N3iKRstM3XX 0x
 				return fmt.Errorf("failed to unmarshal genesis state: %w", err)
 			}
 
-			// Get evmvalidator genesis state
-			var evmvalGenState types.GenesisState
-			if appState[types.ModuleName] != nil {
-				cdc.MustUnmarshalJSON(appState[types.ModuleName], &evmvalGenState)
-			} else {
-				evmvalGenState = *types.DefaultGenesisState()
-			}
-
-			// Add validator to genesis state
-			evmvalGenState.Validators = append(evmvalGenState.Validators, validator)
-
-			// Create collateral ownership record for the validator
-			ownership := types.CollateralOwnership{
-				ValAddr:        valAddr,
-				Owner:          collateralOwner,
-				Shares:         math.NewUint(0), // not used in InitGenesis
-				CreationHeight: 0,               // not used in InitGenesis
+			validator, err := AddGenesisValidator(cdc, appState, pubkey, collateralOwner, collateral, extraVotingPower, jailed)
+			if err != nil {
+				return err
 			}
-			evmvalGenState.CollateralOwnerships = append(evmvalGenState.CollateralOwnerships, ownership)
 
-			// Validate the modified genesis state
-			if err := evmvalGenState.Validate(); err != nil {
-				return fmt.Errorf("failed to validate evmvalidator genesis state: %w", err)
+			//nolint:forbidigo
+			{
+				println("------------ Validator Info ------------")
+				println("evm address :", validator.Addr.String())
+				println("acc address :", sdk.AccAddress(validator.Addr.Bytes()).String())
+				println("val address :", sdk.ValAddress(validator.Addr.Bytes()).String())
+				println("cons address:", validator.MustConsAddr().String())
+				println()
+				println("pubkey (hex)   :", fmt.Sprintf("%X", pubkey))
+				println("pubkey (base64):", base64.StdEncoding.EncodeToString(pubkey))
+				println("----------------------------------------")
 			}
 
-			// Marshal the evmvalidator genesis state
-			appState[types.ModuleName] = cdc.MustMarshalJSON(&evmvalGenState)
-
 			// Save the app state to genesis file
 			appStateJSON, err := json.MarshalIndent(appState, "", "  ")
 			if err != nil {
@@ -190,3 +151,97 @@ $ mitosisd genesis add-validator AxB91wLslhi5+SijCPT7Zxmsb04h1me96N3iKRstM3XX 0x
 
 	return cmd
 }
+
+// AddGenesisValidator adds a validator and its collateral ownership to the evmvalidator genesis state of appState.
+// The collateral is in gwei. The modified genesis state is validated before it is written back to appState.
+func AddGenesisValidator(
+	cdc codec.JSONCodec,
+	appState map[string]json.RawMessage,
+	pubkey []byte,
+	collateralOwner mitotypes.EthAddress,
+	collateral math.Uint,
+	extraVotingPower math.Uint,
+	jailed bool,
+) (types.Validator, error) {
+	evmvalGenState, err := getGenesisState(cdc, appState)
+	if err != nil {
+		return types.Validator{}, err
+	}
+
+	validator, err := appendGenesisValidator(evmvalGenState, pubkey, collateralOwner, collateral, extraVotingPower, jailed)
+	if err != nil {
+		return types.Validator{}, err
+	}
+
+	if err := setGenesisState(cdc, appState, evmvalGenState); err != nil {
+		return types.Validator{}, err
+	}
+
+	return validator, nil
+}
+
+// getGenesisState returns the evmvalidator genesis state of appState, or the default one if it is missing
+func getGenesisState(cdc codec.JSONCodec, appState map[string]json.RawMessage) (*types.GenesisState, error) {
+	if appState[types.ModuleName] == nil {
+		return types.DefaultGenesisState(), nil
+	}
+
+	var evmvalGenState types.GenesisState
+	if err := cdc.UnmarshalJSON(appState[types.ModuleName], &evmvalGenState); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal evmvalidator genesis state: %w", err)
+	}
+
+	return &evmvalGenState, nil
+}
+
+// setGenesisState validates the evmvalidator genesis state and writes it to appState
+func setGenesisState(cdc codec.JSONCodec, appState map[string]json.RawMessage, evmvalGenState *types.GenesisState) error {
+	if err := evmvalGenState.Validate(); err != nil {
+		return fmt.Errorf("failed to validate evmvalidator genesis state: %w", err)
+	}
+
+	appState[types.ModuleName] = cdc.MustMarshalJSON(evmvalGenState)
+
+	return nil
+}
+
+// appendGenesisValidator appends a validator and its collateral ownership to the evmvalidator genesis state
+func appendGenesisValidator(
+	evmvalGenState *types.GenesisState,
+	pubkey []byte,
+	collateralOwner mitotypes.EthAddress,
+	collateral math.Uint,
+	extraVotingPower math.Uint,
+	jailed bool,
+) (types.Validator, error) {
+	valAddr, err := types.PubkeyToEthAddress(pubkey)
+	if err != nil {
+		return types.Validator{}, errors.Wrap(err, "failed to convert pubkey to eth address")
+	}
+
+	// Create validator
+	validator := types.Validator{
+		Addr:             valAddr,
+		Pubkey:           pubkey,
+		Collateral:       collateral,
+		CollateralShares: math.NewUint(0), // not used in InitGenesis
+		ExtraVotingPower: extraVotingPower,
+		VotingPower:      0, // Will be computed during InitGenesis
+		Jailed:           jailed,
+		Bonded:           false,
+	}
+
+	// Add validator to genesis state
+	evmvalGenState.Validators = append(evmvalGenState.Validators, validator)
+
+	// Create collateral ownership record for the validator
+	ownership := types.CollateralOwnership{
+		ValAddr:        valAddr,
+		Owner:          collateralOwner,
+		Shares:         math.NewUint(0), // not used in InitGenesis
+		CreationHeight: 0,               // not used in InitGenesis
+	}
+	evmvalGenState.CollateralOwnerships = append(evmvalGenState.CollateralOwnerships, ownership)
+
+	return validator, nil
+}
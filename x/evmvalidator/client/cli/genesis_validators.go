@@ -0,0 +1,423 @@
+package cli
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+
+	"cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/server"
+	"github.com/cosmos/cosmos-sdk/x/genutil"
+	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
+	"github.com/ethereum/go-ethereum/common"
+	mitotypes "github.com/mitosis-org/chain/types"
+	"github.com/mitosis-org/chain/x/evmvalidator/types"
+	"github.com/spf13/cobra"
+)
+
+const (
+	flagFile   = "file"
+	flagDryRun = "dry-run"
+)
+
+// GenesisValidatorEntry is a validator in the file of add-validators
+type GenesisValidatorEntry struct {
+	// Pubkey is the 33-byte compressed secp256k1 public key in hex or base64
+	Pubkey          string `json:"pubkey"`
+	CollateralOwner string `json:"collateral_owner"`
+	// Collateral is in gwei
+	Collateral       jsonUint `json:"collateral"`
+	ExtraVotingPower jsonUint `json:"extra_voting_power"`
+	Jailed           bool     `json:"jailed"`
+	// Address is the expected EVM address of the validator, which must be derived from the pubkey
+	Address string `json:"address"`
+}
+
+// jsonUint is an unsigned integer given as either a JSON string or a JSON number
+type jsonUint string
+
+func (u *jsonUint) UnmarshalJSON(bz []byte) error {
+	var s string
+	if err := json.Unmarshal(bz, &s); err == nil {
+		*u = jsonUint(s)
+		return nil
+	}
+
+	var n json.Number
+	if err := json.Unmarshal(bz, &n); err != nil {
+		return fmt.Errorf("must be an unsigned integer: %s", bz)
+	}
+	*u = jsonUint(n.String())
+
+	return nil
+}
+
+// genesisValidatorRow is a parsed GenesisValidatorEntry
+type genesisValidatorRow struct {
+	pubkey           []byte
+	collateralOwner  mitotypes.EthAddress
+	collateral       math.Uint
+	extraVotingPower math.Uint
+	jailed           bool
+}
+
+// GetGenesisValidatorsCmd returns a command to add genesis validators from a file to the evmvalidator module
+func GetGenesisValidatorsCmd(defaultNodeHome string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "add-validators --file [validators.json|validators.csv]",
+		Short: "Add genesis validators from a JSON or CSV file to the evmvalidator module",
+		Long: `Add genesis validators from a JSON or CSV file to the evmvalidator module.
+
+Each validator has the same fields as the arguments of add-validator, and its address:
+  - pubkey: 33-byte compressed secp256k1 public key in hex or base64
+  - collateral_owner: EVM address of the collateral owner
+  - collateral: collateral in gwei
+  - extra_voting_power: extra voting power in gwei (default: 0)
+  - jailed: whether the validator is jailed (default: false)
+  - address: EVM address of the validator, checked against the pubkey
+
+All the validators are checked before the genesis is written: a duplicated validator in the file or
+in the genesis, or a missing or mismatched address fails the whole batch. The distribution of the voting power
+of the resulting genesis validators is printed.
+
+JSON:
+[
+  {"pubkey": "03a98478cf8213c7fea5a328d89675b5b544fb0c677893690b88473aa3aac0f3ec", "collateral_owner": "0x0123456789abcdef0123456789abcdef01234567", "collateral": "1000000000000000000", "extra_voting_power": "0", "jailed": false, "address": "0x45060aAD16cbF37e9c31A3c1A976ab8D6c0f2a8d"}
+]
+
+CSV (with a header, lines starting with # are ignored):
+pubkey,collateral_owner,collateral,extra_voting_power,jailed,address
+03a98478cf8213c7fea5a328d89675b5b544fb0c677893690b88473aa3aac0f3ec,0x0123456789abcdef0123456789abcdef01234567,1000000000000000000,0,false,0x45060aAD16cbF37e9c31A3c1A976ab8D6c0f2a8d
+
+Example:
+$ mitosisd genesis add-validators --file validators.csv
+`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			cdc := clientCtx.Codec
+
+			file, _ := cmd.Flags().GetString(flagFile)
+			dryRun, _ := cmd.Flags().GetBool(flagDryRun)
+
+			entries, err := ReadGenesisValidatorEntries(file)
+			if err != nil {
+				return err
+			}
+
+			// Get genesis
+			serverCtx := server.GetServerContextFromCmd(cmd)
+			genFile := serverCtx.Config.GenesisFile()
+			appState, genDoc, err := genutiltypes.GenesisStateFromGenFile(genFile)
+			if err != nil {
+				return fmt.Errorf("failed to unmarshal genesis state: %w", err)
+			}
+
+			evmvalGenState, err := getGenesisState(cdc, appState)
+			if err != nil {
+				return err
+			}
+
+			rows, err := parseGenesisValidatorEntries(entries, evmvalGenState.Validators)
+			if err != nil {
+				return err
+			}
+
+			for i, row := range rows {
+				if _, err := appendGenesisValidator(evmvalGenState, row.pubkey, row.collateralOwner, row.collateral, row.extraVotingPower, row.jailed); err != nil {
+					return fmt.Errorf("validator %d: %w", i+1, err)
+				}
+			}
+
+			if err := setGenesisState(cdc, appState, evmvalGenState); err != nil {
+				return err
+			}
+
+			if err := printVotingPowerSummary(cmd.OutOrStdout(), evmvalGenState); err != nil {
+				return err
+			}
+
+			if dryRun {
+				_, _ = fmt.Fprintf(cmd.OutOrStdout(), "Dry run: %d validator(s) are not written to %s\n", len(rows), genFile)
+				return nil
+			}
+
+			// Save the app state to genesis file
+			appStateJSON, err := json.MarshalIndent(appState, "", "  ")
+			if err != nil {
+				return fmt.Errorf("failed to marshal application genesis state: %w", err)
+			}
+
+			genDoc.AppState = appStateJSON
+			if err := genutil.ExportGenesisFile(genDoc, genFile); err != nil {
+				return err
+			}
+
+			_, _ = fmt.Fprintf(cmd.OutOrStdout(), "Added %d validator(s) to %s\n", len(rows), genFile)
+
+			return nil
+		},
+	}
+
+	cmd.Flags().String(flags.FlagHome, defaultNodeHome, "The application home directory")
+	cmd.Flags().String(flagFile, "", "JSON or CSV file of the validators to add")
+	cmd.Flags().Bool(flagDryRun, false, "check the validators and print the voting power distribution without writing the genesis")
+	_ = cmd.MarkFlagRequired(flagFile)
+
+	return cmd
+}
+
+// ReadGenesisValidatorEntries reads the validators from a JSON (.json) or CSV (.csv) file
+func ReadGenesisValidatorEntries(file string) ([]GenesisValidatorEntry, error) {
+	f, err := os.Open(filepath.Clean(file))
+	if err != nil {
+		return nil, fmt.Errorf("failed to open validators file: %w", err)
+	}
+	defer f.Close()
+
+	var entries []GenesisValidatorEntry
+	switch ext := strings.ToLower(filepath.Ext(file)); ext {
+	case ".json":
+		decoder := json.NewDecoder(f)
+		decoder.DisallowUnknownFields()
+		if err := decoder.Decode(&entries); err != nil {
+			return nil, fmt.Errorf("failed to parse validators file %s: %w", file, err)
+		}
+	case ".csv":
+		if entries, err = readGenesisValidatorCSV(f); err != nil {
+			return nil, fmt.Errorf("failed to parse validators file %s: %w", file, err)
+		}
+	default:
+		return nil, fmt.Errorf("unsupported validators file extension %q: must be .json or .csv", ext)
+	}
+
+	if len(entries) == 0 {
+		return nil, fmt.Errorf("no validators in %s", file)
+	}
+
+	return entries, nil
+}
+
+// readGenesisValidatorCSV reads the validators from CSV with a header of the field names of GenesisValidatorEntry
+func readGenesisValidatorCSV(r io.Reader) ([]GenesisValidatorEntry, error) {
+	reader := csv.NewReader(r)
+	reader.Comment = '#'
+	reader.TrimLeadingSpace = true
+
+	records, err := reader.ReadAll()
+	if err != nil {
+		return nil, err
+	}
+	if len(records) == 0 {
+		return nil, nil
+	}
+
+	columns := make(map[string]int)
+	for i, name := range records[0] {
+		name = strings.ToLower(strings.TrimSpace(name))
+		switch name {
+		case "pubkey", "collateral_owner", "collateral", "extra_voting_power", "jailed", "address":
+		default:
+			return nil, fmt.Errorf("unknown column %q in the header", name)
+		}
+		if _, ok := columns[name]; ok {
+			return nil, fmt.Errorf("duplicated column %q in the header", name)
+		}
+		columns[name] = i
+	}
+	for _, name := range []string{"pubkey", "collateral_owner", "collateral", "address"} {
+		if _, ok := columns[name]; !ok {
+			return nil, fmt.Errorf("missing column %q in the header", name)
+		}
+	}
+
+	field := func(record []string, name string) string {
+		i, ok := columns[name]
+		if !ok {
+			return ""
+		}
+		return strings.TrimSpace(record[i])
+	}
+
+	entries := make([]GenesisValidatorEntry, 0, len(records)-1)
+	for i, record := range records[1:] {
+		entry := GenesisValidatorEntry{
+			Pubkey:           field(record, "pubkey"),
+			CollateralOwner:  field(record, "collateral_owner"),
+			Collateral:       jsonUint(field(record, "collateral")),
+			ExtraVotingPower: jsonUint(field(record, "extra_voting_power")),
+			Address:          field(record, "address"),
+		}
+		if jailed := field(record, "jailed"); jailed != "" {
+			if entry.Jailed, err = strconv.ParseBool(jailed); err != nil {
+				// the header is the first line
+				return nil, fmt.Errorf("validator %d: invalid jailed %q", i+1, jailed)
+			}
+		}
+		entries = append(entries, entry)
+	}
+
+	return entries, nil
+}
+
+// parseGenesisValidatorEntries parses and checks all the entries against each other and the existing validators.
+// It reports all the invalid entries at once so that the file can be fixed in one go.
+func parseGenesisValidatorEntries(entries []GenesisValidatorEntry, existing []types.Validator) ([]genesisValidatorRow, error) {
+	seen := make(map[mitotypes.EthAddress]string, len(existing)+len(entries))
+	for _, validator := range existing {
+		seen[validator.Addr] = "the genesis"
+	}
+
+	var (
+		rows     []genesisValidatorRow
+		problems []string
+	)
+	for i, entry := range entries {
+		row, err := parseGenesisValidatorEntry(entry)
+		if err != nil {
+			problems = append(problems, fmt.Sprintf("validator %d: %v", i+1, err))
+			continue
+		}
+
+		valAddr, err := types.PubkeyToEthAddress(row.pubkey)
+		if err != nil {
+			problems = append(problems, fmt.Sprintf("validator %d: failed to convert pubkey to eth address: %v", i+1, err))
+			continue
+		}
+		if where, ok := seen[valAddr]; ok {
+			problems = append(problems, fmt.Sprintf("validator %d: duplicated validator %s already in %s", i+1, valAddr, where))
+			continue
+		}
+		seen[valAddr] = fmt.Sprintf("validator %d", i+1)
+
+		rows = append(rows, row)
+	}
+
+	if len(problems) > 0 {
+		return nil, fmt.Errorf("found %d invalid validator(s):\n  %s", len(problems), strings.Join(problems, "\n  "))
+	}
+
+	return rows, nil
+}
+
+func parseGenesisValidatorEntry(entry GenesisValidatorEntry) (genesisValidatorRow, error) {
+	var (
+		row genesisValidatorRow
+		err error
+	)
+
+	if row.pubkey, err = parsePubkey(entry.Pubkey); err != nil {
+		return row, fmt.Errorf("invalid pubkey: %w", err)
+	}
+
+	// The address is required so that every pubkey is checked against the intended validator.
+	if entry.Address == "" {
+		return row, fmt.Errorf("missing address")
+	}
+	if !common.IsHexAddress(entry.Address) {
+		return row, fmt.Errorf("invalid address %q", entry.Address)
+	}
+	if err := types.ValidatePubkeyWithEthAddress(row.pubkey, mitotypes.EthAddress(common.HexToAddress(entry.Address))); err != nil {
+		expected, _ := types.PubkeyToEthAddress(row.pubkey)
+		return row, fmt.Errorf("address %s is not derived from the pubkey (expected %s)", entry.Address, expected)
+	}
+
+	if !common.IsHexAddress(entry.CollateralOwner) {
+		return row, fmt.Errorf("invalid collateral owner %q", entry.CollateralOwner)
+	}
+	row.collateralOwner = mitotypes.EthAddress(common.HexToAddress(entry.CollateralOwner))
+	if row.collateralOwner == (mitotypes.EthAddress{}) {
+		return row, fmt.Errorf("collateral owner must not be the zero address")
+	}
+
+	if row.collateral, err = math.ParseUint(string(entry.Collateral)); err != nil {
+		return row, fmt.Errorf("invalid collateral %q", entry.Collateral)
+	}
+
+	row.extraVotingPower = math.ZeroUint()
+	if entry.ExtraVotingPower != "" {
+		if row.extraVotingPower, err = math.ParseUint(string(entry.ExtraVotingPower)); err != nil {
+			return row, fmt.Errorf("invalid extra voting power %q", entry.ExtraVotingPower)
+		}
+	}
+
+	row.jailed = entry.Jailed
+
+	return row, nil
+}
+
+// printVotingPowerSummary prints the voting power distribution of the genesis validators as computed in InitGenesis
+func printVotingPowerSummary(w io.Writer, evmvalGenState *types.GenesisState) error {
+	type validatorPower struct {
+		validator types.Validator
+		power     int64
+	}
+
+	var (
+		powers     []validatorPower
+		totalPower int64
+	)
+	for _, validator := range evmvalGenState.Validators {
+		power := int64(0)
+		if !validator.Jailed {
+			power = validator.ComputeVotingPower(evmvalGenState.Params.MaxLeverageRatio)
+		}
+		powers = append(powers, validatorPower{validator: validator, power: power})
+		totalPower += power
+	}
+	sort.SliceStable(powers, func(i, j int) bool { return powers[i].power > powers[j].power })
+
+	share := func(power int64) string {
+		if totalPower == 0 {
+			return "-"
+		}
+		return fmt.Sprintf("%.2f%%", float64(power)*100/float64(totalPower))
+	}
+
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	_, _ = fmt.Fprintln(tw, "ADDRESS\tCOLLATERAL (gwei)\tEXTRA (gwei)\tJAILED\tVOTING POWER\tSHARE")
+	for _, p := range powers {
+		_, _ = fmt.Fprintf(tw, "%s\t%s\t%s\t%t\t%d\t%s\n",
+			p.validator.Addr, p.validator.Collateral, p.validator.ExtraVotingPower, p.validator.Jailed, p.power, share(p.power))
+	}
+	if err := tw.Flush(); err != nil {
+		return err
+	}
+
+	_, _ = fmt.Fprintf(w, "Total: %d validator(s), voting power %d\n", len(powers), totalPower)
+	if totalPower == 0 {
+		_, _ = fmt.Fprintln(w, "WARN: the total voting power is 0, so the chain cannot produce blocks")
+		return nil
+	}
+
+	// The minimum number of validators to reach more than 2/3 of the voting power to commit blocks
+	var accumulated int64
+	quorum := 0
+	for _, p := range powers {
+		accumulated += p.power
+		quorum++
+		if accumulated*3 > totalPower*2 {
+			break
+		}
+	}
+	_, _ = fmt.Fprintf(w, "%d validator(s) hold more than 2/3 of the voting power\n", quorum)
+	if powers[0].power*3 >= totalPower {
+		_, _ = fmt.Fprintf(w, "WARN: %s holds at least 1/3 of the voting power and can halt the chain alone\n", powers[0].validator.Addr)
+	}
+	for _, p := range powers {
+		if p.power == 0 && !p.validator.Jailed {
+			_, _ = fmt.Fprintf(w, "WARN: %s has no voting power as its collateral is less than %s gwei\n", p.validator.Addr, types.VotingPowerReduction)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,220 @@
+package cli
+
+import (
+	"bytes"
+	"encoding/hex"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"cosmossdk.io/math"
+	"github.com/cometbft/cometbft/crypto/secp256k1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	mitotypes "github.com/mitosis-org/chain/types"
+	"github.com/mitosis-org/chain/x/evmvalidator/types"
+)
+
+const testCollateralOwner = "0x0123456789abcdef0123456789abcdef01234567"
+
+func writeTestFile(t *testing.T, name, content string) string {
+	t.Helper()
+
+	file := filepath.Join(t.TempDir(), name)
+	require.NoError(t, os.WriteFile(file, []byte(content), 0o600))
+
+	return file
+}
+
+func testPubkey(seed string) string {
+	return hex.EncodeToString(secp256k1.GenPrivKeySecp256k1([]byte(seed)).PubKey().Bytes())
+}
+
+func testAddress(t *testing.T, pubkey string) mitotypes.EthAddress {
+	t.Helper()
+
+	bz, err := hex.DecodeString(pubkey)
+	require.NoError(t, err)
+	addr, err := types.PubkeyToEthAddress(bz)
+	require.NoError(t, err)
+
+	return addr
+}
+
+func TestReadGenesisValidatorEntries(t *testing.T) {
+	pubkey1, pubkey2 := testPubkey("1"), testPubkey("2")
+	addr1, addr2 := testAddress(t, pubkey1).String(), testAddress(t, pubkey2).String()
+
+	jsonFile := writeTestFile(t, "validators.json", `[
+  {"pubkey": "`+pubkey1+`", "collateral_owner": "`+testCollateralOwner+`", "collateral": "1000000000000", "address": "`+addr1+`"},
+  {"pubkey": "`+pubkey2+`", "collateral_owner": "`+testCollateralOwner+`", "collateral": 2000000000000, "extra_voting_power": "5", "jailed": true, "address": "`+addr2+`"}
+]`)
+	csvFile := writeTestFile(t, "validators.csv", `# genesis validators
+pubkey, collateral_owner, collateral, extra_voting_power, jailed, address
+`+pubkey1+`,`+testCollateralOwner+`,1000000000000,,,`+addr1+`
+`+pubkey2+`,`+testCollateralOwner+`,2000000000000,5,true,`+addr2+`
+`)
+
+	for _, file := range []string{jsonFile, csvFile} {
+		entries, err := ReadGenesisValidatorEntries(file)
+		require.NoError(t, err, file)
+		require.Len(t, entries, 2, file)
+
+		assert.Equal(t, GenesisValidatorEntry{
+			Pubkey:          pubkey1,
+			CollateralOwner: testCollateralOwner,
+			Collateral:      "1000000000000",
+			Address:         addr1,
+		}, entries[0], file)
+		assert.Equal(t, GenesisValidatorEntry{
+			Pubkey:           pubkey2,
+			CollateralOwner:  testCollateralOwner,
+			Collateral:       "2000000000000",
+			ExtraVotingPower: "5",
+			Jailed:           true,
+			Address:          addr2,
+		}, entries[1], file)
+	}
+}
+
+func TestReadGenesisValidatorEntries_Invalid(t *testing.T) {
+	tests := []struct {
+		name        string
+		file        string
+		content     string
+		expectedErr string
+	}{
+		{
+			name:        "unsupported extension",
+			file:        "validators.txt",
+			content:     "",
+			expectedErr: "must be .json or .csv",
+		},
+		{
+			name:        "unknown JSON field",
+			file:        "validators.json",
+			content:     `[{"pubkey": "", "power": 1}]`,
+			expectedErr: "unknown field",
+		},
+		{
+			name:        "empty JSON",
+			file:        "validators.json",
+			content:     `[]`,
+			expectedErr: "no validators",
+		},
+		{
+			name:        "missing CSV column",
+			file:        "validators.csv",
+			content:     "pubkey,collateral\n" + validHexPubkey + ",1\n",
+			expectedErr: `missing column "collateral_owner"`,
+		},
+		{
+			name:        "missing CSV address column",
+			file:        "validators.csv",
+			content:     "pubkey,collateral_owner,collateral\n" + validHexPubkey + "," + testCollateralOwner + ",1\n",
+			expectedErr: `missing column "address"`,
+		},
+		{
+			name:        "unknown CSV column",
+			file:        "validators.csv",
+			content:     "pubkey,collateral_owner,collateral,power\n",
+			expectedErr: `unknown column "power"`,
+		},
+		{
+			name:        "invalid jailed",
+			file:        "validators.csv",
+			content:     "pubkey,collateral_owner,collateral,jailed,address\n" + validHexPubkey + "," + testCollateralOwner + ",1,maybe,\n",
+			expectedErr: `validator 1: invalid jailed "maybe"`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ReadGenesisValidatorEntries(writeTestFile(t, tt.file, tt.content))
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.expectedErr)
+		})
+	}
+}
+
+func TestParseGenesisValidatorEntries(t *testing.T) {
+	pubkey1, pubkey2 := testPubkey("1"), testPubkey("2")
+	pubkeyBytes1, err := hex.DecodeString(pubkey1)
+	require.NoError(t, err)
+	addr1, addr2 := testAddress(t, pubkey1), testAddress(t, pubkey2)
+
+	valid := GenesisValidatorEntry{Pubkey: pubkey1, CollateralOwner: testCollateralOwner, Collateral: "1000", Address: addr1.String()}
+
+	rows, err := parseGenesisValidatorEntries([]GenesisValidatorEntry{
+		valid,
+		{Pubkey: pubkey2, CollateralOwner: testCollateralOwner, Collateral: "2000", ExtraVotingPower: "10", Jailed: true, Address: addr2.String()},
+	}, nil)
+	require.NoError(t, err)
+	require.Len(t, rows, 2)
+	assert.Equal(t, pubkeyBytes1, rows[0].pubkey)
+	assert.Equal(t, math.NewUint(1000), rows[0].collateral)
+	assert.Equal(t, math.ZeroUint(), rows[0].extraVotingPower)
+	assert.Equal(t, math.NewUint(10), rows[1].extraVotingPower)
+	assert.True(t, rows[1].jailed)
+
+	// All the invalid validators are reported at once
+	_, err = parseGenesisValidatorEntries([]GenesisValidatorEntry{
+		valid,
+		{Pubkey: "0x1234", CollateralOwner: testCollateralOwner, Collateral: "1", Address: addr2.String()},
+		{Pubkey: pubkey2, CollateralOwner: testCollateralOwner, Collateral: "1", Address: addr1.String()},
+		{Pubkey: pubkey2, CollateralOwner: "owner", Collateral: "1", Address: addr2.String()},
+		{Pubkey: pubkey2, CollateralOwner: "0x0000000000000000000000000000000000000000", Collateral: "1", Address: addr2.String()},
+		{Pubkey: pubkey2, CollateralOwner: testCollateralOwner, Collateral: "-1", Address: addr2.String()},
+		{Pubkey: pubkey2, CollateralOwner: testCollateralOwner, Collateral: "1", ExtraVotingPower: "x", Address: addr2.String()},
+		{Pubkey: strings.ToUpper(pubkey1), CollateralOwner: testCollateralOwner, Collateral: "1", Address: addr1.String()},
+		{Pubkey: pubkey2, CollateralOwner: testCollateralOwner, Collateral: "1"},
+		{Pubkey: pubkey2, CollateralOwner: testCollateralOwner, Collateral: "1", Address: "0x1234"},
+	}, nil)
+	require.Error(t, err)
+	for _, expected := range []string{
+		"found 9 invalid validator(s)",
+		"validator 2: invalid pubkey",
+		"validator 3: address " + addr1.String() + " is not derived from the pubkey",
+		`validator 4: invalid collateral owner "owner"`,
+		"validator 5: collateral owner must not be the zero address",
+		`validator 6: invalid collateral "-1"`,
+		`validator 7: invalid extra voting power "x"`,
+		"validator 8: duplicated validator " + addr1.String() + " already in validator 1",
+		"validator 9: missing address",
+		`validator 10: invalid address "0x1234"`,
+	} {
+		assert.Contains(t, err.Error(), expected)
+	}
+
+	// Validators already in the genesis are detected
+	_, err = parseGenesisValidatorEntries([]GenesisValidatorEntry{valid}, []types.Validator{{Addr: addr1}})
+	require.ErrorContains(t, err, "already in the genesis")
+}
+
+func TestPrintVotingPowerSummary(t *testing.T) {
+	genState := types.DefaultGenesisState()
+	for i, collateral := range []uint64{3_000_000_000, 1_000_000_000, 5_000_000_000, 10} {
+		pubkey, err := hex.DecodeString(testPubkey(string(rune('a' + i))))
+		require.NoError(t, err)
+		_, err = appendGenesisValidator(genState, pubkey, mitotypes.EthAddress{1}, math.NewUint(collateral), math.ZeroUint(), i == 2)
+		require.NoError(t, err)
+	}
+
+	var buf bytes.Buffer
+	require.NoError(t, printVotingPowerSummary(&buf, genState))
+	out := buf.String()
+
+	// The jailed validator has no voting power
+	assert.Contains(t, out, "Total: 4 validator(s), voting power 4\n")
+	assert.Contains(t, out, "75.00%")
+	assert.Contains(t, out, "1 validator(s) hold more than 2/3 of the voting power")
+	assert.Contains(t, out, genState.Validators[0].Addr.String()+" holds at least 1/3 of the voting power")
+	assert.Contains(t, out, genState.Validators[3].Addr.String()+" has no voting power")
+	assert.NotContains(t, out, genState.Validators[2].Addr.String()+" has no voting power")
+
+	buf.Reset()
+	require.NoError(t, printVotingPowerSummary(&buf, types.DefaultGenesisState()))
+	assert.Contains(t, buf.String(), "the total voting power is 0")
+}
@@ -24,6 +24,7 @@ func GetQueryCmd() *cobra.Command {
 	cmd.AddCommand(
 		GetCmdQueryParams(),
 		GetCmdQueryValidatorEntrypointContractAddr(),
+		GetCmdQueryCircuitBreaker(),
 		GetCmdQueryValidator(),
 		GetCmdQueryValidatorByConsAddr(),
 		GetCmdQueryValidators(),
@@ -33,6 +34,8 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdQueryCollateralOwnership(),
 		GetCmdQueryCollateralOwnerships(),
 		GetCmdQueryCollateralOwnershipsByValidator(),
+		GetCmdQueryEventReceipt(),
+		GetCmdQueryTxEventReceipts(),
 	)
 
 	return cmd
@@ -92,6 +95,33 @@ func GetCmdQueryValidatorEntrypointContractAddr() *cobra.Command {
 	return cmd
 }
 
+// GetCmdQueryCircuitBreaker implements the query circuit breaker command.
+func GetCmdQueryCircuitBreaker() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "circuit-breaker",
+		Short: "Query the circuit breaker flags",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.CircuitBreaker(cmd.Context(), &types.QueryCircuitBreakerRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // GetCmdQueryValidator implements the query validator command.
 func GetCmdQueryValidator() *cobra.Command {
 	cmd := &cobra.Command{
@@ -424,3 +454,57 @@ func GetCmdQueryCollateralOwnershipsByValidator() *cobra.Command {
 
 	return cmd
 }
+
+// GetCmdQueryEventReceipt implements the query event receipt command.
+func GetCmdQueryEventReceipt() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "event-receipt [evm-block-hash]",
+		Short: "Query the receipts of the validator entrypoint events delivered from an EVM block",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.EventReceipt(cmd.Context(), &types.QueryEventReceiptRequest{EvmBlockHash: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryTxEventReceipts implements the query tx event receipts command.
+func GetCmdQueryTxEventReceipts() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "tx-event-receipts [tx-hash]",
+		Short: "Query the receipts of the validator entrypoint events emitted by an EVM transaction",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.TxEventReceipts(cmd.Context(), &types.QueryTxEventReceiptsRequest{TxHash: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
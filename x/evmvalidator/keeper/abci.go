@@ -17,6 +17,9 @@ func (k Keeper) EndBlocker(ctx sdk.Context) ([]abci.ValidatorUpdate, error) {
 		return nil, err
 	}
 
+	// Prune expired event receipts
+	k.PruneEventReceipts(ctx)
+
 	// Update active validator set
 	return k.ApplyAndReturnValidatorSetUpdates(ctx)
 }
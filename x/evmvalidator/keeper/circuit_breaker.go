@@ -0,0 +1,89 @@
+package keeper
+
+import (
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	mitotypes "github.com/mitosis-org/chain/types"
+	"github.com/mitosis-org/chain/x/evmvalidator/types"
+)
+
+// GetCircuitBreaker gets the circuit breaker flags
+func (k Keeper) GetCircuitBreaker(ctx sdk.Context) (cb types.CircuitBreaker) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.CircuitBreakerKey)
+	if bz == nil {
+		return cb
+	}
+
+	k.cdc.MustUnmarshal(bz, &cb)
+	return cb
+}
+
+// SetCircuitBreaker sets the circuit breaker flags.
+// The extra voting power updates queued while paused are applied when they are unpaused.
+func (k Keeper) SetCircuitBreaker(ctx sdk.Context, cb types.CircuitBreaker) {
+	prev := k.GetCircuitBreaker(ctx)
+
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&cb)
+	store.Set(types.CircuitBreakerKey, bz)
+
+	if prev.ExtraVotingPowerUpdatesPaused && !cb.ExtraVotingPowerUpdatesPaused {
+		k.ApplyPendingExtraVotingPowerUpdates(ctx)
+	}
+}
+
+// SetPendingExtraVotingPowerUpdate queues an extra voting power update.
+// It overrides the update previously queued for the same validator.
+func (k Keeper) SetPendingExtraVotingPowerUpdate(ctx sdk.Context, update types.PendingExtraVotingPowerUpdate) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&update)
+	store.Set(types.GetPendingExtraVotingPowerUpdateKey(update.ValAddr), bz)
+}
+
+// GetPendingExtraVotingPowerUpdate gets the extra voting power update queued for a validator
+func (k Keeper) GetPendingExtraVotingPowerUpdate(ctx sdk.Context, valAddr mitotypes.EthAddress) (update types.PendingExtraVotingPowerUpdate, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetPendingExtraVotingPowerUpdateKey(valAddr))
+	if bz == nil {
+		return update, false
+	}
+
+	k.cdc.MustUnmarshal(bz, &update)
+	return update, true
+}
+
+// GetAllPendingExtraVotingPowerUpdates gets all queued extra voting power updates
+func (k Keeper) GetAllPendingExtraVotingPowerUpdates(ctx sdk.Context) []types.PendingExtraVotingPowerUpdate {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, types.PendingExtraVotingPowerUpdateKeyPrefix)
+	defer iterator.Close()
+
+	var updates []types.PendingExtraVotingPowerUpdate
+	for ; iterator.Valid(); iterator.Next() {
+		var update types.PendingExtraVotingPowerUpdate
+		k.cdc.MustUnmarshal(iterator.Value(), &update)
+		updates = append(updates, update)
+	}
+
+	return updates
+}
+
+// ApplyPendingExtraVotingPowerUpdates applies and removes all queued extra voting power updates.
+// The updates of validators which no longer exist are dropped.
+func (k Keeper) ApplyPendingExtraVotingPowerUpdates(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+
+	for _, update := range k.GetAllPendingExtraVotingPowerUpdates(ctx) {
+		store.Delete(types.GetPendingExtraVotingPowerUpdateKey(update.ValAddr))
+
+		validator, found := k.GetValidator(ctx, update.ValAddr)
+		if !found {
+			k.Logger(ctx).Info("Dropping the pending extra voting power update of an unknown validator",
+				"valAddr", update.ValAddr.String())
+			continue
+		}
+
+		k.UpdateExtraVotingPower(ctx, &validator, update.ExtraVotingPower)
+	}
+}
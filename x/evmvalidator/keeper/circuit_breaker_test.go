@@ -0,0 +1,177 @@
+package keeper_test
+
+import (
+	"context"
+	"math/big"
+	"testing"
+	"time"
+
+	"cosmossdk.io/math"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/mitosis-org/chain/bindings"
+	"github.com/mitosis-org/chain/x/evmvalidator/keeper"
+	"github.com/mitosis-org/chain/x/evmvalidator/testutil"
+	"github.com/mitosis-org/chain/x/evmvalidator/types"
+	"github.com/stretchr/testify/suite"
+)
+
+// CircuitBreakerTestSuite is a test suite for the circuit breaker functionality
+type CircuitBreakerTestSuite struct {
+	suite.Suite
+	tk testutil.TestKeeper
+}
+
+// SetupTest initializes the test suite
+func (s *CircuitBreakerTestSuite) SetupTest() {
+	s.tk = testutil.NewTestKeeper(&s.Suite)
+	s.tk.SetupDefaultTestParams()
+}
+
+// TestCircuitBreakerTestSuite runs the circuit breaker test suite
+func TestCircuitBreakerTestSuite(t *testing.T) {
+	suite.Run(t, new(CircuitBreakerTestSuite))
+}
+
+func (s *CircuitBreakerTestSuite) Test_UpdateCircuitBreaker() {
+	msgServer := keeper.NewMsgServerImpl(s.tk.Keeper)
+	cb := types.CircuitBreaker{DepositsPaused: true, WithdrawalsPaused: true}
+
+	_, err := msgServer.UpdateCircuitBreaker(s.tk.Ctx, &types.MsgUpdateCircuitBreaker{
+		Authority:      "invalid-authority",
+		CircuitBreaker: &cb,
+	})
+	s.Require().ErrorContains(err, "invalid authority")
+	s.Require().Equal(types.CircuitBreaker{}, s.tk.Keeper.GetCircuitBreaker(s.tk.Ctx))
+
+	// A message without the circuit breaker is rejected
+	_, err = msgServer.UpdateCircuitBreaker(s.tk.Ctx, &types.MsgUpdateCircuitBreaker{
+		Authority: s.tk.Authority,
+	})
+	s.Require().ErrorIs(err, types.ErrInvalidMessage)
+
+	_, err = msgServer.UpdateCircuitBreaker(s.tk.Ctx, &types.MsgUpdateCircuitBreaker{
+		Authority:      s.tk.Authority,
+		CircuitBreaker: &cb,
+	})
+	s.Require().NoError(err)
+	s.Require().Equal(cb, s.tk.Keeper.GetCircuitBreaker(s.tk.Ctx))
+
+	// The flags are exported in the genesis
+	s.Require().Equal(cb, s.tk.Keeper.ExportGenesis(s.tk.Ctx).CircuitBreaker)
+}
+
+func (s *CircuitBreakerTestSuite) Test_RegistrationPaused() {
+	s.tk.Keeper.SetCircuitBreaker(s.tk.Ctx, types.CircuitBreaker{RegistrationPaused: true})
+
+	_, pubkey, valAddr := testutil.GenerateSecp256k1Key()
+	event := &bindings.ConsensusValidatorEntrypointMsgRegisterValidator{
+		ValAddr:                     common.BytesToAddress(valAddr.Bytes()),
+		PubKey:                      pubkey,
+		InitialCollateralOwner:      common.BytesToAddress(valAddr.Bytes()),
+		InitialCollateralAmountGwei: big.NewInt(1000000000),
+	}
+
+	// Paused registrations are ignored to be refunded through the fallback
+	err, ignore := s.tk.Keeper.ProcessRegisterValidator(s.tk.Ctx, event)
+	s.Require().ErrorIs(err, types.ErrFeaturePaused)
+	s.Require().True(ignore)
+
+	_, found := s.tk.Keeper.GetValidator(s.tk.Ctx, valAddr)
+	s.Require().False(found)
+}
+
+func (s *CircuitBreakerTestSuite) Test_DepositsPaused() {
+	validator := s.tk.RegisterTestValidator(math.NewUint(1000000000), math.ZeroUint(), false)
+	s.tk.Keeper.SetCircuitBreaker(s.tk.Ctx, types.CircuitBreaker{DepositsPaused: true})
+
+	event := &bindings.ConsensusValidatorEntrypointMsgDepositCollateral{
+		ValAddr:         common.BytesToAddress(validator.Addr.Bytes()),
+		CollateralOwner: common.BytesToAddress(validator.Addr.Bytes()),
+		AmountGwei:      big.NewInt(1000000000),
+	}
+
+	// Paused deposits are ignored to be refunded through the fallback
+	err, ignore := s.tk.Keeper.ProcessDepositCollateral(s.tk.Ctx, event)
+	s.Require().ErrorIs(err, types.ErrFeaturePaused)
+	s.Require().True(ignore)
+
+	updated, found := s.tk.Keeper.GetValidator(s.tk.Ctx, validator.Addr)
+	s.Require().True(found)
+	s.Require().Equal(validator.Collateral, updated.Collateral)
+}
+
+func (s *CircuitBreakerTestSuite) Test_ExtraVotingPowerUpdatesPaused() {
+	validator := s.tk.RegisterTestValidator(math.NewUint(1000000000), math.ZeroUint(), false)
+	s.tk.Keeper.SetCircuitBreaker(s.tk.Ctx, types.CircuitBreaker{ExtraVotingPowerUpdatesPaused: true})
+
+	updateEvent := func(extraVotingPowerGwei int64) *bindings.ConsensusValidatorEntrypointMsgUpdateExtraVotingPower {
+		return &bindings.ConsensusValidatorEntrypointMsgUpdateExtraVotingPower{
+			ValAddr:             common.BytesToAddress(validator.Addr.Bytes()),
+			ExtraVotingPowerWei: big.NewInt(0).Mul(big.NewInt(extraVotingPowerGwei), big.NewInt(1e9)),
+		}
+	}
+
+	// The updates are queued while paused, and only the latest one is kept
+	for _, extraVotingPower := range []int64{1e9, 2e9} {
+		err, _ := s.tk.Keeper.ProcessUpdateExtraVotingPower(s.tk.Ctx, updateEvent(extraVotingPower))
+		s.Require().NoError(err)
+	}
+
+	updated, found := s.tk.Keeper.GetValidator(s.tk.Ctx, validator.Addr)
+	s.Require().True(found)
+	s.Require().Equal(math.ZeroUint(), updated.ExtraVotingPower)
+	s.Require().Equal([]types.PendingExtraVotingPowerUpdate{
+		{ValAddr: validator.Addr, ExtraVotingPower: math.NewUint(2e9)},
+	}, s.tk.Keeper.GetAllPendingExtraVotingPowerUpdates(s.tk.Ctx))
+
+	// The update of an unknown validator is not queued
+	_, _, unknownValAddr := testutil.GenerateSecp256k1Key()
+	err, ignore := s.tk.Keeper.ProcessUpdateExtraVotingPower(s.tk.Ctx, &bindings.ConsensusValidatorEntrypointMsgUpdateExtraVotingPower{
+		ValAddr:             unknownValAddr.Address(),
+		ExtraVotingPowerWei: big.NewInt(1e9),
+	})
+	s.Require().ErrorIs(err, types.ErrValidatorNotFound)
+	s.Require().True(ignore)
+	s.Require().Len(s.tk.Keeper.GetAllPendingExtraVotingPowerUpdates(s.tk.Ctx), 1)
+
+	// The queued update is applied after unpausing
+	s.tk.Keeper.SetCircuitBreaker(s.tk.Ctx, types.CircuitBreaker{})
+
+	updated, found = s.tk.Keeper.GetValidator(s.tk.Ctx, validator.Addr)
+	s.Require().True(found)
+	s.Require().Equal(math.NewUint(2e9), updated.ExtraVotingPower)
+	s.Require().Empty(s.tk.Keeper.GetAllPendingExtraVotingPowerUpdates(s.tk.Ctx))
+}
+
+func (s *CircuitBreakerTestSuite) Test_WithdrawalsPaused() {
+	validator := s.tk.RegisterTestValidator(math.NewUint(10000000000), math.ZeroUint(), false)
+
+	now := time.Now().Unix()
+	ctx := s.tk.Ctx.WithBlockTime(time.Unix(now, 0))
+	withdrawal := &types.Withdrawal{
+		ValAddr:        validator.Addr,
+		Amount:         1000000000,
+		Receiver:       validator.Addr,
+		MaturesAt:      now - 10,
+		CreationHeight: ctx.BlockHeight(),
+	}
+	s.tk.Keeper.AddNewWithdrawalWithNextID(ctx, withdrawal)
+
+	inserted := 0
+	s.tk.MockEvmEng.InsertWithdrawalFn = func(context.Context, common.Address, uint64) error {
+		inserted++
+		return nil
+	}
+
+	// Matured withdrawals are kept in the queue while paused
+	s.tk.Keeper.SetCircuitBreaker(ctx, types.CircuitBreaker{WithdrawalsPaused: true})
+	s.Require().NoError(s.tk.Keeper.ProcessMaturedWithdrawals(ctx))
+	s.Require().Equal(0, inserted)
+	s.Require().Len(s.tk.Keeper.GetAllWithdrawals(ctx), 1)
+
+	// They are processed after unpausing
+	s.tk.Keeper.SetCircuitBreaker(ctx, types.CircuitBreaker{})
+	s.Require().NoError(s.tk.Keeper.ProcessMaturedWithdrawals(ctx))
+	s.Require().Equal(1, inserted)
+	s.Require().Empty(s.tk.Keeper.GetAllWithdrawals(ctx))
+}
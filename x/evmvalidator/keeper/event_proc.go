@@ -68,9 +68,19 @@ func (k *Keeper) FilterParams(ctx context.Context) ([]common.Address, [][]common
 // Deliver delivers related EVM log events
 func (k *Keeper) Deliver(ctx context.Context, blockHash common.Hash, elog evmengtypes.EVMEvent) error {
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	receipt, err := k.newEventReceipt(sdkCtx, blockHash, elog)
+	if err != nil {
+		return errors.Wrap(err, "locate event log",
+			"name", eventName(elog),
+			"height", sdkCtx.BlockHeight(),
+			"evmBlockHash", blockHash.Hex(),
+		)
+	}
+
 	cacheCtx, writeCache := sdkCtx.CacheContext()
 
-	err, ignore := k.ProcessEvent(cacheCtx, blockHash, elog)
+	result, err, ignore := k.ProcessEvent(cacheCtx, blockHash, elog)
 	if err != nil {
 		if ignore {
 			// If the processing fails but needs to be ignored, the error will be logged and
@@ -82,6 +92,11 @@ func (k *Keeper) Deliver(ctx context.Context, blockHash common.Hash, elog evmeng
 				"evmLog", elog.String(),
 				"err", err,
 			)
+
+			receipt.Status = types.EventReceiptStatusIgnored
+			receipt.Reason = err.Error()
+			k.SetEventReceipt(sdkCtx, receipt)
+
 			return nil
 		} else {
 			return errors.Wrap(err, "failed to process event",
@@ -93,23 +108,29 @@ func (k *Keeper) Deliver(ctx context.Context, blockHash common.Hash, elog evmeng
 		}
 	}
 
+	receipt.Status = result.Status
+	receipt.Reason = result.Reason
+	k.SetEventReceipt(cacheCtx, receipt)
+
 	writeCache()
 	return nil
 }
 
 // ProcessEvent parses the provided event and processes it.
-// If the second return value is true, the error will be ignored.
-func (k *Keeper) ProcessEvent(originCtx sdk.Context, blockHash common.Hash, elog evmengtypes.EVMEvent) (error, bool) {
+// The first return value contains the status and the reason of the processing result.
+// If the third return value is true, the error will be ignored.
+func (k *Keeper) ProcessEvent(originCtx sdk.Context, blockHash common.Hash, elog evmengtypes.EVMEvent) (types.EventReceipt, error, bool) {
 	ctx, writeCache := originCtx.CacheContext()
+	result := types.EventReceipt{Status: types.EventReceiptStatusApplied}
 
 	ethlog, err := elog.ToEthLog()
 	if err != nil {
-		return err, false
+		return types.EventReceipt{}, err, false
 	}
 
 	contract, err := getValidatorEntrypointContract(ethlog.Address)
 	if err != nil {
-		return err, false
+		return types.EventReceipt{}, err, false
 	}
 
 	switch ethlog.Topics[0] {
@@ -122,7 +143,7 @@ func (k *Keeper) ProcessEvent(originCtx sdk.Context, blockHash common.Hash, elog
 	case EventMsgRegisterValidator.ID:
 		event, err := contract.ParseMsgRegisterValidator(ethlog)
 		if err != nil {
-			return errors.Wrap(err, "parse MsgRegisterValidator"), false
+			return types.EventReceipt{}, errors.Wrap(err, "parse MsgRegisterValidator"), false
 		}
 
 		k.Logger(ctx).Debug("📣 Process MsgRegisterValidator",
@@ -136,14 +157,14 @@ func (k *Keeper) ProcessEvent(originCtx sdk.Context, blockHash common.Hash, elog
 
 		if err, ignore := k.ProcessRegisterValidator(ctx, event); err != nil {
 			if !ignore {
-				return errors.Wrap(err, "process MsgRegisterValidator"), false
+				return types.EventReceipt{}, errors.Wrap(err, "process MsgRegisterValidator"), false
 			}
 
 			// Reset the context to the original context to rollback previous state changes
 			ctx, writeCache = originCtx.CacheContext()
 
 			if errFB := k.FallbackRegisterValidator(ctx, event); errFB != nil {
-				return stderrors.Join(
+				return types.EventReceipt{}, stderrors.Join(
 					errors.Wrap(err, "process MsgRegisterValidator"),
 					errors.Wrap(errFB, "fallback MsgRegisterValidator"),
 				), false
@@ -155,6 +176,9 @@ func (k *Keeper) ProcessEvent(originCtx sdk.Context, blockHash common.Hash, elog
 				"evmBlockHash", blockHash.Hex(),
 				"evmLog", elog.String(),
 				"err", err)
+
+			result.Status = types.EventReceiptStatusRefunded
+			result.Reason = err.Error()
 		}
 
 	// Potential failure cases are:
@@ -165,7 +189,7 @@ func (k *Keeper) ProcessEvent(originCtx sdk.Context, blockHash common.Hash, elog
 	case EventMsgDepositCollateral.ID:
 		event, err := contract.ParseMsgDepositCollateral(ethlog)
 		if err != nil {
-			return errors.Wrap(err, "parse MsgDepositCollateral"), false
+			return types.EventReceipt{}, errors.Wrap(err, "parse MsgDepositCollateral"), false
 		}
 
 		k.Logger(ctx).Debug("📣 Process MsgDepositCollateral",
@@ -178,14 +202,14 @@ func (k *Keeper) ProcessEvent(originCtx sdk.Context, blockHash common.Hash, elog
 
 		if err, ignore := k.ProcessDepositCollateral(ctx, event); err != nil {
 			if !ignore {
-				return errors.Wrap(err, "process MsgDepositCollateral"), false
+				return types.EventReceipt{}, errors.Wrap(err, "process MsgDepositCollateral"), false
 			}
 
 			// Reset the context to the original context to rollback previous state changes
 			ctx, writeCache = originCtx.CacheContext()
 
 			if errFB := k.FallbackDepositCollateral(ctx, event); errFB != nil {
-				return stderrors.Join(
+				return types.EventReceipt{}, stderrors.Join(
 					errors.Wrap(err, "process MsgDepositCollateral"),
 					errors.Wrap(errFB, "fallback MsgDepositCollateral"),
 				), false
@@ -197,6 +221,9 @@ func (k *Keeper) ProcessEvent(originCtx sdk.Context, blockHash common.Hash, elog
 				"evmBlockHash", blockHash.Hex(),
 				"evmLog", elog.String(),
 				"err", err)
+
+			result.Status = types.EventReceiptStatusRefunded
+			result.Reason = err.Error()
 		}
 
 	// Potential failure cases are:
@@ -207,7 +234,7 @@ func (k *Keeper) ProcessEvent(originCtx sdk.Context, blockHash common.Hash, elog
 	case EventMsgWithdrawCollateral.ID:
 		event, err := contract.ParseMsgWithdrawCollateral(ethlog)
 		if err != nil {
-			return errors.Wrap(err, "parse MsgWithdrawCollateral"), false
+			return types.EventReceipt{}, errors.Wrap(err, "parse MsgWithdrawCollateral"), false
 		}
 
 		k.Logger(ctx).Debug("📣 Process MsgWithdrawCollateral",
@@ -221,7 +248,7 @@ func (k *Keeper) ProcessEvent(originCtx sdk.Context, blockHash common.Hash, elog
 		)
 
 		if err, ignore := k.ProcessWithdrawCollateral(ctx, event); err != nil {
-			return errors.Wrap(err, "process MsgWithdrawCollateral"), ignore
+			return types.EventReceipt{}, errors.Wrap(err, "process MsgWithdrawCollateral"), ignore
 		}
 
 	// Potential failure cases are:
@@ -232,7 +259,7 @@ func (k *Keeper) ProcessEvent(originCtx sdk.Context, blockHash common.Hash, elog
 	case EventMsgTransferCollateralOwnership.ID:
 		event, err := contract.ParseMsgTransferCollateralOwnership(ethlog)
 		if err != nil {
-			return errors.Wrap(err, "parse MsgTransferCollateralOwnership"), false
+			return types.EventReceipt{}, errors.Wrap(err, "parse MsgTransferCollateralOwnership"), false
 		}
 
 		k.Logger(ctx).Debug("📣 Process MsgTransferCollateralOwnership",
@@ -244,7 +271,7 @@ func (k *Keeper) ProcessEvent(originCtx sdk.Context, blockHash common.Hash, elog
 		)
 
 		if err, ignore := k.ProcessTransferCollateralOwnership(ctx, event); err != nil {
-			return errors.Wrap(err, "process MsgTransferCollateralOwnership"), ignore
+			return types.EventReceipt{}, errors.Wrap(err, "process MsgTransferCollateralOwnership"), ignore
 		}
 
 	// Potential failure cases are:
@@ -255,7 +282,7 @@ func (k *Keeper) ProcessEvent(originCtx sdk.Context, blockHash common.Hash, elog
 	case EventMsgUnjail.ID:
 		event, err := contract.ParseMsgUnjail(ethlog)
 		if err != nil {
-			return errors.Wrap(err, "parse MsgUnjail"), false
+			return types.EventReceipt{}, errors.Wrap(err, "parse MsgUnjail"), false
 		}
 
 		k.Logger(ctx).Debug("📣 Process MsgUnjail",
@@ -265,17 +292,18 @@ func (k *Keeper) ProcessEvent(originCtx sdk.Context, blockHash common.Hash, elog
 		)
 
 		if err, ignore := k.ProcessUnjail(ctx, event); err != nil {
-			return errors.Wrap(err, "process MsgUnjail"), ignore
+			return types.EventReceipt{}, errors.Wrap(err, "process MsgUnjail"), ignore
 		}
 
 	// Potential failure cases are:
 	// - The validator does not exist (might be verified at the EVM contract level)
 	// Fortunately, this logic is not critical. Even if it fails, users won't lose money
 	// and the state won't become corrupted. Therefore, we simply ignore errors when they occur.
+	// While extra voting power updates are paused, the update is queued and applied after unpausing.
 	case EventMsgUpdateExtraVotingPower.ID:
 		event, err := contract.ParseMsgUpdateExtraVotingPower(ethlog)
 		if err != nil {
-			return errors.Wrap(err, "parse MsgUpdateExtraVotingPower"), false
+			return types.EventReceipt{}, errors.Wrap(err, "parse MsgUpdateExtraVotingPower"), false
 		}
 
 		k.Logger(ctx).Debug("📣 Process MsgUpdateExtraVotingPower",
@@ -286,16 +314,20 @@ func (k *Keeper) ProcessEvent(originCtx sdk.Context, blockHash common.Hash, elog
 		)
 
 		if err, ignore := k.ProcessUpdateExtraVotingPower(ctx, event); err != nil {
-			return errors.Wrap(err, "process MsgUpdateExtraVotingPower"), ignore
+			return types.EventReceipt{}, errors.Wrap(err, "process MsgUpdateExtraVotingPower"), ignore
+		}
+
+		if k.GetCircuitBreaker(ctx).ExtraVotingPowerUpdatesPaused {
+			result.Status = types.EventReceiptStatusQueued
 		}
 
 	default:
-		return errors.New("unknown event"), false
+		return types.EventReceipt{}, errors.New("unknown event"), false
 	}
 
 	// If we reached here, processing was successful, so commit the changes to the parent context
 	writeCache()
-	return nil, false
+	return result, nil, false
 }
 
 // ProcessRegisterValidator processes MsgRegisterValidator event
@@ -305,6 +337,11 @@ func (k *Keeper) ProcessRegisterValidator(ctx sdk.Context, event *bindings.Conse
 	initialCollateralOwner := mitotypes.EthAddress(event.InitialCollateralOwner)
 	initialCollateral := sdkmath.NewUintFromBigInt(event.InitialCollateralAmountGwei)
 
+	// Check if registrations are paused. The initial collateral will be refunded through the fallback.
+	if k.GetCircuitBreaker(ctx).RegistrationPaused {
+		return errors.Wrap(types.ErrFeaturePaused, "registration paused"), true
+	}
+
 	// Register the validator
 	if err := k.RegisterValidator(ctx, valAddr, event.PubKey, initialCollateralOwner, initialCollateral, sdkmath.ZeroUint(), false); err != nil {
 		ignore := errors.Is(err, types.ErrValidatorAlreadyExists) ||
@@ -327,6 +364,11 @@ func (k *Keeper) ProcessDepositCollateral(ctx sdk.Context, event *bindings.Conse
 	collateralOwner := mitotypes.EthAddress(event.CollateralOwner)
 	amount := sdkmath.NewUintFromBigInt(event.AmountGwei)
 
+	// Check if deposits are paused. The collateral will be refunded through the fallback.
+	if k.GetCircuitBreaker(ctx).DepositsPaused {
+		return errors.Wrap(types.ErrFeaturePaused, "deposits paused"), true
+	}
+
 	// Check if validator exists
 	validator, found := k.GetValidator(ctx, valAddr)
 	if !found {
@@ -442,6 +484,15 @@ func (k *Keeper) ProcessUpdateExtraVotingPower(ctx sdk.Context, event *bindings.
 		return types.ErrValidatorNotFound, true
 	}
 
+	// Queue the update to apply it after unpausing if extra voting power updates are paused
+	if k.GetCircuitBreaker(ctx).ExtraVotingPowerUpdatesPaused {
+		k.SetPendingExtraVotingPowerUpdate(ctx, types.PendingExtraVotingPowerUpdate{
+			ValAddr:          valAddr,
+			ExtraVotingPower: extraVotingPower,
+		})
+		return nil, false
+	}
+
 	// Update extra voting power
 	k.UpdateExtraVotingPower(ctx, &validator, extraVotingPower)
 
@@ -21,6 +21,14 @@ func (k Keeper) InitGenesis(ctx sdk.Context, data *types.GenesisState) ([]abci.V
 	// Set ConsensusValidatorEntrypoint contract address
 	k.SetValidatorEntrypointContractAddr(ctx, data.ValidatorEntrypointContractAddr)
 
+	// Set circuit breaker flags
+	k.SetCircuitBreaker(ctx, data.CircuitBreaker)
+
+	// Set pending extra voting power updates
+	for _, update := range data.PendingExtraVotingPowerUpdates {
+		k.SetPendingExtraVotingPowerUpdate(ctx, update)
+	}
+
 	// Validate that each validator has only one collateral owner
 	initialCollateralOwnersByValidator := make(map[mitotypes.EthAddress]mitotypes.EthAddress)
 	for _, ownership := range data.CollateralOwnerships {
@@ -41,6 +49,11 @@ func (k Keeper) InitGenesis(ctx sdk.Context, data *types.GenesisState) ([]abci.V
 		if err = k.RegisterValidator(ctx, validator.Addr, validator.Pubkey, initialCollateralOwner, validator.Collateral, validator.ExtraVotingPower, validator.Jailed); err != nil {
 			return nil, err
 		}
+
+		if !validator.FeeRecipient.Equal(mitotypes.EthAddress{}) {
+			registered, _ := k.GetValidator(ctx, validator.Addr)
+			k.UpdateFeeRecipient(ctx, &registered, validator.FeeRecipient)
+		}
 	}
 
 	// Set withdrawals
@@ -65,6 +78,8 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 		k.GetAllWithdrawals(ctx),
 		k.GetLastValidatorPowers(ctx),
 		k.GetAllCollateralOwnerships(ctx),
+		k.GetCircuitBreaker(ctx),
+		k.GetAllPendingExtraVotingPowerUpdates(ctx),
 	)
 }
 
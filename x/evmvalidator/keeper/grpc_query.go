@@ -8,6 +8,8 @@ import (
 	"cosmossdk.io/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/mitosis-org/chain/x/evmvalidator/types"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -47,6 +49,17 @@ func (q QueryServer) ValidatorEntrypointContractAddr(ctx context.Context, req *t
 	return &types.QueryValidatorEntrypointContractAddrResponse{Addr: addr}, nil
 }
 
+// CircuitBreaker returns the circuit breaker flags
+func (q QueryServer) CircuitBreaker(ctx context.Context, req *types.QueryCircuitBreakerRequest) (*types.QueryCircuitBreakerResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	cb := q.k.GetCircuitBreaker(sdkCtx)
+	return &types.QueryCircuitBreakerResponse{CircuitBreaker: cb}, nil
+}
+
 // Validator returns a specific validator by validator address
 func (q QueryServer) Validator(ctx context.Context, req *types.QueryValidatorRequest) (*types.QueryValidatorResponse, error) {
 	if req == nil {
@@ -133,22 +146,12 @@ func (q QueryServer) Withdrawal(ctx context.Context, req *types.QueryWithdrawalR
 
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
 
-	// Since withdrawals are indexed by maturesAt and ID, we need to iterate all withdrawals
-	// to find one with the requested ID
-	var foundWithdrawal *types.Withdrawal
-	q.k.IterateWithdrawalsByMaturesAt(sdkCtx, func(withdrawal types.Withdrawal) bool {
-		if withdrawal.ID == req.Id {
-			foundWithdrawal = &withdrawal
-			return true
-		}
-		return false
-	})
-
-	if foundWithdrawal == nil {
+	withdrawal, found := q.k.GetWithdrawal(sdkCtx, req.Id)
+	if !found {
 		return nil, status.Errorf(codes.NotFound, "withdrawal with ID %d not found", req.Id)
 	}
 
-	return &types.QueryWithdrawalResponse{Withdrawal: *foundWithdrawal}, nil
+	return &types.QueryWithdrawalResponse{Withdrawal: withdrawal}, nil
 }
 
 // Withdrawals returns all withdrawals
@@ -346,3 +349,43 @@ func (q QueryServer) CollateralOwnership(ctx context.Context, req *types.QueryCo
 		},
 	}, nil
 }
+
+// EventReceipt returns the receipts of the events delivered from the EVM block
+func (q QueryServer) EventReceipt(ctx context.Context, req *types.QueryEventReceiptRequest) (*types.QueryEventReceiptResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	bz, err := hexutil.Decode(req.EvmBlockHash)
+	if err != nil || len(bz) != common.HashLength {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid evm block hash: %s", req.EvmBlockHash)
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	receipts := q.k.GetEventReceiptsByBlockHash(sdkCtx, common.BytesToHash(bz))
+	if len(receipts) == 0 {
+		return nil, status.Errorf(codes.NotFound, "event receipt for evm block %s not found", req.EvmBlockHash)
+	}
+
+	return &types.QueryEventReceiptResponse{Receipts: receipts}, nil
+}
+
+// TxEventReceipts returns the receipts of the events emitted by the EVM transaction
+func (q QueryServer) TxEventReceipts(ctx context.Context, req *types.QueryTxEventReceiptsRequest) (*types.QueryTxEventReceiptsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	bz, err := hexutil.Decode(req.TxHash)
+	if err != nil || len(bz) != common.HashLength {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid tx hash: %s", req.TxHash)
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	receipts := q.k.GetEventReceiptsByTxHash(sdkCtx, common.BytesToHash(bz))
+	if len(receipts) == 0 {
+		return nil, status.Errorf(codes.NotFound, "event receipt for tx %s not found", req.TxHash)
+	}
+
+	return &types.QueryTxEventReceiptsResponse{Receipts: receipts}, nil
+}
@@ -21,6 +21,7 @@ type Keeper struct {
 	storeKey              storetypes.StoreKey
 	slashingKeeper        types.SlashingKeeper // initialized later
 	evmEngKeeper          types.EvmEngineKeeper
+	eventLogSource        types.EventLogSource // initialized later
 	validatorAddressCodec address.Codec
 	consensusAddressCodec address.Codec
 	authority             string
@@ -58,6 +59,11 @@ func (k *Keeper) SetEvmEngineKeeper(evmEngKeeper types.EvmEngineKeeper) {
 	k.evmEngKeeper = evmEngKeeper
 }
 
+// SetEventLogSource sets the source of the EVM logs of the delivered events
+func (k *Keeper) SetEventLogSource(eventLogSource types.EventLogSource) {
+	k.eventLogSource = eventLogSource
+}
+
 // GetParams gets the parameters for the x/evmvalidator module
 func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
 	store := ctx.KVStore(k.storeKey)
@@ -338,6 +344,29 @@ func (k Keeper) SetWithdrawal(ctx sdk.Context, withdrawal types.Withdrawal) {
 
 	key = types.GetWithdrawalByValidatorKey(withdrawal.ValAddr, withdrawal.MaturesAt, withdrawal.ID)
 	store.Set(key, bz)
+
+	maturesAtBz := make([]byte, 8)
+	binary.BigEndian.PutUint64(maturesAtBz, uint64(withdrawal.MaturesAt)) //nolint:gosec
+	store.Set(types.GetWithdrawalByIDKey(withdrawal.ID), maturesAtBz)
+}
+
+// GetWithdrawal gets the withdrawal by ID
+func (k Keeper) GetWithdrawal(ctx sdk.Context, id uint64) (withdrawal types.Withdrawal, found bool) {
+	store := ctx.KVStore(k.storeKey)
+
+	maturesAtBz := store.Get(types.GetWithdrawalByIDKey(id))
+	if maturesAtBz == nil {
+		return types.Withdrawal{}, false
+	}
+	maturesAt := int64(binary.BigEndian.Uint64(maturesAtBz)) //nolint:gosec
+
+	bz := store.Get(types.GetWithdrawalByMaturesAtKey(maturesAt, id))
+	if bz == nil {
+		return types.Withdrawal{}, false
+	}
+
+	k.cdc.MustUnmarshal(bz, &withdrawal)
+	return withdrawal, true
 }
 
 // AddNewWithdrawalWithNextID adds a new withdrawal with the next ID
@@ -356,6 +385,8 @@ func (k Keeper) DeleteWithdrawal(ctx sdk.Context, withdrawal types.Withdrawal) {
 
 	key = types.GetWithdrawalByValidatorKey(withdrawal.ValAddr, withdrawal.MaturesAt, withdrawal.ID)
 	store.Delete(key)
+
+	store.Delete(types.GetWithdrawalByIDKey(withdrawal.ID))
 }
 
 // IterateWithdrawalsByMaturesAt iterates through all withdrawals by maturesAt (sorted by maturesAt)
@@ -0,0 +1,23 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	v2 "github.com/mitosis-org/chain/x/evmvalidator/migrations/v2"
+)
+
+// Migrator is a struct for handling in-place store migrations of the evmvalidator module.
+type Migrator struct {
+	keeper *Keeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(keeper *Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// Migrate1to2 migrates the evmvalidator module state from consensus version 1 to 2.
+//
+// It backfills the withdrawal index by ID so that a withdrawal can be looked up without iterating all withdrawals.
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	return v2.MigrateStore(ctx, m.keeper.storeKey)
+}
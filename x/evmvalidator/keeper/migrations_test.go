@@ -0,0 +1,76 @@
+package keeper_test
+
+import (
+	"github.com/mitosis-org/chain/x/evmvalidator/keeper"
+	"github.com/mitosis-org/chain/x/evmvalidator/testutil"
+	"github.com/mitosis-org/chain/x/evmvalidator/types"
+)
+
+func (s *KeeperTestSuite) Test_Migrate1to2() {
+	_, _, valAddr1 := testutil.GenerateSecp256k1Key()
+	_, _, valAddr2 := testutil.GenerateSecp256k1Key()
+
+	withdrawals := []types.Withdrawal{
+		s.createTestWithdrawal(valAddr1, 100, 1000, 1),
+		s.createTestWithdrawal(valAddr1, 200, 2000, 2),
+		s.createTestWithdrawal(valAddr2, 300, 1500, 3),
+	}
+	for _, w := range withdrawals {
+		s.tk.Keeper.SetWithdrawal(s.tk.Ctx, w)
+	}
+	s.tk.Keeper.SetWithdrawalLastID(s.tk.Ctx, 3)
+
+	// Simulate the v1 store, which has no withdrawal index by ID
+	store := s.tk.Ctx.KVStore(s.tk.StoreKey)
+	for _, w := range withdrawals {
+		store.Delete(types.GetWithdrawalByIDKey(w.ID))
+	}
+	for _, w := range withdrawals {
+		_, found := s.tk.Keeper.GetWithdrawal(s.tk.Ctx, w.ID)
+		s.Require().False(found)
+	}
+
+	m := keeper.NewMigrator(s.tk.Keeper)
+	s.Require().NoError(m.Migrate1to2(s.tk.Ctx))
+
+	for _, w := range withdrawals {
+		got, found := s.tk.Keeper.GetWithdrawal(s.tk.Ctx, w.ID)
+		s.Require().True(found)
+		s.Require().Equal(w, got)
+	}
+
+	// The query must find the migrated withdrawals
+	qs := keeper.NewQueryServer(s.tk.Keeper)
+	resp, err := qs.Withdrawal(s.tk.Ctx, &types.QueryWithdrawalRequest{Id: 2})
+	s.Require().NoError(err)
+	s.Require().Equal(withdrawals[1], resp.Withdrawal)
+
+	// The rest of the state must be untouched
+	var all []types.Withdrawal
+	s.tk.Keeper.IterateWithdrawalsByMaturesAt(s.tk.Ctx, func(w types.Withdrawal) bool {
+		all = append(all, w)
+		return false
+	})
+	s.Require().Equal([]types.Withdrawal{withdrawals[0], withdrawals[2], withdrawals[1]}, all)
+	s.Require().Equal(uint64(3), s.tk.Keeper.GetWithdrawalLastID(s.tk.Ctx))
+
+	// Running the migration again must be a no-op
+	s.Require().NoError(m.Migrate1to2(s.tk.Ctx))
+	got, found := s.tk.Keeper.GetWithdrawal(s.tk.Ctx, 3)
+	s.Require().True(found)
+	s.Require().Equal(withdrawals[2], got)
+}
+
+func (s *KeeperTestSuite) Test_GetWithdrawal_DeletedWithdrawal() {
+	_, _, valAddr := testutil.GenerateSecp256k1Key()
+	w := s.createTestWithdrawal(valAddr, 100, 1000, 1)
+	s.tk.Keeper.SetWithdrawal(s.tk.Ctx, w)
+
+	got, found := s.tk.Keeper.GetWithdrawal(s.tk.Ctx, 1)
+	s.Require().True(found)
+	s.Require().Equal(w, got)
+
+	s.tk.Keeper.DeleteWithdrawal(s.tk.Ctx, w)
+	_, found = s.tk.Keeper.GetWithdrawal(s.tk.Ctx, 1)
+	s.Require().False(found)
+}
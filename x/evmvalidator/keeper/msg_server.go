@@ -29,7 +29,7 @@ func (m msgServer) UpdateParams(ctx context.Context, msg *types.MsgUpdateParams)
 		return nil, errors.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", m.k.authority, msg.Authority)
 	}
 
-	if err := msg.Params.Validate(); err != nil {
+	if err := msg.ValidateBasic(); err != nil {
 		return nil, err
 	}
 
@@ -57,7 +57,50 @@ func (m msgServer) UpdateValidatorEntrypointContractAddr(ctx context.Context, ms
 		return nil, errors.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", m.k.authority, msg.Authority)
 	}
 
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
 	m.k.SetValidatorEntrypointContractAddr(sdkCtx, msg.Addr)
 
 	return &types.MsgUpdateValidatorEntrypointContractAddrResponse{}, nil
 }
+
+// UpdateCircuitBreaker updates the circuit breaker flags
+func (m msgServer) UpdateCircuitBreaker(ctx context.Context, msg *types.MsgUpdateCircuitBreaker) (*types.MsgUpdateCircuitBreakerResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	if m.k.authority != msg.Authority {
+		return nil, errors.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", m.k.authority, msg.Authority)
+	}
+
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	m.k.SetCircuitBreaker(sdkCtx, *msg.CircuitBreaker)
+
+	return &types.MsgUpdateCircuitBreakerResponse{}, nil
+}
+
+// UpdateValidatorFeeRecipient updates the fee recipient registered for a validator
+func (m msgServer) UpdateValidatorFeeRecipient(ctx context.Context, msg *types.MsgUpdateValidatorFeeRecipient) (*types.MsgUpdateValidatorFeeRecipientResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	if m.k.authority != msg.Authority {
+		return nil, errors.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", m.k.authority, msg.Authority)
+	}
+
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	validator, found := m.k.GetValidator(sdkCtx, msg.ValAddr)
+	if !found {
+		return nil, errors.Wrapf(types.ErrValidatorNotFound, "validator %s", msg.ValAddr.String())
+	}
+
+	m.k.UpdateFeeRecipient(sdkCtx, &validator, msg.FeeRecipient)
+
+	return &types.MsgUpdateValidatorFeeRecipientResponse{}, nil
+}
@@ -43,7 +43,7 @@ func (s *MsgServerTestSuite) Test_UpdateParams() {
 
 	// Create the message with the correct authority
 	msg := &types.MsgUpdateParams{
-		Authority: "evmgov", // This should match the authority set in the keeper
+		Authority: s.tk.Authority,
 		Params:    newParams,
 	}
 
@@ -69,7 +69,7 @@ func (s *MsgServerTestSuite) Test_UpdateParams() {
 
 	// Case 3: Test with invalid params
 	invalidParamsMsg := &types.MsgUpdateParams{
-		Authority: "evmgov",
+		Authority: s.tk.Authority,
 		Params: types.Params{
 			MaxValidators:    100,
 			MaxLeverageRatio: math.LegacyNewDec(-1), // Invalid negative leverage ratio
@@ -110,7 +110,7 @@ func (s *MsgServerTestSuite) Test_UpdateParams_UpdatesValidatorStates_MaxLeverag
 	newParams.MaxLeverageRatio = math.LegacyNewDec(5) // Decrease from 10 to 5
 
 	msg := &types.MsgUpdateParams{
-		Authority: "evmgov",
+		Authority: s.tk.Authority,
 		Params:    newParams,
 	}
 
@@ -149,7 +149,7 @@ func (s *MsgServerTestSuite) Test_UpdateParams_UpdatesValidatorStates_MinVotingP
 	newParams.MinVotingPower = 2 // Increase from 1 to 2
 
 	msg := &types.MsgUpdateParams{
-		Authority: "evmgov",
+		Authority: s.tk.Authority,
 		Params:    newParams,
 	}
 
@@ -178,7 +178,7 @@ func (s *MsgServerTestSuite) Test_UpdateValidatorEntrypointContractAddr() {
 
 	// Case 1: Test with valid authority
 	msg := &types.MsgUpdateValidatorEntrypointContractAddr{
-		Authority: "evmgov",
+		Authority: s.tk.Authority,
 		Addr:      ethAddr,
 	}
 
@@ -202,3 +202,57 @@ func (s *MsgServerTestSuite) Test_UpdateValidatorEntrypointContractAddr() {
 	s.Require().Error(err)
 	s.Require().Contains(err.Error(), "invalid authority")
 }
+
+// Test_UpdateValidatorFeeRecipient tests the UpdateValidatorFeeRecipient message handler
+func (s *MsgServerTestSuite) Test_UpdateValidatorFeeRecipient() {
+	// Set up initial params
+	s.tk.SetupDefaultTestParams()
+
+	// Register a validator
+	validator := s.tk.RegisterTestValidator(math.NewUint(1000000000), math.ZeroUint(), false) // 1 MITO
+
+	// Create msg server
+	msgServer := keeper.NewMsgServerImpl(s.tk.Keeper)
+
+	_, _, feeRecipient := testutil.GenerateSecp256k1Key()
+
+	// Case 1: Test with valid authority
+	msg := &types.MsgUpdateValidatorFeeRecipient{
+		Authority:    s.tk.Authority,
+		ValAddr:      validator.Addr,
+		FeeRecipient: feeRecipient,
+	}
+
+	resp, err := msgServer.UpdateValidatorFeeRecipient(s.tk.Ctx, msg)
+	s.Require().NoError(err)
+	s.Require().NotNil(resp)
+
+	// Verify the fee recipient was registered and is enforced for the validator's proposals
+	updatedValidator, found := s.tk.Keeper.GetValidator(s.tk.Ctx, validator.Addr)
+	s.Require().True(found)
+	s.Require().Equal(feeRecipient, updatedValidator.FeeRecipient)
+	s.Require().NoError(s.tk.Keeper.VerifyFeeRecipient(s.tk.Ctx, validator.MustConsAddr(), feeRecipient))
+	s.Require().ErrorIs(s.tk.Keeper.VerifyFeeRecipient(s.tk.Ctx, validator.MustConsAddr(), validator.Addr), types.ErrInvalidFeeRecipient)
+
+	// Case 2: Test with invalid authority
+	invalidMsg := &types.MsgUpdateValidatorFeeRecipient{
+		Authority:    "invalid-authority",
+		ValAddr:      validator.Addr,
+		FeeRecipient: feeRecipient,
+	}
+
+	_, err = msgServer.UpdateValidatorFeeRecipient(s.tk.Ctx, invalidMsg)
+	s.Require().Error(err)
+	s.Require().Contains(err.Error(), "invalid authority")
+
+	// Case 3: Test with unknown validator
+	_, _, unknownAddr := testutil.GenerateSecp256k1Key()
+	unknownMsg := &types.MsgUpdateValidatorFeeRecipient{
+		Authority:    s.tk.Authority,
+		ValAddr:      unknownAddr,
+		FeeRecipient: feeRecipient,
+	}
+
+	_, err = msgServer.UpdateValidatorFeeRecipient(s.tk.Ctx, unknownMsg)
+	s.Require().ErrorIs(err, types.ErrValidatorNotFound)
+}
@@ -0,0 +1,141 @@
+package keeper
+
+import (
+	"bytes"
+	"slices"
+	"sort"
+
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/mitosis-org/chain/x/evmvalidator/types"
+	"github.com/omni-network/omni/lib/errors"
+	evmengtypes "github.com/omni-network/omni/octane/evmengine/types"
+)
+
+// SetEventReceipt sets the event receipt and indexes it by tx hash, and by height for pruning
+func (k Keeper) SetEventReceipt(ctx sdk.Context, receipt types.EventReceipt) {
+	store := ctx.KVStore(k.storeKey)
+	blockHash, txHash := common.HexToHash(receipt.EvmBlockHash), common.HexToHash(receipt.TxHash)
+	key := types.GetEventReceiptKey(blockHash, txHash, receipt.LogIndex)
+
+	store.Set(key, k.cdc.MustMarshal(&receipt))
+	store.Set(types.GetEventReceiptByTxHashKey(blockHash, txHash, receipt.LogIndex), []byte{})
+	store.Set(types.GetEventReceiptByHeightKey(receipt.Height, key), []byte{})
+}
+
+// HasEventReceipt returns true if the event receipt of the event log exists
+func (k Keeper) HasEventReceipt(ctx sdk.Context, blockHash common.Hash, txHash common.Hash, logIndex uint64) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(types.GetEventReceiptKey(blockHash, txHash, logIndex))
+}
+
+// GetEventReceiptsByBlockHash gets all event receipts of the EVM block sorted by the log index
+func (k Keeper) GetEventReceiptsByBlockHash(ctx sdk.Context, blockHash common.Hash) []types.EventReceipt {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, types.GetEventReceiptByBlockHashIterationKey(blockHash))
+	defer iterator.Close()
+
+	var receipts []types.EventReceipt
+	for ; iterator.Valid(); iterator.Next() {
+		var receipt types.EventReceipt
+		k.cdc.MustUnmarshal(iterator.Value(), &receipt)
+		receipts = append(receipts, receipt)
+	}
+
+	// The receipts are ordered by the tx hash in the store
+	sort.Slice(receipts, func(i, j int) bool { return receipts[i].LogIndex < receipts[j].LogIndex })
+
+	return receipts
+}
+
+// GetEventReceiptsByTxHash gets all event receipts of the EVM transaction sorted by the log index
+func (k Keeper) GetEventReceiptsByTxHash(ctx sdk.Context, txHash common.Hash) []types.EventReceipt {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, types.GetEventReceiptByTxHashIterationKey(txHash))
+	defer iterator.Close()
+
+	var receipts []types.EventReceipt
+	for ; iterator.Valid(); iterator.Next() {
+		bz := store.Get(types.ParseEventReceiptKeyFromByTxHashKey(iterator.Key()))
+		if bz == nil {
+			panic("event receipt not found for index [BUG]")
+		}
+
+		var receipt types.EventReceipt
+		k.cdc.MustUnmarshal(bz, &receipt)
+		receipts = append(receipts, receipt)
+	}
+
+	return receipts
+}
+
+// PruneEventReceipts deletes the event receipts older than types.EventReceiptRetentionBlocks
+func (k Keeper) PruneEventReceipts(ctx sdk.Context) {
+	pruneHeight := ctx.BlockHeight() - types.EventReceiptRetentionBlocks
+	if pruneHeight <= 0 {
+		return
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	iterator := store.Iterator(types.EventReceiptByHeightKeyPrefix, types.GetEventReceiptByHeightEndKey(pruneHeight))
+	defer iterator.Close()
+
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+
+	for _, key := range keys {
+		receiptKey := types.ParseEventReceiptKeyFromByHeightKey(key)
+		blockHash, txHash, logIndex := types.ParseEventReceiptKey(receiptKey)
+
+		store.Delete(receiptKey)
+		store.Delete(types.GetEventReceiptByTxHashKey(blockHash, txHash, logIndex))
+		store.Delete(key)
+	}
+}
+
+// newEventReceipt returns an event receipt for the delivered event.
+// The delivered event doesn't include the tx hash and the log index, so the event is located among the logs of
+// the EVM block fetched by x/evmengine to deliver the events. Identical events in the same block are located in
+// the order of the log index, in which they are delivered.
+func (k Keeper) newEventReceipt(ctx sdk.Context, blockHash common.Hash, elog evmengtypes.EVMEvent) (types.EventReceipt, error) {
+	if k.eventLogSource == nil {
+		return types.EventReceipt{}, errors.New("event log source not set [BUG]")
+	}
+
+	ethlog, err := elog.ToEthLog()
+	if err != nil {
+		return types.EventReceipt{}, err
+	}
+
+	logs, ok := k.eventLogSource.FetchedLogs(blockHash, ethlog.Address)
+	if !ok {
+		return types.EventReceipt{}, errors.New("logs of the evm block not fetched [BUG]", "evmBlockHash", blockHash.Hex())
+	}
+	logs = slices.Clone(logs)
+	sort.Slice(logs, func(i, j int) bool { return logs[i].Index < logs[j].Index })
+
+	for _, l := range logs {
+		if !sameEventLog(l, ethlog) || k.HasEventReceipt(ctx, blockHash, l.TxHash, uint64(l.Index)) {
+			continue
+		}
+
+		return types.EventReceipt{
+			EvmBlockHash: blockHash.Hex(),
+			TxHash:       l.TxHash.Hex(),
+			LogIndex:     uint64(l.Index),
+			EventName:    eventName(elog),
+			Height:       ctx.BlockHeight(),
+		}, nil
+	}
+
+	return types.EventReceipt{}, errors.New("delivered event not found in the fetched logs [BUG]", "evmBlockHash", blockHash.Hex())
+}
+
+// sameEventLog returns true if the logs have the same address, topics and data
+func sameEventLog(a, b ethtypes.Log) bool {
+	return a.Address == b.Address && slices.Equal(a.Topics, b.Topics) && bytes.Equal(a.Data, b.Data)
+}
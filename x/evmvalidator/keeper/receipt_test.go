@@ -0,0 +1,276 @@
+package keeper_test
+
+import (
+	"math/big"
+	"testing"
+
+	"cosmossdk.io/math"
+	"github.com/ethereum/go-ethereum/common"
+	mitotypes "github.com/mitosis-org/chain/types"
+	"github.com/mitosis-org/chain/x/evmvalidator/keeper"
+	"github.com/mitosis-org/chain/x/evmvalidator/testutil"
+	"github.com/mitosis-org/chain/x/evmvalidator/types"
+	evmengtypes "github.com/omni-network/omni/octane/evmengine/types"
+	"github.com/stretchr/testify/suite"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ReceiptTestSuite is a test suite for event receipt functionality
+type ReceiptTestSuite struct {
+	suite.Suite
+	tk           testutil.TestKeeper
+	entrypoint   common.Address
+	evmBlockHash common.Hash
+	txHash       common.Hash
+}
+
+// SetupTest initializes the test suite
+func (s *ReceiptTestSuite) SetupTest() {
+	s.tk = testutil.NewTestKeeper(&s.Suite)
+	s.tk.SetupDefaultTestParams()
+
+	s.entrypoint = common.HexToAddress("0x00000000000000000000000000000000000000e1")
+	s.tk.Keeper.SetValidatorEntrypointContractAddr(s.tk.Ctx, mitotypes.EthAddress(s.entrypoint))
+
+	s.evmBlockHash = common.HexToHash("0xb1")
+	s.txHash = common.HexToHash("0x71")
+}
+
+// TestReceiptTestSuite runs the receipt test suite
+func TestReceiptTestSuite(t *testing.T) {
+	suite.Run(t, new(ReceiptTestSuite))
+}
+
+// depositEvent returns an EVM event of MsgDepositCollateral
+func (s *ReceiptTestSuite) depositEvent(valAddr mitotypes.EthAddress) evmengtypes.EVMEvent {
+	data, err := keeper.EventMsgDepositCollateral.Inputs.Pack(
+		valAddr.Address(),
+		common.HexToAddress("0x00000000000000000000000000000000000000c1"),
+		big.NewInt(1000000000),
+	)
+	s.Require().NoError(err)
+
+	return evmengtypes.EVMEvent{
+		Address: s.entrypoint.Bytes(),
+		Topics:  [][]byte{keeper.EventMsgDepositCollateral.ID.Bytes()},
+		Data:    data,
+	}
+}
+
+// deliver emits the event as the next log of the EVM block in the tx, and delivers it
+func (s *ReceiptTestSuite) deliver(blockHash common.Hash, txHash common.Hash, elog evmengtypes.EVMEvent) error {
+	ethlog, err := elog.ToEthLog()
+	s.Require().NoError(err)
+
+	ethlog.BlockHash = blockHash
+	ethlog.TxHash = txHash
+	ethlog.Index = uint(len(s.tk.MockLogs.Logs[blockHash]))
+	s.tk.MockLogs.AddLog(ethlog)
+
+	return s.tk.Keeper.Deliver(s.tk.Ctx, blockHash, elog)
+}
+
+func (s *ReceiptTestSuite) Test_Deliver_RecordsReceipts() {
+	validator := s.tk.RegisterTestValidator(math.NewUint(1000000000), math.ZeroUint(), false)
+	_, _, unknownValAddr := testutil.GenerateSecp256k1Key()
+
+	// Two identical events in the same tx get distinct log indexes
+	for _, valAddr := range []mitotypes.EthAddress{validator.Addr, validator.Addr, unknownValAddr} {
+		s.Require().NoError(s.deliver(s.evmBlockHash, s.txHash, s.depositEvent(valAddr)))
+	}
+
+	receipts := s.tk.Keeper.GetEventReceiptsByBlockHash(s.tk.Ctx, s.evmBlockHash)
+	s.Require().Len(receipts, 3)
+	for i := 0; i < 2; i++ {
+		s.Require().Equal(types.EventReceipt{
+			EvmBlockHash: s.evmBlockHash.Hex(),
+			TxHash:       s.txHash.Hex(),
+			LogIndex:     uint64(i),
+			EventName:    "MsgDepositCollateral",
+			Status:       types.EventReceiptStatusApplied,
+			Height:       s.tk.Ctx.BlockHeight(),
+		}, receipts[i])
+	}
+
+	// Depositing to an unknown validator is refunded
+	s.Require().Equal(uint64(2), receipts[2].LogIndex)
+	s.Require().Equal(types.EventReceiptStatusRefunded, receipts[2].Status)
+	s.Require().Contains(receipts[2].Reason, types.ErrValidatorNotFound.Error())
+
+	// The receipts are found by the tx hash
+	s.Require().Equal(receipts, s.tk.Keeper.GetEventReceiptsByTxHash(s.tk.Ctx, s.txHash))
+}
+
+func (s *ReceiptTestSuite) Test_Deliver_MultipleTxs() {
+	validator := s.tk.RegisterTestValidator(math.NewUint(1000000000), math.ZeroUint(), false)
+	txHash2 := common.HexToHash("0x72")
+
+	s.Require().NoError(s.deliver(s.evmBlockHash, s.txHash, s.depositEvent(validator.Addr)))
+	s.Require().NoError(s.deliver(s.evmBlockHash, txHash2, s.depositEvent(validator.Addr)))
+
+	receipts := s.tk.Keeper.GetEventReceiptsByTxHash(s.tk.Ctx, txHash2)
+	s.Require().Len(receipts, 1)
+	s.Require().Equal(uint64(1), receipts[0].LogIndex)
+
+	// The receipts of another EVM block are indexed separately
+	nextBlockHash := common.HexToHash("0xb2")
+	s.Require().NoError(s.deliver(nextBlockHash, s.txHash, s.depositEvent(validator.Addr)))
+	s.Require().Len(s.tk.Keeper.GetEventReceiptsByTxHash(s.tk.Ctx, s.txHash), 2)
+	s.Require().Len(s.tk.Keeper.GetEventReceiptsByBlockHash(s.tk.Ctx, nextBlockHash), 1)
+}
+
+func (s *ReceiptTestSuite) Test_Deliver_LogsNotFetched() {
+	validator := s.tk.RegisterTestValidator(math.NewUint(1000000000), math.ZeroUint(), false)
+
+	// The logs of the EVM block are not fetched
+	err := s.tk.Keeper.Deliver(s.tk.Ctx, s.evmBlockHash, s.depositEvent(validator.Addr))
+	s.Require().ErrorContains(err, "logs of the evm block not fetched")
+
+	// The delivered event is not among the fetched logs
+	s.Require().NoError(s.deliver(s.evmBlockHash, s.txHash, s.depositEvent(validator.Addr)))
+	err = s.tk.Keeper.Deliver(s.tk.Ctx, s.evmBlockHash, s.depositEvent(validator.Addr))
+	s.Require().ErrorContains(err, "delivered event not found in the fetched logs")
+
+	s.Require().Len(s.tk.Keeper.GetEventReceiptsByBlockHash(s.tk.Ctx, s.evmBlockHash), 1)
+}
+
+func (s *ReceiptTestSuite) Test_Deliver_PausedDepositRefunded() {
+	validator := s.tk.RegisterTestValidator(math.NewUint(1000000000), math.ZeroUint(), false)
+	s.tk.Keeper.SetCircuitBreaker(s.tk.Ctx, types.CircuitBreaker{DepositsPaused: true})
+
+	s.Require().NoError(s.deliver(s.evmBlockHash, s.txHash, s.depositEvent(validator.Addr)))
+
+	receipts := s.tk.Keeper.GetEventReceiptsByBlockHash(s.tk.Ctx, s.evmBlockHash)
+	s.Require().Len(receipts, 1)
+	s.Require().Equal(types.EventReceiptStatusRefunded, receipts[0].Status)
+	s.Require().Contains(receipts[0].Reason, types.ErrFeaturePaused.Error())
+}
+
+func (s *ReceiptTestSuite) Test_Deliver_PausedExtraVotingPowerUpdateQueued() {
+	validator := s.tk.RegisterTestValidator(math.NewUint(1000000000), math.ZeroUint(), false)
+	s.tk.Keeper.SetCircuitBreaker(s.tk.Ctx, types.CircuitBreaker{ExtraVotingPowerUpdatesPaused: true})
+
+	data, err := keeper.EventMsgUpdateExtraVotingPower.Inputs.Pack(validator.Addr.Address(), big.NewInt(1e18))
+	s.Require().NoError(err)
+	s.Require().NoError(s.deliver(s.evmBlockHash, s.txHash, evmengtypes.EVMEvent{
+		Address: s.entrypoint.Bytes(),
+		Topics:  [][]byte{keeper.EventMsgUpdateExtraVotingPower.ID.Bytes()},
+		Data:    data,
+	}))
+
+	receipts := s.tk.Keeper.GetEventReceiptsByBlockHash(s.tk.Ctx, s.evmBlockHash)
+	s.Require().Len(receipts, 1)
+	s.Require().Equal(types.EventReceiptStatusQueued, receipts[0].Status)
+	s.Require().Len(s.tk.Keeper.GetAllPendingExtraVotingPowerUpdates(s.tk.Ctx), 1)
+}
+
+func (s *ReceiptTestSuite) Test_Deliver_IgnoredReceipt() {
+	validator := s.tk.RegisterTestValidator(math.NewUint(1000000000), math.ZeroUint(), false)
+	_, _, unknownValAddr := testutil.GenerateSecp256k1Key()
+
+	data, err := keeper.EventMsgUnjail.Inputs.Pack(unknownValAddr.Address())
+	s.Require().NoError(err)
+	unjail := evmengtypes.EVMEvent{
+		Address: s.entrypoint.Bytes(),
+		Topics:  [][]byte{keeper.EventMsgUnjail.ID.Bytes()},
+		Data:    data,
+	}
+
+	s.Require().NoError(s.deliver(s.evmBlockHash, s.txHash, unjail))
+	s.Require().NoError(s.deliver(s.evmBlockHash, s.txHash, s.depositEvent(validator.Addr)))
+
+	receipts := s.tk.Keeper.GetEventReceiptsByBlockHash(s.tk.Ctx, s.evmBlockHash)
+	s.Require().Len(receipts, 2)
+	s.Require().Equal("MsgUnjail", receipts[0].EventName)
+	s.Require().Equal(types.EventReceiptStatusIgnored, receipts[0].Status)
+	s.Require().Contains(receipts[0].Reason, types.ErrValidatorNotFound.Error())
+
+	s.Require().Equal(uint64(1), receipts[1].LogIndex)
+	s.Require().Equal(types.EventReceiptStatusApplied, receipts[1].Status)
+}
+
+func (s *ReceiptTestSuite) Test_PruneEventReceipts() {
+	blockHash1 := common.HexToHash("0x05")
+	blockHash2 := common.HexToHash("0x06")
+
+	s.tk.Keeper.SetEventReceipt(s.tk.Ctx, types.EventReceipt{
+		EvmBlockHash: blockHash1.Hex(),
+		TxHash:       s.txHash.Hex(),
+		Status:       types.EventReceiptStatusApplied,
+		Height:       10,
+	})
+	s.tk.Keeper.SetEventReceipt(s.tk.Ctx, types.EventReceipt{
+		EvmBlockHash: blockHash2.Hex(),
+		TxHash:       s.txHash.Hex(),
+		Status:       types.EventReceiptStatusApplied,
+		Height:       11,
+	})
+
+	// Nothing is pruned within the retention window
+	s.tk.Keeper.PruneEventReceipts(s.tk.Ctx.WithBlockHeight(10 + types.EventReceiptRetentionBlocks - 1))
+	s.Require().Len(s.tk.Keeper.GetEventReceiptsByBlockHash(s.tk.Ctx, blockHash1), 1)
+	s.Require().Len(s.tk.Keeper.GetEventReceiptsByBlockHash(s.tk.Ctx, blockHash2), 1)
+	s.Require().Len(s.tk.Keeper.GetEventReceiptsByTxHash(s.tk.Ctx, s.txHash), 2)
+
+	// The receipt is pruned with its tx hash index
+	s.tk.Keeper.PruneEventReceipts(s.tk.Ctx.WithBlockHeight(10 + types.EventReceiptRetentionBlocks))
+	s.Require().Empty(s.tk.Keeper.GetEventReceiptsByBlockHash(s.tk.Ctx, blockHash1))
+	s.Require().Len(s.tk.Keeper.GetEventReceiptsByBlockHash(s.tk.Ctx, blockHash2), 1)
+	s.Require().Len(s.tk.Keeper.GetEventReceiptsByTxHash(s.tk.Ctx, s.txHash), 1)
+}
+
+func (s *ReceiptTestSuite) Test_QueryEventReceipt() {
+	queryServer := keeper.NewQueryServer(s.tk.Keeper)
+
+	receipt := types.EventReceipt{
+		EvmBlockHash: s.evmBlockHash.Hex(),
+		TxHash:       s.txHash.Hex(),
+		LogIndex:     1,
+		EventName:    "MsgUnjail",
+		Status:       types.EventReceiptStatusIgnored,
+		Reason:       "validator not found",
+		Height:       s.tk.Ctx.BlockHeight(),
+	}
+	s.tk.Keeper.SetEventReceipt(s.tk.Ctx, receipt)
+
+	res, err := queryServer.EventReceipt(s.tk.Ctx, &types.QueryEventReceiptRequest{EvmBlockHash: s.evmBlockHash.Hex()})
+	s.Require().NoError(err)
+	s.Require().Equal([]types.EventReceipt{receipt}, res.Receipts)
+
+	_, err = queryServer.EventReceipt(s.tk.Ctx, &types.QueryEventReceiptRequest{EvmBlockHash: common.HexToHash("0x08").Hex()})
+	s.Require().Equal(codes.NotFound, status.Code(err))
+
+	_, err = queryServer.EventReceipt(s.tk.Ctx, &types.QueryEventReceiptRequest{EvmBlockHash: "0x1234"})
+	s.Require().Equal(codes.InvalidArgument, status.Code(err))
+
+	_, err = queryServer.EventReceipt(s.tk.Ctx, nil)
+	s.Require().Equal(codes.InvalidArgument, status.Code(err))
+}
+
+func (s *ReceiptTestSuite) Test_QueryTxEventReceipts() {
+	queryServer := keeper.NewQueryServer(s.tk.Keeper)
+
+	receipt := types.EventReceipt{
+		EvmBlockHash: s.evmBlockHash.Hex(),
+		TxHash:       s.txHash.Hex(),
+		LogIndex:     3,
+		EventName:    "MsgDepositCollateral",
+		Status:       types.EventReceiptStatusApplied,
+		Height:       s.tk.Ctx.BlockHeight(),
+	}
+	s.tk.Keeper.SetEventReceipt(s.tk.Ctx, receipt)
+
+	res, err := queryServer.TxEventReceipts(s.tk.Ctx, &types.QueryTxEventReceiptsRequest{TxHash: s.txHash.Hex()})
+	s.Require().NoError(err)
+	s.Require().Equal([]types.EventReceipt{receipt}, res.Receipts)
+
+	_, err = queryServer.TxEventReceipts(s.tk.Ctx, &types.QueryTxEventReceiptsRequest{TxHash: common.HexToHash("0x08").Hex()})
+	s.Require().Equal(codes.NotFound, status.Code(err))
+
+	_, err = queryServer.TxEventReceipts(s.tk.Ctx, &types.QueryTxEventReceiptsRequest{TxHash: "0x1234"})
+	s.Require().Equal(codes.InvalidArgument, status.Code(err))
+
+	_, err = queryServer.TxEventReceipts(s.tk.Ctx, nil)
+	s.Require().Equal(codes.InvalidArgument, status.Code(err))
+}
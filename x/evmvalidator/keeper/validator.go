@@ -490,6 +490,56 @@ func (k Keeper) UpdateExtraVotingPower(ctx sdk.Context, validator *types.Validat
 	k.UpdateValidatorState(ctx, validator, "update extra voting power")
 }
 
+// UpdateFeeRecipient updates the registered fee recipient of the validator.
+// The zero address clears the registration.
+func (k Keeper) UpdateFeeRecipient(ctx sdk.Context, validator *types.Validator, feeRecipient mitotypes.EthAddress) {
+	// Update validator's fee recipient
+	oldFeeRecipient := validator.FeeRecipient
+	validator.FeeRecipient = feeRecipient
+	k.SetValidator(ctx, *validator)
+
+	// Emit event
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeUpdateFeeRecipient,
+			sdk.NewAttribute(types.AttributeKeyValAddr, validator.Addr.String()),
+			sdk.NewAttribute(types.AttributeKeyOldFeeRecipient, oldFeeRecipient.String()),
+			sdk.NewAttribute(types.AttributeKeyFeeRecipient, feeRecipient.String()),
+		),
+	)
+
+	k.Logger(ctx).Info("💳 Validator Fee Recipient Updated",
+		"height", ctx.BlockHeight(),
+		"validator", validator.Addr.String(),
+		"oldFeeRecipient", oldFeeRecipient.String(),
+		"newFeeRecipient", feeRecipient.String(),
+	)
+}
+
+// VerifyFeeRecipient verifies that the fee recipient of a payload proposed by the given proposer
+// matches the fee recipient registered for the proposer.
+// It accepts any fee recipient if the proposer has not registered one, for backward compatibility
+// with the validators using the fee recipient configured in app.toml.
+func (k Keeper) VerifyFeeRecipient(ctx sdk.Context, proposer sdk.ConsAddress, feeRecipient mitotypes.EthAddress) error {
+	validator, found := k.GetValidatorByConsAddr(ctx, proposer)
+	if !found {
+		return errors.Wrap(types.ErrValidatorNotFound, fmt.Sprintf("proposer %X", proposer.Bytes()))
+	}
+
+	if validator.FeeRecipient.Equal(mitotypes.EthAddress{}) {
+		return nil
+	}
+
+	if !validator.FeeRecipient.Equal(feeRecipient) {
+		return errors.Wrap(types.ErrInvalidFeeRecipient, fmt.Sprintf(
+			"proposed %s, registered %s for validator %s",
+			feeRecipient.String(), validator.FeeRecipient.String(), validator.Addr.String(),
+		))
+	}
+
+	return nil
+}
+
 func (k Keeper) UpdateValidatorState(ctx sdk.Context, validator *types.Validator, context string) {
 	params := k.GetParams(ctx)
 	oldVotingPower := validator.VotingPower
@@ -837,6 +837,42 @@ func (s *ValidatorTestSuite) Test_UpdateExtraVotingPower() {
 	s.Require().Equal(expectedValidator, validator)
 }
 
+func (s *ValidatorTestSuite) Test_UpdateFeeRecipient() {
+	s.tk.SetupDefaultTestParams()
+
+	validator := s.tk.RegisterTestValidator(math.NewUint(1000000000), math.ZeroUint(), false) // 1 MITO
+	initialValidator := validator
+	consAddr := validator.MustConsAddr()
+
+	// No fee recipient is registered initially, so any fee recipient is accepted
+	_, _, feeRecipient := testutil.GenerateSecp256k1Key()
+	_, _, otherRecipient := testutil.GenerateSecp256k1Key()
+	s.Require().True(validator.FeeRecipient.Equal(mitotypes.EthAddress{}))
+	s.Require().NoError(s.tk.Keeper.VerifyFeeRecipient(s.tk.Ctx, consAddr, otherRecipient))
+
+	// Update fee recipient
+	s.tk.Keeper.UpdateFeeRecipient(s.tk.Ctx, &validator, feeRecipient)
+
+	// Check if only the fee recipient was updated
+	expectedValidator := initialValidator
+	expectedValidator.FeeRecipient = feeRecipient
+	s.Require().Equal(expectedValidator, validator)
+	storedValidator, found := s.tk.Keeper.GetValidator(s.tk.Ctx, validator.Addr)
+	s.Require().True(found)
+	s.Require().Equal(expectedValidator, storedValidator)
+
+	// Only the registered fee recipient is accepted
+	s.Require().NoError(s.tk.Keeper.VerifyFeeRecipient(s.tk.Ctx, consAddr, feeRecipient))
+	err := s.tk.Keeper.VerifyFeeRecipient(s.tk.Ctx, consAddr, otherRecipient)
+	s.Require().ErrorIs(err, types.ErrInvalidFeeRecipient)
+
+	// An unknown proposer is rejected
+	_, unknownPubkey, _ := testutil.GenerateSecp256k1Key()
+	unknownValidator := types.Validator{Pubkey: unknownPubkey}
+	err = s.tk.Keeper.VerifyFeeRecipient(s.tk.Ctx, unknownValidator.MustConsAddr(), feeRecipient)
+	s.Require().ErrorIs(err, types.ErrValidatorNotFound)
+}
+
 // ==================== UpdateValidatorState Tests ====================
 
 func (s *ValidatorTestSuite) Test_UpdateValidatorState() {
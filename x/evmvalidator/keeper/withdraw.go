@@ -12,6 +12,11 @@ import (
 func (k Keeper) ProcessMaturedWithdrawals(ctx sdk.Context) error {
 	var err error
 
+	// Matured withdrawals are kept in the queue while withdrawals are paused
+	if k.GetCircuitBreaker(ctx).WithdrawalsPaused {
+		return nil
+	}
+
 	params := k.GetParams(ctx)
 	currentTime := ctx.BlockTime().Unix()
 	withdrawalLimit := params.WithdrawalLimit
@@ -0,0 +1,43 @@
+package v2
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/mitosis-org/chain/x/evmvalidator/types"
+	"github.com/omni-network/omni/lib/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// MigrateStore performs in-place store migrations from v1 to v2.
+//
+// It backfills the withdrawal index by ID, which stores maturesAt of each withdrawal,
+// from the existing withdrawal entries indexed by maturesAt and ID.
+func MigrateStore(ctx sdk.Context, storeKey storetypes.StoreKey) error {
+	store := ctx.KVStore(storeKey)
+
+	iterator := prefix.NewStore(store, types.WithdrawalByMaturesAtKeyPrefix).Iterator(nil, nil)
+	defer iterator.Close()
+
+	// Collect keys first not to write to the store while iterating it.
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+
+	for _, key := range keys {
+		// key: maturesAt (8 bytes) | id (8 bytes)
+		if len(key) != 16 {
+			return errors.New(fmt.Sprintf("invalid withdrawal key length: %d", len(key)))
+		}
+		maturesAtBz := key[:8]
+		id := binary.BigEndian.Uint64(key[8:])
+
+		store.Set(types.GetWithdrawalByIDKey(id), maturesAtBz)
+	}
+
+	return nil
+}
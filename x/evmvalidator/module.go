@@ -3,6 +3,7 @@ package evmvalidator
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"strings"
 
 	"cosmossdk.io/core/appmodule"
@@ -31,7 +32,7 @@ import (
 )
 
 const (
-	ConsensusVersion = 1
+	ConsensusVersion = 2
 )
 
 var (
@@ -151,6 +152,11 @@ func (am AppModule) ValidateGenesis(cdc codec.JSONCodec, _ client.TxEncodingConf
 func (am AppModule) RegisterServices(cfg module.Configurator) {
 	types.RegisterMsgServer(cfg.MsgServer(), keeper.NewMsgServerImpl(am.keeper))
 	types.RegisterQueryServer(cfg.QueryServer(), keeper.NewQueryServer(am.keeper))
+
+	m := keeper.NewMigrator(am.keeper)
+	if err := cfg.RegisterMigration(types.ModuleName, 1, m.Migrate1to2); err != nil {
+		panic(fmt.Sprintf("failed to register migration of x/%s from version 1 to 2: %v", types.ModuleName, err))
+	}
 }
 
 // IsOnePerModuleType implements the depinject.OnePerModuleType interface.
@@ -16,6 +16,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/testutil"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	"github.com/ethereum/go-ethereum/crypto"
 	mitotypes "github.com/mitosis-org/chain/types"
 	"github.com/mitosis-org/chain/x/evmvalidator/keeper"
@@ -31,6 +32,8 @@ type TestKeeper struct {
 	StoreKey   storetypes.StoreKey
 	MockSlash  *MockSlashingKeeper
 	MockEvmEng *MockEvmEngineKeeper
+	MockLogs   *MockEventLogSource
+	Authority  string
 }
 
 // GenerateSecp256k1Key generates a new secp256k1 private key and returns the private key, compressed pubkey, and eth address
@@ -85,6 +88,10 @@ func NewTestKeeper(s *suite.Suite) TestKeeper {
 	// Create mock keepers
 	mockSlash := &MockSlashingKeeper{}
 	mockEvmEng := &MockEvmEngineKeeper{}
+	mockLogs := &MockEventLogSource{}
+
+	// The authority is the evmgov module account
+	authority := authtypes.NewModuleAddress("evmgov").String()
 
 	// Create keeper
 	k := keeper.NewKeeper(
@@ -92,11 +99,12 @@ func NewTestKeeper(s *suite.Suite) TestKeeper {
 		storeKey,
 		address.NewBech32Codec("mitovaloper"),
 		address.NewBech32Codec("mitovalcons"),
-		"evmgov",
+		authority,
 	)
 
 	k.SetSlashingKeeper(mockSlash)
 	k.SetEvmEngineKeeper(mockEvmEng)
+	k.SetEventLogSource(mockLogs)
 
 	return TestKeeper{
 		Keeper:     k,
@@ -105,6 +113,8 @@ func NewTestKeeper(s *suite.Suite) TestKeeper {
 		StoreKey:   storeKey,
 		MockSlash:  mockSlash,
 		MockEvmEng: mockEvmEng,
+		MockLogs:   mockLogs,
+		Authority:  authority,
 	}
 }
 
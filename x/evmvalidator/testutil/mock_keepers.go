@@ -6,6 +6,7 @@ import (
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/mitosis-org/chain/x/evmvalidator/types"
 )
 
@@ -57,7 +58,36 @@ func (m MockEvmEngineKeeper) InsertWithdrawal(ctx context.Context, withdrawalAdd
 	return nil
 }
 
+// MockEventLogSource is a mock of EventLogSource interface for testing
+type MockEventLogSource struct {
+	Logs map[common.Hash][]ethtypes.Log
+}
+
+// AddLog adds the log to the fetched logs of its EVM block
+func (m *MockEventLogSource) AddLog(log ethtypes.Log) {
+	if m.Logs == nil {
+		m.Logs = make(map[common.Hash][]ethtypes.Log)
+	}
+	m.Logs[log.BlockHash] = append(m.Logs[log.BlockHash], log)
+}
+
+func (m *MockEventLogSource) FetchedLogs(blockHash common.Hash, contractAddr common.Address) ([]ethtypes.Log, bool) {
+	blockLogs, ok := m.Logs[blockHash]
+	if !ok {
+		return nil, false
+	}
+
+	var logs []ethtypes.Log
+	for _, log := range blockLogs {
+		if log.Address == contractAddr {
+			logs = append(logs, log)
+		}
+	}
+	return logs, true
+}
+
 var (
 	_ types.SlashingKeeper  = MockSlashingKeeper{}
 	_ types.EvmEngineKeeper = MockEvmEngineKeeper{}
+	_ types.EventLogSource  = (*MockEventLogSource)(nil)
 )
@@ -0,0 +1,676 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: mitosis/evmvalidator/v1/circuit_breaker.proto
+
+package types
+
+import (
+	cosmossdk_io_math "cosmossdk.io/math"
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	github_com_mitosis_org_chain_types "github.com/mitosis-org/chain/types"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// CircuitBreaker defines the emergency flags to pause each feature of the
+// module during an incident
+type CircuitBreaker struct {
+	// registration_paused pauses validator registrations.
+	// The initial collateral of a paused registration is refunded.
+	RegistrationPaused bool `protobuf:"varint,1,opt,name=registration_paused,json=registrationPaused,proto3" json:"registration_paused,omitempty"`
+	// deposits_paused pauses collateral deposits.
+	// The collateral of a paused deposit is refunded.
+	DepositsPaused bool `protobuf:"varint,2,opt,name=deposits_paused,json=depositsPaused,proto3" json:"deposits_paused,omitempty"`
+	// withdrawals_paused pauses the processing of matured withdrawals.
+	// Withdrawals are kept in the queue and processed after unpausing.
+	WithdrawalsPaused bool `protobuf:"varint,3,opt,name=withdrawals_paused,json=withdrawalsPaused,proto3" json:"withdrawals_paused,omitempty"`
+	// extra_voting_power_updates_paused pauses extra voting power updates.
+	// The updates are queued while paused and applied after unpausing. Only the
+	// latest update of each validator is kept.
+	ExtraVotingPowerUpdatesPaused bool `protobuf:"varint,4,opt,name=extra_voting_power_updates_paused,json=extraVotingPowerUpdatesPaused,proto3" json:"extra_voting_power_updates_paused,omitempty"`
+}
+
+func (m *CircuitBreaker) Reset()         { *m = CircuitBreaker{} }
+func (m *CircuitBreaker) String() string { return proto.CompactTextString(m) }
+func (*CircuitBreaker) ProtoMessage()    {}
+func (*CircuitBreaker) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7b07dc231e31c522, []int{0}
+}
+func (m *CircuitBreaker) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CircuitBreaker) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CircuitBreaker.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CircuitBreaker) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CircuitBreaker.Merge(m, src)
+}
+func (m *CircuitBreaker) XXX_Size() int {
+	return m.Size()
+}
+func (m *CircuitBreaker) XXX_DiscardUnknown() {
+	xxx_messageInfo_CircuitBreaker.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CircuitBreaker proto.InternalMessageInfo
+
+func (m *CircuitBreaker) GetRegistrationPaused() bool {
+	if m != nil {
+		return m.RegistrationPaused
+	}
+	return false
+}
+
+func (m *CircuitBreaker) GetDepositsPaused() bool {
+	if m != nil {
+		return m.DepositsPaused
+	}
+	return false
+}
+
+func (m *CircuitBreaker) GetWithdrawalsPaused() bool {
+	if m != nil {
+		return m.WithdrawalsPaused
+	}
+	return false
+}
+
+func (m *CircuitBreaker) GetExtraVotingPowerUpdatesPaused() bool {
+	if m != nil {
+		return m.ExtraVotingPowerUpdatesPaused
+	}
+	return false
+}
+
+// PendingExtraVotingPowerUpdate defines an extra voting power update queued
+// while extra voting power updates are paused
+type PendingExtraVotingPowerUpdate struct {
+	// val_addr is the address of the validator
+	ValAddr github_com_mitosis_org_chain_types.EthAddress `protobuf:"bytes,1,opt,name=val_addr,json=valAddr,proto3,customtype=github.com/mitosis-org/chain/types.EthAddress" json:"val_addr"`
+	// extra_voting_power is the new extra voting power of the validator (in gwei
+	// unit)
+	ExtraVotingPower cosmossdk_io_math.Uint `protobuf:"bytes,2,opt,name=extra_voting_power,json=extraVotingPower,proto3,customtype=cosmossdk.io/math.Uint" json:"extra_voting_power"`
+}
+
+func (m *PendingExtraVotingPowerUpdate) Reset()         { *m = PendingExtraVotingPowerUpdate{} }
+func (m *PendingExtraVotingPowerUpdate) String() string { return proto.CompactTextString(m) }
+func (*PendingExtraVotingPowerUpdate) ProtoMessage()    {}
+func (*PendingExtraVotingPowerUpdate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7b07dc231e31c522, []int{1}
+}
+func (m *PendingExtraVotingPowerUpdate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PendingExtraVotingPowerUpdate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PendingExtraVotingPowerUpdate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PendingExtraVotingPowerUpdate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingExtraVotingPowerUpdate.Merge(m, src)
+}
+func (m *PendingExtraVotingPowerUpdate) XXX_Size() int {
+	return m.Size()
+}
+func (m *PendingExtraVotingPowerUpdate) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingExtraVotingPowerUpdate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PendingExtraVotingPowerUpdate proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*CircuitBreaker)(nil), "mitosis.evmvalidator.v1.CircuitBreaker")
+	proto.RegisterType((*PendingExtraVotingPowerUpdate)(nil), "mitosis.evmvalidator.v1.PendingExtraVotingPowerUpdate")
+}
+
+func init() {
+	proto.RegisterFile("mitosis/evmvalidator/v1/circuit_breaker.proto", fileDescriptor_7b07dc231e31c522)
+}
+
+var fileDescriptor_7b07dc231e31c522 = []byte{
+	// 397 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x92, 0x4d, 0x0b, 0xd3, 0x30,
+	0x18, 0xc7, 0x5b, 0x15, 0x9d, 0x51, 0xa6, 0x46, 0xf1, 0x65, 0xb0, 0x4e, 0x77, 0xd1, 0x4b, 0x1b,
+	0x86, 0xf8, 0x01, 0xac, 0x0c, 0x04, 0x2f, 0x65, 0x30, 0x0f, 0x7a, 0x28, 0x59, 0x13, 0xda, 0xb0,
+	0xb6, 0x29, 0xc9, 0xd3, 0x6e, 0x7e, 0x0b, 0x3f, 0x8c, 0x1f, 0x62, 0x27, 0x19, 0x9e, 0xc4, 0xc3,
+	0x90, 0xf5, 0x8b, 0x48, 0x93, 0x6e, 0xcc, 0x17, 0xbc, 0xe5, 0xc9, 0xff, 0xf7, 0xbc, 0x3f, 0xc8,
+	0x2f, 0x04, 0x48, 0x2d, 0x34, 0xe1, 0x4d, 0xd1, 0xd0, 0x5c, 0x30, 0x0a, 0x52, 0x91, 0x66, 0x46,
+	0x12, 0xa1, 0x92, 0x5a, 0x40, 0xbc, 0x52, 0x9c, 0xae, 0xb9, 0x0a, 0x2a, 0x25, 0x41, 0xe2, 0x47,
+	0x3d, 0x1e, 0x5c, 0xe2, 0x41, 0x33, 0x1b, 0x3d, 0x48, 0x65, 0x2a, 0x0d, 0x43, 0xba, 0x97, 0xc5,
+	0x47, 0x4f, 0x12, 0xa9, 0x0b, 0xa9, 0x63, 0x2b, 0x58, 0xc3, 0x4a, 0xd3, 0xd6, 0x45, 0xc3, 0x37,
+	0x36, 0x47, 0x68, 0x53, 0x60, 0x82, 0xee, 0x2b, 0x9e, 0x0a, 0x0d, 0x8a, 0x82, 0x90, 0x65, 0x5c,
+	0xd1, 0x5a, 0x73, 0xf6, 0xd8, 0x7d, 0xea, 0xbe, 0x18, 0x2c, 0xf0, 0xa5, 0x14, 0x19, 0x05, 0x3f,
+	0x47, 0x77, 0x18, 0xaf, 0xa4, 0x16, 0xa0, 0x4f, 0xf0, 0x15, 0x03, 0x0f, 0x4f, 0xdf, 0x3d, 0xe8,
+	0x23, 0xbc, 0x11, 0x90, 0x31, 0x45, 0x37, 0x34, 0x3f, 0xb3, 0x57, 0x0d, 0x7b, 0xef, 0x42, 0xe9,
+	0xf1, 0xb7, 0xe8, 0x19, 0xdf, 0x82, 0xa2, 0x71, 0x23, 0x41, 0x94, 0x69, 0x5c, 0xc9, 0x0d, 0x57,
+	0x71, 0x5d, 0x31, 0x0a, 0xfc, 0xec, 0x7d, 0xcd, 0x78, 0x8f, 0x0d, 0xf8, 0xde, 0x70, 0x51, 0x87,
+	0x2d, 0x2d, 0x65, 0x23, 0x4d, 0xbf, 0xba, 0x68, 0x1c, 0xf1, 0x92, 0x89, 0x32, 0x9d, 0xff, 0x13,
+	0xc4, 0x11, 0x1a, 0x34, 0x34, 0x8f, 0x29, 0x63, 0xca, 0x74, 0x7a, 0x3b, 0x7c, 0xb5, 0x3b, 0x4c,
+	0x9c, 0x1f, 0x87, 0x89, 0x9f, 0x0a, 0xc8, 0xea, 0x55, 0x90, 0xc8, 0x82, 0xf4, 0x63, 0xf7, 0xa5,
+	0x4a, 0x49, 0x92, 0x51, 0x51, 0x12, 0xf8, 0x54, 0x71, 0x1d, 0xcc, 0x21, 0x7b, 0xcd, 0x98, 0xe2,
+	0x5a, 0x2f, 0x6e, 0x34, 0x34, 0xef, 0xde, 0xf8, 0x23, 0xc2, 0x7f, 0x57, 0x6f, 0x06, 0x73, 0x33,
+	0xf4, 0xfb, 0xd8, 0x0f, 0xed, 0x2e, 0x34, 0x5b, 0x07, 0x42, 0x92, 0x82, 0x42, 0x16, 0x2c, 0x45,
+	0x09, 0xdf, 0xbe, 0xf8, 0xb7, 0xfa, 0x2d, 0x75, 0xe6, 0xe2, 0xee, 0x9f, 0xdd, 0x85, 0xef, 0x76,
+	0x47, 0xcf, 0xdd, 0x1f, 0x3d, 0xf7, 0xe7, 0xd1, 0x73, 0x3f, 0xb7, 0x9e, 0xb3, 0x6f, 0x3d, 0xe7,
+	0x7b, 0xeb, 0x39, 0x1f, 0x66, 0xff, 0x2d, 0x77, 0xfb, 0xfb, 0x81, 0x99, 0xea, 0x57, 0xd7, 0xcd,
+	0x29, 0xbc, 0xfc, 0x35, 0x00, 0xf0, 0x16, 0x9f, 0x86, 0x85, 0x02, 0x00, 0x00,
+}
+
+func (m *CircuitBreaker) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CircuitBreaker) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CircuitBreaker) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ExtraVotingPowerUpdatesPaused {
+		i--
+		if m.ExtraVotingPowerUpdatesPaused {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.WithdrawalsPaused {
+		i--
+		if m.WithdrawalsPaused {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.DepositsPaused {
+		i--
+		if m.DepositsPaused {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.RegistrationPaused {
+		i--
+		if m.RegistrationPaused {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PendingExtraVotingPowerUpdate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PendingExtraVotingPowerUpdate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PendingExtraVotingPowerUpdate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.ExtraVotingPower.Size()
+		i -= size
+		if _, err := m.ExtraVotingPower.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintCircuitBreaker(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.ValAddr.Size()
+		i -= size
+		if _, err := m.ValAddr.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintCircuitBreaker(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintCircuitBreaker(dAtA []byte, offset int, v uint64) int {
+	offset -= sovCircuitBreaker(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *CircuitBreaker) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RegistrationPaused {
+		n += 2
+	}
+	if m.DepositsPaused {
+		n += 2
+	}
+	if m.WithdrawalsPaused {
+		n += 2
+	}
+	if m.ExtraVotingPowerUpdatesPaused {
+		n += 2
+	}
+	return n
+}
+
+func (m *PendingExtraVotingPowerUpdate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ValAddr.Size()
+	n += 1 + l + sovCircuitBreaker(uint64(l))
+	l = m.ExtraVotingPower.Size()
+	n += 1 + l + sovCircuitBreaker(uint64(l))
+	return n
+}
+
+func sovCircuitBreaker(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozCircuitBreaker(x uint64) (n int) {
+	return sovCircuitBreaker(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *CircuitBreaker) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCircuitBreaker
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CircuitBreaker: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CircuitBreaker: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RegistrationPaused", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCircuitBreaker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RegistrationPaused = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DepositsPaused", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCircuitBreaker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DepositsPaused = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawalsPaused", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCircuitBreaker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.WithdrawalsPaused = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExtraVotingPowerUpdatesPaused", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCircuitBreaker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ExtraVotingPowerUpdatesPaused = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCircuitBreaker(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCircuitBreaker
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PendingExtraVotingPowerUpdate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCircuitBreaker
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PendingExtraVotingPowerUpdate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PendingExtraVotingPowerUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValAddr", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCircuitBreaker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCircuitBreaker
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCircuitBreaker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ValAddr.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExtraVotingPower", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCircuitBreaker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCircuitBreaker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCircuitBreaker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ExtraVotingPower.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCircuitBreaker(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCircuitBreaker
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipCircuitBreaker(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowCircuitBreaker
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowCircuitBreaker
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowCircuitBreaker
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthCircuitBreaker
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupCircuitBreaker
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthCircuitBreaker
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthCircuitBreaker        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowCircuitBreaker          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupCircuitBreaker = fmt.Errorf("proto: unexpected end of group")
+)
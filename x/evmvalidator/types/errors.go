@@ -11,4 +11,7 @@ var (
 	ErrInvalidPubKey          = errors.Register(ModuleName, 3, "invalid validator pubkey")
 	ErrInvalidVotingPower     = errors.Register(ModuleName, 4, "invalid voting power")
 	ErrInsufficientCollateral = errors.Register(ModuleName, 5, "insufficient collateral")
+	ErrFeaturePaused          = errors.Register(ModuleName, 6, "feature paused by circuit breaker")
+	ErrInvalidFeeRecipient    = errors.Register(ModuleName, 7, "invalid fee recipient")
+	ErrInvalidMessage         = errors.Register(ModuleName, 8, "invalid message")
 )
@@ -8,6 +8,7 @@ const (
 	EventTypeTransferCollateralOwnership = "transfer_collateral_ownership"
 	EventTypeUnjailValidator             = "unjail_validator"
 	EventTypeUpdateExtraVotingPower      = "update_extra_voting_power"
+	EventTypeUpdateFeeRecipient          = "update_fee_recipient"
 	EventTypeUpdateVotingPower           = "update_voting_power"
 	EventTypeJailValidator               = "jail_validator"
 	EventTypeSlashValidator              = "slash_validator"
@@ -22,6 +23,8 @@ const (
 	AttributeKeyCollateralNewOwner  = "collateral_new_owner"
 	AttributeKeyExtraVotingPower    = "extra_voting_power"
 	AttributeKeyOldExtraVotingPower = "old_extra_voting_power"
+	AttributeKeyFeeRecipient        = "fee_recipient"
+	AttributeKeyOldFeeRecipient     = "old_fee_recipient"
 	AttributeKeyVotingPower         = "voting_power"
 	AttributeKeyOldVotingPower      = "old_voting_power"
 	AttributeKeyJailed              = "jailed"
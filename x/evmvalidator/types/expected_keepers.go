@@ -5,6 +5,7 @@ import (
 
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
@@ -23,3 +24,10 @@ type SlashingKeeper interface {
 type EvmEngineKeeper interface {
 	InsertWithdrawal(ctx context.Context, withdrawalAddr common.Address, amountGwei uint64) error
 }
+
+// EventLogSource provides the EVM logs fetched from the execution client by x/evmengine
+// to deliver the events, since the delivered events don't include the tx hash and the log index.
+type EventLogSource interface {
+	// FetchedLogs returns the logs of the EVM block emitted by the contract, which were fetched last.
+	FetchedLogs(blockHash common.Hash, contractAddr common.Address) ([]ethtypes.Log, bool)
+}
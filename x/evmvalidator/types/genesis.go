@@ -16,6 +16,8 @@ func NewGenesisState(
 	withdrawals []Withdrawal,
 	lastValidatorPowers []LastValidatorPower,
 	collateralOwnerships []CollateralOwnership,
+	circuitBreaker CircuitBreaker,
+	pendingExtraVotingPowerUpdates []PendingExtraVotingPowerUpdate,
 ) *GenesisState {
 	return &GenesisState{
 		Params:                          params,
@@ -24,6 +26,8 @@ func NewGenesisState(
 		Withdrawals:                     withdrawals,
 		LastValidatorPowers:             lastValidatorPowers,
 		CollateralOwnerships:            collateralOwnerships,
+		CircuitBreaker:                  circuitBreaker,
+		PendingExtraVotingPowerUpdates:  pendingExtraVotingPowerUpdates,
 	}
 }
 
@@ -36,6 +40,8 @@ func DefaultGenesisState() *GenesisState {
 		Withdrawals:                     []Withdrawal{},
 		LastValidatorPowers:             []LastValidatorPower{},
 		CollateralOwnerships:            []CollateralOwnership{},
+		CircuitBreaker:                  CircuitBreaker{},
+		PendingExtraVotingPowerUpdates:  []PendingExtraVotingPowerUpdate{},
 	}
 }
 
@@ -90,5 +96,17 @@ func (gs GenesisState) Validate() error {
 		}
 	}
 
+	// Validate pending extra voting power updates
+	pendingValAddrs := make(map[mitotypes.EthAddress]bool)
+	for i, update := range gs.PendingExtraVotingPowerUpdates {
+		if update.ExtraVotingPower.IsNil() {
+			return fmt.Errorf("pending extra voting power update %d has invalid extra voting power: %s", i, update.ExtraVotingPower)
+		}
+		if pendingValAddrs[update.ValAddr] {
+			return fmt.Errorf("pending extra voting power update %d has duplicate validator: %s", i, update.ValAddr.String())
+		}
+		pendingValAddrs[update.ValAddr] = true
+	}
+
 	return nil
 }
@@ -39,6 +39,11 @@ type GenesisState struct {
 	LastValidatorPowers []LastValidatorPower `protobuf:"bytes,5,rep,name=last_validator_powers,json=lastValidatorPowers,proto3" json:"last_validator_powers"`
 	// collateral_ownerships is the list of collateral ownership records
 	CollateralOwnerships []CollateralOwnership `protobuf:"bytes,6,rep,name=collateral_ownerships,json=collateralOwnerships,proto3" json:"collateral_ownerships"`
+	// circuit_breaker is the circuit breaker flags
+	CircuitBreaker CircuitBreaker `protobuf:"bytes,7,opt,name=circuit_breaker,json=circuitBreaker,proto3" json:"circuit_breaker"`
+	// pending_extra_voting_power_updates is the list of extra voting power
+	// updates queued while paused
+	PendingExtraVotingPowerUpdates []PendingExtraVotingPowerUpdate `protobuf:"bytes,8,rep,name=pending_extra_voting_power_updates,json=pendingExtraVotingPowerUpdates,proto3" json:"pending_extra_voting_power_updates"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -109,6 +114,20 @@ func (m *GenesisState) GetCollateralOwnerships() []CollateralOwnership {
 	return nil
 }
 
+func (m *GenesisState) GetCircuitBreaker() CircuitBreaker {
+	if m != nil {
+		return m.CircuitBreaker
+	}
+	return CircuitBreaker{}
+}
+
+func (m *GenesisState) GetPendingExtraVotingPowerUpdates() []PendingExtraVotingPowerUpdate {
+	if m != nil {
+		return m.PendingExtraVotingPowerUpdates
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "mitosis.evmvalidator.v1.GenesisState")
 }
@@ -118,34 +137,39 @@ func init() {
 }
 
 var fileDescriptor_45d10f69860e1271 = []byte{
-	// 418 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x92, 0x4f, 0x8b, 0xd3, 0x40,
-	0x18, 0xc6, 0x13, 0xb7, 0xf6, 0x30, 0xdd, 0x53, 0xdc, 0xc5, 0xd0, 0x43, 0x52, 0xaa, 0x62, 0x41,
-	0x37, 0xa1, 0x2b, 0x1e, 0x3d, 0xd8, 0x65, 0x51, 0x58, 0xc1, 0x52, 0x41, 0xc1, 0x4b, 0x98, 0x26,
-	0x43, 0x32, 0x90, 0x64, 0xc2, 0xbc, 0xaf, 0x89, 0xbd, 0xfa, 0x09, 0xfc, 0x3a, 0x7e, 0x83, 0x1e,
-	0x7b, 0x14, 0x0f, 0x45, 0xda, 0x2f, 0x22, 0x99, 0x4e, 0xd3, 0x4a, 0xc9, 0xde, 0x12, 0xe6, 0xf7,
-	0xfc, 0x9e, 0x77, 0xfe, 0x90, 0x67, 0x19, 0x47, 0x01, 0x1c, 0x7c, 0x56, 0x66, 0x25, 0x4d, 0x79,
-	0x44, 0x51, 0x48, 0xbf, 0x1c, 0xfb, 0x31, 0xcb, 0x19, 0x70, 0xf0, 0x0a, 0x29, 0x50, 0x58, 0x8f,
-	0x35, 0xe6, 0x1d, 0x63, 0x5e, 0x39, 0xee, 0x5f, 0xc4, 0x22, 0x16, 0x8a, 0xf1, 0xeb, 0xaf, 0x1d,
-	0xde, 0x7f, 0xda, 0x66, 0x2d, 0xa8, 0xa4, 0x99, 0x96, 0xf6, 0x9f, 0xb7, 0x51, 0x87, 0x06, 0x05,
-	0x0e, 0x7f, 0x75, 0xc8, 0xf9, 0xbb, 0xdd, 0x3c, 0x9f, 0x90, 0x22, 0xb3, 0xde, 0x90, 0xee, 0xce,
-	0x64, 0x9b, 0x03, 0x73, 0xd4, 0xbb, 0x76, 0xbd, 0x96, 0xf9, 0xbc, 0xa9, 0xc2, 0x26, 0x9d, 0xe5,
-	0xda, 0x35, 0x66, 0x3a, 0x64, 0xfd, 0x30, 0xc9, 0xb0, 0xa1, 0x02, 0x96, 0xa3, 0x5c, 0x14, 0x82,
-	0xe7, 0x18, 0x84, 0x22, 0x47, 0x49, 0x43, 0x0c, 0x68, 0x14, 0x49, 0xfb, 0xc1, 0xc0, 0x1c, 0x9d,
-	0x4f, 0x5e, 0xd7, 0xd1, 0x3f, 0x6b, 0xf7, 0x2a, 0xe6, 0x98, 0x7c, 0x9b, 0x7b, 0xa1, 0xc8, 0x7c,
-	0xdd, 0x76, 0x25, 0x64, 0xec, 0x87, 0x09, 0xe5, 0xb9, 0x8f, 0x8b, 0x82, 0x81, 0x77, 0x8b, 0xc9,
-	0xdb, 0x28, 0x92, 0x0c, 0x60, 0xe6, 0x36, 0x05, 0xb7, 0x8d, 0xff, 0x46, 0xeb, 0x6b, 0xc6, 0x7a,
-	0x4f, 0x48, 0x83, 0x80, 0x7d, 0x36, 0x38, 0x1b, 0xf5, 0xae, 0x87, 0xad, 0xfb, 0xf8, 0xbc, 0xff,
-	0xd1, 0x5b, 0x39, 0xca, 0x5a, 0x77, 0xa4, 0x57, 0x71, 0x4c, 0x22, 0x49, 0x2b, 0x9a, 0x82, 0xdd,
-	0x51, 0xaa, 0x27, 0xad, 0xaa, 0x2f, 0x0d, 0xab, 0x5d, 0xc7, 0x69, 0x8b, 0x91, 0xcb, 0x94, 0x02,
-	0x06, 0x87, 0xf3, 0x29, 0x44, 0xc5, 0x24, 0xd8, 0x0f, 0x95, 0xf6, 0x45, 0xab, 0xf6, 0x03, 0x05,
-	0x6c, 0xa6, 0x9c, 0xd6, 0x19, 0xad, 0x7f, 0x94, 0x9e, 0xac, 0x80, 0x15, 0x93, 0xcb, 0x50, 0xa4,
-	0x29, 0x45, 0x26, 0x69, 0x1a, 0x88, 0x2a, 0x67, 0x12, 0x12, 0x5e, 0x80, 0xdd, 0x55, 0x35, 0x2f,
-	0x5b, 0x6b, 0x6e, 0x9a, 0xd4, 0xc7, 0x7d, 0x48, 0xf7, 0x5c, 0x84, 0xa7, 0x4b, 0x30, 0xb9, 0x5b,
-	0x6e, 0x1c, 0x73, 0xb5, 0x71, 0xcc, 0xbf, 0x1b, 0xc7, 0xfc, 0xb9, 0x75, 0x8c, 0xd5, 0xd6, 0x31,
-	0x7e, 0x6f, 0x1d, 0xe3, 0xeb, 0xf8, 0xde, 0x0b, 0xfd, 0xfe, 0xff, 0xab, 0x54, 0xf7, 0x3b, 0xef,
-	0xaa, 0xf7, 0xf8, 0xea, 0x5f, 0x00, 0x00, 0x00, 0xff, 0xff, 0xeb, 0xb5, 0xf1, 0x7c, 0x36, 0x03,
-	0x00, 0x00,
+	// 506 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x93, 0x41, 0x8b, 0xd3, 0x40,
+	0x14, 0xc7, 0x1b, 0x77, 0xad, 0x32, 0x5d, 0x14, 0xe2, 0x2e, 0x86, 0x1e, 0xd2, 0x52, 0x95, 0x2d,
+	0x68, 0x13, 0xba, 0xa2, 0x37, 0x0f, 0x76, 0x29, 0x0a, 0x2b, 0xb8, 0xac, 0x58, 0xc1, 0x4b, 0x98,
+	0x26, 0x43, 0x32, 0x98, 0x66, 0xc2, 0xbc, 0xd7, 0x74, 0xf7, 0xea, 0xc9, 0xa3, 0x1f, 0xc0, 0x0f,
+	0xb4, 0xc7, 0x3d, 0x8a, 0x87, 0x45, 0xda, 0x2f, 0x22, 0x99, 0xcc, 0x66, 0xbb, 0x2e, 0xd3, 0x5b,
+	0xd3, 0xfc, 0xfe, 0xbf, 0x7f, 0xde, 0xf0, 0x86, 0x3c, 0x9b, 0x71, 0x14, 0xc0, 0xc1, 0x67, 0xc5,
+	0xac, 0xa0, 0x29, 0x8f, 0x28, 0x0a, 0xe9, 0x17, 0x43, 0x3f, 0x66, 0x19, 0x03, 0x0e, 0x5e, 0x2e,
+	0x05, 0x0a, 0xfb, 0xb1, 0xc6, 0xbc, 0x75, 0xcc, 0x2b, 0x86, 0xed, 0xdd, 0x58, 0xc4, 0x42, 0x31,
+	0x7e, 0xf9, 0xab, 0xc2, 0xdb, 0x4f, 0x4d, 0xd6, 0x9c, 0x4a, 0x3a, 0xd3, 0xd2, 0xf6, 0xbe, 0x89,
+	0xba, 0x6e, 0xa8, 0xc0, 0x81, 0x09, 0x0c, 0xb9, 0x0c, 0xe7, 0x1c, 0x83, 0xa9, 0x64, 0xf4, 0x1b,
+	0xd3, 0x78, 0xef, 0x57, 0x93, 0xec, 0xbc, 0xab, 0x3e, 0xff, 0x13, 0x52, 0x64, 0xf6, 0x1b, 0xd2,
+	0xac, 0x8a, 0x1d, 0xab, 0x6b, 0xf5, 0x5b, 0x07, 0x1d, 0xcf, 0x30, 0x8e, 0x77, 0xac, 0xb0, 0xd1,
+	0xf6, 0xf9, 0x65, 0xa7, 0x71, 0xa2, 0x43, 0xf6, 0x77, 0x8b, 0xf4, 0x6a, 0x2a, 0x60, 0x19, 0xca,
+	0xb3, 0x5c, 0xf0, 0x0c, 0x83, 0x50, 0x64, 0x28, 0x69, 0x88, 0x01, 0x8d, 0x22, 0xe9, 0xdc, 0xe9,
+	0x5a, 0xfd, 0x9d, 0xd1, 0xab, 0x32, 0xfa, 0xe7, 0xb2, 0x33, 0x88, 0x39, 0x26, 0xf3, 0xa9, 0x17,
+	0x8a, 0x99, 0xaf, 0xdb, 0x06, 0x42, 0xc6, 0x7e, 0x98, 0x50, 0x9e, 0xf9, 0x78, 0x96, 0x33, 0xf0,
+	0xc6, 0x98, 0xbc, 0x8d, 0x22, 0xc9, 0x00, 0x4e, 0x3a, 0x75, 0xc1, 0xb8, 0xf6, 0x1f, 0x6a, 0x7d,
+	0xc9, 0xd8, 0xef, 0x09, 0xa9, 0x11, 0x70, 0xb6, 0xba, 0x5b, 0xfd, 0xd6, 0x41, 0xcf, 0x38, 0xc7,
+	0xe4, 0xea, 0x41, 0x8f, 0xb2, 0x96, 0xb5, 0x8f, 0x48, 0x6b, 0xc1, 0x31, 0x89, 0x24, 0x5d, 0xd0,
+	0x14, 0x9c, 0x6d, 0xa5, 0x7a, 0x62, 0x54, 0x7d, 0xa9, 0x59, 0xed, 0x5a, 0x4f, 0xdb, 0x8c, 0xec,
+	0xa5, 0x14, 0x30, 0xb8, 0x3e, 0x9f, 0x5c, 0x2c, 0x98, 0x04, 0xe7, 0xae, 0xd2, 0x3e, 0x37, 0x6a,
+	0x3f, 0x50, 0xc0, 0xfa, 0x2b, 0x8f, 0xcb, 0x8c, 0xd6, 0x3f, 0x4a, 0x6f, 0xbd, 0x01, 0x3b, 0x26,
+	0x7b, 0xa1, 0x48, 0x53, 0x8a, 0x4c, 0xd2, 0x34, 0x10, 0x8b, 0x8c, 0x49, 0x48, 0x78, 0x0e, 0x4e,
+	0x53, 0xd5, 0xbc, 0x30, 0xd6, 0x1c, 0xd6, 0xa9, 0x8f, 0x57, 0x21, 0xdd, 0xb3, 0x1b, 0xde, 0x7e,
+	0x05, 0xf6, 0x84, 0x3c, 0xfc, 0x6f, 0xa9, 0x9c, 0x7b, 0x6a, 0x67, 0xf6, 0xcd, 0x15, 0x15, 0x3f,
+	0xaa, 0x70, 0x6d, 0x7f, 0x10, 0xde, 0xf8, 0xd7, 0xfe, 0x61, 0x91, 0x5e, 0xce, 0xb2, 0x88, 0x67,
+	0x71, 0xc0, 0x4e, 0x51, 0xd2, 0xa0, 0x10, 0x58, 0x3e, 0xa8, 0xe3, 0x0a, 0xe6, 0x79, 0x44, 0x91,
+	0x81, 0x73, 0x5f, 0x8d, 0xf3, 0xda, 0xbc, 0x9f, 0x95, 0x62, 0x5c, 0x1a, 0x26, 0x4a, 0xa0, 0x0e,
+	0xe8, 0xb3, 0x8a, 0xeb, 0x6a, 0x37, 0xdf, 0x04, 0xc1, 0xe8, 0xe8, 0x7c, 0xe9, 0x5a, 0x17, 0x4b,
+	0xd7, 0xfa, 0xbb, 0x74, 0xad, 0x9f, 0x2b, 0xb7, 0x71, 0xb1, 0x72, 0x1b, 0xbf, 0x57, 0x6e, 0xe3,
+	0xeb, 0x70, 0xe3, 0xce, 0x9e, 0xde, 0xbc, 0x7e, 0x6a, 0x85, 0xa7, 0x4d, 0x75, 0xe5, 0x5e, 0xfe,
+	0x1b, 0x00, 0x2e, 0x43, 0x01, 0x79, 0x48, 0x04, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -168,6 +192,30 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.PendingExtraVotingPowerUpdates) > 0 {
+		for iNdEx := len(m.PendingExtraVotingPowerUpdates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PendingExtraVotingPowerUpdates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	{
+		size, err := m.CircuitBreaker.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
 	if len(m.CollateralOwnerships) > 0 {
 		for iNdEx := len(m.CollateralOwnerships) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -292,6 +340,14 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	l = m.CircuitBreaker.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.PendingExtraVotingPowerUpdates) > 0 {
+		for _, e := range m.PendingExtraVotingPowerUpdates {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -532,6 +588,73 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CircuitBreaker", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CircuitBreaker.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingExtraVotingPowerUpdates", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingExtraVotingPowerUpdates = append(m.PendingExtraVotingPowerUpdates, PendingExtraVotingPowerUpdate{})
+			if err := m.PendingExtraVotingPowerUpdates[len(m.PendingExtraVotingPowerUpdates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
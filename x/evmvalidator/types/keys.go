@@ -5,6 +5,7 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/address"
+	"github.com/ethereum/go-ethereum/common"
 	mitotypes "github.com/mitosis-org/chain/types"
 )
 
@@ -46,6 +47,24 @@ var (
 
 	// CollateralOwnershipKeyPrefix is the prefix for a collateral ownership by validator and owner
 	CollateralOwnershipKeyPrefix = []byte{0x0A}
+
+	// EventReceiptKeyPrefix is the prefix for an event receipt by EVM block hash, tx hash and log index
+	EventReceiptKeyPrefix = []byte{0x0B}
+
+	// EventReceiptByHeightKeyPrefix is the prefix for an event receipt index, by height
+	EventReceiptByHeightKeyPrefix = []byte{0x0C}
+
+	// CircuitBreakerKey is the key for the circuit breaker flags
+	CircuitBreakerKey = []byte{0x0D}
+
+	// WithdrawalByIDKeyPrefix is the prefix for a withdrawal index by ID, which stores maturesAt of the withdrawal
+	WithdrawalByIDKeyPrefix = []byte{0x0E}
+
+	// EventReceiptByTxHashKeyPrefix is the prefix for an event receipt index, by tx hash
+	EventReceiptByTxHashKeyPrefix = []byte{0x0F}
+
+	// PendingExtraVotingPowerUpdateKeyPrefix is the prefix for an extra voting power update queued while paused
+	PendingExtraVotingPowerUpdateKeyPrefix = []byte{0x10}
 )
 
 // GetValidatorKey creates key for a validator from validator address
@@ -87,6 +106,13 @@ func GetWithdrawalByMaturesAtKey(maturesAt int64, id uint64) []byte {
 	return append(WithdrawalByMaturesAtKeyPrefix, append(maturesAtBytes, idBytes...)...)
 }
 
+// GetWithdrawalByIDKey creates a key for a withdrawal index by ID
+func GetWithdrawalByIDKey(id uint64) []byte {
+	idBytes := make([]byte, 8)
+	binary.BigEndian.PutUint64(idBytes, id)
+	return append(WithdrawalByIDKeyPrefix, idBytes...)
+}
+
 // GetWithdrawalByValidatorKey creates a key for a withdrawal by validator and maturesAt
 func GetWithdrawalByValidatorKey(valAddr mitotypes.EthAddress, maturesAt int64, id uint64) []byte {
 	maturesAtBytes := make([]byte, 8)
@@ -119,3 +145,71 @@ func GetCollateralOwnershipByValidatorIterationKey(valAddr mitotypes.EthAddress)
 		address.MustLengthPrefix(valAddr.Bytes())...,
 	)
 }
+
+// GetEventReceiptKey creates a key for an event receipt by EVM block hash, tx hash and log index
+func GetEventReceiptKey(blockHash common.Hash, txHash common.Hash, logIndex uint64) []byte {
+	logIndexBytes := make([]byte, 8)
+	binary.BigEndian.PutUint64(logIndexBytes, logIndex)
+	return append(GetEventReceiptByBlockHashIterationKey(blockHash), append(txHash.Bytes(), logIndexBytes...)...)
+}
+
+// GetEventReceiptByBlockHashIterationKey creates a key for iterating event receipts by EVM block hash
+func GetEventReceiptByBlockHashIterationKey(blockHash common.Hash) []byte {
+	return append(EventReceiptKeyPrefix, blockHash.Bytes()...)
+}
+
+// GetEventReceiptByTxHashKey creates a key for an event receipt index by tx hash.
+// The key is suffixed by the EVM block hash and the log index of the event receipt.
+func GetEventReceiptByTxHashKey(blockHash common.Hash, txHash common.Hash, logIndex uint64) []byte {
+	logIndexBytes := make([]byte, 8)
+	binary.BigEndian.PutUint64(logIndexBytes, logIndex)
+	return append(GetEventReceiptByTxHashIterationKey(txHash), append(blockHash.Bytes(), logIndexBytes...)...)
+}
+
+// GetEventReceiptByTxHashIterationKey creates a key for iterating event receipts by tx hash
+func GetEventReceiptByTxHashIterationKey(txHash common.Hash) []byte {
+	return append(EventReceiptByTxHashKeyPrefix, txHash.Bytes()...)
+}
+
+// ParseEventReceiptKey returns the EVM block hash, the tx hash and the log index from an event receipt key
+func ParseEventReceiptKey(key []byte) (blockHash common.Hash, txHash common.Hash, logIndex uint64) {
+	key = key[len(EventReceiptKeyPrefix):]
+	return common.BytesToHash(key[:common.HashLength]),
+		common.BytesToHash(key[common.HashLength : 2*common.HashLength]),
+		binary.BigEndian.Uint64(key[2*common.HashLength:])
+}
+
+// ParseEventReceiptKeyFromByTxHashKey returns the event receipt key from an event receipt index key by tx hash
+func ParseEventReceiptKeyFromByTxHashKey(key []byte) []byte {
+	key = key[len(EventReceiptByTxHashKeyPrefix):]
+	txHash := common.BytesToHash(key[:common.HashLength])
+	blockHash := common.BytesToHash(key[common.HashLength : 2*common.HashLength])
+	logIndex := binary.BigEndian.Uint64(key[2*common.HashLength:])
+
+	return GetEventReceiptKey(blockHash, txHash, logIndex)
+}
+
+// GetEventReceiptByHeightKey creates a key for an event receipt index by height.
+// The key is suffixed by the event receipt key without its prefix.
+func GetEventReceiptByHeightKey(height int64, receiptKey []byte) []byte {
+	heightBytes := make([]byte, 8)
+	binary.BigEndian.PutUint64(heightBytes, uint64(height)) //nolint:gosec
+	return append(EventReceiptByHeightKeyPrefix, append(heightBytes, receiptKey[len(EventReceiptKeyPrefix):]...)...)
+}
+
+// ParseEventReceiptKeyFromByHeightKey returns the event receipt key from an event receipt index key by height
+func ParseEventReceiptKeyFromByHeightKey(key []byte) []byte {
+	return append(EventReceiptKeyPrefix, key[len(EventReceiptByHeightKeyPrefix)+8:]...)
+}
+
+// GetEventReceiptByHeightEndKey creates an exclusive end key for iterating event receipts up to the height
+func GetEventReceiptByHeightEndKey(height int64) []byte {
+	heightBytes := make([]byte, 8)
+	binary.BigEndian.PutUint64(heightBytes, uint64(height+1)) //nolint:gosec
+	return append(EventReceiptByHeightKeyPrefix, heightBytes...)
+}
+
+// GetPendingExtraVotingPowerUpdateKey creates key for a pending extra voting power update from validator address
+func GetPendingExtraVotingPowerUpdateKey(valAddr mitotypes.EthAddress) []byte {
+	return append(PendingExtraVotingPowerUpdateKeyPrefix, address.MustLengthPrefix(valAddr.Bytes())...)
+}
@@ -0,0 +1,72 @@
+package types
+
+import (
+	"cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	mitotypes "github.com/mitosis-org/chain/types"
+)
+
+var (
+	_ sdk.HasValidateBasic = (*MsgUpdateParams)(nil)
+	_ sdk.HasValidateBasic = (*MsgUpdateValidatorEntrypointContractAddr)(nil)
+	_ sdk.HasValidateBasic = (*MsgUpdateCircuitBreaker)(nil)
+	_ sdk.HasValidateBasic = (*MsgUpdateValidatorFeeRecipient)(nil)
+)
+
+// ValidateBasic performs stateless validation of MsgUpdateParams
+func (m *MsgUpdateParams) ValidateBasic() error {
+	if err := validateAuthority(m.Authority); err != nil {
+		return err
+	}
+
+	return m.Params.Validate()
+}
+
+// ValidateBasic performs stateless validation of MsgUpdateValidatorEntrypointContractAddr
+func (m *MsgUpdateValidatorEntrypointContractAddr) ValidateBasic() error {
+	if err := validateAuthority(m.Authority); err != nil {
+		return err
+	}
+
+	// The zero address would silently stop delivering the events of the validator entrypoint.
+	if m.Addr.Equal(mitotypes.EthAddress{}) {
+		return errors.Wrap(ErrInvalidMessage, "addr must not be the zero address")
+	}
+
+	return nil
+}
+
+// ValidateBasic performs stateless validation of MsgUpdateCircuitBreaker
+func (m *MsgUpdateCircuitBreaker) ValidateBasic() error {
+	if err := validateAuthority(m.Authority); err != nil {
+		return err
+	}
+
+	// A missing circuit breaker would silently unpause all the features.
+	if m.CircuitBreaker == nil {
+		return errors.Wrap(ErrInvalidMessage, "circuit_breaker must be set")
+	}
+
+	return nil
+}
+
+// ValidateBasic performs stateless validation of MsgUpdateValidatorFeeRecipient
+func (m *MsgUpdateValidatorFeeRecipient) ValidateBasic() error {
+	if err := validateAuthority(m.Authority); err != nil {
+		return err
+	}
+
+	if m.ValAddr.Equal(mitotypes.EthAddress{}) {
+		return errors.Wrap(ErrInvalidMessage, "val_addr must not be the zero address")
+	}
+
+	return nil
+}
+
+func validateAuthority(authority string) error {
+	if _, err := sdk.AccAddressFromBech32(authority); err != nil {
+		return errors.Wrapf(ErrInvalidMessage, "invalid authority address: %s", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,56 @@
+package types_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/ethereum/go-ethereum/common"
+	mitotypes "github.com/mitosis-org/chain/types"
+	"github.com/mitosis-org/chain/x/evmvalidator/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgs_ValidateBasic(t *testing.T) {
+	authority := authtypes.NewModuleAddress("evmgov").String()
+	addr := mitotypes.EthAddress(common.HexToAddress("0x00000000000000000000000000000000000000e1"))
+	params := types.Params{
+		MaxValidators:    100,
+		MaxLeverageRatio: math.LegacyNewDec(10),
+		MinVotingPower:   1,
+		WithdrawalLimit:  10,
+	}
+
+	testCases := []struct {
+		name    string
+		msg     sdk.HasValidateBasic
+		wantErr bool
+	}{
+		{"update params", &types.MsgUpdateParams{Authority: authority, Params: params}, false},
+		{"update params: invalid authority", &types.MsgUpdateParams{Authority: "evmgov", Params: params}, true},
+		{"update params: invalid params", &types.MsgUpdateParams{Authority: authority}, true},
+		{"update entrypoint", &types.MsgUpdateValidatorEntrypointContractAddr{Authority: authority, Addr: addr}, false},
+		{"update entrypoint: invalid authority", &types.MsgUpdateValidatorEntrypointContractAddr{Addr: addr}, true},
+		{"update entrypoint: zero addr", &types.MsgUpdateValidatorEntrypointContractAddr{Authority: authority}, true},
+		{"update circuit breaker", &types.MsgUpdateCircuitBreaker{Authority: authority, CircuitBreaker: &types.CircuitBreaker{DepositsPaused: true}}, false},
+		{"update circuit breaker: unpause all", &types.MsgUpdateCircuitBreaker{Authority: authority, CircuitBreaker: &types.CircuitBreaker{}}, false},
+		{"update circuit breaker: invalid authority", &types.MsgUpdateCircuitBreaker{Authority: "evmgov", CircuitBreaker: &types.CircuitBreaker{}}, true},
+		{"update circuit breaker: empty update", &types.MsgUpdateCircuitBreaker{Authority: authority}, true},
+		{"update fee recipient", &types.MsgUpdateValidatorFeeRecipient{Authority: authority, ValAddr: addr, FeeRecipient: addr}, false},
+		{"update fee recipient: clear", &types.MsgUpdateValidatorFeeRecipient{Authority: authority, ValAddr: addr}, false},
+		{"update fee recipient: invalid authority", &types.MsgUpdateValidatorFeeRecipient{Authority: "evmgov", ValAddr: addr}, true},
+		{"update fee recipient: zero val addr", &types.MsgUpdateValidatorFeeRecipient{Authority: authority, FeeRecipient: addr}, true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if tc.wantErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
@@ -201,6 +201,90 @@ func (m *QueryValidatorEntrypointContractAddrResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_QueryValidatorEntrypointContractAddrResponse proto.InternalMessageInfo
 
+// QueryCircuitBreakerRequest is the request type for the Query/CircuitBreaker
+// RPC method
+type QueryCircuitBreakerRequest struct {
+}
+
+func (m *QueryCircuitBreakerRequest) Reset()         { *m = QueryCircuitBreakerRequest{} }
+func (m *QueryCircuitBreakerRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryCircuitBreakerRequest) ProtoMessage()    {}
+func (*QueryCircuitBreakerRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_14eb3edd860cda8c, []int{4}
+}
+func (m *QueryCircuitBreakerRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCircuitBreakerRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCircuitBreakerRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryCircuitBreakerRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCircuitBreakerRequest.Merge(m, src)
+}
+func (m *QueryCircuitBreakerRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCircuitBreakerRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCircuitBreakerRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryCircuitBreakerRequest proto.InternalMessageInfo
+
+// QueryCircuitBreakerResponse is the response type for the
+// Query/CircuitBreaker RPC method
+type QueryCircuitBreakerResponse struct {
+	CircuitBreaker CircuitBreaker `protobuf:"bytes,1,opt,name=circuit_breaker,json=circuitBreaker,proto3" json:"circuit_breaker"`
+}
+
+func (m *QueryCircuitBreakerResponse) Reset()         { *m = QueryCircuitBreakerResponse{} }
+func (m *QueryCircuitBreakerResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryCircuitBreakerResponse) ProtoMessage()    {}
+func (*QueryCircuitBreakerResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_14eb3edd860cda8c, []int{5}
+}
+func (m *QueryCircuitBreakerResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCircuitBreakerResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCircuitBreakerResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryCircuitBreakerResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCircuitBreakerResponse.Merge(m, src)
+}
+func (m *QueryCircuitBreakerResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCircuitBreakerResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCircuitBreakerResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryCircuitBreakerResponse proto.InternalMessageInfo
+
+func (m *QueryCircuitBreakerResponse) GetCircuitBreaker() CircuitBreaker {
+	if m != nil {
+		return m.CircuitBreaker
+	}
+	return CircuitBreaker{}
+}
+
 // QueryValidatorRequest is the request type for the Query/Validator RPC method
 type QueryValidatorRequest struct {
 	ValAddr []byte `protobuf:"bytes,1,opt,name=val_addr,json=valAddr,proto3" json:"val_addr,omitempty"`
@@ -210,7 +294,7 @@ func (m *QueryValidatorRequest) Reset()         { *m = QueryValidatorRequest{} }
 func (m *QueryValidatorRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryValidatorRequest) ProtoMessage()    {}
 func (*QueryValidatorRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_14eb3edd860cda8c, []int{4}
+	return fileDescriptor_14eb3edd860cda8c, []int{6}
 }
 func (m *QueryValidatorRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -256,7 +340,7 @@ func (m *QueryValidatorResponse) Reset()         { *m = QueryValidatorResponse{}
 func (m *QueryValidatorResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryValidatorResponse) ProtoMessage()    {}
 func (*QueryValidatorResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_14eb3edd860cda8c, []int{5}
+	return fileDescriptor_14eb3edd860cda8c, []int{7}
 }
 func (m *QueryValidatorResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -302,7 +386,7 @@ func (m *QueryValidatorByConsAddrRequest) Reset()         { *m = QueryValidatorB
 func (m *QueryValidatorByConsAddrRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryValidatorByConsAddrRequest) ProtoMessage()    {}
 func (*QueryValidatorByConsAddrRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_14eb3edd860cda8c, []int{6}
+	return fileDescriptor_14eb3edd860cda8c, []int{8}
 }
 func (m *QueryValidatorByConsAddrRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -348,7 +432,7 @@ func (m *QueryValidatorByConsAddrResponse) Reset()         { *m = QueryValidator
 func (m *QueryValidatorByConsAddrResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryValidatorByConsAddrResponse) ProtoMessage()    {}
 func (*QueryValidatorByConsAddrResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_14eb3edd860cda8c, []int{7}
+	return fileDescriptor_14eb3edd860cda8c, []int{9}
 }
 func (m *QueryValidatorByConsAddrResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -395,7 +479,7 @@ func (m *QueryValidatorsRequest) Reset()         { *m = QueryValidatorsRequest{}
 func (m *QueryValidatorsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryValidatorsRequest) ProtoMessage()    {}
 func (*QueryValidatorsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_14eb3edd860cda8c, []int{8}
+	return fileDescriptor_14eb3edd860cda8c, []int{10}
 }
 func (m *QueryValidatorsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -442,7 +526,7 @@ func (m *QueryValidatorsResponse) Reset()         { *m = QueryValidatorsResponse
 func (m *QueryValidatorsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryValidatorsResponse) ProtoMessage()    {}
 func (*QueryValidatorsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_14eb3edd860cda8c, []int{9}
+	return fileDescriptor_14eb3edd860cda8c, []int{11}
 }
 func (m *QueryValidatorsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -495,7 +579,7 @@ func (m *QueryWithdrawalRequest) Reset()         { *m = QueryWithdrawalRequest{}
 func (m *QueryWithdrawalRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryWithdrawalRequest) ProtoMessage()    {}
 func (*QueryWithdrawalRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_14eb3edd860cda8c, []int{10}
+	return fileDescriptor_14eb3edd860cda8c, []int{12}
 }
 func (m *QueryWithdrawalRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -541,7 +625,7 @@ func (m *QueryWithdrawalResponse) Reset()         { *m = QueryWithdrawalResponse
 func (m *QueryWithdrawalResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryWithdrawalResponse) ProtoMessage()    {}
 func (*QueryWithdrawalResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_14eb3edd860cda8c, []int{11}
+	return fileDescriptor_14eb3edd860cda8c, []int{13}
 }
 func (m *QueryWithdrawalResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -588,7 +672,7 @@ func (m *QueryWithdrawalsRequest) Reset()         { *m = QueryWithdrawalsRequest
 func (m *QueryWithdrawalsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryWithdrawalsRequest) ProtoMessage()    {}
 func (*QueryWithdrawalsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_14eb3edd860cda8c, []int{12}
+	return fileDescriptor_14eb3edd860cda8c, []int{14}
 }
 func (m *QueryWithdrawalsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -635,7 +719,7 @@ func (m *QueryWithdrawalsResponse) Reset()         { *m = QueryWithdrawalsRespon
 func (m *QueryWithdrawalsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryWithdrawalsResponse) ProtoMessage()    {}
 func (*QueryWithdrawalsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_14eb3edd860cda8c, []int{13}
+	return fileDescriptor_14eb3edd860cda8c, []int{15}
 }
 func (m *QueryWithdrawalsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -690,7 +774,7 @@ func (m *QueryWithdrawalsByValidatorRequest) Reset()         { *m = QueryWithdra
 func (m *QueryWithdrawalsByValidatorRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryWithdrawalsByValidatorRequest) ProtoMessage()    {}
 func (*QueryWithdrawalsByValidatorRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_14eb3edd860cda8c, []int{14}
+	return fileDescriptor_14eb3edd860cda8c, []int{16}
 }
 func (m *QueryWithdrawalsByValidatorRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -744,7 +828,7 @@ func (m *QueryWithdrawalsByValidatorResponse) Reset()         { *m = QueryWithdr
 func (m *QueryWithdrawalsByValidatorResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryWithdrawalsByValidatorResponse) ProtoMessage()    {}
 func (*QueryWithdrawalsByValidatorResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_14eb3edd860cda8c, []int{15}
+	return fileDescriptor_14eb3edd860cda8c, []int{17}
 }
 func (m *QueryWithdrawalsByValidatorResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -798,7 +882,7 @@ func (m *CollateralOwnershipWithAmount) Reset()         { *m = CollateralOwnersh
 func (m *CollateralOwnershipWithAmount) String() string { return proto.CompactTextString(m) }
 func (*CollateralOwnershipWithAmount) ProtoMessage()    {}
 func (*CollateralOwnershipWithAmount) Descriptor() ([]byte, []int) {
-	return fileDescriptor_14eb3edd860cda8c, []int{16}
+	return fileDescriptor_14eb3edd860cda8c, []int{18}
 }
 func (m *CollateralOwnershipWithAmount) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -845,7 +929,7 @@ func (m *QueryCollateralOwnershipsRequest) Reset()         { *m = QueryCollatera
 func (m *QueryCollateralOwnershipsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryCollateralOwnershipsRequest) ProtoMessage()    {}
 func (*QueryCollateralOwnershipsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_14eb3edd860cda8c, []int{17}
+	return fileDescriptor_14eb3edd860cda8c, []int{19}
 }
 func (m *QueryCollateralOwnershipsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -892,7 +976,7 @@ func (m *QueryCollateralOwnershipsResponse) Reset()         { *m = QueryCollater
 func (m *QueryCollateralOwnershipsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryCollateralOwnershipsResponse) ProtoMessage()    {}
 func (*QueryCollateralOwnershipsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_14eb3edd860cda8c, []int{18}
+	return fileDescriptor_14eb3edd860cda8c, []int{20}
 }
 func (m *QueryCollateralOwnershipsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -951,7 +1035,7 @@ func (m *QueryCollateralOwnershipsByValidatorRequest) String() string {
 }
 func (*QueryCollateralOwnershipsByValidatorRequest) ProtoMessage() {}
 func (*QueryCollateralOwnershipsByValidatorRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_14eb3edd860cda8c, []int{19}
+	return fileDescriptor_14eb3edd860cda8c, []int{21}
 }
 func (m *QueryCollateralOwnershipsByValidatorRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1009,7 +1093,7 @@ func (m *QueryCollateralOwnershipsByValidatorResponse) String() string {
 }
 func (*QueryCollateralOwnershipsByValidatorResponse) ProtoMessage() {}
 func (*QueryCollateralOwnershipsByValidatorResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_14eb3edd860cda8c, []int{20}
+	return fileDescriptor_14eb3edd860cda8c, []int{22}
 }
 func (m *QueryCollateralOwnershipsByValidatorResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1063,7 +1147,7 @@ func (m *QueryCollateralOwnershipRequest) Reset()         { *m = QueryCollateral
 func (m *QueryCollateralOwnershipRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryCollateralOwnershipRequest) ProtoMessage()    {}
 func (*QueryCollateralOwnershipRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_14eb3edd860cda8c, []int{21}
+	return fileDescriptor_14eb3edd860cda8c, []int{23}
 }
 func (m *QueryCollateralOwnershipRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1116,7 +1200,7 @@ func (m *QueryCollateralOwnershipResponse) Reset()         { *m = QueryCollatera
 func (m *QueryCollateralOwnershipResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryCollateralOwnershipResponse) ProtoMessage()    {}
 func (*QueryCollateralOwnershipResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_14eb3edd860cda8c, []int{22}
+	return fileDescriptor_14eb3edd860cda8c, []int{24}
 }
 func (m *QueryCollateralOwnershipResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1152,11 +1236,201 @@ func (m *QueryCollateralOwnershipResponse) GetCollateralOwnership() CollateralOw
 	return CollateralOwnershipWithAmount{}
 }
 
+// QueryEventReceiptRequest is the request type for the Query/EventReceipt RPC
+// method
+type QueryEventReceiptRequest struct {
+	// evm_block_hash is the hex-encoded hash of the EVM block
+	EvmBlockHash string `protobuf:"bytes,1,opt,name=evm_block_hash,json=evmBlockHash,proto3" json:"evm_block_hash,omitempty"`
+}
+
+func (m *QueryEventReceiptRequest) Reset()         { *m = QueryEventReceiptRequest{} }
+func (m *QueryEventReceiptRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryEventReceiptRequest) ProtoMessage()    {}
+func (*QueryEventReceiptRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_14eb3edd860cda8c, []int{25}
+}
+func (m *QueryEventReceiptRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEventReceiptRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEventReceiptRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryEventReceiptRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEventReceiptRequest.Merge(m, src)
+}
+func (m *QueryEventReceiptRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEventReceiptRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEventReceiptRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryEventReceiptRequest proto.InternalMessageInfo
+
+func (m *QueryEventReceiptRequest) GetEvmBlockHash() string {
+	if m != nil {
+		return m.EvmBlockHash
+	}
+	return ""
+}
+
+// QueryEventReceiptResponse is the response type for the Query/EventReceipt
+// RPC method
+type QueryEventReceiptResponse struct {
+	// receipts are sorted by the log index
+	Receipts []EventReceipt `protobuf:"bytes,1,rep,name=receipts,proto3" json:"receipts"`
+}
+
+func (m *QueryEventReceiptResponse) Reset()         { *m = QueryEventReceiptResponse{} }
+func (m *QueryEventReceiptResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryEventReceiptResponse) ProtoMessage()    {}
+func (*QueryEventReceiptResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_14eb3edd860cda8c, []int{26}
+}
+func (m *QueryEventReceiptResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEventReceiptResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEventReceiptResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryEventReceiptResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEventReceiptResponse.Merge(m, src)
+}
+func (m *QueryEventReceiptResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEventReceiptResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEventReceiptResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryEventReceiptResponse proto.InternalMessageInfo
+
+func (m *QueryEventReceiptResponse) GetReceipts() []EventReceipt {
+	if m != nil {
+		return m.Receipts
+	}
+	return nil
+}
+
+// QueryTxEventReceiptsRequest is the request type for the
+// Query/TxEventReceipts RPC method
+type QueryTxEventReceiptsRequest struct {
+	// tx_hash is the hex-encoded hash of the EVM transaction
+	TxHash string `protobuf:"bytes,1,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`
+}
+
+func (m *QueryTxEventReceiptsRequest) Reset()         { *m = QueryTxEventReceiptsRequest{} }
+func (m *QueryTxEventReceiptsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryTxEventReceiptsRequest) ProtoMessage()    {}
+func (*QueryTxEventReceiptsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_14eb3edd860cda8c, []int{27}
+}
+func (m *QueryTxEventReceiptsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTxEventReceiptsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTxEventReceiptsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTxEventReceiptsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTxEventReceiptsRequest.Merge(m, src)
+}
+func (m *QueryTxEventReceiptsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTxEventReceiptsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTxEventReceiptsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTxEventReceiptsRequest proto.InternalMessageInfo
+
+func (m *QueryTxEventReceiptsRequest) GetTxHash() string {
+	if m != nil {
+		return m.TxHash
+	}
+	return ""
+}
+
+// QueryTxEventReceiptsResponse is the response type for the
+// Query/TxEventReceipts RPC method
+type QueryTxEventReceiptsResponse struct {
+	// receipts are sorted by the log index
+	Receipts []EventReceipt `protobuf:"bytes,1,rep,name=receipts,proto3" json:"receipts"`
+}
+
+func (m *QueryTxEventReceiptsResponse) Reset()         { *m = QueryTxEventReceiptsResponse{} }
+func (m *QueryTxEventReceiptsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryTxEventReceiptsResponse) ProtoMessage()    {}
+func (*QueryTxEventReceiptsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_14eb3edd860cda8c, []int{28}
+}
+func (m *QueryTxEventReceiptsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTxEventReceiptsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTxEventReceiptsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTxEventReceiptsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTxEventReceiptsResponse.Merge(m, src)
+}
+func (m *QueryTxEventReceiptsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTxEventReceiptsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTxEventReceiptsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTxEventReceiptsResponse proto.InternalMessageInfo
+
+func (m *QueryTxEventReceiptsResponse) GetReceipts() []EventReceipt {
+	if m != nil {
+		return m.Receipts
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "mitosis.evmvalidator.v1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "mitosis.evmvalidator.v1.QueryParamsResponse")
 	proto.RegisterType((*QueryValidatorEntrypointContractAddrRequest)(nil), "mitosis.evmvalidator.v1.QueryValidatorEntrypointContractAddrRequest")
 	proto.RegisterType((*QueryValidatorEntrypointContractAddrResponse)(nil), "mitosis.evmvalidator.v1.QueryValidatorEntrypointContractAddrResponse")
+	proto.RegisterType((*QueryCircuitBreakerRequest)(nil), "mitosis.evmvalidator.v1.QueryCircuitBreakerRequest")
+	proto.RegisterType((*QueryCircuitBreakerResponse)(nil), "mitosis.evmvalidator.v1.QueryCircuitBreakerResponse")
 	proto.RegisterType((*QueryValidatorRequest)(nil), "mitosis.evmvalidator.v1.QueryValidatorRequest")
 	proto.RegisterType((*QueryValidatorResponse)(nil), "mitosis.evmvalidator.v1.QueryValidatorResponse")
 	proto.RegisterType((*QueryValidatorByConsAddrRequest)(nil), "mitosis.evmvalidator.v1.QueryValidatorByConsAddrRequest")
@@ -1176,6 +1450,10 @@ func init() {
 	proto.RegisterType((*QueryCollateralOwnershipsByValidatorResponse)(nil), "mitosis.evmvalidator.v1.QueryCollateralOwnershipsByValidatorResponse")
 	proto.RegisterType((*QueryCollateralOwnershipRequest)(nil), "mitosis.evmvalidator.v1.QueryCollateralOwnershipRequest")
 	proto.RegisterType((*QueryCollateralOwnershipResponse)(nil), "mitosis.evmvalidator.v1.QueryCollateralOwnershipResponse")
+	proto.RegisterType((*QueryEventReceiptRequest)(nil), "mitosis.evmvalidator.v1.QueryEventReceiptRequest")
+	proto.RegisterType((*QueryEventReceiptResponse)(nil), "mitosis.evmvalidator.v1.QueryEventReceiptResponse")
+	proto.RegisterType((*QueryTxEventReceiptsRequest)(nil), "mitosis.evmvalidator.v1.QueryTxEventReceiptsRequest")
+	proto.RegisterType((*QueryTxEventReceiptsResponse)(nil), "mitosis.evmvalidator.v1.QueryTxEventReceiptsResponse")
 }
 
 func init() {
@@ -1183,83 +1461,99 @@ func init() {
 }
 
 var fileDescriptor_14eb3edd860cda8c = []byte{
-	// 1211 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x58, 0x4d, 0x6c, 0x1b, 0x45,
-	0x14, 0xce, 0x84, 0x36, 0xd4, 0x2f, 0x15, 0x82, 0x89, 0xfb, 0x67, 0x51, 0xbb, 0xd9, 0x94, 0xa6,
-	0x34, 0xf1, 0x4e, 0x1d, 0x44, 0x45, 0xdb, 0x50, 0xa9, 0x4e, 0x52, 0x84, 0x5a, 0x4a, 0x30, 0x14,
-	0x04, 0x17, 0x33, 0xb1, 0x57, 0xf6, 0x82, 0xbd, 0xe3, 0xee, 0x4e, 0x1c, 0xac, 0x28, 0x17, 0x2e,
-	0x5c, 0x38, 0x54, 0xe2, 0xc2, 0x81, 0x03, 0x42, 0x1c, 0x38, 0x82, 0x54, 0x8e, 0x48, 0x5c, 0x90,
-	0x7a, 0x8c, 0xca, 0x01, 0xc4, 0xa1, 0xaa, 0x92, 0x4a, 0x1c, 0x11, 0xe2, 0xca, 0x01, 0x79, 0xf6,
-	0x79, 0xd7, 0x8e, 0x77, 0xbd, 0xeb, 0x60, 0x04, 0xe2, 0x12, 0x79, 0x27, 0xef, 0xe7, 0xfb, 0xbe,
-	0x99, 0x37, 0xfe, 0xbc, 0x30, 0x53, 0x37, 0xa5, 0x70, 0x4c, 0x87, 0x19, 0xcd, 0x7a, 0x93, 0xd7,
-	0xcc, 0x32, 0x97, 0xc2, 0x66, 0xcd, 0x1c, 0xbb, 0xbd, 0x6e, 0xd8, 0x2d, 0xbd, 0x61, 0x0b, 0x29,
-	0xe8, 0x31, 0x0c, 0xd2, 0xbb, 0x83, 0xf4, 0x66, 0x2e, 0xf5, 0x74, 0x45, 0x88, 0x4a, 0xcd, 0x60,
-	0xbc, 0x61, 0x32, 0x6e, 0x59, 0x42, 0x72, 0x69, 0x0a, 0xcb, 0x71, 0xd3, 0x52, 0xe7, 0x4a, 0xc2,
-	0xa9, 0x0b, 0x87, 0xad, 0x71, 0xc7, 0x70, 0xeb, 0xb1, 0x66, 0x6e, 0xcd, 0x90, 0x3c, 0xc7, 0x1a,
-	0xbc, 0x62, 0x5a, 0x2a, 0x18, 0x63, 0x4f, 0xb8, 0xb1, 0x45, 0xf5, 0xc4, 0xdc, 0x07, 0xfc, 0x57,
-	0xb2, 0x22, 0x2a, 0xc2, 0x5d, 0x6f, 0x7f, 0xc2, 0xd5, 0xa7, 0x78, 0xdd, 0xb4, 0x04, 0x53, 0x7f,
-	0x71, 0xe9, 0x74, 0x18, 0x97, 0x06, 0xb7, 0x79, 0xbd, 0x53, 0x6e, 0x36, 0x2c, 0xca, 0x67, 0xa6,
-	0x02, 0xb5, 0x24, 0xd0, 0xd7, 0xda, 0xa0, 0x57, 0x55, 0x76, 0xc1, 0xb8, 0xbd, 0x6e, 0x38, 0x52,
-	0x7b, 0x1b, 0xa6, 0x7a, 0x56, 0x9d, 0x86, 0xb0, 0x1c, 0x83, 0xe6, 0x61, 0xc2, 0xed, 0x72, 0x9c,
-	0x9c, 0x22, 0x67, 0x27, 0x17, 0x32, 0x7a, 0x88, 0x66, 0xba, 0x9b, 0x98, 0x4f, 0xdc, 0x7b, 0x90,
-	0x19, 0xfb, 0xea, 0xd7, 0xaf, 0xcf, 0x91, 0x02, 0x66, 0x6a, 0x59, 0x98, 0x53, 0xa5, 0xdf, 0xec,
-	0x84, 0xaf, 0x58, 0xd2, 0x6e, 0x35, 0x84, 0x69, 0xc9, 0x25, 0x61, 0x49, 0x9b, 0x97, 0xe4, 0xd5,
-	0x72, 0xd9, 0xee, 0x20, 0x69, 0xc1, 0x7c, 0xbc, 0x70, 0x84, 0xf8, 0x32, 0x1c, 0xe0, 0xe5, 0xb2,
-	0xad, 0x00, 0x1e, 0xce, 0x3f, 0xdf, 0xee, 0xff, 0xcb, 0x83, 0x4c, 0xb6, 0x62, 0xca, 0xea, 0xfa,
-	0x9a, 0x5e, 0x12, 0x75, 0x86, 0x90, 0xb3, 0xc2, 0xae, 0xb0, 0x52, 0x95, 0x9b, 0x16, 0x93, 0xad,
-	0x86, 0xe1, 0xe8, 0x2b, 0xb2, 0xda, 0xae, 0x64, 0x38, 0x4e, 0x41, 0x95, 0xd0, 0x16, 0xe0, 0x48,
-	0x6f, 0x6b, 0xc4, 0x44, 0x4f, 0xc0, 0xa1, 0x26, 0xaf, 0x15, 0xfd, 0x3e, 0x85, 0xc7, 0x9b, 0xbc,
-	0xd6, 0x4e, 0xd6, 0x0c, 0x38, 0xba, 0x37, 0x07, 0x81, 0x5d, 0x87, 0x84, 0xa7, 0x10, 0xca, 0xa7,
-	0x85, 0xca, 0xe7, 0xa5, 0x77, 0x2b, 0xe8, 0xe7, 0x6b, 0x1c, 0x32, 0xbd, 0x6d, 0xf2, 0xad, 0x25,
-	0x61, 0x39, 0x5d, 0xc2, 0xd1, 0x2b, 0x90, 0x28, 0x09, 0xcb, 0xf1, 0x51, 0x26, 0xf2, 0xd3, 0xf7,
-	0xef, 0x66, 0x4f, 0xe2, 0xa9, 0x6b, 0x87, 0x1b, 0x96, 0xb3, 0xee, 0x20, 0xe7, 0xd7, 0xa5, 0x6d,
-	0x5a, 0x95, 0xc2, 0xa1, 0x12, 0x96, 0xd1, 0x04, 0x9c, 0x0a, 0x6f, 0xf1, 0x4f, 0x70, 0x7a, 0x77,
-	0xaf, 0x74, 0x9d, 0xd3, 0x48, 0xaf, 0x01, 0xf8, 0xa3, 0x84, 0x7d, 0xce, 0xe8, 0x48, 0xa4, 0x3d,
-	0x77, 0xba, 0x3b, 0xc7, 0x38, 0x77, 0xfa, 0x2a, 0xaf, 0x18, 0x98, 0x5b, 0xe8, 0xca, 0xd4, 0xbe,
-	0x21, 0x70, 0xac, 0xaf, 0x05, 0x52, 0x79, 0x05, 0xc0, 0x83, 0xd2, 0x3e, 0xde, 0x8f, 0x0d, 0xcf,
-	0xa5, 0xab, 0x00, 0x7d, 0xa9, 0x07, 0xf2, 0xb8, 0x82, 0x3c, 0x1b, 0x09, 0xd9, 0xc5, 0xd2, 0x83,
-	0xf9, 0x2c, 0xaa, 0xf2, 0x96, 0x29, 0xab, 0x65, 0x9b, 0x6f, 0xf0, 0x5a, 0x47, 0x95, 0x27, 0x60,
-	0xdc, 0x2c, 0x2b, 0x35, 0x0e, 0x14, 0xc6, 0xcd, 0xb2, 0x66, 0x22, 0xb9, 0xee, 0x48, 0x24, 0x77,
-	0x13, 0x60, 0xc3, 0x5b, 0x45, 0x01, 0x67, 0x42, 0xc9, 0xf9, 0x05, 0x7a, 0xd8, 0xf9, 0x15, 0x34,
-	0xde, 0xd7, 0x6a, 0xe4, 0x7b, 0xf5, 0x2d, 0x81, 0xe3, 0xfd, 0x3d, 0x90, 0xcf, 0x2a, 0x4c, 0xfa,
-	0x68, 0x3a, 0xbb, 0x35, 0x2c, 0xa1, 0xee, 0x12, 0xa3, 0xdb, 0xaf, 0x8f, 0x08, 0x68, 0x7b, 0x71,
-	0xe7, 0x87, 0xb9, 0x42, 0xf6, 0x28, 0x38, 0xbe, 0x6f, 0x05, 0xbf, 0x27, 0x30, 0x33, 0x10, 0xc9,
-	0x7f, 0x5f, 0xcc, 0xef, 0x08, 0x9c, 0x5c, 0x12, 0xb5, 0x1a, 0x97, 0x86, 0xcd, 0x6b, 0xaf, 0x6e,
-	0x58, 0x86, 0xed, 0x54, 0xcd, 0x46, 0x1b, 0xc2, 0xd5, 0xba, 0x58, 0xb7, 0x24, 0xbd, 0x05, 0x09,
-	0xd1, 0x59, 0xc6, 0xd3, 0x36, 0x1f, 0x0a, 0x3d, 0xa0, 0x54, 0xcf, 0x5d, 0xe4, 0x55, 0xa2, 0x2b,
-	0x30, 0xc1, 0x55, 0x03, 0x85, 0x3e, 0x91, 0xcf, 0xe2, 0xf7, 0xc8, 0x51, 0x97, 0x84, 0x53, 0x7e,
-	0x5f, 0x37, 0x05, 0xab, 0x73, 0x59, 0xd5, 0x6f, 0x99, 0x96, 0xbc, 0x7f, 0x37, 0x3b, 0x89, 0xf4,
-	0xda, 0x8f, 0x05, 0x4c, 0xd6, 0xde, 0xc3, 0x3b, 0x34, 0xa0, 0xf1, 0xc8, 0x07, 0xe6, 0x11, 0x81,
-	0xe9, 0x01, 0xcd, 0x70, 0xb3, 0x9b, 0x70, 0xa4, 0xe4, 0xfd, 0xbf, 0xe8, 0x11, 0xee, 0x6c, 0xfb,
-	0x85, 0x61, 0xb4, 0xf3, 0xb7, 0xa1, 0x5b, 0xc5, 0x64, 0x29, 0xa0, 0xff, 0xe8, 0x8e, 0xc4, 0x1d,
-	0x82, 0xfe, 0x21, 0x88, 0xe6, 0xbf, 0x33, 0x68, 0xbf, 0x11, 0xf4, 0x28, 0x91, 0x90, 0xfe, 0x2f,
-	0x9b, 0x50, 0x40, 0xfb, 0x11, 0x80, 0x27, 0x86, 0xee, 0x49, 0x38, 0xa8, 0x38, 0x2b, 0x04, 0x87,
-	0x0b, 0xee, 0x83, 0xf6, 0x29, 0x09, 0x1f, 0x16, 0x4f, 0x39, 0x09, 0xc9, 0x20, 0xe5, 0x70, 0x6c,
-	0x46, 0x20, 0xdc, 0x54, 0x80, 0x70, 0x0b, 0xbf, 0x3f, 0x09, 0x07, 0x15, 0x34, 0xfa, 0x31, 0x81,
-	0x09, 0xd7, 0xda, 0xd2, 0xb9, 0xd0, 0x66, 0xfd, 0x7e, 0x3a, 0x35, 0x1f, 0x2f, 0xd8, 0x65, 0xa9,
-	0xcd, 0x7e, 0xf8, 0xe3, 0xa3, 0x4f, 0xc6, 0xa7, 0x69, 0x86, 0x0d, 0xf6, 0xfa, 0xf4, 0x0f, 0x02,
-	0x99, 0x08, 0x63, 0x4c, 0x97, 0x07, 0xb7, 0x8e, 0x67, 0xc3, 0x53, 0x2b, 0x7f, 0xb3, 0x0a, 0x32,
-	0x5b, 0x52, 0xcc, 0x5e, 0xa4, 0x97, 0x59, 0xe4, 0xef, 0x93, 0xa2, 0xe1, 0x95, 0x2a, 0x96, 0xb0,
-	0x96, 0x3a, 0x4e, 0xf4, 0x4b, 0x02, 0x09, 0xaf, 0x21, 0xd5, 0x63, 0x22, 0xeb, 0x30, 0x61, 0xb1,
-	0xe3, 0x11, 0xf3, 0x05, 0x85, 0xf9, 0x3c, 0xd5, 0xa3, 0x31, 0x3b, 0x6c, 0xb3, 0x73, 0xe8, 0xb7,
-	0xe8, 0x36, 0x81, 0xa9, 0x00, 0xf3, 0x4c, 0x5f, 0x88, 0x09, 0xa0, 0xcf, 0xd2, 0xa7, 0x2e, 0xee,
-	0x23, 0x13, 0x49, 0x2c, 0x2b, 0x12, 0x57, 0xe8, 0x62, 0x0c, 0x12, 0xc5, 0xb5, 0x56, 0xd1, 0xfb,
-	0xe9, 0xc0, 0x36, 0xbd, 0x8f, 0x5b, 0xf4, 0x33, 0x02, 0xe0, 0x7b, 0x67, 0x1a, 0x57, 0x4a, 0x6f,
-	0x0c, 0xce, 0xc7, 0x4f, 0x40, 0xdc, 0x73, 0x0a, 0xf7, 0x33, 0x74, 0x26, 0x06, 0x6e, 0xfa, 0x05,
-	0x01, 0xf0, 0xed, 0x49, 0x14, 0xbc, 0x3e, 0x47, 0x1d, 0x05, 0xaf, 0xdf, 0x58, 0x6b, 0x39, 0x05,
-	0x6f, 0x8e, 0x3e, 0x1b, 0x0a, 0xaf, 0xcb, 0x17, 0xb1, 0x4d, 0xb3, 0xbc, 0x45, 0x3f, 0x27, 0x30,
-	0xd9, 0xe5, 0xc8, 0x68, 0xec, 0xa6, 0x9e, 0x8a, 0xb9, 0x21, 0x32, 0x10, 0xe7, 0xbc, 0xc2, 0x79,
-	0x86, 0x9e, 0x8e, 0x83, 0x93, 0xfe, 0x44, 0xe0, 0x68, 0xb0, 0x69, 0xa4, 0x97, 0x63, 0xf7, 0xee,
-	0xff, 0x2e, 0x4e, 0x2d, 0xee, 0x2f, 0x19, 0x39, 0xe4, 0x15, 0x87, 0x45, 0x7a, 0x69, 0xb8, 0x39,
-	0xec, 0x61, 0xf6, 0x03, 0x81, 0x64, 0xd0, 0xb7, 0x34, 0x8d, 0x18, 0xad, 0x01, 0x06, 0x2e, 0x75,
-	0x69, 0x3f, 0xa9, 0xb1, 0xef, 0x96, 0x40, 0xa3, 0x40, 0xff, 0x24, 0x90, 0x89, 0x70, 0x1b, 0x51,
-	0x17, 0x7f, 0x3c, 0xff, 0x14, 0x75, 0xf1, 0xc7, 0xb4, 0x3c, 0xda, 0x0d, 0x45, 0xf4, 0x1a, 0x5d,
-	0x1e, 0x72, 0xf3, 0x82, 0xe9, 0x3f, 0x24, 0x30, 0x15, 0xd0, 0x39, 0xea, 0x6a, 0x0d, 0xb7, 0x2b,
-	0xa9, 0x8b, 0xfb, 0xc8, 0x44, 0x6a, 0x6f, 0x28, 0x6a, 0x37, 0xe9, 0x8d, 0x51, 0x50, 0x63, 0x9b,
-	0xea, 0xf3, 0x56, 0xfe, 0xfa, 0xbd, 0x9d, 0x34, 0xd9, 0xde, 0x49, 0x93, 0x87, 0x3b, 0x69, 0x72,
-	0x67, 0x37, 0x3d, 0xb6, 0xbd, 0x9b, 0x1e, 0xfb, 0x79, 0x37, 0x3d, 0xf6, 0x4e, 0x6e, 0xe0, 0xbb,
-	0xac, 0x0f, 0x7a, 0xbb, 0xab, 0x57, 0x5b, 0x6b, 0x13, 0xea, 0x5d, 0xdf, 0x73, 0x7f, 0x05, 0x00,
-	0x00, 0xff, 0xff, 0xe4, 0x81, 0xda, 0x60, 0x08, 0x15, 0x00, 0x00,
+	// 1468 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x59, 0x4f, 0x6c, 0xdb, 0x54,
+	0x18, 0xef, 0x2b, 0x5b, 0xb7, 0x7c, 0xad, 0x3a, 0xf1, 0xda, 0xad, 0x9b, 0xd9, 0x9a, 0xcd, 0xdd,
+	0x3f, 0xd6, 0xc6, 0x6e, 0xba, 0xad, 0xda, 0x9f, 0x32, 0x6d, 0xe9, 0x3a, 0x40, 0x1b, 0x63, 0x84,
+	0x0d, 0x04, 0x1c, 0xc2, 0x8b, 0x63, 0x25, 0x66, 0x89, 0x9d, 0xd9, 0xaf, 0x59, 0x4b, 0xd5, 0x0b,
+	0x17, 0x2e, 0x1c, 0x26, 0x71, 0x41, 0x88, 0x03, 0x42, 0x1c, 0xb8, 0x20, 0x81, 0x34, 0xc4, 0x05,
+	0x24, 0x2e, 0x48, 0x3b, 0x4e, 0xe3, 0x00, 0xe2, 0x30, 0x4d, 0xdb, 0x24, 0x8e, 0x1c, 0xb8, 0x72,
+	0x40, 0x79, 0xfe, 0xec, 0xd8, 0x8d, 0x9d, 0x38, 0x5d, 0x27, 0x10, 0x97, 0xca, 0x7e, 0xfe, 0xfe,
+	0xfc, 0x7e, 0xbf, 0xe7, 0xf7, 0xf9, 0xfb, 0x52, 0x98, 0xa8, 0x19, 0xdc, 0x72, 0x0c, 0x47, 0xd5,
+	0x1b, 0xb5, 0x06, 0xab, 0x1a, 0x25, 0xc6, 0x2d, 0x5b, 0x6d, 0x64, 0xd5, 0x1b, 0x8b, 0xba, 0xbd,
+	0xac, 0xd4, 0x6d, 0x8b, 0x5b, 0x74, 0x0c, 0x8d, 0x94, 0xa0, 0x91, 0xd2, 0xc8, 0x4a, 0xbb, 0xcb,
+	0x96, 0x55, 0xae, 0xea, 0x2a, 0xab, 0x1b, 0x2a, 0x33, 0x4d, 0x8b, 0x33, 0x6e, 0x58, 0xa6, 0xe3,
+	0xba, 0x49, 0x47, 0x34, 0xcb, 0xa9, 0x59, 0x8e, 0x5a, 0x64, 0x8e, 0xee, 0xc6, 0x53, 0x1b, 0xd9,
+	0xa2, 0xce, 0x59, 0x56, 0xad, 0xb3, 0xb2, 0x61, 0x0a, 0x63, 0xb4, 0xdd, 0xe5, 0xda, 0x16, 0xc4,
+	0x9d, 0xea, 0xde, 0xe0, 0xa3, 0xd1, 0xb2, 0x55, 0xb6, 0xdc, 0xf5, 0xe6, 0x15, 0xae, 0x3e, 0xcb,
+	0x6a, 0x86, 0x69, 0xa9, 0xe2, 0x2f, 0x2e, 0xed, 0x8f, 0xe3, 0x52, 0x67, 0x36, 0xab, 0x79, 0xe1,
+	0x0e, 0xc5, 0x59, 0xb5, 0x98, 0xb9, 0x86, 0x99, 0x38, 0x43, 0xcd, 0xb0, 0xb5, 0x45, 0x83, 0x17,
+	0x8a, 0xb6, 0xce, 0xae, 0xeb, 0x68, 0x2e, 0x8f, 0x02, 0x7d, 0xad, 0xc9, 0xf1, 0x8a, 0x48, 0x96,
+	0xd7, 0x6f, 0x2c, 0xea, 0x0e, 0x97, 0xdf, 0x82, 0x91, 0xd0, 0xaa, 0x53, 0xb7, 0x4c, 0x47, 0xa7,
+	0x39, 0x18, 0x70, 0x41, 0xed, 0x24, 0x7b, 0xc9, 0xe1, 0xc1, 0x99, 0xb4, 0x12, 0x23, 0xb1, 0xe2,
+	0x3a, 0xe6, 0x52, 0x77, 0xee, 0xa7, 0xfb, 0xbe, 0xfa, 0xe3, 0x9b, 0x23, 0x24, 0x8f, 0x9e, 0x72,
+	0x06, 0x26, 0x45, 0xe8, 0x37, 0x3c, 0xf3, 0x05, 0x93, 0xdb, 0xcb, 0x75, 0xcb, 0x30, 0xf9, 0xbc,
+	0x65, 0x72, 0x9b, 0x69, 0xfc, 0x5c, 0xa9, 0x64, 0x7b, 0x48, 0x96, 0x61, 0x2a, 0x99, 0x39, 0x42,
+	0x7c, 0x19, 0x36, 0xb1, 0x52, 0xc9, 0x16, 0x00, 0x87, 0x72, 0xc7, 0x9b, 0xf9, 0x7f, 0xbf, 0x9f,
+	0xce, 0x94, 0x0d, 0x5e, 0x59, 0x2c, 0x2a, 0x9a, 0x55, 0x53, 0x11, 0x72, 0xc6, 0xb2, 0xcb, 0xaa,
+	0x56, 0x61, 0x86, 0xa9, 0xf2, 0xe5, 0xba, 0xee, 0x28, 0x0b, 0xbc, 0xd2, 0x8c, 0xa4, 0x3b, 0x4e,
+	0x5e, 0x84, 0x90, 0x77, 0x83, 0x24, 0x52, 0xcf, 0xbb, 0xc2, 0xe5, 0x5c, 0xdd, 0x3c, 0x60, 0xef,
+	0xc3, 0x73, 0x91, 0x4f, 0x11, 0xc7, 0x3b, 0xb0, 0x6d, 0x8d, 0xe0, 0xa8, 0xd9, 0xa1, 0x58, 0xcd,
+	0xc2, 0x91, 0x82, 0xda, 0x0d, 0x6b, 0xa1, 0x47, 0xf2, 0x0c, 0x6c, 0x0f, 0x8b, 0x82, 0xa0, 0xe8,
+	0x2e, 0xd8, 0xda, 0x60, 0xd5, 0x42, 0x4b, 0x81, 0xfc, 0x96, 0x06, 0xab, 0x36, 0x69, 0xc9, 0x3a,
+	0xec, 0x58, 0xeb, 0x83, 0x50, 0x2f, 0x42, 0xca, 0xc7, 0x81, 0x20, 0xe5, 0x58, 0x90, 0xbe, 0x7b,
+	0x10, 0x5f, 0xcb, 0x5f, 0x66, 0x90, 0x0e, 0xa7, 0xc9, 0x2d, 0xcf, 0x5b, 0xa6, 0x13, 0xd8, 0x52,
+	0x7a, 0x06, 0x52, 0x9a, 0x65, 0x3a, 0x2d, 0x94, 0xa9, 0xdc, 0xbe, 0x7b, 0xb7, 0x33, 0x7b, 0xf0,
+	0xf8, 0x34, 0xcd, 0x75, 0xd3, 0x59, 0x74, 0x70, 0x37, 0x5e, 0xe7, 0xb6, 0x61, 0x96, 0xf3, 0x5b,
+	0x35, 0x0c, 0x23, 0x5b, 0xb0, 0x37, 0x3e, 0xc5, 0xd3, 0xe0, 0xf4, 0xee, 0x5a, 0xe9, 0xbc, 0x73,
+	0x42, 0x2f, 0x00, 0xb4, 0x6a, 0x02, 0xe6, 0x39, 0xa8, 0x20, 0x91, 0x66, 0x01, 0x51, 0xdc, 0x82,
+	0x84, 0x05, 0x44, 0xb9, 0xc2, 0xca, 0x3a, 0xfa, 0xe6, 0x03, 0x9e, 0xf2, 0xb7, 0x04, 0xc6, 0xda,
+	0x52, 0x20, 0x95, 0x57, 0x00, 0x7c, 0x28, 0xcd, 0x83, 0xf7, 0x4c, 0xef, 0x5c, 0x02, 0x01, 0xe8,
+	0x8b, 0x21, 0xc8, 0xfd, 0xf8, 0x4e, 0x76, 0x83, 0xec, 0x62, 0x09, 0x61, 0x3e, 0x8c, 0xaa, 0xbc,
+	0x69, 0xf0, 0x4a, 0xc9, 0x66, 0x37, 0x59, 0xd5, 0x53, 0x65, 0x18, 0xfa, 0x8d, 0x92, 0x50, 0x63,
+	0x53, 0xbe, 0xdf, 0x28, 0xc9, 0x06, 0x8c, 0xb5, 0x59, 0x22, 0xb9, 0xcb, 0x00, 0x37, 0xfd, 0x55,
+	0x14, 0x70, 0x22, 0x96, 0x5c, 0x2b, 0x40, 0x88, 0x5d, 0x2b, 0x82, 0xcc, 0xda, 0x52, 0x6d, 0xf8,
+	0x5e, 0x7d, 0x47, 0x60, 0x67, 0x7b, 0x0e, 0xe4, 0x73, 0x05, 0x06, 0x5b, 0x68, 0xbc, 0xdd, 0xea,
+	0x95, 0x50, 0x30, 0xc4, 0xc6, 0xed, 0xd7, 0x87, 0x04, 0xe4, 0xb5, 0xb8, 0x73, 0xbd, 0x94, 0x10,
+	0x7a, 0x21, 0x02, 0xca, 0x7a, 0x14, 0xfc, 0x89, 0xc0, 0x44, 0x47, 0x24, 0xff, 0x7d, 0x31, 0x7f,
+	0x24, 0xb0, 0x67, 0xde, 0xaa, 0x56, 0x19, 0xd7, 0x6d, 0x56, 0x7d, 0xf5, 0xa6, 0xa9, 0xdb, 0x4e,
+	0xc5, 0xa8, 0x37, 0x21, 0x9c, 0xab, 0x59, 0x8b, 0x26, 0xa7, 0xd7, 0x20, 0x65, 0x79, 0xcb, 0xf8,
+	0xb6, 0x4d, 0xc5, 0x97, 0xfe, 0xf6, 0x50, 0xa1, 0x5a, 0xe4, 0x47, 0xa2, 0x0b, 0x30, 0xc0, 0x44,
+	0x02, 0x81, 0x3e, 0x95, 0xcb, 0xe0, 0x17, 0x6e, 0x87, 0x4b, 0xc2, 0x29, 0x5d, 0x57, 0x0c, 0x4b,
+	0xad, 0x31, 0x5e, 0x51, 0xae, 0x19, 0x26, 0xbf, 0x77, 0x3b, 0x33, 0x88, 0xf4, 0x9a, 0xb7, 0x79,
+	0x74, 0x96, 0xdf, 0xc3, 0x1a, 0x1a, 0x91, 0x78, 0xc3, 0x0f, 0xcc, 0x63, 0x02, 0xfb, 0x3a, 0x24,
+	0xc3, 0xcd, 0x6e, 0xc0, 0x76, 0xcd, 0x7f, 0x5e, 0xf0, 0x09, 0x7b, 0xdb, 0x3e, 0xdb, 0x8b, 0x76,
+	0xad, 0x6d, 0x08, 0xaa, 0x38, 0xaa, 0x45, 0xe4, 0xdf, 0xb8, 0x57, 0xe2, 0x16, 0xc1, 0xce, 0x26,
+	0x8a, 0xe6, 0xbf, 0x73, 0xd0, 0xfe, 0x24, 0x30, 0x95, 0x0c, 0xd2, 0xff, 0x65, 0x13, 0xf2, 0xd8,
+	0x7e, 0x44, 0xe0, 0x49, 0xa0, 0xfb, 0x28, 0x6c, 0x16, 0x9c, 0x05, 0x82, 0xa1, 0xbc, 0x7b, 0x23,
+	0x7f, 0x42, 0xe2, 0x0f, 0x8b, 0xaf, 0x1c, 0x87, 0xd1, 0x28, 0xe5, 0xf0, 0xd8, 0x6c, 0x80, 0x70,
+	0x23, 0x11, 0xc2, 0xc9, 0x67, 0xf1, 0x53, 0xb4, 0xd0, 0xd0, 0x4d, 0x9e, 0xd7, 0x35, 0xdd, 0xa8,
+	0x73, 0x8f, 0xe7, 0x7e, 0x18, 0xd6, 0x1b, 0xb5, 0x42, 0xb1, 0x6a, 0x69, 0xd7, 0x0b, 0x15, 0xe6,
+	0x54, 0xdc, 0x5e, 0x2b, 0x3f, 0xa4, 0x37, 0x6a, 0xb9, 0xe6, 0xe2, 0x4b, 0xcc, 0xa9, 0xc8, 0x06,
+	0xec, 0x8a, 0x88, 0x80, 0xa4, 0x2e, 0xc1, 0x56, 0xdb, 0x5d, 0xf2, 0xde, 0x80, 0x03, 0xb1, 0x44,
+	0x82, 0x01, 0x82, 0xb8, 0xfd, 0x08, 0xf2, 0x2c, 0x76, 0xcc, 0x57, 0x97, 0x82, 0xb6, 0x7e, 0xb9,
+	0x19, 0x83, 0x2d, 0x7c, 0x29, 0x08, 0x74, 0x80, 0x2f, 0x09, 0x88, 0x55, 0xd8, 0x1d, 0xed, 0xf7,
+	0x34, 0x50, 0xce, 0x7c, 0xba, 0x1d, 0x36, 0x8b, 0x74, 0xf4, 0x23, 0x02, 0x03, 0xee, 0x1c, 0x43,
+	0x27, 0x63, 0x03, 0xb6, 0x0f, 0x4f, 0xd2, 0x54, 0x32, 0x63, 0x17, 0xbd, 0x7c, 0xe8, 0x83, 0x5f,
+	0x1e, 0x7f, 0xdc, 0xbf, 0x8f, 0xa6, 0xd5, 0xce, 0x73, 0x20, 0xfd, 0x8b, 0x40, 0xba, 0xcb, 0x14,
+	0x44, 0xcf, 0x77, 0x4e, 0x9d, 0x6c, 0xe6, 0x92, 0x16, 0x9e, 0x30, 0x0a, 0x32, 0x9b, 0x17, 0xcc,
+	0x5e, 0xa0, 0xa7, 0xd5, 0xae, 0xb3, 0x6b, 0x41, 0xf7, 0x43, 0x15, 0x34, 0x8c, 0x25, 0x4e, 0x28,
+	0xfd, 0x9a, 0xc0, 0x70, 0x78, 0x30, 0xa2, 0x47, 0x3b, 0xc3, 0x8b, 0x1c, 0xd7, 0xa4, 0x63, 0xbd,
+	0x39, 0x21, 0x85, 0x69, 0x41, 0xe1, 0x08, 0x3d, 0xac, 0x26, 0x9c, 0xaa, 0xe9, 0x97, 0x04, 0x52,
+	0xbe, 0x40, 0x54, 0x49, 0xa8, 0xa4, 0x87, 0x52, 0x4d, 0x6c, 0x8f, 0x00, 0x67, 0x05, 0xc0, 0x69,
+	0xaa, 0x74, 0xd7, 0xd8, 0x51, 0x57, 0xbc, 0xba, 0xb7, 0x4a, 0xef, 0x12, 0x18, 0x89, 0x98, 0x9f,
+	0xe8, 0x89, 0x84, 0x00, 0xda, 0xa6, 0x3a, 0xe9, 0xe4, 0x3a, 0x3c, 0x91, 0xc4, 0x79, 0x41, 0xe2,
+	0x0c, 0x9d, 0x4b, 0x40, 0xa2, 0x50, 0x5c, 0x2e, 0xf8, 0xd3, 0xa3, 0xba, 0xe2, 0x5f, 0xae, 0xd2,
+	0xcf, 0x08, 0x80, 0x9f, 0xc5, 0xa1, 0x49, 0xa5, 0xf4, 0x8f, 0xed, 0x74, 0x72, 0x07, 0xc4, 0x3d,
+	0x29, 0x70, 0x1f, 0xa0, 0x13, 0x09, 0x70, 0xd3, 0x2f, 0x08, 0x40, 0xab, 0x43, 0xed, 0x06, 0xaf,
+	0x6d, 0xa8, 0x92, 0xa6, 0x93, 0x3b, 0x20, 0xbc, 0xac, 0x80, 0x37, 0x49, 0x9f, 0x8f, 0x85, 0x17,
+	0x68, 0x8d, 0xd5, 0x15, 0xa3, 0xb4, 0x4a, 0x3f, 0x27, 0x30, 0xd8, 0x8a, 0xe4, 0xd0, 0xc4, 0x49,
+	0x7d, 0x15, 0xb3, 0x3d, 0x78, 0x20, 0xce, 0x29, 0x81, 0xf3, 0x20, 0xdd, 0x9f, 0x04, 0x27, 0xfd,
+	0x95, 0xc0, 0x8e, 0xe8, 0xb9, 0x81, 0x9e, 0x4e, 0x9c, 0xbb, 0xbd, 0x1d, 0x93, 0xe6, 0xd6, 0xe7,
+	0x8c, 0x1c, 0x72, 0x82, 0xc3, 0x1c, 0x3d, 0xd5, 0xdb, 0x39, 0x0c, 0x31, 0xfb, 0x99, 0xc0, 0x68,
+	0x54, 0xa3, 0x46, 0xbb, 0x1c, 0xad, 0x0e, 0x3d, 0xbc, 0x74, 0x6a, 0x3d, 0xae, 0x89, 0x6b, 0x4b,
+	0x64, 0xaf, 0x48, 0xff, 0x26, 0x90, 0xee, 0xd2, 0x70, 0x76, 0xfb, 0x50, 0x25, 0x6b, 0xa1, 0xa5,
+	0x85, 0x27, 0x8c, 0x82, 0x44, 0x2f, 0x09, 0xa2, 0x17, 0xe8, 0xf9, 0x1e, 0x37, 0x2f, 0x9a, 0xfe,
+	0x03, 0x02, 0x23, 0x11, 0x99, 0xbb, 0x95, 0xd6, 0xf8, 0x8e, 0x55, 0x3a, 0xb9, 0x0e, 0x4f, 0xa4,
+	0x76, 0x55, 0x50, 0xbb, 0x4c, 0x2f, 0x6d, 0x04, 0x35, 0x75, 0x45, 0x5c, 0xaf, 0xd2, 0xef, 0x09,
+	0x0c, 0x05, 0x3b, 0x29, 0xda, 0xe5, 0xd4, 0x47, 0xb4, 0xa7, 0xd2, 0x4c, 0x2f, 0x2e, 0xc8, 0xe6,
+	0xac, 0x60, 0x73, 0x8a, 0x9e, 0x88, 0x65, 0xa3, 0x37, 0xdd, 0x0a, 0x5e, 0x33, 0xa7, 0xae, 0x84,
+	0x3b, 0xe0, 0x55, 0xfa, 0x03, 0x81, 0x6d, 0x6b, 0xfa, 0x48, 0xda, 0xa5, 0x35, 0x88, 0x6e, 0x57,
+	0xa5, 0xe3, 0x3d, 0x7a, 0x21, 0x85, 0x39, 0x41, 0x61, 0x96, 0x1e, 0x8b, 0xa5, 0xc0, 0x97, 0x0a,
+	0x6b, 0x59, 0x60, 0x5f, 0xbc, 0x9a, 0xbb, 0x78, 0xe7, 0xe1, 0x38, 0xb9, 0xfb, 0x70, 0x9c, 0x3c,
+	0x78, 0x38, 0x4e, 0x6e, 0x3d, 0x1a, 0xef, 0xbb, 0xfb, 0x68, 0xbc, 0xef, 0xb7, 0x47, 0xe3, 0x7d,
+	0x6f, 0x67, 0x3b, 0xfe, 0xc2, 0xbd, 0x14, 0xce, 0x22, 0x7e, 0xf0, 0x2e, 0x0e, 0x88, 0xff, 0x00,
+	0x1c, 0xfd, 0x67, 0x00, 0x71, 0xa8, 0x5c, 0x21, 0x4d, 0x19, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1279,6 +1573,8 @@ type QueryClient interface {
 	// ValidatorEntrypointContractAddr returns the address of the entrypoint
 	// contract for validators
 	ValidatorEntrypointContractAddr(ctx context.Context, in *QueryValidatorEntrypointContractAddrRequest, opts ...grpc.CallOption) (*QueryValidatorEntrypointContractAddrResponse, error)
+	// CircuitBreaker returns the circuit breaker flags
+	CircuitBreaker(ctx context.Context, in *QueryCircuitBreakerRequest, opts ...grpc.CallOption) (*QueryCircuitBreakerResponse, error)
 	// Validator returns validator details by address
 	Validator(ctx context.Context, in *QueryValidatorRequest, opts ...grpc.CallOption) (*QueryValidatorResponse, error)
 	// ValidatorByConsAddr returns validator details by consensus address
@@ -1299,6 +1595,12 @@ type QueryClient interface {
 	// CollateralOwnership returns the collateral ownership for a specific
 	// validator and owner
 	CollateralOwnership(ctx context.Context, in *QueryCollateralOwnershipRequest, opts ...grpc.CallOption) (*QueryCollateralOwnershipResponse, error)
+	// EventReceipt returns the receipts of the ConsensusValidatorEntrypoint
+	// event logs delivered from a specific EVM block
+	EventReceipt(ctx context.Context, in *QueryEventReceiptRequest, opts ...grpc.CallOption) (*QueryEventReceiptResponse, error)
+	// TxEventReceipts returns the receipts of the ConsensusValidatorEntrypoint
+	// event logs emitted by a specific EVM transaction
+	TxEventReceipts(ctx context.Context, in *QueryTxEventReceiptsRequest, opts ...grpc.CallOption) (*QueryTxEventReceiptsResponse, error)
 }
 
 type queryClient struct {
@@ -1327,6 +1629,15 @@ func (c *queryClient) ValidatorEntrypointContractAddr(ctx context.Context, in *Q
 	return out, nil
 }
 
+func (c *queryClient) CircuitBreaker(ctx context.Context, in *QueryCircuitBreakerRequest, opts ...grpc.CallOption) (*QueryCircuitBreakerResponse, error) {
+	out := new(QueryCircuitBreakerResponse)
+	err := c.cc.Invoke(ctx, "/mitosis.evmvalidator.v1.Query/CircuitBreaker", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) Validator(ctx context.Context, in *QueryValidatorRequest, opts ...grpc.CallOption) (*QueryValidatorResponse, error) {
 	out := new(QueryValidatorResponse)
 	err := c.cc.Invoke(ctx, "/mitosis.evmvalidator.v1.Query/Validator", in, out, opts...)
@@ -1408,6 +1719,24 @@ func (c *queryClient) CollateralOwnership(ctx context.Context, in *QueryCollater
 	return out, nil
 }
 
+func (c *queryClient) EventReceipt(ctx context.Context, in *QueryEventReceiptRequest, opts ...grpc.CallOption) (*QueryEventReceiptResponse, error) {
+	out := new(QueryEventReceiptResponse)
+	err := c.cc.Invoke(ctx, "/mitosis.evmvalidator.v1.Query/EventReceipt", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) TxEventReceipts(ctx context.Context, in *QueryTxEventReceiptsRequest, opts ...grpc.CallOption) (*QueryTxEventReceiptsResponse, error) {
+	out := new(QueryTxEventReceiptsResponse)
+	err := c.cc.Invoke(ctx, "/mitosis.evmvalidator.v1.Query/TxEventReceipts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Params returns the parameters of the x/evmvalidator module
@@ -1415,6 +1744,8 @@ type QueryServer interface {
 	// ValidatorEntrypointContractAddr returns the address of the entrypoint
 	// contract for validators
 	ValidatorEntrypointContractAddr(context.Context, *QueryValidatorEntrypointContractAddrRequest) (*QueryValidatorEntrypointContractAddrResponse, error)
+	// CircuitBreaker returns the circuit breaker flags
+	CircuitBreaker(context.Context, *QueryCircuitBreakerRequest) (*QueryCircuitBreakerResponse, error)
 	// Validator returns validator details by address
 	Validator(context.Context, *QueryValidatorRequest) (*QueryValidatorResponse, error)
 	// ValidatorByConsAddr returns validator details by consensus address
@@ -1435,6 +1766,12 @@ type QueryServer interface {
 	// CollateralOwnership returns the collateral ownership for a specific
 	// validator and owner
 	CollateralOwnership(context.Context, *QueryCollateralOwnershipRequest) (*QueryCollateralOwnershipResponse, error)
+	// EventReceipt returns the receipts of the ConsensusValidatorEntrypoint
+	// event logs delivered from a specific EVM block
+	EventReceipt(context.Context, *QueryEventReceiptRequest) (*QueryEventReceiptResponse, error)
+	// TxEventReceipts returns the receipts of the ConsensusValidatorEntrypoint
+	// event logs emitted by a specific EVM transaction
+	TxEventReceipts(context.Context, *QueryTxEventReceiptsRequest) (*QueryTxEventReceiptsResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -1447,6 +1784,9 @@ func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsReq
 func (*UnimplementedQueryServer) ValidatorEntrypointContractAddr(ctx context.Context, req *QueryValidatorEntrypointContractAddrRequest) (*QueryValidatorEntrypointContractAddrResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ValidatorEntrypointContractAddr not implemented")
 }
+func (*UnimplementedQueryServer) CircuitBreaker(ctx context.Context, req *QueryCircuitBreakerRequest) (*QueryCircuitBreakerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CircuitBreaker not implemented")
+}
 func (*UnimplementedQueryServer) Validator(ctx context.Context, req *QueryValidatorRequest) (*QueryValidatorResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Validator not implemented")
 }
@@ -1474,6 +1814,12 @@ func (*UnimplementedQueryServer) CollateralOwnershipsByValidator(ctx context.Con
 func (*UnimplementedQueryServer) CollateralOwnership(ctx context.Context, req *QueryCollateralOwnershipRequest) (*QueryCollateralOwnershipResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CollateralOwnership not implemented")
 }
+func (*UnimplementedQueryServer) EventReceipt(ctx context.Context, req *QueryEventReceiptRequest) (*QueryEventReceiptResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EventReceipt not implemented")
+}
+func (*UnimplementedQueryServer) TxEventReceipts(ctx context.Context, req *QueryTxEventReceiptsRequest) (*QueryTxEventReceiptsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TxEventReceipts not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -1515,6 +1861,24 @@ func _Query_ValidatorEntrypointContractAddr_Handler(srv interface{}, ctx context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_CircuitBreaker_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCircuitBreakerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CircuitBreaker(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mitosis.evmvalidator.v1.Query/CircuitBreaker",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CircuitBreaker(ctx, req.(*QueryCircuitBreakerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_Validator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryValidatorRequest)
 	if err := dec(in); err != nil {
@@ -1677,6 +2041,43 @@ func _Query_CollateralOwnership_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_EventReceipt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEventReceiptRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).EventReceipt(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mitosis.evmvalidator.v1.Query/EventReceipt",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EventReceipt(ctx, req.(*QueryEventReceiptRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TxEventReceipts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTxEventReceiptsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TxEventReceipts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mitosis.evmvalidator.v1.Query/TxEventReceipts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TxEventReceipts(ctx, req.(*QueryTxEventReceiptsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Query_serviceDesc = _Query_serviceDesc
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "mitosis.evmvalidator.v1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -1689,6 +2090,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ValidatorEntrypointContractAddr",
 			Handler:    _Query_ValidatorEntrypointContractAddr_Handler,
 		},
+		{
+			MethodName: "CircuitBreaker",
+			Handler:    _Query_CircuitBreaker_Handler,
+		},
 		{
 			MethodName: "Validator",
 			Handler:    _Query_Validator_Handler,
@@ -1725,6 +2130,14 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "CollateralOwnership",
 			Handler:    _Query_CollateralOwnership_Handler,
 		},
+		{
+			MethodName: "EventReceipt",
+			Handler:    _Query_EventReceipt_Handler,
+		},
+		{
+			MethodName: "TxEventReceipts",
+			Handler:    _Query_TxEventReceipts_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "mitosis/evmvalidator/v1/query.proto",
@@ -1842,7 +2255,63 @@ func (m *QueryValidatorEntrypointContractAddrResponse) MarshalToSizedBuffer(dAtA
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryValidatorRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryCircuitBreakerRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCircuitBreakerRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCircuitBreakerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCircuitBreakerResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCircuitBreakerResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCircuitBreakerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.CircuitBreaker.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryValidatorRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2576,6 +3045,140 @@ func (m *QueryCollateralOwnershipResponse) MarshalToSizedBuffer(dAtA []byte) (in
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryEventReceiptRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEventReceiptRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEventReceiptRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.EvmBlockHash) > 0 {
+		i -= len(m.EvmBlockHash)
+		copy(dAtA[i:], m.EvmBlockHash)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.EvmBlockHash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEventReceiptResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEventReceiptResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEventReceiptResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Receipts) > 0 {
+		for iNdEx := len(m.Receipts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Receipts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTxEventReceiptsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTxEventReceiptsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTxEventReceiptsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TxHash) > 0 {
+		i -= len(m.TxHash)
+		copy(dAtA[i:], m.TxHash)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.TxHash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTxEventReceiptsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTxEventReceiptsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTxEventReceiptsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Receipts) > 0 {
+		for iNdEx := len(m.Receipts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Receipts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -2627,6 +3230,26 @@ func (m *QueryValidatorEntrypointContractAddrResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryCircuitBreakerRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryCircuitBreakerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.CircuitBreaker.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
 func (m *QueryValidatorRequest) Size() (n int) {
 	if m == nil {
 		return 0
@@ -2907,6 +3530,62 @@ func (m *QueryCollateralOwnershipResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryEventReceiptRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.EvmBlockHash)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryEventReceiptResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Receipts) > 0 {
+		for _, e := range m.Receipts {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryTxEventReceiptsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TxHash)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTxEventReceiptsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Receipts) > 0 {
+		for _, e := range m.Receipts {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -3179,7 +3858,7 @@ func (m *QueryValidatorEntrypointContractAddrResponse) Unmarshal(dAtA []byte) er
 	}
 	return nil
 }
-func (m *QueryValidatorRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryCircuitBreakerRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3202,42 +3881,175 @@ func (m *QueryValidatorRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryValidatorRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryCircuitBreakerRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryValidatorRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryCircuitBreakerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValAddr", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthQuery
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValAddr = append(m.ValAddr[:0], dAtA[iNdEx:postIndex]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCircuitBreakerResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCircuitBreakerResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCircuitBreakerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CircuitBreaker", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CircuitBreaker.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryValidatorRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryValidatorRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryValidatorRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValAddr", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValAddr = append(m.ValAddr[:0], dAtA[iNdEx:postIndex]...)
 			if m.ValAddr == nil {
 				m.ValAddr = []byte{}
 			}
@@ -5079,6 +5891,338 @@ func (m *QueryCollateralOwnershipResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryEventReceiptRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEventReceiptRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEventReceiptRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EvmBlockHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EvmBlockHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEventReceiptResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEventReceiptResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEventReceiptResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Receipts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Receipts = append(m.Receipts, EventReceipt{})
+			if err := m.Receipts[len(m.Receipts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTxEventReceiptsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTxEventReceiptsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTxEventReceiptsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTxEventReceiptsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTxEventReceiptsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTxEventReceiptsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Receipts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Receipts = append(m.Receipts, EventReceipt{})
+			if err := m.Receipts[len(m.Receipts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
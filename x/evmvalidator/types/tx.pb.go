@@ -216,44 +216,244 @@ func (m *MsgUpdateValidatorEntrypointContractAddrResponse) XXX_DiscardUnknown()
 
 var xxx_messageInfo_MsgUpdateValidatorEntrypointContractAddrResponse proto.InternalMessageInfo
 
+// MsgUpdateCircuitBreaker is the Msg/UpdateCircuitBreaker request type
+type MsgUpdateCircuitBreaker struct {
+	// authority is the address that controls the module
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// circuit_breaker is the new circuit breaker flags. It must be set even to
+	// unpause all the features, so that a message missing it is rejected.
+	CircuitBreaker *CircuitBreaker `protobuf:"bytes,2,opt,name=circuit_breaker,json=circuitBreaker,proto3" json:"circuit_breaker,omitempty"`
+}
+
+func (m *MsgUpdateCircuitBreaker) Reset()         { *m = MsgUpdateCircuitBreaker{} }
+func (m *MsgUpdateCircuitBreaker) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateCircuitBreaker) ProtoMessage()    {}
+func (*MsgUpdateCircuitBreaker) Descriptor() ([]byte, []int) {
+	return fileDescriptor_41df9bb569436605, []int{4}
+}
+func (m *MsgUpdateCircuitBreaker) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateCircuitBreaker) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateCircuitBreaker.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateCircuitBreaker) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateCircuitBreaker.Merge(m, src)
+}
+func (m *MsgUpdateCircuitBreaker) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateCircuitBreaker) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateCircuitBreaker.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateCircuitBreaker proto.InternalMessageInfo
+
+func (m *MsgUpdateCircuitBreaker) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgUpdateCircuitBreaker) GetCircuitBreaker() *CircuitBreaker {
+	if m != nil {
+		return m.CircuitBreaker
+	}
+	return nil
+}
+
+// MsgUpdateCircuitBreakerResponse is the Msg/UpdateCircuitBreaker response
+// type
+type MsgUpdateCircuitBreakerResponse struct {
+}
+
+func (m *MsgUpdateCircuitBreakerResponse) Reset()         { *m = MsgUpdateCircuitBreakerResponse{} }
+func (m *MsgUpdateCircuitBreakerResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateCircuitBreakerResponse) ProtoMessage()    {}
+func (*MsgUpdateCircuitBreakerResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_41df9bb569436605, []int{5}
+}
+func (m *MsgUpdateCircuitBreakerResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateCircuitBreakerResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateCircuitBreakerResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateCircuitBreakerResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateCircuitBreakerResponse.Merge(m, src)
+}
+func (m *MsgUpdateCircuitBreakerResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateCircuitBreakerResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateCircuitBreakerResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateCircuitBreakerResponse proto.InternalMessageInfo
+
+// MsgUpdateValidatorFeeRecipient is the Msg/UpdateValidatorFeeRecipient
+// request type
+type MsgUpdateValidatorFeeRecipient struct {
+	// authority is the address that controls the module
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// val_addr is the address of the validator
+	ValAddr github_com_mitosis_org_chain_types.EthAddress `protobuf:"bytes,2,opt,name=val_addr,json=valAddr,proto3,customtype=github.com/mitosis-org/chain/types.EthAddress" json:"val_addr"`
+	// fee_recipient is the new fee recipient of the payloads proposed by the
+	// validator. The zero address clears the registration.
+	FeeRecipient github_com_mitosis_org_chain_types.EthAddress `protobuf:"bytes,3,opt,name=fee_recipient,json=feeRecipient,proto3,customtype=github.com/mitosis-org/chain/types.EthAddress" json:"fee_recipient"`
+}
+
+func (m *MsgUpdateValidatorFeeRecipient) Reset()         { *m = MsgUpdateValidatorFeeRecipient{} }
+func (m *MsgUpdateValidatorFeeRecipient) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateValidatorFeeRecipient) ProtoMessage()    {}
+func (*MsgUpdateValidatorFeeRecipient) Descriptor() ([]byte, []int) {
+	return fileDescriptor_41df9bb569436605, []int{6}
+}
+func (m *MsgUpdateValidatorFeeRecipient) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateValidatorFeeRecipient) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateValidatorFeeRecipient.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateValidatorFeeRecipient) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateValidatorFeeRecipient.Merge(m, src)
+}
+func (m *MsgUpdateValidatorFeeRecipient) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateValidatorFeeRecipient) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateValidatorFeeRecipient.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateValidatorFeeRecipient proto.InternalMessageInfo
+
+func (m *MsgUpdateValidatorFeeRecipient) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+// MsgUpdateValidatorFeeRecipientResponse is the
+// Msg/UpdateValidatorFeeRecipient response type
+type MsgUpdateValidatorFeeRecipientResponse struct {
+}
+
+func (m *MsgUpdateValidatorFeeRecipientResponse) Reset() {
+	*m = MsgUpdateValidatorFeeRecipientResponse{}
+}
+func (m *MsgUpdateValidatorFeeRecipientResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateValidatorFeeRecipientResponse) ProtoMessage()    {}
+func (*MsgUpdateValidatorFeeRecipientResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_41df9bb569436605, []int{7}
+}
+func (m *MsgUpdateValidatorFeeRecipientResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateValidatorFeeRecipientResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateValidatorFeeRecipientResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateValidatorFeeRecipientResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateValidatorFeeRecipientResponse.Merge(m, src)
+}
+func (m *MsgUpdateValidatorFeeRecipientResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateValidatorFeeRecipientResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateValidatorFeeRecipientResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateValidatorFeeRecipientResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgUpdateParams)(nil), "mitosis.evmvalidator.v1.MsgUpdateParams")
 	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "mitosis.evmvalidator.v1.MsgUpdateParamsResponse")
 	proto.RegisterType((*MsgUpdateValidatorEntrypointContractAddr)(nil), "mitosis.evmvalidator.v1.MsgUpdateValidatorEntrypointContractAddr")
 	proto.RegisterType((*MsgUpdateValidatorEntrypointContractAddrResponse)(nil), "mitosis.evmvalidator.v1.MsgUpdateValidatorEntrypointContractAddrResponse")
+	proto.RegisterType((*MsgUpdateCircuitBreaker)(nil), "mitosis.evmvalidator.v1.MsgUpdateCircuitBreaker")
+	proto.RegisterType((*MsgUpdateCircuitBreakerResponse)(nil), "mitosis.evmvalidator.v1.MsgUpdateCircuitBreakerResponse")
+	proto.RegisterType((*MsgUpdateValidatorFeeRecipient)(nil), "mitosis.evmvalidator.v1.MsgUpdateValidatorFeeRecipient")
+	proto.RegisterType((*MsgUpdateValidatorFeeRecipientResponse)(nil), "mitosis.evmvalidator.v1.MsgUpdateValidatorFeeRecipientResponse")
 }
 
 func init() { proto.RegisterFile("mitosis/evmvalidator/v1/tx.proto", fileDescriptor_41df9bb569436605) }
 
 var fileDescriptor_41df9bb569436605 = []byte{
-	// 432 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0xc8, 0xcd, 0x2c, 0xc9,
-	0x2f, 0xce, 0x2c, 0xd6, 0x4f, 0x2d, 0xcb, 0x2d, 0x4b, 0xcc, 0xc9, 0x4c, 0x49, 0x2c, 0xc9, 0x2f,
-	0xd2, 0x2f, 0x33, 0xd4, 0x2f, 0xa9, 0xd0, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x87, 0xaa,
-	0xd0, 0x43, 0x56, 0xa1, 0x57, 0x66, 0x28, 0x25, 0x99, 0x9c, 0x5f, 0x9c, 0x9b, 0x5f, 0x1c, 0x0f,
-	0x56, 0xa6, 0x0f, 0xe1, 0x40, 0xf4, 0x48, 0x89, 0xa4, 0xe7, 0xa7, 0xe7, 0x43, 0xc4, 0x41, 0x2c,
-	0xa8, 0xa8, 0x38, 0x44, 0x8d, 0x7e, 0x6e, 0x71, 0x3a, 0xc8, 0x86, 0xdc, 0xe2, 0x74, 0xa8, 0x84,
-	0x0a, 0x2e, 0x47, 0x14, 0x24, 0x16, 0x25, 0xe6, 0x42, 0x0d, 0x55, 0x9a, 0xc1, 0xc8, 0xc5, 0xef,
-	0x5b, 0x9c, 0x1e, 0x5a, 0x90, 0x92, 0x58, 0x92, 0x1a, 0x00, 0x96, 0x11, 0x32, 0xe3, 0xe2, 0x4c,
-	0x2c, 0x2d, 0xc9, 0xc8, 0x2f, 0xca, 0x2c, 0xa9, 0x94, 0x60, 0x54, 0x60, 0xd4, 0xe0, 0x74, 0x92,
-	0xb8, 0xb4, 0x45, 0x57, 0x04, 0xea, 0x1a, 0xc7, 0x94, 0x94, 0xa2, 0xd4, 0xe2, 0xe2, 0xe0, 0x92,
-	0xa2, 0xcc, 0xbc, 0xf4, 0x20, 0x84, 0x52, 0x21, 0x5b, 0x2e, 0x36, 0x88, 0xd9, 0x12, 0x4c, 0x0a,
-	0x8c, 0x1a, 0xdc, 0x46, 0xf2, 0x7a, 0x38, 0x7c, 0xa9, 0x07, 0xb1, 0xc8, 0x89, 0xe5, 0xc4, 0x3d,
-	0x79, 0x86, 0x20, 0xa8, 0x26, 0x2b, 0xbe, 0xa6, 0xe7, 0x1b, 0xb4, 0x10, 0xc6, 0x29, 0x49, 0x72,
-	0x89, 0xa3, 0xb9, 0x2c, 0x28, 0xb5, 0xb8, 0x20, 0x3f, 0xaf, 0x38, 0x55, 0x69, 0x2f, 0x23, 0x97,
-	0x06, 0x5c, 0x2e, 0x0c, 0x66, 0xb2, 0x6b, 0x5e, 0x49, 0x51, 0x65, 0x41, 0x7e, 0x66, 0x5e, 0x89,
-	0x73, 0x7e, 0x5e, 0x49, 0x51, 0x62, 0x72, 0x09, 0xc8, 0xa9, 0x64, 0x7b, 0xc7, 0x93, 0x8b, 0x25,
-	0x31, 0x25, 0xa5, 0x08, 0xec, 0x19, 0x1e, 0x27, 0x53, 0x90, 0x5b, 0x6f, 0xdd, 0x93, 0xd7, 0x4d,
-	0xcf, 0x2c, 0xc9, 0x28, 0x4d, 0xd2, 0x4b, 0xce, 0xcf, 0xd5, 0x87, 0x7a, 0x4f, 0x37, 0xbf, 0x28,
-	0x5d, 0x3f, 0x39, 0x23, 0x31, 0x33, 0x4f, 0xbf, 0xa4, 0xb2, 0x20, 0xb5, 0x58, 0xcf, 0xb5, 0x24,
-	0x03, 0x6a, 0x6a, 0x10, 0xd8, 0x08, 0x0c, 0xaf, 0x19, 0x71, 0x19, 0x10, 0xeb, 0x7c, 0x98, 0x9f,
-	0x8d, 0x36, 0x30, 0x71, 0x31, 0xfb, 0x16, 0xa7, 0x0b, 0x65, 0x71, 0xf1, 0xa0, 0xc4, 0x96, 0x06,
-	0xce, 0x50, 0x46, 0x0b, 0x3d, 0x29, 0x03, 0x62, 0x55, 0xc2, 0xec, 0x14, 0xda, 0xca, 0xc8, 0xa5,
-	0x4a, 0x5c, 0x20, 0x3b, 0x12, 0x36, 0x9b, 0x80, 0x11, 0x52, 0x9e, 0x14, 0x1b, 0x01, 0x73, 0xb7,
-	0x14, 0x6b, 0xc3, 0xf3, 0x0d, 0x5a, 0x8c, 0x4e, 0xde, 0x27, 0x1e, 0xc9, 0x31, 0x5e, 0x78, 0x24,
-	0xc7, 0xf8, 0xe0, 0x91, 0x1c, 0xe3, 0x84, 0xc7, 0x72, 0x0c, 0x17, 0x1e, 0xcb, 0x31, 0xdc, 0x78,
-	0x2c, 0xc7, 0x10, 0x65, 0x88, 0x37, 0x16, 0x2b, 0x50, 0xf3, 0x0c, 0x38, 0x52, 0x93, 0xd8, 0xc0,
-	0x19, 0xc6, 0x18, 0x10, 0x00, 0x00, 0xff, 0xff, 0x8c, 0x2b, 0x68, 0x8b, 0xdd, 0x03, 0x00, 0x00,
+	// 587 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x55, 0x4d, 0x6b, 0x13, 0x41,
+	0x18, 0xce, 0xb4, 0xb1, 0xda, 0x31, 0xb6, 0x10, 0x02, 0x49, 0x57, 0xd8, 0xc4, 0xe0, 0x47, 0x28,
+	0x64, 0xb7, 0x89, 0xf8, 0x81, 0x20, 0xd2, 0x94, 0x0a, 0x45, 0x0a, 0x65, 0x45, 0x0f, 0xbd, 0x84,
+	0xc9, 0xee, 0x74, 0x33, 0x9a, 0xdd, 0x59, 0x66, 0x26, 0x4b, 0x73, 0x93, 0xfe, 0x02, 0x4f, 0xea,
+	0xcf, 0x28, 0xa8, 0x47, 0xef, 0xbd, 0x59, 0x3c, 0x89, 0x87, 0x22, 0xc9, 0xa1, 0x7f, 0x43, 0xb2,
+	0x1f, 0x69, 0x36, 0x71, 0xd3, 0x34, 0xb9, 0xed, 0xce, 0x3c, 0xef, 0xf3, 0x3e, 0xcf, 0xfb, 0xc1,
+	0xc0, 0x82, 0x45, 0x04, 0xe5, 0x84, 0xab, 0xd8, 0xb5, 0x5c, 0xd4, 0x22, 0x06, 0x12, 0x94, 0xa9,
+	0x6e, 0x45, 0x15, 0x87, 0x8a, 0xc3, 0xa8, 0xa0, 0xe9, 0x6c, 0x80, 0x50, 0x86, 0x11, 0x8a, 0x5b,
+	0x91, 0xd6, 0x74, 0xca, 0x2d, 0xca, 0xeb, 0x1e, 0x4c, 0xf5, 0x7f, 0xfc, 0x18, 0x29, 0x63, 0x52,
+	0x93, 0xfa, 0xe7, 0xfd, 0xaf, 0xe0, 0x34, 0xeb, 0x63, 0x54, 0x8b, 0x9b, 0xfd, 0x0c, 0x16, 0x37,
+	0x83, 0x8b, 0xbb, 0x71, 0x22, 0x1c, 0xc4, 0x90, 0x15, 0x92, 0x96, 0xe3, 0x50, 0x3a, 0x61, 0x7a,
+	0x9b, 0x88, 0x7a, 0x83, 0x61, 0xf4, 0x1e, 0x33, 0x1f, 0x5e, 0xfc, 0x02, 0xe0, 0xea, 0x2e, 0x37,
+	0xdf, 0x38, 0x06, 0x12, 0x78, 0xcf, 0x23, 0x4a, 0x3f, 0x86, 0xcb, 0xa8, 0x2d, 0x9a, 0x94, 0x11,
+	0xd1, 0xc9, 0x81, 0x02, 0x28, 0x2d, 0xd7, 0x72, 0xbf, 0xbe, 0x95, 0x33, 0x81, 0xf8, 0x4d, 0xc3,
+	0x60, 0x98, 0xf3, 0xd7, 0x82, 0x11, 0xdb, 0xd4, 0x2e, 0xa0, 0xe9, 0xe7, 0x70, 0xc9, 0x97, 0x92,
+	0x5b, 0x28, 0x80, 0xd2, 0xcd, 0x6a, 0x5e, 0x89, 0x29, 0x8a, 0xe2, 0x27, 0xaa, 0x25, 0x4f, 0xce,
+	0xf2, 0x09, 0x2d, 0x08, 0x7a, 0xb6, 0x72, 0x74, 0x7e, 0xbc, 0x7e, 0x41, 0x57, 0x5c, 0x83, 0xd9,
+	0x11, 0x65, 0x1a, 0xe6, 0x0e, 0xb5, 0x39, 0x2e, 0xfe, 0x00, 0xb0, 0x34, 0xb8, 0x7b, 0x1b, 0x32,
+	0x6f, 0xdb, 0x82, 0x75, 0x1c, 0x4a, 0x6c, 0xb1, 0x45, 0x6d, 0xc1, 0x90, 0x2e, 0xfa, 0x52, 0x67,
+	0xb6, 0xb3, 0x03, 0x93, 0xc8, 0x30, 0x98, 0x67, 0x26, 0x55, 0x7b, 0xd4, 0xd7, 0xfa, 0xe7, 0x2c,
+	0x5f, 0x36, 0x89, 0x68, 0xb6, 0x1b, 0x8a, 0x4e, 0x2d, 0x35, 0xb0, 0x57, 0xa6, 0xcc, 0x54, 0xf5,
+	0x26, 0x22, 0xb6, 0x2a, 0x3a, 0x0e, 0xe6, 0xca, 0xb6, 0x68, 0x06, 0xac, 0x9a, 0x47, 0x31, 0x66,
+	0xad, 0x0a, 0x37, 0xa6, 0x95, 0x3f, 0xf0, 0xfc, 0x15, 0x0c, 0xd5, 0x63, 0xcb, 0x6f, 0x66, 0xcd,
+	0xef, 0xe5, 0xcc, 0x16, 0xf7, 0xe0, 0xea, 0xc8, 0x58, 0x04, 0xad, 0x7b, 0x10, 0xdb, 0xba, 0x68,
+	0x66, 0x6d, 0x45, 0x8f, 0xfc, 0x8f, 0x39, 0xbd, 0x03, 0xf3, 0x31, 0xa2, 0x07, 0xc6, 0x3e, 0x2d,
+	0x40, 0x79, 0xbc, 0x1a, 0x2f, 0x31, 0xd6, 0xb0, 0x4e, 0x1c, 0x82, 0x6d, 0x31, 0x87, 0xbf, 0x1b,
+	0x2e, 0x6a, 0xd5, 0xe7, 0x6f, 0xe3, 0x75, 0x17, 0xb5, 0xbc, 0x61, 0xda, 0x87, 0xb7, 0x0e, 0x30,
+	0xae, 0xb3, 0x50, 0x5a, 0x6e, 0x71, 0x1e, 0xda, 0xd4, 0xc1, 0x90, 0xcb, 0xb1, 0xda, 0x95, 0xe0,
+	0xfd, 0xc9, 0x75, 0x09, 0x4b, 0x58, 0xfd, 0x99, 0x84, 0x8b, 0xbb, 0xdc, 0x4c, 0xbf, 0x83, 0xa9,
+	0xc8, 0x26, 0x97, 0x62, 0xdb, 0x38, 0xb2, 0x59, 0xd2, 0xc6, 0xb4, 0xc8, 0x30, 0x67, 0xfa, 0x3b,
+	0x80, 0xf7, 0xa6, 0x5b, 0xc0, 0xcd, 0xcb, 0xb9, 0x2f, 0xa1, 0x90, 0x76, 0xe6, 0xa6, 0x18, 0xe8,
+	0x3e, 0x02, 0x30, 0xf3, 0xdf, 0x25, 0x9a, 0xa2, 0x04, 0xd1, 0x08, 0xe9, 0xe9, 0x55, 0x23, 0x06,
+	0x22, 0x3e, 0x03, 0x78, 0x7b, 0xd2, 0xc0, 0x3f, 0xb9, 0x82, 0xdf, 0xe1, 0x40, 0xe9, 0xc5, 0x8c,
+	0x81, 0xa1, 0x32, 0xe9, 0xda, 0x87, 0xf3, 0xe3, 0x75, 0x50, 0x7b, 0x75, 0xd2, 0x95, 0xc1, 0x69,
+	0x57, 0x06, 0x7f, 0xbb, 0x32, 0xf8, 0xd8, 0x93, 0x13, 0xa7, 0x3d, 0x39, 0xf1, 0xbb, 0x27, 0x27,
+	0xf6, 0x2b, 0x13, 0x47, 0xfc, 0x30, 0xfa, 0xee, 0x78, 0x13, 0xdf, 0x58, 0xf2, 0xde, 0x9a, 0x87,
+	0xff, 0x06, 0x00, 0xe2, 0xba, 0x2c, 0x5e, 0x47, 0x07, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -273,6 +473,11 @@ type MsgClient interface {
 	// UpdateValidatorEntrypointContractAddr updates the address of the
 	// ConsensusValidatorEntrypoint contract
 	UpdateValidatorEntrypointContractAddr(ctx context.Context, in *MsgUpdateValidatorEntrypointContractAddr, opts ...grpc.CallOption) (*MsgUpdateValidatorEntrypointContractAddrResponse, error)
+	// UpdateCircuitBreaker updates the circuit breaker flags
+	UpdateCircuitBreaker(ctx context.Context, in *MsgUpdateCircuitBreaker, opts ...grpc.CallOption) (*MsgUpdateCircuitBreakerResponse, error)
+	// UpdateValidatorFeeRecipient updates the fee recipient registered for a
+	// validator
+	UpdateValidatorFeeRecipient(ctx context.Context, in *MsgUpdateValidatorFeeRecipient, opts ...grpc.CallOption) (*MsgUpdateValidatorFeeRecipientResponse, error)
 }
 
 type msgClient struct {
@@ -301,6 +506,24 @@ func (c *msgClient) UpdateValidatorEntrypointContractAddr(ctx context.Context, i
 	return out, nil
 }
 
+func (c *msgClient) UpdateCircuitBreaker(ctx context.Context, in *MsgUpdateCircuitBreaker, opts ...grpc.CallOption) (*MsgUpdateCircuitBreakerResponse, error) {
+	out := new(MsgUpdateCircuitBreakerResponse)
+	err := c.cc.Invoke(ctx, "/mitosis.evmvalidator.v1.Msg/UpdateCircuitBreaker", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateValidatorFeeRecipient(ctx context.Context, in *MsgUpdateValidatorFeeRecipient, opts ...grpc.CallOption) (*MsgUpdateValidatorFeeRecipientResponse, error) {
+	out := new(MsgUpdateValidatorFeeRecipientResponse)
+	err := c.cc.Invoke(ctx, "/mitosis.evmvalidator.v1.Msg/UpdateValidatorFeeRecipient", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// UpdateParams updates the module parameters
@@ -308,6 +531,11 @@ type MsgServer interface {
 	// UpdateValidatorEntrypointContractAddr updates the address of the
 	// ConsensusValidatorEntrypoint contract
 	UpdateValidatorEntrypointContractAddr(context.Context, *MsgUpdateValidatorEntrypointContractAddr) (*MsgUpdateValidatorEntrypointContractAddrResponse, error)
+	// UpdateCircuitBreaker updates the circuit breaker flags
+	UpdateCircuitBreaker(context.Context, *MsgUpdateCircuitBreaker) (*MsgUpdateCircuitBreakerResponse, error)
+	// UpdateValidatorFeeRecipient updates the fee recipient registered for a
+	// validator
+	UpdateValidatorFeeRecipient(context.Context, *MsgUpdateValidatorFeeRecipient) (*MsgUpdateValidatorFeeRecipientResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -320,6 +548,12 @@ func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateP
 func (*UnimplementedMsgServer) UpdateValidatorEntrypointContractAddr(ctx context.Context, req *MsgUpdateValidatorEntrypointContractAddr) (*MsgUpdateValidatorEntrypointContractAddrResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateValidatorEntrypointContractAddr not implemented")
 }
+func (*UnimplementedMsgServer) UpdateCircuitBreaker(ctx context.Context, req *MsgUpdateCircuitBreaker) (*MsgUpdateCircuitBreakerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateCircuitBreaker not implemented")
+}
+func (*UnimplementedMsgServer) UpdateValidatorFeeRecipient(ctx context.Context, req *MsgUpdateValidatorFeeRecipient) (*MsgUpdateValidatorFeeRecipientResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateValidatorFeeRecipient not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -361,6 +595,43 @@ func _Msg_UpdateValidatorEntrypointContractAddr_Handler(srv interface{}, ctx con
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_UpdateCircuitBreaker_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateCircuitBreaker)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateCircuitBreaker(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mitosis.evmvalidator.v1.Msg/UpdateCircuitBreaker",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateCircuitBreaker(ctx, req.(*MsgUpdateCircuitBreaker))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateValidatorFeeRecipient_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateValidatorFeeRecipient)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateValidatorFeeRecipient(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mitosis.evmvalidator.v1.Msg/UpdateValidatorFeeRecipient",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateValidatorFeeRecipient(ctx, req.(*MsgUpdateValidatorFeeRecipient))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Msg_serviceDesc = _Msg_serviceDesc
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "mitosis.evmvalidator.v1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -373,6 +644,14 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateValidatorEntrypointContractAddr",
 			Handler:    _Msg_UpdateValidatorEntrypointContractAddr_Handler,
 		},
+		{
+			MethodName: "UpdateCircuitBreaker",
+			Handler:    _Msg_UpdateCircuitBreaker_Handler,
+		},
+		{
+			MethodName: "UpdateValidatorFeeRecipient",
+			Handler:    _Msg_UpdateValidatorFeeRecipient_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "mitosis/evmvalidator/v1/tx.proto",
@@ -504,6 +783,144 @@ func (m *MsgUpdateValidatorEntrypointContractAddrResponse) MarshalToSizedBuffer(
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgUpdateCircuitBreaker) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateCircuitBreaker) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateCircuitBreaker) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CircuitBreaker != nil {
+		{
+			size, err := m.CircuitBreaker.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateCircuitBreakerResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateCircuitBreakerResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateCircuitBreakerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateValidatorFeeRecipient) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateValidatorFeeRecipient) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateValidatorFeeRecipient) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.FeeRecipient.Size()
+		i -= size
+		if _, err := m.FeeRecipient.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.ValAddr.Size()
+		i -= size
+		if _, err := m.ValAddr.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateValidatorFeeRecipientResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateValidatorFeeRecipientResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateValidatorFeeRecipientResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -525,51 +942,433 @@ func (m *MsgUpdateParams) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
-	l = m.Params.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
-}
+	l = m.Params.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateValidatorEntrypointContractAddr) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Addr.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateValidatorEntrypointContractAddrResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateCircuitBreaker) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.CircuitBreaker != nil {
+		l = m.CircuitBreaker.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUpdateCircuitBreakerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateValidatorFeeRecipient) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.ValAddr.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.FeeRecipient.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateValidatorFeeRecipientResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *MsgUpdateParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
+	return nil
 }
-
-func (m *MsgUpdateValidatorEntrypointContractAddr) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func (m *MsgUpdateValidatorEntrypointContractAddr) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateValidatorEntrypointContractAddr: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateValidatorEntrypointContractAddr: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Addr", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Addr.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	l = m.Addr.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
-}
 
-func (m *MsgUpdateValidatorEntrypointContractAddrResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
+	return nil
 }
+func (m *MsgUpdateValidatorEntrypointContractAddrResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateValidatorEntrypointContractAddrResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateValidatorEntrypointContractAddrResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateCircuitBreaker) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -592,10 +1391,10 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateCircuitBreaker: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateCircuitBreaker: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -632,7 +1431,7 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CircuitBreaker", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -659,7 +1458,10 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.CircuitBreaker == nil {
+				m.CircuitBreaker = &CircuitBreaker{}
+			}
+			if err := m.CircuitBreaker.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -684,7 +1486,7 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateCircuitBreakerResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -707,10 +1509,10 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateCircuitBreakerResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateCircuitBreakerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -734,7 +1536,7 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateValidatorEntrypointContractAddr) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateValidatorFeeRecipient) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -757,10 +1559,10 @@ func (m *MsgUpdateValidatorEntrypointContractAddr) Unmarshal(dAtA []byte) error
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateValidatorEntrypointContractAddr: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateValidatorFeeRecipient: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateValidatorEntrypointContractAddr: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateValidatorFeeRecipient: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -797,7 +1599,7 @@ func (m *MsgUpdateValidatorEntrypointContractAddr) Unmarshal(dAtA []byte) error
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Addr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValAddr", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -824,7 +1626,40 @@ func (m *MsgUpdateValidatorEntrypointContractAddr) Unmarshal(dAtA []byte) error
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Addr.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ValAddr.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeRecipient", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FeeRecipient.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -849,7 +1684,7 @@ func (m *MsgUpdateValidatorEntrypointContractAddr) Unmarshal(dAtA []byte) error
 	}
 	return nil
 }
-func (m *MsgUpdateValidatorEntrypointContractAddrResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateValidatorFeeRecipientResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -872,10 +1707,10 @@ func (m *MsgUpdateValidatorEntrypointContractAddrResponse) Unmarshal(dAtA []byte
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateValidatorEntrypointContractAddrResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateValidatorFeeRecipientResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateValidatorEntrypointContractAddrResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateValidatorFeeRecipientResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
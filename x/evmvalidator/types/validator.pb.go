@@ -7,10 +7,10 @@ import (
 	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
+	_ "github.com/cosmos/cosmos-sdk/codec/types"
 	_ "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
-	_ "github.com/cosmos/gogoproto/types/any"
 	github_com_mitosis_org_chain_types "github.com/mitosis-org/chain/types"
 	io "io"
 	math "math"
@@ -28,6 +28,48 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// EventReceiptStatus defines the outcome of processing an entrypoint event
+type EventReceiptStatus int32
+
+const (
+	// EVENT_RECEIPT_STATUS_UNSPECIFIED defines an unspecified status
+	EventReceiptStatusUnspecified EventReceiptStatus = 0
+	// EVENT_RECEIPT_STATUS_APPLIED means the event was applied to the state
+	EventReceiptStatusApplied EventReceiptStatus = 1
+	// EVENT_RECEIPT_STATUS_IGNORED means the event failed and was ignored
+	EventReceiptStatusIgnored EventReceiptStatus = 2
+	// EVENT_RECEIPT_STATUS_REFUNDED means the event failed and the collateral
+	// was refunded through the fallback logic
+	EventReceiptStatusRefunded EventReceiptStatus = 3
+	// EVENT_RECEIPT_STATUS_QUEUED means the event was queued since the feature
+	// is paused, and will be applied after unpausing
+	EventReceiptStatusQueued EventReceiptStatus = 4
+)
+
+var EventReceiptStatus_name = map[int32]string{
+	0: "EVENT_RECEIPT_STATUS_UNSPECIFIED",
+	1: "EVENT_RECEIPT_STATUS_APPLIED",
+	2: "EVENT_RECEIPT_STATUS_IGNORED",
+	3: "EVENT_RECEIPT_STATUS_REFUNDED",
+	4: "EVENT_RECEIPT_STATUS_QUEUED",
+}
+
+var EventReceiptStatus_value = map[string]int32{
+	"EVENT_RECEIPT_STATUS_UNSPECIFIED": 0,
+	"EVENT_RECEIPT_STATUS_APPLIED":     1,
+	"EVENT_RECEIPT_STATUS_IGNORED":     2,
+	"EVENT_RECEIPT_STATUS_REFUNDED":    3,
+	"EVENT_RECEIPT_STATUS_QUEUED":      4,
+}
+
+func (x EventReceiptStatus) String() string {
+	return proto.EnumName(EventReceiptStatus_name, int32(x))
+}
+
+func (EventReceiptStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_b9e8a7b8b89b7374, []int{0}
+}
+
 // Validator defines a validator in the x/evmvalidator module
 type Validator struct {
 	// addr is the Ethereum address of the validator
@@ -49,6 +91,10 @@ type Validator struct {
 	// bonded indicates if the validator is bonded (meaning it is in the active
 	// validator set)
 	Bonded bool `protobuf:"varint,7,opt,name=bonded,proto3" json:"bonded,omitempty"`
+	// fee_recipient is the registered recipient of the EVM gas fee tips of the
+	// blocks proposed by the validator. The zero address means it is not
+	// registered.
+	FeeRecipient github_com_mitosis_org_chain_types.EthAddress `protobuf:"bytes,9,opt,name=fee_recipient,json=feeRecipient,proto3,customtype=github.com/mitosis-org/chain/types.EthAddress" json:"fee_recipient"`
 }
 
 func (m *Validator) Reset()         { *m = Validator{} }
@@ -289,11 +335,114 @@ func (m *CollateralOwnership) GetCreationHeight() int64 {
 	return 0
 }
 
+// EventReceipt defines the outcome of a delivered
+// ConsensusValidatorEntrypoint event log
+type EventReceipt struct {
+	// evm_block_hash is the hash of the EVM block containing the event log
+	EvmBlockHash string `protobuf:"bytes,1,opt,name=evm_block_hash,json=evmBlockHash,proto3" json:"evm_block_hash,omitempty"`
+	// tx_hash is the hash of the EVM transaction emitting the event log
+	TxHash string `protobuf:"bytes,2,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`
+	// log_index is the index of the event log in the EVM block
+	LogIndex uint64 `protobuf:"varint,3,opt,name=log_index,json=logIndex,proto3" json:"log_index,omitempty"`
+	// event_name is the name of the event (e.g. MsgRegisterValidator)
+	EventName string `protobuf:"bytes,4,opt,name=event_name,json=eventName,proto3" json:"event_name,omitempty"`
+	// status is the outcome of processing the event
+	Status EventReceiptStatus `protobuf:"varint,5,opt,name=status,proto3,enum=mitosis.evmvalidator.v1.EventReceiptStatus" json:"status,omitempty"`
+	// reason is the error that caused the event to be ignored or refunded
+	Reason string `protobuf:"bytes,6,opt,name=reason,proto3" json:"reason,omitempty"`
+	// height is the height at which the event was delivered
+	Height int64 `protobuf:"varint,7,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *EventReceipt) Reset()         { *m = EventReceipt{} }
+func (m *EventReceipt) String() string { return proto.CompactTextString(m) }
+func (*EventReceipt) ProtoMessage()    {}
+func (*EventReceipt) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b9e8a7b8b89b7374, []int{4}
+}
+func (m *EventReceipt) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventReceipt) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventReceipt.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventReceipt) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventReceipt.Merge(m, src)
+}
+func (m *EventReceipt) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventReceipt) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventReceipt.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventReceipt proto.InternalMessageInfo
+
+func (m *EventReceipt) GetEvmBlockHash() string {
+	if m != nil {
+		return m.EvmBlockHash
+	}
+	return ""
+}
+
+func (m *EventReceipt) GetTxHash() string {
+	if m != nil {
+		return m.TxHash
+	}
+	return ""
+}
+
+func (m *EventReceipt) GetLogIndex() uint64 {
+	if m != nil {
+		return m.LogIndex
+	}
+	return 0
+}
+
+func (m *EventReceipt) GetEventName() string {
+	if m != nil {
+		return m.EventName
+	}
+	return ""
+}
+
+func (m *EventReceipt) GetStatus() EventReceiptStatus {
+	if m != nil {
+		return m.Status
+	}
+	return EventReceiptStatusUnspecified
+}
+
+func (m *EventReceipt) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func (m *EventReceipt) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
 func init() {
+	proto.RegisterEnum("mitosis.evmvalidator.v1.EventReceiptStatus", EventReceiptStatus_name, EventReceiptStatus_value)
 	proto.RegisterType((*Validator)(nil), "mitosis.evmvalidator.v1.Validator")
 	proto.RegisterType((*Withdrawal)(nil), "mitosis.evmvalidator.v1.Withdrawal")
 	proto.RegisterType((*LastValidatorPower)(nil), "mitosis.evmvalidator.v1.LastValidatorPower")
 	proto.RegisterType((*CollateralOwnership)(nil), "mitosis.evmvalidator.v1.CollateralOwnership")
+	proto.RegisterType((*EventReceipt)(nil), "mitosis.evmvalidator.v1.EventReceipt")
 }
 
 func init() {
@@ -301,45 +450,66 @@ func init() {
 }
 
 var fileDescriptor_b9e8a7b8b89b7374 = []byte{
-	// 603 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x94, 0xcf, 0x6e, 0xd3, 0x30,
-	0x1c, 0xc7, 0x9b, 0xb4, 0xeb, 0x3a, 0x6f, 0xe2, 0x8f, 0x99, 0x4a, 0x98, 0x44, 0x56, 0x7a, 0x59,
-	0x2f, 0x8d, 0x55, 0x21, 0x1e, 0x60, 0x85, 0x49, 0x4c, 0x03, 0x31, 0x82, 0x18, 0xd2, 0x38, 0x44,
-	0x4e, 0x62, 0x12, 0xb3, 0x24, 0xae, 0x6c, 0x37, 0xdb, 0x24, 0x1e, 0x82, 0x87, 0xe0, 0xc2, 0x9d,
-	0x87, 0x18, 0xb7, 0x89, 0x13, 0xe2, 0x30, 0xa1, 0xee, 0x45, 0x50, 0x6c, 0xaf, 0xeb, 0xa4, 0x89,
-	0x43, 0x07, 0xb7, 0x7c, 0x7f, 0xb6, 0x3f, 0x3f, 0xfb, 0xfb, 0x8d, 0x0d, 0x36, 0x72, 0x2a, 0x99,
-	0xa0, 0x02, 0x91, 0x32, 0x2f, 0x71, 0x46, 0x63, 0x2c, 0x19, 0x47, 0xe5, 0x00, 0x4d, 0x85, 0x37,
-	0xe2, 0x4c, 0x32, 0x78, 0xdf, 0x4c, 0xf4, 0x66, 0x27, 0x7a, 0xe5, 0x60, 0xcd, 0x8d, 0x98, 0xc8,
-	0x99, 0x40, 0x21, 0x16, 0x04, 0x95, 0x83, 0x90, 0x48, 0x3c, 0x40, 0x11, 0xa3, 0x85, 0x5e, 0xb8,
-	0xf6, 0x40, 0x8f, 0x07, 0x4a, 0x21, 0x2d, 0xcc, 0xd0, 0x6a, 0xc2, 0x12, 0xa6, 0xeb, 0xd5, 0xd7,
-	0xc5, 0x82, 0x84, 0xb1, 0x24, 0x23, 0x48, 0xa9, 0x70, 0xfc, 0x01, 0xe1, 0xe2, 0x58, 0x0f, 0x75,
-	0xbf, 0xd7, 0xc1, 0xd2, 0xde, 0x45, 0x73, 0xb8, 0x0d, 0x1a, 0x38, 0x8e, 0xb9, 0x63, 0x75, 0xac,
-	0xde, 0xca, 0xf0, 0xc9, 0xc9, 0xd9, 0x7a, 0xed, 0xd7, 0xd9, 0x7a, 0x3f, 0xa1, 0x32, 0x1d, 0x87,
-	0x5e, 0xc4, 0x72, 0x64, 0xf6, 0xdc, 0x67, 0x3c, 0x41, 0x51, 0x8a, 0x69, 0x81, 0xe4, 0xf1, 0x88,
-	0x08, 0x6f, 0x4b, 0xa6, 0x9b, 0x71, 0xcc, 0x89, 0x10, 0xbe, 0x42, 0xc0, 0x36, 0x68, 0x8e, 0xc6,
-	0xe1, 0x01, 0x39, 0x76, 0xec, 0x0a, 0xe6, 0x1b, 0x05, 0x5f, 0x02, 0x10, 0xb1, 0x2c, 0xc3, 0x92,
-	0x70, 0x9c, 0x39, 0xf5, 0x8e, 0xd5, 0x5b, 0x1a, 0xf6, 0x4d, 0xa3, 0xb6, 0x3e, 0x8b, 0x88, 0x0f,
-	0x3c, 0xca, 0x50, 0x8e, 0x65, 0xea, 0xbd, 0xa5, 0x85, 0xfc, 0xf1, 0xad, 0xbf, 0x6c, 0x4e, 0x59,
-	0x49, 0x7f, 0x06, 0x00, 0xf7, 0xc1, 0xdd, 0x4b, 0x15, 0x88, 0x14, 0x73, 0x22, 0x9c, 0xd6, 0x3c,
-	0xd4, 0x3b, 0x97, 0x9c, 0x37, 0x0a, 0x03, 0xdf, 0x03, 0x48, 0x8e, 0x24, 0xc7, 0x41, 0xc9, 0x24,
-	0x2d, 0x92, 0x60, 0xc4, 0x0e, 0x09, 0x77, 0x1a, 0x73, 0xc1, 0x15, 0x68, 0x4f, 0x71, 0x76, 0x2b,
-	0x0c, 0x7c, 0x04, 0x56, 0xae, 0x60, 0x17, 0x3a, 0x56, 0xaf, 0xee, 0x2f, 0x97, 0x33, 0x53, 0xda,
-	0xa0, 0xf9, 0x11, 0xd3, 0x8c, 0xc4, 0x4e, 0xb3, 0x63, 0xf5, 0x5a, 0xbe, 0x51, 0x55, 0x3d, 0x64,
-	0x45, 0x4c, 0x62, 0x67, 0x51, 0xd7, 0xb5, 0xea, 0x7e, 0xb5, 0x01, 0x78, 0x47, 0x65, 0x1a, 0x73,
-	0x7c, 0x88, 0x33, 0xd8, 0x06, 0x36, 0x8d, 0x55, 0x94, 0x8d, 0x61, 0x73, 0x72, 0xb6, 0x6e, 0x6f,
-	0x3f, 0xf3, 0x6d, 0x1a, 0xc3, 0x5d, 0xd0, 0x2a, 0x71, 0x16, 0xa8, 0xa0, 0xed, 0x9b, 0x04, 0xbd,
-	0x58, 0xe2, 0x6c, 0xd3, 0x64, 0x8d, 0x73, 0x36, 0x2e, 0xa4, 0xca, 0xb3, 0xe1, 0x1b, 0x05, 0x5f,
-	0x83, 0x16, 0x27, 0x11, 0xa1, 0xa5, 0xb1, 0x6d, 0xee, 0x4e, 0x53, 0x0c, 0x7c, 0x08, 0x40, 0x8e,
-	0xe5, 0x98, 0x13, 0x11, 0x60, 0x69, 0x4c, 0x5b, 0x32, 0x95, 0x4d, 0x09, 0x37, 0xc0, 0xed, 0x88,
-	0x13, 0x2c, 0x29, 0x2b, 0x82, 0x94, 0xd0, 0x24, 0x95, 0xca, 0xbb, 0xba, 0x7f, 0xeb, 0xa2, 0xfc,
-	0x5c, 0x55, 0xbb, 0x9f, 0x00, 0x7c, 0x81, 0x85, 0x9c, 0xfe, 0xfa, 0xda, 0xf1, 0x59, 0x6b, 0xac,
-	0x7f, 0x62, 0xcd, 0x2a, 0x58, 0xd0, 0xf9, 0xda, 0x6a, 0x1b, 0x5a, 0x74, 0xbf, 0xd8, 0xe0, 0xde,
-	0xd3, 0xe9, 0xef, 0xf6, 0xea, 0xb0, 0x20, 0x5c, 0xa4, 0x74, 0xf4, 0x1f, 0xfa, 0xef, 0x80, 0x05,
-	0x56, 0xe1, 0x6f, 0x96, 0xb4, 0x66, 0xc0, 0x2d, 0xd0, 0x34, 0x37, 0x6c, 0xae, 0x7b, 0x6b, 0x16,
-	0x5f, 0x17, 0x52, 0xe3, 0xba, 0x90, 0x86, 0x3b, 0x27, 0x13, 0xd7, 0x3a, 0x9d, 0xb8, 0xd6, 0xef,
-	0x89, 0x6b, 0x7d, 0x3e, 0x77, 0x6b, 0xa7, 0xe7, 0x6e, 0xed, 0xe7, 0xb9, 0x5b, 0xdb, 0x1f, 0xfc,
-	0x75, 0xff, 0x47, 0x57, 0xdf, 0x5e, 0x75, 0x9c, 0xb0, 0xa9, 0x1e, 0xbc, 0xc7, 0x7f, 0x02, 0x00,
-	0x00, 0xff, 0xff, 0xf0, 0x84, 0xdd, 0x95, 0xa0, 0x05, 0x00, 0x00,
+	// 929 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x96, 0xcd, 0x6e, 0xdb, 0x46,
+	0x10, 0xc7, 0x45, 0x5a, 0x96, 0xad, 0x8d, 0xeb, 0xba, 0xdb, 0xc0, 0x51, 0x94, 0x58, 0x56, 0x8c,
+	0x02, 0x31, 0x5a, 0x58, 0x84, 0x5b, 0xf4, 0x58, 0x14, 0xb2, 0xc5, 0x24, 0x42, 0x52, 0x45, 0xa6,
+	0x2c, 0x17, 0x70, 0x0f, 0xc4, 0x8a, 0x1c, 0x91, 0x5b, 0x93, 0x5c, 0x82, 0xbb, 0xa2, 0x65, 0xa0,
+	0x0f, 0x50, 0xf8, 0xd4, 0x17, 0xf0, 0xa9, 0xed, 0xa1, 0xf7, 0x3e, 0x44, 0x8e, 0x41, 0x4f, 0x45,
+	0x0f, 0x46, 0x61, 0x1f, 0xfa, 0x16, 0x45, 0xc1, 0xe5, 0xda, 0x51, 0x60, 0x25, 0x07, 0xa5, 0xb9,
+	0xe9, 0x3f, 0xc3, 0xf9, 0xcd, 0x72, 0x3e, 0x96, 0x42, 0x0f, 0x43, 0x2a, 0x18, 0xa7, 0xdc, 0x80,
+	0x34, 0x4c, 0x49, 0x40, 0x5d, 0x22, 0x58, 0x62, 0xa4, 0xdb, 0xc6, 0xb5, 0x68, 0xc4, 0x09, 0x13,
+	0x0c, 0xdf, 0x51, 0x0f, 0x36, 0x26, 0x1f, 0x6c, 0xa4, 0xdb, 0xd5, 0x9a, 0xc3, 0x78, 0xc8, 0xb8,
+	0x31, 0x20, 0x1c, 0x8c, 0x74, 0x7b, 0x00, 0x82, 0x6c, 0x1b, 0x0e, 0xa3, 0x51, 0x1e, 0x58, 0xbd,
+	0x9b, 0xfb, 0x6d, 0xa9, 0x8c, 0x5c, 0x28, 0xd7, 0x6d, 0x8f, 0x79, 0x2c, 0xb7, 0x67, 0xbf, 0xae,
+	0x02, 0x3c, 0xc6, 0xbc, 0x00, 0x0c, 0xa9, 0x06, 0xa3, 0xa1, 0x41, 0xa2, 0x93, 0xdc, 0xb5, 0xf1,
+	0x6b, 0x11, 0x95, 0x0f, 0xae, 0x92, 0xe3, 0x36, 0x2a, 0x12, 0xd7, 0x4d, 0x2a, 0x5a, 0x5d, 0xdb,
+	0x5c, 0xda, 0xf9, 0xf2, 0xc5, 0xf9, 0x7a, 0xe1, 0xaf, 0xf3, 0xf5, 0x2d, 0x8f, 0x0a, 0x7f, 0x34,
+	0x68, 0x38, 0x2c, 0x34, 0xd4, 0x99, 0xb7, 0x58, 0xe2, 0x19, 0x8e, 0x4f, 0x68, 0x64, 0x88, 0x93,
+	0x18, 0x78, 0xc3, 0x14, 0x7e, 0xd3, 0x75, 0x13, 0xe0, 0xdc, 0x92, 0x08, 0xbc, 0x8a, 0x4a, 0xf1,
+	0x68, 0x70, 0x04, 0x27, 0x15, 0x3d, 0x83, 0x59, 0x4a, 0xe1, 0x6f, 0x10, 0x72, 0x58, 0x10, 0x10,
+	0x01, 0x09, 0x09, 0x2a, 0x73, 0x75, 0x6d, 0xb3, 0xbc, 0xb3, 0xa5, 0x12, 0xad, 0xe6, 0xef, 0xc2,
+	0xdd, 0xa3, 0x06, 0x65, 0x46, 0x48, 0x84, 0xdf, 0xe8, 0xd3, 0x48, 0xfc, 0xf1, 0xfb, 0xd6, 0x2d,
+	0xf5, 0x96, 0x99, 0xb4, 0x26, 0x00, 0xf8, 0x10, 0x7d, 0xf4, 0x4a, 0xd9, 0xdc, 0x27, 0x09, 0xf0,
+	0xca, 0xe2, 0x2c, 0xd4, 0x95, 0x57, 0x9c, 0x9e, 0xc4, 0xe0, 0xef, 0x10, 0x86, 0xb1, 0x48, 0x88,
+	0x9d, 0x32, 0x41, 0x23, 0xcf, 0x8e, 0xd9, 0x31, 0x24, 0x95, 0xe2, 0x4c, 0x70, 0x09, 0x3a, 0x90,
+	0x9c, 0x6e, 0x86, 0xc1, 0x0f, 0xd0, 0xd2, 0x6b, 0xd8, 0xf9, 0xba, 0xb6, 0x39, 0x67, 0xdd, 0x4a,
+	0x27, 0x1e, 0x59, 0x45, 0xa5, 0xef, 0x09, 0x0d, 0xc0, 0xad, 0x94, 0xea, 0xda, 0xe6, 0xa2, 0xa5,
+	0x54, 0x66, 0x1f, 0xb0, 0xc8, 0x05, 0xb7, 0xb2, 0x90, 0xdb, 0x73, 0x85, 0x0f, 0xd1, 0x07, 0x43,
+	0x00, 0x3b, 0x01, 0x87, 0xc6, 0x14, 0x22, 0x51, 0x29, 0xbf, 0x4b, 0x1b, 0x97, 0x86, 0x00, 0xd6,
+	0x15, 0x6a, 0xe3, 0x37, 0x1d, 0xa1, 0x6f, 0xa9, 0xf0, 0xdd, 0x84, 0x1c, 0x93, 0x00, 0xaf, 0x22,
+	0x9d, 0xba, 0x72, 0x4c, 0x8a, 0x3b, 0xa5, 0x8b, 0xf3, 0x75, 0xbd, 0xdd, 0xb2, 0x74, 0xea, 0xe2,
+	0x2e, 0x5a, 0x4c, 0x49, 0x60, 0xcb, 0x21, 0xd2, 0xdf, 0x25, 0xfb, 0x42, 0x4a, 0x82, 0xa6, 0x9a,
+	0x23, 0x12, 0xb2, 0x51, 0x24, 0xe4, 0xac, 0x14, 0x2d, 0xa5, 0xf0, 0x1e, 0x5a, 0x4c, 0xc0, 0x01,
+	0x9a, 0xaa, 0x96, 0xcc, 0x9c, 0xe9, 0x1a, 0x83, 0xd7, 0x10, 0x0a, 0x89, 0x18, 0x25, 0xc0, 0x6d,
+	0x22, 0x54, 0x43, 0xca, 0xca, 0xd2, 0x14, 0xf8, 0x21, 0xfa, 0xd0, 0x49, 0x80, 0x08, 0xca, 0x22,
+	0xdb, 0x07, 0xea, 0xf9, 0x42, 0xf6, 0x65, 0xce, 0x5a, 0xbe, 0x32, 0x3f, 0x91, 0xd6, 0x8d, 0x1f,
+	0x10, 0x7e, 0x46, 0xb8, 0xb8, 0x5e, 0xab, 0xbc, 0x9b, 0x93, 0xa5, 0xd1, 0xfe, 0x97, 0xd2, 0xdc,
+	0x46, 0xf3, 0xf9, 0xec, 0xe8, 0xf2, 0x18, 0xb9, 0xd8, 0xf8, 0x45, 0x47, 0x1f, 0xef, 0x5e, 0x8f,
+	0xf2, 0xf3, 0xe3, 0x08, 0x12, 0xee, 0xd3, 0xf8, 0x3d, 0xe4, 0x7f, 0x8a, 0xe6, 0xd9, 0x71, 0xa4,
+	0xf2, 0xcf, 0x8c, 0xcb, 0x19, 0xd8, 0x44, 0x25, 0xb5, 0xbd, 0x33, 0xdd, 0x09, 0x2a, 0x78, 0x5a,
+	0x93, 0x8a, 0x53, 0x9b, 0xf4, 0xaf, 0x86, 0x96, 0xcc, 0x14, 0x22, 0x61, 0x65, 0xed, 0x8f, 0x05,
+	0xfe, 0x04, 0x2d, 0x43, 0x1a, 0xda, 0x83, 0x80, 0x39, 0x47, 0xb6, 0x4f, 0xb8, 0x2f, 0xab, 0x54,
+	0xb6, 0x96, 0x20, 0x0d, 0x77, 0x32, 0xe3, 0x13, 0xc2, 0x7d, 0x7c, 0x07, 0x2d, 0x88, 0x71, 0xee,
+	0xd6, 0xa5, 0xbb, 0x24, 0xc6, 0xd2, 0x71, 0x0f, 0x95, 0x03, 0xe6, 0xd9, 0x34, 0x72, 0x61, 0xac,
+	0x46, 0x75, 0x31, 0x60, 0x5e, 0x3b, 0xd3, 0xd9, 0x64, 0x41, 0x96, 0xcb, 0x8e, 0x48, 0x08, 0xf9,
+	0x0d, 0x62, 0x95, 0xa5, 0xa5, 0x43, 0x42, 0xc0, 0xbb, 0xa8, 0xc4, 0x05, 0x11, 0x23, 0x2e, 0x87,
+	0x6e, 0xf9, 0xf3, 0xcf, 0x1a, 0x6f, 0xf8, 0x34, 0x34, 0x26, 0x4f, 0xdc, 0x93, 0x21, 0x96, 0x0a,
+	0xcd, 0x16, 0x25, 0x01, 0xc2, 0x59, 0x24, 0xa7, 0xb2, 0x6c, 0x29, 0x95, 0xd9, 0x55, 0x21, 0x16,
+	0x64, 0x21, 0x94, 0xfa, 0xf4, 0x1f, 0x1d, 0xe1, 0x9b, 0x38, 0xfc, 0x18, 0xd5, 0xcd, 0x03, 0xb3,
+	0xb3, 0x6f, 0x5b, 0xe6, 0xae, 0xd9, 0xee, 0xee, 0xdb, 0xbd, 0xfd, 0xe6, 0x7e, 0xbf, 0x67, 0xf7,
+	0x3b, 0xbd, 0xae, 0xb9, 0xdb, 0x7e, 0xd4, 0x36, 0x5b, 0x2b, 0x85, 0xea, 0x83, 0xd3, 0xb3, 0xfa,
+	0xda, 0xcd, 0xe8, 0x7e, 0xc4, 0x63, 0x70, 0xe8, 0x90, 0x82, 0x8b, 0xbf, 0x46, 0xf7, 0xa7, 0x82,
+	0x9a, 0xdd, 0xee, 0xb3, 0x0c, 0xa2, 0x55, 0xd7, 0x4e, 0xcf, 0xea, 0x77, 0x6f, 0x42, 0x9a, 0x71,
+	0x1c, 0xbc, 0x0d, 0xd0, 0x7e, 0xdc, 0x79, 0x6e, 0x99, 0xad, 0x15, 0xfd, 0x4d, 0x80, 0xb6, 0x17,
+	0xb1, 0x04, 0x5c, 0xdc, 0x44, 0x6b, 0x53, 0x01, 0x96, 0xf9, 0xa8, 0xdf, 0x69, 0x99, 0xad, 0x95,
+	0xb9, 0x6a, 0xed, 0xf4, 0xac, 0x5e, 0x9d, 0x52, 0x54, 0x18, 0x8e, 0xe4, 0x95, 0xfa, 0x15, 0xba,
+	0x37, 0x15, 0xb1, 0xd7, 0x37, 0xfb, 0x66, 0x6b, 0xa5, 0x58, 0xbd, 0x7f, 0x7a, 0x56, 0xaf, 0xdc,
+	0x04, 0xec, 0x8d, 0x60, 0x04, 0x6e, 0xb5, 0xf8, 0xe3, 0xcf, 0xb5, 0xc2, 0xce, 0xd3, 0x17, 0x17,
+	0x35, 0xed, 0xe5, 0x45, 0x4d, 0xfb, 0xfb, 0xa2, 0xa6, 0xfd, 0x74, 0x59, 0x2b, 0xbc, 0xbc, 0xac,
+	0x15, 0xfe, 0xbc, 0xac, 0x15, 0x0e, 0xb7, 0xdf, 0xba, 0x2a, 0xe3, 0xd7, 0xff, 0x42, 0xc8, 0xcd,
+	0x19, 0x94, 0xe4, 0x77, 0xfb, 0x8b, 0xff, 0x06, 0x00, 0x95, 0x38, 0xd4, 0x0f, 0x67, 0x08, 0x00,
+	0x00,
 }
 
 func (m *Validator) Marshal() (dAtA []byte, err error) {
@@ -362,6 +532,16 @@ func (m *Validator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.FeeRecipient.Size()
+		i -= size
+		if _, err := m.FeeRecipient.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintValidator(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
 	{
 		size := m.CollateralShares.Size()
 		i -= size
@@ -596,6 +776,72 @@ func (m *CollateralOwnership) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *EventReceipt) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventReceipt) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventReceipt) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintValidator(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintValidator(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Status != 0 {
+		i = encodeVarintValidator(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.EventName) > 0 {
+		i -= len(m.EventName)
+		copy(dAtA[i:], m.EventName)
+		i = encodeVarintValidator(dAtA, i, uint64(len(m.EventName)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.LogIndex != 0 {
+		i = encodeVarintValidator(dAtA, i, uint64(m.LogIndex))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.TxHash) > 0 {
+		i -= len(m.TxHash)
+		copy(dAtA[i:], m.TxHash)
+		i = encodeVarintValidator(dAtA, i, uint64(len(m.TxHash)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.EvmBlockHash) > 0 {
+		i -= len(m.EvmBlockHash)
+		copy(dAtA[i:], m.EvmBlockHash)
+		i = encodeVarintValidator(dAtA, i, uint64(len(m.EvmBlockHash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintValidator(dAtA []byte, offset int, v uint64) int {
 	offset -= sovValidator(v)
 	base := offset
@@ -634,6 +880,8 @@ func (m *Validator) Size() (n int) {
 	}
 	l = m.CollateralShares.Size()
 	n += 1 + l + sovValidator(uint64(l))
+	l = m.FeeRecipient.Size()
+	n += 1 + l + sovValidator(uint64(l))
 	return n
 }
 
@@ -694,6 +942,40 @@ func (m *CollateralOwnership) Size() (n int) {
 	return n
 }
 
+func (m *EventReceipt) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.EvmBlockHash)
+	if l > 0 {
+		n += 1 + l + sovValidator(uint64(l))
+	}
+	l = len(m.TxHash)
+	if l > 0 {
+		n += 1 + l + sovValidator(uint64(l))
+	}
+	if m.LogIndex != 0 {
+		n += 1 + sovValidator(uint64(m.LogIndex))
+	}
+	l = len(m.EventName)
+	if l > 0 {
+		n += 1 + l + sovValidator(uint64(l))
+	}
+	if m.Status != 0 {
+		n += 1 + sovValidator(uint64(m.Status))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovValidator(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovValidator(uint64(m.Height))
+	}
+	return n
+}
+
 func sovValidator(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -957,6 +1239,39 @@ func (m *Validator) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeRecipient", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValidator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthValidator
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthValidator
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FeeRecipient.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipValidator(dAtA[iNdEx:])
@@ -1441,6 +1756,241 @@ func (m *CollateralOwnership) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *EventReceipt) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowValidator
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventReceipt: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventReceipt: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EvmBlockHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValidator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthValidator
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthValidator
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EvmBlockHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValidator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthValidator
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthValidator
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LogIndex", wireType)
+			}
+			m.LogIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValidator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LogIndex |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EventName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValidator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthValidator
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthValidator
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EventName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValidator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= EventReceiptStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValidator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthValidator
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthValidator
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValidator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipValidator(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthValidator
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipValidator(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0